@@ -3,6 +3,7 @@ package cmd
 import (
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/worker"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/db/postgres"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
 	"github.com/spf13/cobra"
 )
@@ -18,7 +19,21 @@ var workerCmd = &cobra.Command{
 		appLogger.InitLogger()
 		appLogger.Infof("AppVersion: %s, LogLevel: %s, Mode: %s", cfg.Server.AppVersion, cfg.Logger.Level, cfg.Server.Mode)
 
-		server, err := worker.NewTaskProcessor(cfg, appLogger)
+		psqlClient, err := postgres.NewPsqlClient(cfg)
+		if err != nil {
+			appLogger.Fatalf("Postgresql init: %s", err)
+		} else {
+			appLogger.Infof("Postgres connected")
+		}
+		defer postgres.Close(psqlClient) //nolint:errcheck
+
+		scheduler := worker.NewTaskScheduler(cfg, appLogger)
+		if err := scheduler.Start(); err != nil {
+			appLogger.Fatal(err)
+		}
+		defer scheduler.Shutdown()
+
+		server, err := worker.NewTaskProcessor(cfg, appLogger, psqlClient)
 		if err != nil {
 			appLogger.Fatal(err)
 		}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -16,6 +17,8 @@ var (
 
 type Config struct {
 	Server         ServerConfig
+	Api            ApiConfig
+	Grpc           GrpcConfig
 	Postgres       PostgresConfig
 	Redis          RedisConfig
 	Jwt            JwtConfig
@@ -24,6 +27,15 @@ type Config struct {
 	SmtpEmail      SmtpEmailConfig
 	Email          EmailConfig
 	TaskRedis      TaskRedisConfig
+	Trash          TrashConfig
+	Storage        StorageConfig
+	Workflow       WorkflowConfig
+	Reminder       ReminderConfig
+	Calendar       CalendarConfig
+	Hierarchy      HierarchyConfig
+	Quota          QuotaConfig
+	Idempotency    IdempotencyConfig
+	Graphql        GraphqlConfig
 }
 
 type ServerConfig struct {
@@ -35,6 +47,53 @@ type ServerConfig struct {
 	WriteTimeout   int
 }
 
+// ApiConfig is the versions of the REST api. Deprecations are the deprecated
+// routes, they answer with the Deprecation and Sunset headers.
+type ApiConfig struct {
+	Deprecations []DeprecationConfig
+}
+
+// DeprecationConfig is a deprecated route of a version of the api, the legacy
+// version is the /api alias of v1. Route is a route pattern of the version,
+// e.g. /item/{id}, or empty for every route, Method is empty for every method.
+// Date is when the route was deprecated and Sunset when it will be removed,
+// both RFC 3339 times, Link is the documentation of the deprecation.
+type DeprecationConfig struct {
+	Version string
+	Method  string
+	Route   string
+	Date    string
+	Sunset  string
+	Link    string
+}
+
+// Times returns Date and Sunset, Sunset is zero when it is empty.
+func (c DeprecationConfig) Times() (time.Time, time.Time, error) {
+	date, err := time.Parse(time.RFC3339, c.Date)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	var sunset time.Time
+	if c.Sunset != "" {
+		sunset, err = time.Parse(time.RFC3339, c.Sunset)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	return date, sunset, nil
+}
+
+// GrpcConfig is the gRPC server of the grpc command, Reflection registers the
+// server reflection service for tools like grpcurl. GatewayPort serves the
+// REST gateway of the services, it is disabled when empty.
+type GrpcConfig struct {
+	Port        string
+	Reflection  bool
+	GatewayPort string
+}
+
 type Logger struct {
 	Encoding string
 	Level    string
@@ -62,6 +121,26 @@ type TaskRedisConfig struct {
 	Db   int
 }
 
+type TrashConfig struct {
+	RetentionDays int
+	PurgeCron     string
+}
+
+type StorageConfig struct {
+	Driver               string
+	MaxUploadSize        int64
+	PresignExpireMinutes int
+	LocalPath            string
+	LocalBaseUrl         string
+	LocalSecretKey       string
+	S3Endpoint           string
+	S3AccessKey          string
+	S3SecretKey          string
+	S3Bucket             string
+	S3Region             string
+	S3UseSsl             bool
+}
+
 type JwtConfig struct {
 	SecretKey                  string
 	Issuer                     string
@@ -79,6 +158,96 @@ type FirstSuperUserConfig struct {
 	Password string
 }
 
+// WorkflowConfig is the status workflow of items. Items are created with the
+// Initial status and change status with the transitions.
+type WorkflowConfig struct {
+	Initial     string
+	Transitions []WorkflowTransitionConfig
+}
+
+// WorkflowTransitionConfig is a transition of the workflow. Allowed holds roles:
+// owner, editor, viewer (the permission of a shared item) and superuser, Notify
+// the item roles whose users are emailed after the transition.
+type WorkflowTransitionConfig struct {
+	Name    string
+	From    []string
+	To      string
+	Allowed []string
+	Notify  []string
+}
+
+// ReminderConfig is the reminder of the items with a due date, sent Before
+// minutes before the due date to the users with a Notify role on the item
+// (owner, editor, viewer).
+type ReminderConfig struct {
+	Before int
+	Notify []string
+}
+
+// CalendarConfig is the iCalendar feed of the items with a due date. FeedUrl
+// is the public url of the feed route, items due more than PastDays ago are
+// left out.
+type CalendarConfig struct {
+	FeedUrl  string
+	PastDays int
+}
+
+// HierarchyConfig is the item tree. MaxDepth is the most levels a tree can
+// have, OnDelete what happens to the children of a deleted item: cascade
+// deletes them as well, reparent moves them to the parent of the deleted item.
+type HierarchyConfig struct {
+	MaxDepth int
+	OnDelete string
+}
+
+// QuotaConfig is the plans limiting what users can do, the users without a plan
+// have the DefaultPlan.
+type QuotaConfig struct {
+	DefaultPlan string
+	Plans       []PlanConfig
+}
+
+// PlanConfig is a plan: the items a user can own, the bytes of the attachments
+// a user can upload and the API calls a user can make per UTC day. A limit of
+// 0 is unlimited.
+type PlanConfig struct {
+	Name        string
+	MaxItems    int64
+	MaxStorage  int64
+	MaxApiCalls int64
+}
+
+// Plan returns the plan named name, the default plan when name is empty.
+func (c QuotaConfig) Plan(name string) (PlanConfig, bool) {
+	if name == "" {
+		name = c.DefaultPlan
+	}
+	for _, plan := range c.Plans {
+		if plan.Name == name {
+			return plan, true
+		}
+	}
+	return PlanConfig{}, false
+}
+
+// IdempotencyConfig is the replay of the requests sent with an Idempotency-Key
+// header. The responses are kept Ttl seconds, a request still running after
+// LockTtl seconds, e.g. of a crashed server, can be retried.
+type IdempotencyConfig struct {
+	Ttl     int
+	LockTtl int
+}
+
+// GraphqlConfig is the limits of the GraphQL api. MaxDepth is the deepest
+// nesting of fields, MaxComplexity the largest cost of a query, a connection
+// costing its children once per node of its page. Introspection enables the
+// schema queries.
+type GraphqlConfig struct {
+	MaxDepth      int
+	MaxComplexity int
+	Introspection bool
+}
+
 type EmailConfig struct {
 	From                string
 	Name                string
@@ -87,6 +256,9 @@ type EmailConfig struct {
 	Copyright           string
 	VerificationSubject string
 	ResetSubject        string
+	MentionSubject      string
+	TransitionSubject   string
+	ReminderSubject     string
 }
 
 type SmtpEmailConfig struct {
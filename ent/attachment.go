@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// Attachment is the model entity for the Attachment schema.
+type Attachment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uint `json:"item_id,omitempty"`
+	// UploaderID holds the value of the "uploader_id" field.
+	UploaderID *uint `json:"uploader_id,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// ProcessingStatus holds the value of the "processing_status" field.
+	ProcessingStatus *attachment.ProcessingStatus `json:"processing_status,omitempty"`
+	// Width holds the value of the "width" field.
+	Width *int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height *int `json:"height,omitempty"`
+	// Thumbnails holds the value of the "thumbnails" field.
+	Thumbnails []models.ItemAttachmentThumbnail `json:"thumbnails,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentQuery when eager-loading is set.
+	Edges        AttachmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttachmentEdges holds the relations/edges for other nodes in the graph.
+type AttachmentEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Uploader holds the value of the uploader edge.
+	Uploader *User `json:"uploader,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// UploaderOrErr returns the Uploader value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) UploaderOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.Uploader == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Uploader, nil
+	}
+	return nil, &NotLoadedError{edge: "uploader"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Attachment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attachment.FieldThumbnails:
+			values[i] = new([]byte)
+		case attachment.FieldID, attachment.FieldItemID, attachment.FieldUploaderID, attachment.FieldSize, attachment.FieldWidth, attachment.FieldHeight:
+			values[i] = new(sql.NullInt64)
+		case attachment.FieldFilename, attachment.FieldContentType, attachment.FieldChecksum, attachment.FieldStorageKey, attachment.FieldProcessingStatus:
+			values[i] = new(sql.NullString)
+		case attachment.FieldCreateTime, attachment.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Attachment fields.
+func (a *Attachment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attachment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = uint(value.Int64)
+		case attachment.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				a.CreateTime = value.Time
+			}
+		case attachment.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				a.UpdateTime = value.Time
+			}
+		case attachment.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				a.ItemID = uint(value.Int64)
+			}
+		case attachment.FieldUploaderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uploader_id", values[i])
+			} else if value.Valid {
+				a.UploaderID = new(uint)
+				*a.UploaderID = uint(value.Int64)
+			}
+		case attachment.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				a.Filename = value.String
+			}
+		case attachment.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				a.ContentType = value.String
+			}
+		case attachment.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				a.Size = value.Int64
+			}
+		case attachment.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				a.Checksum = value.String
+			}
+		case attachment.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				a.StorageKey = value.String
+			}
+		case attachment.FieldProcessingStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field processing_status", values[i])
+			} else if value.Valid {
+				a.ProcessingStatus = new(attachment.ProcessingStatus)
+				*a.ProcessingStatus = attachment.ProcessingStatus(value.String)
+			}
+		case attachment.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				a.Width = new(int)
+				*a.Width = int(value.Int64)
+			}
+		case attachment.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				a.Height = new(int)
+				*a.Height = int(value.Int64)
+			}
+		case attachment.FieldThumbnails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnails", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Thumbnails); err != nil {
+					return fmt.Errorf("unmarshal field thumbnails: %w", err)
+				}
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Attachment.
+// This includes values selected through modifiers, order, etc.
+func (a *Attachment) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the Attachment entity.
+func (a *Attachment) QueryItem() *ItemQuery {
+	return NewAttachmentClient(a.config).QueryItem(a)
+}
+
+// QueryUploader queries the "uploader" edge of the Attachment entity.
+func (a *Attachment) QueryUploader() *UserQuery {
+	return NewAttachmentClient(a.config).QueryUploader(a)
+}
+
+// Update returns a builder for updating this Attachment.
+// Note that you need to call Attachment.Unwrap() before calling this method if this Attachment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Attachment) Update() *AttachmentUpdateOne {
+	return NewAttachmentClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Attachment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Attachment) Unwrap() *Attachment {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Attachment is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Attachment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Attachment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.ItemID))
+	builder.WriteString(", ")
+	if v := a.UploaderID; v != nil {
+		builder.WriteString("uploader_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(a.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(a.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", a.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(a.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(a.StorageKey)
+	builder.WriteString(", ")
+	if v := a.ProcessingStatus; v != nil {
+		builder.WriteString("processing_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.Width; v != nil {
+		builder.WriteString("width=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.Height; v != nil {
+		builder.WriteString("height=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("thumbnails=")
+	builder.WriteString(fmt.Sprintf("%v", a.Thumbnails))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Attachments is a parsable slice of Attachment.
+type Attachments []*Attachment
@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// AttachmentCreate is the builder for creating a Attachment entity.
+type AttachmentCreate struct {
+	config
+	mutation *AttachmentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ac *AttachmentCreate) SetCreateTime(t time.Time) *AttachmentCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableCreateTime(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ac *AttachmentCreate) SetUpdateTime(t time.Time) *AttachmentCreate {
+	ac.mutation.SetUpdateTime(t)
+	return ac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableUpdateTime(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetUpdateTime(*t)
+	}
+	return ac
+}
+
+// SetItemID sets the "item_id" field.
+func (ac *AttachmentCreate) SetItemID(u uint) *AttachmentCreate {
+	ac.mutation.SetItemID(u)
+	return ac
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (ac *AttachmentCreate) SetUploaderID(u uint) *AttachmentCreate {
+	ac.mutation.SetUploaderID(u)
+	return ac
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableUploaderID(u *uint) *AttachmentCreate {
+	if u != nil {
+		ac.SetUploaderID(*u)
+	}
+	return ac
+}
+
+// SetFilename sets the "filename" field.
+func (ac *AttachmentCreate) SetFilename(s string) *AttachmentCreate {
+	ac.mutation.SetFilename(s)
+	return ac
+}
+
+// SetContentType sets the "content_type" field.
+func (ac *AttachmentCreate) SetContentType(s string) *AttachmentCreate {
+	ac.mutation.SetContentType(s)
+	return ac
+}
+
+// SetSize sets the "size" field.
+func (ac *AttachmentCreate) SetSize(i int64) *AttachmentCreate {
+	ac.mutation.SetSize(i)
+	return ac
+}
+
+// SetChecksum sets the "checksum" field.
+func (ac *AttachmentCreate) SetChecksum(s string) *AttachmentCreate {
+	ac.mutation.SetChecksum(s)
+	return ac
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (ac *AttachmentCreate) SetStorageKey(s string) *AttachmentCreate {
+	ac.mutation.SetStorageKey(s)
+	return ac
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (ac *AttachmentCreate) SetProcessingStatus(as attachment.ProcessingStatus) *AttachmentCreate {
+	ac.mutation.SetProcessingStatus(as)
+	return ac
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableProcessingStatus(as *attachment.ProcessingStatus) *AttachmentCreate {
+	if as != nil {
+		ac.SetProcessingStatus(*as)
+	}
+	return ac
+}
+
+// SetWidth sets the "width" field.
+func (ac *AttachmentCreate) SetWidth(i int) *AttachmentCreate {
+	ac.mutation.SetWidth(i)
+	return ac
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableWidth(i *int) *AttachmentCreate {
+	if i != nil {
+		ac.SetWidth(*i)
+	}
+	return ac
+}
+
+// SetHeight sets the "height" field.
+func (ac *AttachmentCreate) SetHeight(i int) *AttachmentCreate {
+	ac.mutation.SetHeight(i)
+	return ac
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableHeight(i *int) *AttachmentCreate {
+	if i != nil {
+		ac.SetHeight(*i)
+	}
+	return ac
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (ac *AttachmentCreate) SetThumbnails(mat []models.ItemAttachmentThumbnail) *AttachmentCreate {
+	ac.mutation.SetThumbnails(mat)
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AttachmentCreate) SetID(u uint) *AttachmentCreate {
+	ac.mutation.SetID(u)
+	return ac
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (ac *AttachmentCreate) SetItem(i *Item) *AttachmentCreate {
+	return ac.SetItemID(i.ID)
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (ac *AttachmentCreate) SetUploader(u *User) *AttachmentCreate {
+	return ac.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (ac *AttachmentCreate) Mutation() *AttachmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Attachment in the database.
+func (ac *AttachmentCreate) Save(ctx context.Context) (*Attachment, error) {
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*Attachment, AttachmentMutation](ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AttachmentCreate) SaveX(ctx context.Context) *Attachment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AttachmentCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AttachmentCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AttachmentCreate) defaults() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		if attachment.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized attachment.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := attachment.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		if attachment.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized attachment.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := attachment.DefaultUpdateTime()
+		ac.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AttachmentCreate) check() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Attachment.create_time"`)}
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Attachment.update_time"`)}
+	}
+	if _, ok := ac.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "Attachment.item_id"`)}
+	}
+	if _, ok := ac.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "Attachment.filename"`)}
+	}
+	if v, ok := ac.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Attachment.content_type"`)}
+	}
+	if _, ok := ac.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Attachment.size"`)}
+	}
+	if v, ok := ac.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "Attachment.checksum"`)}
+	}
+	if _, ok := ac.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Attachment.storage_key"`)}
+	}
+	if v, ok := ac.mutation.ProcessingStatus(); ok {
+		if err := attachment.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.processing_status": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "Attachment.item"`)}
+	}
+	return nil
+}
+
+func (ac *AttachmentCreate) sqlSave(ctx context.Context) (*Attachment, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Attachment{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(attachment.Table, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.SetField(attachment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ac.mutation.UpdateTime(); ok {
+		_spec.SetField(attachment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ac.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := ac.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := ac.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ac.mutation.Checksum(); ok {
+		_spec.SetField(attachment.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := ac.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := ac.mutation.ProcessingStatus(); ok {
+		_spec.SetField(attachment.FieldProcessingStatus, field.TypeEnum, value)
+		_node.ProcessingStatus = &value
+	}
+	if value, ok := ac.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+		_node.Width = &value
+	}
+	if value, ok := ac.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+		_node.Height = &value
+	}
+	if value, ok := ac.mutation.Thumbnails(); ok {
+		_spec.SetField(attachment.FieldThumbnails, field.TypeJSON, value)
+		_node.Thumbnails = value
+	}
+	if nodes := ac.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.ItemTable,
+			Columns: []string{attachment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UploaderID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttachmentCreateBulk is the builder for creating many Attachment entities in bulk.
+type AttachmentCreateBulk struct {
+	config
+	builders []*AttachmentCreate
+}
+
+// Save creates the Attachment entities in the database.
+func (acb *AttachmentCreateBulk) Save(ctx context.Context) ([]*Attachment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Attachment, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) SaveX(ctx context.Context) []*Attachment {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
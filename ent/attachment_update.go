@@ -0,0 +1,854 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttachmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (au *AttachmentUpdate) SetUpdateTime(t time.Time) *AttachmentUpdate {
+	au.mutation.SetUpdateTime(t)
+	return au
+}
+
+// SetItemID sets the "item_id" field.
+func (au *AttachmentUpdate) SetItemID(u uint) *AttachmentUpdate {
+	au.mutation.SetItemID(u)
+	return au
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (au *AttachmentUpdate) SetUploaderID(u uint) *AttachmentUpdate {
+	au.mutation.SetUploaderID(u)
+	return au
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableUploaderID(u *uint) *AttachmentUpdate {
+	if u != nil {
+		au.SetUploaderID(*u)
+	}
+	return au
+}
+
+// ClearUploaderID clears the value of the "uploader_id" field.
+func (au *AttachmentUpdate) ClearUploaderID() *AttachmentUpdate {
+	au.mutation.ClearUploaderID()
+	return au
+}
+
+// SetFilename sets the "filename" field.
+func (au *AttachmentUpdate) SetFilename(s string) *AttachmentUpdate {
+	au.mutation.SetFilename(s)
+	return au
+}
+
+// SetContentType sets the "content_type" field.
+func (au *AttachmentUpdate) SetContentType(s string) *AttachmentUpdate {
+	au.mutation.SetContentType(s)
+	return au
+}
+
+// SetSize sets the "size" field.
+func (au *AttachmentUpdate) SetSize(i int64) *AttachmentUpdate {
+	au.mutation.ResetSize()
+	au.mutation.SetSize(i)
+	return au
+}
+
+// AddSize adds i to the "size" field.
+func (au *AttachmentUpdate) AddSize(i int64) *AttachmentUpdate {
+	au.mutation.AddSize(i)
+	return au
+}
+
+// SetChecksum sets the "checksum" field.
+func (au *AttachmentUpdate) SetChecksum(s string) *AttachmentUpdate {
+	au.mutation.SetChecksum(s)
+	return au
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (au *AttachmentUpdate) SetProcessingStatus(as attachment.ProcessingStatus) *AttachmentUpdate {
+	au.mutation.SetProcessingStatus(as)
+	return au
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableProcessingStatus(as *attachment.ProcessingStatus) *AttachmentUpdate {
+	if as != nil {
+		au.SetProcessingStatus(*as)
+	}
+	return au
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (au *AttachmentUpdate) ClearProcessingStatus() *AttachmentUpdate {
+	au.mutation.ClearProcessingStatus()
+	return au
+}
+
+// SetWidth sets the "width" field.
+func (au *AttachmentUpdate) SetWidth(i int) *AttachmentUpdate {
+	au.mutation.ResetWidth()
+	au.mutation.SetWidth(i)
+	return au
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableWidth(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetWidth(*i)
+	}
+	return au
+}
+
+// AddWidth adds i to the "width" field.
+func (au *AttachmentUpdate) AddWidth(i int) *AttachmentUpdate {
+	au.mutation.AddWidth(i)
+	return au
+}
+
+// ClearWidth clears the value of the "width" field.
+func (au *AttachmentUpdate) ClearWidth() *AttachmentUpdate {
+	au.mutation.ClearWidth()
+	return au
+}
+
+// SetHeight sets the "height" field.
+func (au *AttachmentUpdate) SetHeight(i int) *AttachmentUpdate {
+	au.mutation.ResetHeight()
+	au.mutation.SetHeight(i)
+	return au
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableHeight(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetHeight(*i)
+	}
+	return au
+}
+
+// AddHeight adds i to the "height" field.
+func (au *AttachmentUpdate) AddHeight(i int) *AttachmentUpdate {
+	au.mutation.AddHeight(i)
+	return au
+}
+
+// ClearHeight clears the value of the "height" field.
+func (au *AttachmentUpdate) ClearHeight() *AttachmentUpdate {
+	au.mutation.ClearHeight()
+	return au
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (au *AttachmentUpdate) SetThumbnails(mat []models.ItemAttachmentThumbnail) *AttachmentUpdate {
+	au.mutation.SetThumbnails(mat)
+	return au
+}
+
+// AppendThumbnails appends mat to the "thumbnails" field.
+func (au *AttachmentUpdate) AppendThumbnails(mat []models.ItemAttachmentThumbnail) *AttachmentUpdate {
+	au.mutation.AppendThumbnails(mat)
+	return au
+}
+
+// ClearThumbnails clears the value of the "thumbnails" field.
+func (au *AttachmentUpdate) ClearThumbnails() *AttachmentUpdate {
+	au.mutation.ClearThumbnails()
+	return au
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (au *AttachmentUpdate) SetItem(i *Item) *AttachmentUpdate {
+	return au.SetItemID(i.ID)
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (au *AttachmentUpdate) SetUploader(u *User) *AttachmentUpdate {
+	return au.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (au *AttachmentUpdate) ClearItem() *AttachmentUpdate {
+	au.mutation.ClearItem()
+	return au
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (au *AttachmentUpdate) ClearUploader() *AttachmentUpdate {
+	au.mutation.ClearUploader()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, AttachmentMutation](ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AttachmentUpdate) defaults() error {
+	if _, ok := au.mutation.UpdateTime(); !ok {
+		if attachment.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized attachment.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := attachment.UpdateDefaultUpdateTime()
+		au.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AttachmentUpdate) check() error {
+	if v, ok := au.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.ProcessingStatus(); ok {
+		if err := attachment.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.processing_status": %w`, err)}
+		}
+	}
+	if _, ok := au.mutation.ItemID(); au.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Attachment.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *AttachmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttachmentUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdateTime(); ok {
+		_spec.SetField(attachment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := au.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.Checksum(); ok {
+		_spec.SetField(attachment.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := au.mutation.ProcessingStatus(); ok {
+		_spec.SetField(attachment.FieldProcessingStatus, field.TypeEnum, value)
+	}
+	if au.mutation.ProcessingStatusCleared() {
+		_spec.ClearField(attachment.FieldProcessingStatus, field.TypeEnum)
+	}
+	if value, ok := au.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedWidth(); ok {
+		_spec.AddField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if au.mutation.WidthCleared() {
+		_spec.ClearField(attachment.FieldWidth, field.TypeInt)
+	}
+	if value, ok := au.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedHeight(); ok {
+		_spec.AddField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if au.mutation.HeightCleared() {
+		_spec.ClearField(attachment.FieldHeight, field.TypeInt)
+	}
+	if value, ok := au.mutation.Thumbnails(); ok {
+		_spec.SetField(attachment.FieldThumbnails, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedThumbnails(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attachment.FieldThumbnails, value)
+		})
+	}
+	if au.mutation.ThumbnailsCleared() {
+		_spec.ClearField(attachment.FieldThumbnails, field.TypeJSON)
+	}
+	if au.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.ItemTable,
+			Columns: []string{attachment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.ItemTable,
+			Columns: []string{attachment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttachmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (auo *AttachmentUpdateOne) SetUpdateTime(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetUpdateTime(t)
+	return auo
+}
+
+// SetItemID sets the "item_id" field.
+func (auo *AttachmentUpdateOne) SetItemID(u uint) *AttachmentUpdateOne {
+	auo.mutation.SetItemID(u)
+	return auo
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (auo *AttachmentUpdateOne) SetUploaderID(u uint) *AttachmentUpdateOne {
+	auo.mutation.SetUploaderID(u)
+	return auo
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableUploaderID(u *uint) *AttachmentUpdateOne {
+	if u != nil {
+		auo.SetUploaderID(*u)
+	}
+	return auo
+}
+
+// ClearUploaderID clears the value of the "uploader_id" field.
+func (auo *AttachmentUpdateOne) ClearUploaderID() *AttachmentUpdateOne {
+	auo.mutation.ClearUploaderID()
+	return auo
+}
+
+// SetFilename sets the "filename" field.
+func (auo *AttachmentUpdateOne) SetFilename(s string) *AttachmentUpdateOne {
+	auo.mutation.SetFilename(s)
+	return auo
+}
+
+// SetContentType sets the "content_type" field.
+func (auo *AttachmentUpdateOne) SetContentType(s string) *AttachmentUpdateOne {
+	auo.mutation.SetContentType(s)
+	return auo
+}
+
+// SetSize sets the "size" field.
+func (auo *AttachmentUpdateOne) SetSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.ResetSize()
+	auo.mutation.SetSize(i)
+	return auo
+}
+
+// AddSize adds i to the "size" field.
+func (auo *AttachmentUpdateOne) AddSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.AddSize(i)
+	return auo
+}
+
+// SetChecksum sets the "checksum" field.
+func (auo *AttachmentUpdateOne) SetChecksum(s string) *AttachmentUpdateOne {
+	auo.mutation.SetChecksum(s)
+	return auo
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (auo *AttachmentUpdateOne) SetProcessingStatus(as attachment.ProcessingStatus) *AttachmentUpdateOne {
+	auo.mutation.SetProcessingStatus(as)
+	return auo
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableProcessingStatus(as *attachment.ProcessingStatus) *AttachmentUpdateOne {
+	if as != nil {
+		auo.SetProcessingStatus(*as)
+	}
+	return auo
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (auo *AttachmentUpdateOne) ClearProcessingStatus() *AttachmentUpdateOne {
+	auo.mutation.ClearProcessingStatus()
+	return auo
+}
+
+// SetWidth sets the "width" field.
+func (auo *AttachmentUpdateOne) SetWidth(i int) *AttachmentUpdateOne {
+	auo.mutation.ResetWidth()
+	auo.mutation.SetWidth(i)
+	return auo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableWidth(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetWidth(*i)
+	}
+	return auo
+}
+
+// AddWidth adds i to the "width" field.
+func (auo *AttachmentUpdateOne) AddWidth(i int) *AttachmentUpdateOne {
+	auo.mutation.AddWidth(i)
+	return auo
+}
+
+// ClearWidth clears the value of the "width" field.
+func (auo *AttachmentUpdateOne) ClearWidth() *AttachmentUpdateOne {
+	auo.mutation.ClearWidth()
+	return auo
+}
+
+// SetHeight sets the "height" field.
+func (auo *AttachmentUpdateOne) SetHeight(i int) *AttachmentUpdateOne {
+	auo.mutation.ResetHeight()
+	auo.mutation.SetHeight(i)
+	return auo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableHeight(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetHeight(*i)
+	}
+	return auo
+}
+
+// AddHeight adds i to the "height" field.
+func (auo *AttachmentUpdateOne) AddHeight(i int) *AttachmentUpdateOne {
+	auo.mutation.AddHeight(i)
+	return auo
+}
+
+// ClearHeight clears the value of the "height" field.
+func (auo *AttachmentUpdateOne) ClearHeight() *AttachmentUpdateOne {
+	auo.mutation.ClearHeight()
+	return auo
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (auo *AttachmentUpdateOne) SetThumbnails(mat []models.ItemAttachmentThumbnail) *AttachmentUpdateOne {
+	auo.mutation.SetThumbnails(mat)
+	return auo
+}
+
+// AppendThumbnails appends mat to the "thumbnails" field.
+func (auo *AttachmentUpdateOne) AppendThumbnails(mat []models.ItemAttachmentThumbnail) *AttachmentUpdateOne {
+	auo.mutation.AppendThumbnails(mat)
+	return auo
+}
+
+// ClearThumbnails clears the value of the "thumbnails" field.
+func (auo *AttachmentUpdateOne) ClearThumbnails() *AttachmentUpdateOne {
+	auo.mutation.ClearThumbnails()
+	return auo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (auo *AttachmentUpdateOne) SetItem(i *Item) *AttachmentUpdateOne {
+	return auo.SetItemID(i.ID)
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (auo *AttachmentUpdateOne) SetUploader(u *User) *AttachmentUpdateOne {
+	return auo.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (auo *AttachmentUpdateOne) ClearItem() *AttachmentUpdateOne {
+	auo.mutation.ClearItem()
+	return auo
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (auo *AttachmentUpdateOne) ClearUploader() *AttachmentUpdateOne {
+	auo.mutation.ClearUploader()
+	return auo
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (auo *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*Attachment, AttachmentMutation](ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AttachmentUpdateOne) defaults() error {
+	if _, ok := auo.mutation.UpdateTime(); !ok {
+		if attachment.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized attachment.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := attachment.UpdateDefaultUpdateTime()
+		auo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AttachmentUpdateOne) check() error {
+	if v, ok := auo.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.ProcessingStatus(); ok {
+		if err := attachment.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.processing_status": %w`, err)}
+		}
+	}
+	if _, ok := auo.mutation.ItemID(); auo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Attachment.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *AttachmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttachmentUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdateTime(); ok {
+		_spec.SetField(attachment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.Checksum(); ok {
+		_spec.SetField(attachment.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.ProcessingStatus(); ok {
+		_spec.SetField(attachment.FieldProcessingStatus, field.TypeEnum, value)
+	}
+	if auo.mutation.ProcessingStatusCleared() {
+		_spec.ClearField(attachment.FieldProcessingStatus, field.TypeEnum)
+	}
+	if value, ok := auo.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedWidth(); ok {
+		_spec.AddField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if auo.mutation.WidthCleared() {
+		_spec.ClearField(attachment.FieldWidth, field.TypeInt)
+	}
+	if value, ok := auo.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedHeight(); ok {
+		_spec.AddField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if auo.mutation.HeightCleared() {
+		_spec.ClearField(attachment.FieldHeight, field.TypeInt)
+	}
+	if value, ok := auo.mutation.Thumbnails(); ok {
+		_spec.SetField(attachment.FieldThumbnails, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedThumbnails(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attachment.FieldThumbnails, value)
+		})
+	}
+	if auo.mutation.ThumbnailsCleared() {
+		_spec.ClearField(attachment.FieldThumbnails, field.TypeJSON)
+	}
+	if auo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.ItemTable,
+			Columns: []string{attachment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.ItemTable,
+			Columns: []string{attachment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(auo.modifiers...)
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
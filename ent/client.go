@@ -14,7 +14,16 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
@@ -23,10 +32,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemImport is the client for interacting with the ItemImport builders.
+	ItemImport *ItemImportClient
+	// ItemRevision is the client for interacting with the ItemRevision builders.
+	ItemRevision *ItemRevisionClient
+	// ItemShare is the client for interacting with the ItemShare builders.
+	ItemShare *ItemShareClient
+	// ItemTransition is the client for interacting with the ItemTransition builders.
+	ItemTransition *ItemTransitionClient
+	// MetadataSchema is the client for interacting with the MetadataSchema builders.
+	MetadataSchema *MetadataSchemaClient
+	// OwnershipTransfer is the client for interacting with the OwnershipTransfer builders.
+	OwnershipTransfer *OwnershipTransferClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// additional fields for node api
+	tables tables
 }
 
 // NewClient creates a new client configured with the given options.
@@ -40,7 +69,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Attachment = NewAttachmentClient(c.config)
+	c.Comment = NewCommentClient(c.config)
 	c.Item = NewItemClient(c.config)
+	c.ItemImport = NewItemImportClient(c.config)
+	c.ItemRevision = NewItemRevisionClient(c.config)
+	c.ItemShare = NewItemShareClient(c.config)
+	c.ItemTransition = NewItemTransitionClient(c.config)
+	c.MetadataSchema = NewMetadataSchemaClient(c.config)
+	c.OwnershipTransfer = NewOwnershipTransferClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -122,10 +160,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Item:   NewItemClient(cfg),
-		User:   NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Attachment:        NewAttachmentClient(cfg),
+		Comment:           NewCommentClient(cfg),
+		Item:              NewItemClient(cfg),
+		ItemImport:        NewItemImportClient(cfg),
+		ItemRevision:      NewItemRevisionClient(cfg),
+		ItemShare:         NewItemShareClient(cfg),
+		ItemTransition:    NewItemTransitionClient(cfg),
+		MetadataSchema:    NewMetadataSchemaClient(cfg),
+		OwnershipTransfer: NewOwnershipTransferClient(cfg),
+		Tag:               NewTagClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
@@ -143,17 +190,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Item:   NewItemClient(cfg),
-		User:   NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Attachment:        NewAttachmentClient(cfg),
+		Comment:           NewCommentClient(cfg),
+		Item:              NewItemClient(cfg),
+		ItemImport:        NewItemImportClient(cfg),
+		ItemRevision:      NewItemRevisionClient(cfg),
+		ItemShare:         NewItemShareClient(cfg),
+		ItemTransition:    NewItemTransitionClient(cfg),
+		MetadataSchema:    NewMetadataSchemaClient(cfg),
+		OwnershipTransfer: NewOwnershipTransferClient(cfg),
+		Tag:               NewTagClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Item.
+//		Attachment.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -175,115 +231,1614 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Item.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Attachment, c.Comment, c.Item, c.ItemImport, c.ItemRevision, c.ItemShare,
+		c.ItemTransition, c.MetadataSchema, c.OwnershipTransfer, c.Tag, c.User,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Attachment, c.Comment, c.Item, c.ItemImport, c.ItemRevision, c.ItemShare,
+		c.ItemTransition, c.MetadataSchema, c.OwnershipTransfer, c.Tag, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *AttachmentMutation:
+		return c.Attachment.mutate(ctx, m)
+	case *CommentMutation:
+		return c.Comment.mutate(ctx, m)
+	case *ItemMutation:
+		return c.Item.mutate(ctx, m)
+	case *ItemImportMutation:
+		return c.ItemImport.mutate(ctx, m)
+	case *ItemRevisionMutation:
+		return c.ItemRevision.mutate(ctx, m)
+	case *ItemShareMutation:
+		return c.ItemShare.mutate(ctx, m)
+	case *ItemTransitionMutation:
+		return c.ItemTransition.mutate(ctx, m)
+	case *MetadataSchemaMutation:
+		return c.MetadataSchema.mutate(ctx, m)
+	case *OwnershipTransferMutation:
+		return c.OwnershipTransfer.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// AttachmentClient is a client for the Attachment schema.
+type AttachmentClient struct {
+	config
+}
+
+// NewAttachmentClient returns a client for the Attachment from the given config.
+func NewAttachmentClient(c config) *AttachmentClient {
+	return &AttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attachment.Hooks(f(g(h())))`.
+func (c *AttachmentClient) Use(hooks ...Hook) {
+	c.hooks.Attachment = append(c.hooks.Attachment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attachment.Intercept(f(g(h())))`.
+func (c *AttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Attachment = append(c.inters.Attachment, interceptors...)
+}
+
+// Create returns a builder for creating a Attachment entity.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	mutation := newAttachmentMutation(c.config, OpCreate)
+	return &AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Attachment entities.
+func (c *AttachmentClient) CreateBulk(builders ...*AttachmentCreate) *AttachmentCreateBulk {
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Attachment.
+func (c *AttachmentClient) Update() *AttachmentUpdate {
+	mutation := newAttachmentMutation(c.config, OpUpdate)
+	return &AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttachmentClient) UpdateOne(a *Attachment) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachment(a))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttachmentClient) UpdateOneID(id uint) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachmentID(id))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Attachment.
+func (c *AttachmentClient) Delete() *AttachmentDelete {
+	mutation := newAttachmentMutation(c.config, OpDelete)
+	return &AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttachmentClient) DeleteOne(a *Attachment) *AttachmentDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttachmentClient) DeleteOneID(id uint) *AttachmentDeleteOne {
+	builder := c.Delete().Where(attachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Attachment.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttachment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Attachment entity by its id.
+func (c *AttachmentClient) Get(ctx context.Context, id uint) (*Attachment, error) {
+	return c.Query().Where(attachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttachmentClient) GetX(ctx context.Context, id uint) *Attachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a Attachment.
+func (c *AttachmentClient) QueryItem(a *Attachment) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachment.ItemTable, attachment.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploader queries the uploader edge of a Attachment.
+func (c *AttachmentClient) QueryUploader(a *Attachment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachment.UploaderTable, attachment.UploaderColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttachmentClient) Hooks() []Hook {
+	hooks := c.hooks.Attachment
+	return append(hooks[:len(hooks):len(hooks)], attachment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttachmentClient) Interceptors() []Interceptor {
+	return c.inters.Attachment
+}
+
+func (c *AttachmentClient) mutate(ctx context.Context, m *AttachmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Attachment mutation op: %q", m.Op())
+	}
+}
+
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
+	config
+}
+
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
+// Create returns a builder for creating a Comment entity.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(co))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentClient) UpdateOneID(id uint) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentClient) DeleteOneID(id uint) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentDeleteOne{builder}
+}
+
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id uint) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a Comment.
+func (c *CommentClient) QueryItem(co *Comment) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ItemTable, comment.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a Comment.
+func (c *CommentClient) QueryAuthor(co *Comment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.AuthorTable, comment.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CommentClient) Hooks() []Hook {
+	hooks := c.hooks.Comment
+	return append(hooks[:len(hooks):len(hooks)], comment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	inters := c.inters.Comment
+	return append(inters[:len(inters):len(inters)], comment.Interceptors[:]...)
+}
+
+func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+	}
+}
+
+// ItemClient is a client for the Item schema.
+type ItemClient struct {
+	config
+}
+
+// NewItemClient returns a client for the Item from the given config.
+func NewItemClient(c config) *ItemClient {
+	return &ItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
+func (c *ItemClient) Use(hooks ...Hook) {
+	c.hooks.Item = append(c.hooks.Item, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
+// Create returns a builder for creating a Item entity.
+func (c *ItemClient) Create() *ItemCreate {
+	mutation := newItemMutation(c.config, OpCreate)
+	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Item.
+func (c *ItemClient) Update() *ItemUpdate {
+	mutation := newItemMutation(c.config, OpUpdate)
+	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemClient) UpdateOne(i *Item) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItem(i))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemClient) UpdateOneID(id uint) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Item.
+func (c *ItemClient) Delete() *ItemDelete {
+	mutation := newItemMutation(c.config, OpDelete)
+	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemClient) DeleteOne(i *Item) *ItemDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemClient) DeleteOneID(id uint) *ItemDeleteOne {
+	builder := c.Delete().Where(item.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemDeleteOne{builder}
+}
+
+// Query returns a query builder for Item.
+func (c *ItemClient) Query() *ItemQuery {
+	return &ItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Item entity by its id.
+func (c *ItemClient) Get(ctx context.Context, id uint) (*Item, error) {
+	return c.Query().Where(item.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemClient) GetX(ctx context.Context, id uint) *Item {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Item.
+func (c *ItemClient) QueryOwner(i *Item) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.OwnerTable, item.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShares queries the shares edge of a Item.
+func (c *ItemClient) QueryShares(i *Item) *ItemShareQuery {
+	query := (&ItemShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemshare.Table, itemshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.SharesTable, item.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Item.
+func (c *ItemClient) QueryRevisions(i *Item) *ItemRevisionQuery {
+	query := (&ItemRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemrevision.Table, itemrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.RevisionsTable, item.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Item.
+func (c *ItemClient) QueryTags(i *Item) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, item.TagsTable, item.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Item.
+func (c *ItemClient) QueryAttachments(i *Item) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.AttachmentsTable, item.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Item.
+func (c *ItemClient) QueryComments(i *Item) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.CommentsTable, item.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransitions queries the transitions edge of a Item.
+func (c *ItemClient) QueryTransitions(i *Item) *ItemTransitionQuery {
+	query := (&ItemTransitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemtransition.Table, itemtransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.TransitionsTable, item.TransitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwnershipTransfers queries the ownership_transfers edge of a Item.
+func (c *ItemClient) QueryOwnershipTransfers(i *Item) *OwnershipTransferQuery {
+	query := (&OwnershipTransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(ownershiptransfer.Table, ownershiptransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.OwnershipTransfersTable, item.OwnershipTransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Item.
+func (c *ItemClient) QueryParent(i *Item) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.ParentTable, item.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Item.
+func (c *ItemClient) QueryChildren(i *Item) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.ChildrenTable, item.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemClient) Hooks() []Hook {
+	hooks := c.hooks.Item
+	return append(hooks[:len(hooks):len(hooks)], item.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	inters := c.inters.Item
+	return append(inters[:len(inters):len(inters)], item.Interceptors[:]...)
+}
+
+func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+	}
+}
+
+// ItemImportClient is a client for the ItemImport schema.
+type ItemImportClient struct {
+	config
+}
+
+// NewItemImportClient returns a client for the ItemImport from the given config.
+func NewItemImportClient(c config) *ItemImportClient {
+	return &ItemImportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemimport.Hooks(f(g(h())))`.
+func (c *ItemImportClient) Use(hooks ...Hook) {
+	c.hooks.ItemImport = append(c.hooks.ItemImport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemimport.Intercept(f(g(h())))`.
+func (c *ItemImportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemImport = append(c.inters.ItemImport, interceptors...)
+}
+
+// Create returns a builder for creating a ItemImport entity.
+func (c *ItemImportClient) Create() *ItemImportCreate {
+	mutation := newItemImportMutation(c.config, OpCreate)
+	return &ItemImportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemImport entities.
+func (c *ItemImportClient) CreateBulk(builders ...*ItemImportCreate) *ItemImportCreateBulk {
+	return &ItemImportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemImport.
+func (c *ItemImportClient) Update() *ItemImportUpdate {
+	mutation := newItemImportMutation(c.config, OpUpdate)
+	return &ItemImportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemImportClient) UpdateOne(ii *ItemImport) *ItemImportUpdateOne {
+	mutation := newItemImportMutation(c.config, OpUpdateOne, withItemImport(ii))
+	return &ItemImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemImportClient) UpdateOneID(id uint) *ItemImportUpdateOne {
+	mutation := newItemImportMutation(c.config, OpUpdateOne, withItemImportID(id))
+	return &ItemImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemImport.
+func (c *ItemImportClient) Delete() *ItemImportDelete {
+	mutation := newItemImportMutation(c.config, OpDelete)
+	return &ItemImportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemImportClient) DeleteOne(ii *ItemImport) *ItemImportDeleteOne {
+	return c.DeleteOneID(ii.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemImportClient) DeleteOneID(id uint) *ItemImportDeleteOne {
+	builder := c.Delete().Where(itemimport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemImportDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemImport.
+func (c *ItemImportClient) Query() *ItemImportQuery {
+	return &ItemImportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemImport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemImport entity by its id.
+func (c *ItemImportClient) Get(ctx context.Context, id uint) (*ItemImport, error) {
+	return c.Query().Where(itemimport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemImportClient) GetX(ctx context.Context, id uint) *ItemImport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a ItemImport.
+func (c *ItemImportClient) QueryOwner(ii *ItemImport) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemimport.Table, itemimport.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemimport.OwnerTable, itemimport.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemImportClient) Hooks() []Hook {
+	hooks := c.hooks.ItemImport
+	return append(hooks[:len(hooks):len(hooks)], itemimport.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemImportClient) Interceptors() []Interceptor {
+	return c.inters.ItemImport
+}
+
+func (c *ItemImportClient) mutate(ctx context.Context, m *ItemImportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemImportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemImportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemImportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemImport mutation op: %q", m.Op())
+	}
+}
+
+// ItemRevisionClient is a client for the ItemRevision schema.
+type ItemRevisionClient struct {
+	config
+}
+
+// NewItemRevisionClient returns a client for the ItemRevision from the given config.
+func NewItemRevisionClient(c config) *ItemRevisionClient {
+	return &ItemRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemrevision.Hooks(f(g(h())))`.
+func (c *ItemRevisionClient) Use(hooks ...Hook) {
+	c.hooks.ItemRevision = append(c.hooks.ItemRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemrevision.Intercept(f(g(h())))`.
+func (c *ItemRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemRevision = append(c.inters.ItemRevision, interceptors...)
+}
+
+// Create returns a builder for creating a ItemRevision entity.
+func (c *ItemRevisionClient) Create() *ItemRevisionCreate {
+	mutation := newItemRevisionMutation(c.config, OpCreate)
+	return &ItemRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemRevision entities.
+func (c *ItemRevisionClient) CreateBulk(builders ...*ItemRevisionCreate) *ItemRevisionCreateBulk {
+	return &ItemRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemRevision.
+func (c *ItemRevisionClient) Update() *ItemRevisionUpdate {
+	mutation := newItemRevisionMutation(c.config, OpUpdate)
+	return &ItemRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemRevisionClient) UpdateOne(ir *ItemRevision) *ItemRevisionUpdateOne {
+	mutation := newItemRevisionMutation(c.config, OpUpdateOne, withItemRevision(ir))
+	return &ItemRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemRevisionClient) UpdateOneID(id uint) *ItemRevisionUpdateOne {
+	mutation := newItemRevisionMutation(c.config, OpUpdateOne, withItemRevisionID(id))
+	return &ItemRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemRevision.
+func (c *ItemRevisionClient) Delete() *ItemRevisionDelete {
+	mutation := newItemRevisionMutation(c.config, OpDelete)
+	return &ItemRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemRevisionClient) DeleteOne(ir *ItemRevision) *ItemRevisionDeleteOne {
+	return c.DeleteOneID(ir.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemRevisionClient) DeleteOneID(id uint) *ItemRevisionDeleteOne {
+	builder := c.Delete().Where(itemrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemRevision.
+func (c *ItemRevisionClient) Query() *ItemRevisionQuery {
+	return &ItemRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemRevision entity by its id.
+func (c *ItemRevisionClient) Get(ctx context.Context, id uint) (*ItemRevision, error) {
+	return c.Query().Where(itemrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemRevisionClient) GetX(ctx context.Context, id uint) *ItemRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ItemRevision.
+func (c *ItemRevisionClient) QueryItem(ir *ItemRevision) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ir.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemrevision.Table, itemrevision.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemrevision.ItemTable, itemrevision.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(ir.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ItemRevision.
+func (c *ItemRevisionClient) QueryUser(ir *ItemRevision) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ir.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemrevision.Table, itemrevision.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemrevision.UserTable, itemrevision.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ir.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemRevisionClient) Hooks() []Hook {
+	hooks := c.hooks.ItemRevision
+	return append(hooks[:len(hooks):len(hooks)], itemrevision.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemRevisionClient) Interceptors() []Interceptor {
+	return c.inters.ItemRevision
+}
+
+func (c *ItemRevisionClient) mutate(ctx context.Context, m *ItemRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemRevision mutation op: %q", m.Op())
+	}
+}
+
+// ItemShareClient is a client for the ItemShare schema.
+type ItemShareClient struct {
+	config
+}
+
+// NewItemShareClient returns a client for the ItemShare from the given config.
+func NewItemShareClient(c config) *ItemShareClient {
+	return &ItemShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemshare.Hooks(f(g(h())))`.
+func (c *ItemShareClient) Use(hooks ...Hook) {
+	c.hooks.ItemShare = append(c.hooks.ItemShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemshare.Intercept(f(g(h())))`.
+func (c *ItemShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemShare = append(c.inters.ItemShare, interceptors...)
+}
+
+// Create returns a builder for creating a ItemShare entity.
+func (c *ItemShareClient) Create() *ItemShareCreate {
+	mutation := newItemShareMutation(c.config, OpCreate)
+	return &ItemShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemShare entities.
+func (c *ItemShareClient) CreateBulk(builders ...*ItemShareCreate) *ItemShareCreateBulk {
+	return &ItemShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemShare.
+func (c *ItemShareClient) Update() *ItemShareUpdate {
+	mutation := newItemShareMutation(c.config, OpUpdate)
+	return &ItemShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemShareClient) UpdateOne(is *ItemShare) *ItemShareUpdateOne {
+	mutation := newItemShareMutation(c.config, OpUpdateOne, withItemShare(is))
+	return &ItemShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemShareClient) UpdateOneID(id uint) *ItemShareUpdateOne {
+	mutation := newItemShareMutation(c.config, OpUpdateOne, withItemShareID(id))
+	return &ItemShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemShare.
+func (c *ItemShareClient) Delete() *ItemShareDelete {
+	mutation := newItemShareMutation(c.config, OpDelete)
+	return &ItemShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemShareClient) DeleteOne(is *ItemShare) *ItemShareDeleteOne {
+	return c.DeleteOneID(is.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemShareClient) DeleteOneID(id uint) *ItemShareDeleteOne {
+	builder := c.Delete().Where(itemshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemShareDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemShare.
+func (c *ItemShareClient) Query() *ItemShareQuery {
+	return &ItemShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemShare entity by its id.
+func (c *ItemShareClient) Get(ctx context.Context, id uint) (*ItemShare, error) {
+	return c.Query().Where(itemshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemShareClient) GetX(ctx context.Context, id uint) *ItemShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ItemShare.
+func (c *ItemShareClient) QueryItem(is *ItemShare) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := is.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemshare.Table, itemshare.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemshare.ItemTable, itemshare.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ItemShare.
+func (c *ItemShareClient) QueryUser(is *ItemShare) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := is.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemshare.Table, itemshare.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemshare.UserTable, itemshare.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemShareClient) Hooks() []Hook {
+	hooks := c.hooks.ItemShare
+	return append(hooks[:len(hooks):len(hooks)], itemshare.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemShareClient) Interceptors() []Interceptor {
+	return c.inters.ItemShare
+}
+
+func (c *ItemShareClient) mutate(ctx context.Context, m *ItemShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemShare mutation op: %q", m.Op())
+	}
+}
+
+// ItemTransitionClient is a client for the ItemTransition schema.
+type ItemTransitionClient struct {
+	config
+}
+
+// NewItemTransitionClient returns a client for the ItemTransition from the given config.
+func NewItemTransitionClient(c config) *ItemTransitionClient {
+	return &ItemTransitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemtransition.Hooks(f(g(h())))`.
+func (c *ItemTransitionClient) Use(hooks ...Hook) {
+	c.hooks.ItemTransition = append(c.hooks.ItemTransition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemtransition.Intercept(f(g(h())))`.
+func (c *ItemTransitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemTransition = append(c.inters.ItemTransition, interceptors...)
+}
+
+// Create returns a builder for creating a ItemTransition entity.
+func (c *ItemTransitionClient) Create() *ItemTransitionCreate {
+	mutation := newItemTransitionMutation(c.config, OpCreate)
+	return &ItemTransitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemTransition entities.
+func (c *ItemTransitionClient) CreateBulk(builders ...*ItemTransitionCreate) *ItemTransitionCreateBulk {
+	return &ItemTransitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemTransition.
+func (c *ItemTransitionClient) Update() *ItemTransitionUpdate {
+	mutation := newItemTransitionMutation(c.config, OpUpdate)
+	return &ItemTransitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemTransitionClient) UpdateOne(it *ItemTransition) *ItemTransitionUpdateOne {
+	mutation := newItemTransitionMutation(c.config, OpUpdateOne, withItemTransition(it))
+	return &ItemTransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemTransitionClient) UpdateOneID(id uint) *ItemTransitionUpdateOne {
+	mutation := newItemTransitionMutation(c.config, OpUpdateOne, withItemTransitionID(id))
+	return &ItemTransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemTransition.
+func (c *ItemTransitionClient) Delete() *ItemTransitionDelete {
+	mutation := newItemTransitionMutation(c.config, OpDelete)
+	return &ItemTransitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemTransitionClient) DeleteOne(it *ItemTransition) *ItemTransitionDeleteOne {
+	return c.DeleteOneID(it.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemTransitionClient) DeleteOneID(id uint) *ItemTransitionDeleteOne {
+	builder := c.Delete().Where(itemtransition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemTransitionDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemTransition.
+func (c *ItemTransitionClient) Query() *ItemTransitionQuery {
+	return &ItemTransitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemTransition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemTransition entity by its id.
+func (c *ItemTransitionClient) Get(ctx context.Context, id uint) (*ItemTransition, error) {
+	return c.Query().Where(itemtransition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemTransitionClient) GetX(ctx context.Context, id uint) *ItemTransition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ItemTransition.
+func (c *ItemTransitionClient) QueryItem(it *ItemTransition) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := it.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtransition.Table, itemtransition.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtransition.ItemTable, itemtransition.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(it.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ItemTransition.
+func (c *ItemTransitionClient) QueryUser(it *ItemTransition) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := it.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtransition.Table, itemtransition.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtransition.UserTable, itemtransition.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(it.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemTransitionClient) Hooks() []Hook {
+	hooks := c.hooks.ItemTransition
+	return append(hooks[:len(hooks):len(hooks)], itemtransition.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemTransitionClient) Interceptors() []Interceptor {
+	return c.inters.ItemTransition
+}
+
+func (c *ItemTransitionClient) mutate(ctx context.Context, m *ItemTransitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemTransitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemTransitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemTransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemTransitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemTransition mutation op: %q", m.Op())
+	}
+}
+
+// MetadataSchemaClient is a client for the MetadataSchema schema.
+type MetadataSchemaClient struct {
+	config
+}
+
+// NewMetadataSchemaClient returns a client for the MetadataSchema from the given config.
+func NewMetadataSchemaClient(c config) *MetadataSchemaClient {
+	return &MetadataSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `metadataschema.Hooks(f(g(h())))`.
+func (c *MetadataSchemaClient) Use(hooks ...Hook) {
+	c.hooks.MetadataSchema = append(c.hooks.MetadataSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metadataschema.Intercept(f(g(h())))`.
+func (c *MetadataSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MetadataSchema = append(c.inters.MetadataSchema, interceptors...)
+}
+
+// Create returns a builder for creating a MetadataSchema entity.
+func (c *MetadataSchemaClient) Create() *MetadataSchemaCreate {
+	mutation := newMetadataSchemaMutation(c.config, OpCreate)
+	return &MetadataSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MetadataSchema entities.
+func (c *MetadataSchemaClient) CreateBulk(builders ...*MetadataSchemaCreate) *MetadataSchemaCreateBulk {
+	return &MetadataSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MetadataSchema.
+func (c *MetadataSchemaClient) Update() *MetadataSchemaUpdate {
+	mutation := newMetadataSchemaMutation(c.config, OpUpdate)
+	return &MetadataSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MetadataSchemaClient) UpdateOne(ms *MetadataSchema) *MetadataSchemaUpdateOne {
+	mutation := newMetadataSchemaMutation(c.config, OpUpdateOne, withMetadataSchema(ms))
+	return &MetadataSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MetadataSchemaClient) UpdateOneID(id uint) *MetadataSchemaUpdateOne {
+	mutation := newMetadataSchemaMutation(c.config, OpUpdateOne, withMetadataSchemaID(id))
+	return &MetadataSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MetadataSchema.
+func (c *MetadataSchemaClient) Delete() *MetadataSchemaDelete {
+	mutation := newMetadataSchemaMutation(c.config, OpDelete)
+	return &MetadataSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MetadataSchemaClient) DeleteOne(ms *MetadataSchema) *MetadataSchemaDeleteOne {
+	return c.DeleteOneID(ms.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MetadataSchemaClient) DeleteOneID(id uint) *MetadataSchemaDeleteOne {
+	builder := c.Delete().Where(metadataschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MetadataSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for MetadataSchema.
+func (c *MetadataSchemaClient) Query() *MetadataSchemaQuery {
+	return &MetadataSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMetadataSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MetadataSchema entity by its id.
+func (c *MetadataSchemaClient) Get(ctx context.Context, id uint) (*MetadataSchema, error) {
+	return c.Query().Where(metadataschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MetadataSchemaClient) GetX(ctx context.Context, id uint) *MetadataSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a MetadataSchema.
+func (c *MetadataSchemaClient) QueryOwner(ms *MetadataSchema) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(metadataschema.Table, metadataschema.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, metadataschema.OwnerTable, metadataschema.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MetadataSchemaClient) Hooks() []Hook {
+	hooks := c.hooks.MetadataSchema
+	return append(hooks[:len(hooks):len(hooks)], metadataschema.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MetadataSchemaClient) Interceptors() []Interceptor {
+	return c.inters.MetadataSchema
+}
+
+func (c *MetadataSchemaClient) mutate(ctx context.Context, m *MetadataSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MetadataSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MetadataSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MetadataSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MetadataSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MetadataSchema mutation op: %q", m.Op())
+	}
+}
+
+// OwnershipTransferClient is a client for the OwnershipTransfer schema.
+type OwnershipTransferClient struct {
+	config
+}
+
+// NewOwnershipTransferClient returns a client for the OwnershipTransfer from the given config.
+func NewOwnershipTransferClient(c config) *OwnershipTransferClient {
+	return &OwnershipTransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ownershiptransfer.Hooks(f(g(h())))`.
+func (c *OwnershipTransferClient) Use(hooks ...Hook) {
+	c.hooks.OwnershipTransfer = append(c.hooks.OwnershipTransfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ownershiptransfer.Intercept(f(g(h())))`.
+func (c *OwnershipTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OwnershipTransfer = append(c.inters.OwnershipTransfer, interceptors...)
+}
+
+// Create returns a builder for creating a OwnershipTransfer entity.
+func (c *OwnershipTransferClient) Create() *OwnershipTransferCreate {
+	mutation := newOwnershipTransferMutation(c.config, OpCreate)
+	return &OwnershipTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OwnershipTransfer entities.
+func (c *OwnershipTransferClient) CreateBulk(builders ...*OwnershipTransferCreate) *OwnershipTransferCreateBulk {
+	return &OwnershipTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OwnershipTransfer.
+func (c *OwnershipTransferClient) Update() *OwnershipTransferUpdate {
+	mutation := newOwnershipTransferMutation(c.config, OpUpdate)
+	return &OwnershipTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OwnershipTransferClient) UpdateOne(ot *OwnershipTransfer) *OwnershipTransferUpdateOne {
+	mutation := newOwnershipTransferMutation(c.config, OpUpdateOne, withOwnershipTransfer(ot))
+	return &OwnershipTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OwnershipTransferClient) UpdateOneID(id uint) *OwnershipTransferUpdateOne {
+	mutation := newOwnershipTransferMutation(c.config, OpUpdateOne, withOwnershipTransferID(id))
+	return &OwnershipTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Intercept adds the query interceptors to all the entity clients.
-// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
-func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Item.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+// Delete returns a delete builder for OwnershipTransfer.
+func (c *OwnershipTransferClient) Delete() *OwnershipTransferDelete {
+	mutation := newOwnershipTransferMutation(c.config, OpDelete)
+	return &OwnershipTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Mutate implements the ent.Mutator interface.
-func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
-	switch m := m.(type) {
-	case *ItemMutation:
-		return c.Item.mutate(ctx, m)
-	case *UserMutation:
-		return c.User.mutate(ctx, m)
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OwnershipTransferClient) DeleteOne(ot *OwnershipTransfer) *OwnershipTransferDeleteOne {
+	return c.DeleteOneID(ot.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OwnershipTransferClient) DeleteOneID(id uint) *OwnershipTransferDeleteOne {
+	builder := c.Delete().Where(ownershiptransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OwnershipTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for OwnershipTransfer.
+func (c *OwnershipTransferClient) Query() *OwnershipTransferQuery {
+	return &OwnershipTransferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOwnershipTransfer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OwnershipTransfer entity by its id.
+func (c *OwnershipTransferClient) Get(ctx context.Context, id uint) (*OwnershipTransfer, error) {
+	return c.Query().Where(ownershiptransfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OwnershipTransferClient) GetX(ctx context.Context, id uint) *OwnershipTransfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryItem(ot *OwnershipTransfer) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownershiptransfer.ItemTable, ownershiptransfer.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFromUser queries the from_user edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryFromUser(ot *OwnershipTransfer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownershiptransfer.FromUserTable, ownershiptransfer.FromUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToUser queries the to_user edge of a OwnershipTransfer.
+func (c *OwnershipTransferClient) QueryToUser(ot *OwnershipTransfer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownershiptransfer.ToUserTable, ownershiptransfer.ToUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OwnershipTransferClient) Hooks() []Hook {
+	hooks := c.hooks.OwnershipTransfer
+	return append(hooks[:len(hooks):len(hooks)], ownershiptransfer.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OwnershipTransferClient) Interceptors() []Interceptor {
+	return c.inters.OwnershipTransfer
+}
+
+func (c *OwnershipTransferClient) mutate(ctx context.Context, m *OwnershipTransferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OwnershipTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OwnershipTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OwnershipTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OwnershipTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+		return nil, fmt.Errorf("ent: unknown OwnershipTransfer mutation op: %q", m.Op())
 	}
 }
 
-// ItemClient is a client for the Item schema.
-type ItemClient struct {
+// TagClient is a client for the Tag schema.
+type TagClient struct {
 	config
 }
 
-// NewItemClient returns a client for the Item from the given config.
-func NewItemClient(c config) *ItemClient {
-	return &ItemClient{config: c}
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
-func (c *ItemClient) Use(hooks ...Hook) {
-	c.hooks.Item = append(c.hooks.Item, hooks...)
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
-func (c *ItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Item = append(c.inters.Item, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
 }
 
-// Create returns a builder for creating a Item entity.
-func (c *ItemClient) Create() *ItemCreate {
-	mutation := newItemMutation(c.config, OpCreate)
-	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Item entities.
-func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
-	return &ItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Item.
-func (c *ItemClient) Update() *ItemUpdate {
-	mutation := newItemMutation(c.config, OpUpdate)
-	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ItemClient) UpdateOne(i *Item) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItem(i))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ItemClient) UpdateOneID(id uint) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id uint) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Item.
-func (c *ItemClient) Delete() *ItemDelete {
-	mutation := newItemMutation(c.config, OpDelete)
-	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ItemClient) DeleteOne(i *Item) *ItemDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ItemClient) DeleteOneID(id uint) *ItemDeleteOne {
-	builder := c.Delete().Where(item.ID(id))
+func (c *TagClient) DeleteOneID(id uint) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ItemDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for Item.
-func (c *ItemClient) Query() *ItemQuery {
-	return &ItemQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeItem},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Item entity by its id.
-func (c *ItemClient) Get(ctx context.Context, id uint) (*Item, error) {
-	return c.Query().Where(item.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uint) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ItemClient) GetX(ctx context.Context, id uint) *Item {
+func (c *TagClient) GetX(ctx context.Context, id uint) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -291,44 +1846,61 @@ func (c *ItemClient) GetX(ctx context.Context, id uint) *Item {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Item.
-func (c *ItemClient) QueryOwner(i *Item) *UserQuery {
+// QueryOwner queries the owner edge of a Tag.
+func (c *TagClient) QueryOwner(t *Tag) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := t.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, item.OwnerTable, item.OwnerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.OwnerTable, tag.OwnerColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Tag.
+func (c *TagClient) QueryItems(t *Tag) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.ItemsTable, tag.ItemsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+func (c *TagClient) Hooks() []Hook {
+	hooks := c.hooks.Tag
+	return append(hooks[:len(hooks):len(hooks)], tag.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ItemClient) Interceptors() []Interceptor {
-	return c.inters.Item
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
@@ -441,14 +2013,176 @@ func (c *UserClient) QueryItems(u *User) *ItemQuery {
 	return query
 }
 
+// QuerySharedItems queries the shared_items edge of a User.
+func (c *UserClient) QuerySharedItems(u *User) *ItemShareQuery {
+	query := (&ItemShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(itemshare.Table, itemshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SharedItemsTable, user.SharedItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemRevisions queries the item_revisions edge of a User.
+func (c *UserClient) QueryItemRevisions(u *User) *ItemRevisionQuery {
+	query := (&ItemRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(itemrevision.Table, itemrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemRevisionsTable, user.ItemRevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a User.
+func (c *UserClient) QueryTags(u *User) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a User.
+func (c *UserClient) QueryAttachments(u *User) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AttachmentsTable, user.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemImports queries the item_imports edge of a User.
+func (c *UserClient) QueryItemImports(u *User) *ItemImportQuery {
+	query := (&ItemImportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(itemimport.Table, itemimport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemImportsTable, user.ItemImportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a User.
+func (c *UserClient) QueryComments(u *User) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CommentsTable, user.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemTransitions queries the item_transitions edge of a User.
+func (c *UserClient) QueryItemTransitions(u *User) *ItemTransitionQuery {
+	query := (&ItemTransitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(itemtransition.Table, itemtransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemTransitionsTable, user.ItemTransitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMetadataSchemas queries the metadata_schemas edge of a User.
+func (c *UserClient) QueryMetadataSchemas(u *User) *MetadataSchemaQuery {
+	query := (&MetadataSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(metadataschema.Table, metadataschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MetadataSchemasTable, user.MetadataSchemasColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentOwnershipTransfers queries the sent_ownership_transfers edge of a User.
+func (c *UserClient) QuerySentOwnershipTransfers(u *User) *OwnershipTransferQuery {
+	query := (&OwnershipTransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(ownershiptransfer.Table, ownershiptransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentOwnershipTransfersTable, user.SentOwnershipTransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceivedOwnershipTransfers queries the received_ownership_transfers edge of a User.
+func (c *UserClient) QueryReceivedOwnershipTransfers(u *User) *OwnershipTransferQuery {
+	query := (&OwnershipTransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(ownershiptransfer.Table, ownershiptransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReceivedOwnershipTransfersTable, user.ReceivedOwnershipTransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+	inters := c.inters.User
+	return append(inters[:len(inters):len(inters)], user.Interceptors[:]...)
 }
 
 func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
@@ -469,9 +2203,11 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Item, User []ent.Hook
+		Attachment, Comment, Item, ItemImport, ItemRevision, ItemShare, ItemTransition,
+		MetadataSchema, OwnershipTransfer, Tag, User []ent.Hook
 	}
 	inters struct {
-		Item, User []ent.Interceptor
+		Attachment, Comment, Item, ItemImport, ItemRevision, ItemShare, ItemTransition,
+		MetadataSchema, OwnershipTransfer, Tag, User []ent.Interceptor
 	}
 )
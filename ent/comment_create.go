@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// CommentCreate is the builder for creating a Comment entity.
+type CommentCreate struct {
+	config
+	mutation *CommentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (cc *CommentCreate) SetCreateTime(t time.Time) *CommentCreate {
+	cc.mutation.SetCreateTime(t)
+	return cc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableCreateTime(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetCreateTime(*t)
+	}
+	return cc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cc *CommentCreate) SetUpdateTime(t time.Time) *CommentCreate {
+	cc.mutation.SetUpdateTime(t)
+	return cc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableUpdateTime(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetUpdateTime(*t)
+	}
+	return cc
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (cc *CommentCreate) SetDeleteTime(t time.Time) *CommentCreate {
+	cc.mutation.SetDeleteTime(t)
+	return cc
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableDeleteTime(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetDeleteTime(*t)
+	}
+	return cc
+}
+
+// SetItemID sets the "item_id" field.
+func (cc *CommentCreate) SetItemID(u uint) *CommentCreate {
+	cc.mutation.SetItemID(u)
+	return cc
+}
+
+// SetAuthorID sets the "author_id" field.
+func (cc *CommentCreate) SetAuthorID(u uint) *CommentCreate {
+	cc.mutation.SetAuthorID(u)
+	return cc
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableAuthorID(u *uint) *CommentCreate {
+	if u != nil {
+		cc.SetAuthorID(*u)
+	}
+	return cc
+}
+
+// SetBody sets the "body" field.
+func (cc *CommentCreate) SetBody(s string) *CommentCreate {
+	cc.mutation.SetBody(s)
+	return cc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cc *CommentCreate) SetEditedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetEditedAt(t)
+	return cc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetEditedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CommentCreate) SetID(u uint) *CommentCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (cc *CommentCreate) SetItem(i *Item) *CommentCreate {
+	return cc.SetItemID(i.ID)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (cc *CommentCreate) SetAuthor(u *User) *CommentCreate {
+	return cc.SetAuthorID(u.ID)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cc *CommentCreate) Mutation() *CommentMutation {
+	return cc.mutation
+}
+
+// Save creates the Comment in the database.
+func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*Comment, CommentMutation](ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CommentCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CommentCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CommentCreate) defaults() error {
+	if _, ok := cc.mutation.CreateTime(); !ok {
+		if comment.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := comment.DefaultCreateTime()
+		cc.mutation.SetCreateTime(v)
+	}
+	if _, ok := cc.mutation.UpdateTime(); !ok {
+		if comment.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := comment.DefaultUpdateTime()
+		cc.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CommentCreate) check() error {
+	if _, ok := cc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Comment.create_time"`)}
+	}
+	if _, ok := cc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Comment.update_time"`)}
+	}
+	if _, ok := cc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "Comment.item_id"`)}
+	}
+	if _, ok := cc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Comment.body"`)}
+	}
+	if v, ok := cc.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Comment.body": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "Comment.item"`)}
+	}
+	return nil
+}
+
+func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Comment{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(comment.Table, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint))
+	)
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cc.mutation.CreateTime(); ok {
+		_spec.SetField(comment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := cc.mutation.UpdateTime(); ok {
+		_spec.SetField(comment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := cc.mutation.DeleteTime(); ok {
+		_spec.SetField(comment.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := cc.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := cc.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if nodes := cc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ItemTable,
+			Columns: []string{comment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CommentCreateBulk is the builder for creating many Comment entities in bulk.
+type CommentCreateBulk struct {
+	config
+	builders []*CommentCreate
+}
+
+// Save creates the Comment entities in the database.
+func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Comment, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CommentCreateBulk) SaveX(ctx context.Context) []*Comment {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CommentCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// CommentUpdate is the builder for updating Comment entities.
+type CommentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cu *CommentUpdate) SetUpdateTime(t time.Time) *CommentUpdate {
+	cu.mutation.SetUpdateTime(t)
+	return cu
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (cu *CommentUpdate) SetDeleteTime(t time.Time) *CommentUpdate {
+	cu.mutation.SetDeleteTime(t)
+	return cu
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDeleteTime(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetDeleteTime(*t)
+	}
+	return cu
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (cu *CommentUpdate) ClearDeleteTime() *CommentUpdate {
+	cu.mutation.ClearDeleteTime()
+	return cu
+}
+
+// SetBody sets the "body" field.
+func (cu *CommentUpdate) SetBody(s string) *CommentUpdate {
+	cu.mutation.SetBody(s)
+	return cu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cu *CommentUpdate) SetEditedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetEditedAt(t)
+	return cu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetEditedAt(*t)
+	}
+	return cu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cu *CommentUpdate) ClearEditedAt() *CommentUpdate {
+	cu.mutation.ClearEditedAt()
+	return cu
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	if err := cu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, CommentMutation](ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CommentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CommentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CommentUpdate) defaults() error {
+	if _, ok := cu.mutation.UpdateTime(); !ok {
+		if comment.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := comment.UpdateDefaultUpdateTime()
+		cu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	if v, ok := cu.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Comment.body": %w`, err)}
+		}
+	}
+	if _, ok := cu.mutation.ItemID(); cu.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Comment.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdateTime(); ok {
+		_spec.SetField(comment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.DeleteTime(); ok {
+		_spec.SetField(comment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if cu.mutation.DeleteTimeCleared() {
+		_spec.ClearField(comment.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CommentUpdateOne is the builder for updating a single Comment entity.
+type CommentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cuo *CommentUpdateOne) SetUpdateTime(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetUpdateTime(t)
+	return cuo
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (cuo *CommentUpdateOne) SetDeleteTime(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetDeleteTime(t)
+	return cuo
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDeleteTime(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetDeleteTime(*t)
+	}
+	return cuo
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (cuo *CommentUpdateOne) ClearDeleteTime() *CommentUpdateOne {
+	cuo.mutation.ClearDeleteTime()
+	return cuo
+}
+
+// SetBody sets the "body" field.
+func (cuo *CommentUpdateOne) SetBody(s string) *CommentUpdateOne {
+	cuo.mutation.SetBody(s)
+	return cuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cuo *CommentUpdateOne) SetEditedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetEditedAt(t)
+	return cuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetEditedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cuo *CommentUpdateOne) ClearEditedAt() *CommentUpdateOne {
+	cuo.mutation.ClearEditedAt()
+	return cuo
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CommentUpdateOne) Select(field string, fields ...string) *CommentUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Comment entity.
+func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	if err := cuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*Comment, CommentMutation](ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CommentUpdateOne) defaults() error {
+	if _, ok := cuo.mutation.UpdateTime(); !ok {
+		if comment.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := comment.UpdateDefaultUpdateTime()
+		cuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	if v, ok := cuo.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Comment.body": %w`, err)}
+		}
+	}
+	if _, ok := cuo.mutation.ItemID(); cuo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Comment.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+		for _, f := range fields {
+			if !comment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != comment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdateTime(); ok {
+		_spec.SetField(comment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.DeleteTime(); ok {
+		_spec.SetField(comment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if cuo.mutation.DeleteTimeCleared() {
+		_spec.ClearField(comment.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Comment{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
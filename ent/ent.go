@@ -12,7 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
@@ -74,8 +83,17 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			item.Table: item.ValidColumn,
-			user.Table: user.ValidColumn,
+			attachment.Table:        attachment.ValidColumn,
+			comment.Table:           comment.ValidColumn,
+			item.Table:              item.ValidColumn,
+			itemimport.Table:        itemimport.ValidColumn,
+			itemrevision.Table:      itemrevision.ValidColumn,
+			itemshare.Table:         itemshare.ValidColumn,
+			itemtransition.Table:    itemtransition.ValidColumn,
+			metadataschema.Table:    metadataschema.ValidColumn,
+			ownershiptransfer.Table: ownershiptransfer.ValidColumn,
+			tag.Table:               tag.ValidColumn,
+			user.Table:              user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
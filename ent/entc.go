@@ -0,0 +1,32 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+)
+
+func main() {
+	// The GraphQL schema of the ent types is generated next to the schema of
+	// the mutations, see internal/graphql/gqlgen.yml.
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithSchemaPath("../internal/graphql/ent.graphql"),
+		entgql.WithConfigPath("../internal/graphql/gqlgen.yml"),
+		entgql.WithWhereInputs(false),
+	)
+	if err != nil {
+		log.Fatalf("creating entgql extension: %v", err)
+	}
+
+	err = entc.Generate("./schema", &gen.Config{
+		Features: []gen.Feature{gen.FeaturePrivacy, gen.FeatureIntercept, gen.FeatureModifier},
+	}, entc.Extensions(ex))
+	if err != nil {
+		log.Fatalf("running ent codegen: %v", err)
+	}
+}
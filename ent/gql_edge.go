@@ -0,0 +1,45 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (i *Item) Owner(ctx context.Context) (*User, error) {
+	result, err := i.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (i *Item) Parent(ctx context.Context) (*Item, error) {
+	result, err := i.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QueryParent().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (u *User) Items(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ItemOrder,
+) (*ItemConnection, error) {
+	opts := []ItemPaginateOption{
+		WithItemOrder(orderBy),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := u.Edges.totalCount[0][alias]
+	if nodes, err := u.NamedItems(alias); err == nil || hasTotalCount {
+		pager, err := newItemPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &ItemConnection{Edges: []*ItemEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return u.QueryItems().Paginate(ctx, after, first, before, last, opts...)
+}
@@ -9,6 +9,30 @@ import (
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
 )
 
+// The AttachmentFunc type is an adapter to allow the use of ordinary
+// function as Attachment mutator.
+type AttachmentFunc func(context.Context, *ent.AttachmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttachmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentMutation", m)
+}
+
+// The CommentFunc type is an adapter to allow the use of ordinary
+// function as Comment mutator.
+type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMutation", m)
+}
+
 // The ItemFunc type is an adapter to allow the use of ordinary
 // function as Item mutator.
 type ItemFunc func(context.Context, *ent.ItemMutation) (ent.Value, error)
@@ -21,6 +45,90 @@ func (f ItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemMutation", m)
 }
 
+// The ItemImportFunc type is an adapter to allow the use of ordinary
+// function as ItemImport mutator.
+type ItemImportFunc func(context.Context, *ent.ItemImportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemImportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemImportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemImportMutation", m)
+}
+
+// The ItemRevisionFunc type is an adapter to allow the use of ordinary
+// function as ItemRevision mutator.
+type ItemRevisionFunc func(context.Context, *ent.ItemRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemRevisionMutation", m)
+}
+
+// The ItemShareFunc type is an adapter to allow the use of ordinary
+// function as ItemShare mutator.
+type ItemShareFunc func(context.Context, *ent.ItemShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemShareMutation", m)
+}
+
+// The ItemTransitionFunc type is an adapter to allow the use of ordinary
+// function as ItemTransition mutator.
+type ItemTransitionFunc func(context.Context, *ent.ItemTransitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemTransitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemTransitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemTransitionMutation", m)
+}
+
+// The MetadataSchemaFunc type is an adapter to allow the use of ordinary
+// function as MetadataSchema mutator.
+type MetadataSchemaFunc func(context.Context, *ent.MetadataSchemaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MetadataSchemaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MetadataSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetadataSchemaMutation", m)
+}
+
+// The OwnershipTransferFunc type is an adapter to allow the use of ordinary
+// function as OwnershipTransfer mutator.
+type OwnershipTransferFunc func(context.Context, *ent.OwnershipTransferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OwnershipTransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OwnershipTransferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OwnershipTransferMutation", m)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
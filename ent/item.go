@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,12 +23,24 @@ type Item struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// DeleteTime holds the value of the "delete_time" field.
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// OwnerID holds the value of the "owner_id" field.
 	OwnerID uint `json:"owner_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uint `json:"parent_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ItemQuery when eager-loading is set.
 	Edges        ItemEdges `json:"edges"`
@@ -38,9 +51,38 @@ type Item struct {
 type ItemEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*ItemShare `json:"shares,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*ItemRevision `json:"revisions,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Transitions holds the value of the transitions edge.
+	Transitions []*ItemTransition `json:"transitions,omitempty"`
+	// OwnershipTransfers holds the value of the ownership_transfers edge.
+	OwnershipTransfers []*OwnershipTransfer `json:"ownership_transfers,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Item `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Item `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [10]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedShares             map[string][]*ItemShare
+	namedRevisions          map[string][]*ItemRevision
+	namedTags               map[string][]*Tag
+	namedAttachments        map[string][]*Attachment
+	namedComments           map[string][]*Comment
+	namedTransitions        map[string][]*ItemTransition
+	namedOwnershipTransfers map[string][]*OwnershipTransfer
+	namedChildren           map[string][]*Item
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -56,16 +98,103 @@ func (e ItemEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) SharesOrErr() ([]*ItemShare, error) {
+	if e.loadedTypes[1] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) RevisionsOrErr() ([]*ItemRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[4] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[5] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// TransitionsOrErr returns the Transitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) TransitionsOrErr() ([]*ItemTransition, error) {
+	if e.loadedTypes[6] {
+		return e.Transitions, nil
+	}
+	return nil, &NotLoadedError{edge: "transitions"}
+}
+
+// OwnershipTransfersOrErr returns the OwnershipTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) OwnershipTransfersOrErr() ([]*OwnershipTransfer, error) {
+	if e.loadedTypes[7] {
+		return e.OwnershipTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "ownership_transfers"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) ParentOrErr() (*Item, error) {
+	if e.loadedTypes[8] {
+		if e.Parent == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) ChildrenOrErr() ([]*Item, error) {
+	if e.loadedTypes[9] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldID, item.FieldOwnerID:
+		case item.FieldMetadata:
+			values[i] = new([]byte)
+		case item.FieldID, item.FieldVersion, item.FieldOwnerID, item.FieldParentID:
 			values[i] = new(sql.NullInt64)
-		case item.FieldTitle, item.FieldDescription:
+		case item.FieldTitle, item.FieldDescription, item.FieldStatus:
 			values[i] = new(sql.NullString)
-		case item.FieldCreateTime, item.FieldUpdateTime:
+		case item.FieldCreateTime, item.FieldUpdateTime, item.FieldDeleteTime, item.FieldDueAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -100,6 +229,19 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.UpdateTime = value.Time
 			}
+		case item.FieldDeleteTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[j])
+			} else if value.Valid {
+				i.DeleteTime = new(time.Time)
+				*i.DeleteTime = value.Time
+			}
+		case item.FieldVersion:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[j])
+			} else if value.Valid {
+				i.Version = int(value.Int64)
+			}
 		case item.FieldTitle:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[j])
@@ -118,6 +260,34 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.OwnerID = uint(value.Int64)
 			}
+		case item.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = value.String
+			}
+		case item.FieldMetadata:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case item.FieldDueAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[j])
+			} else if value.Valid {
+				i.DueAt = new(time.Time)
+				*i.DueAt = value.Time
+			}
+		case item.FieldParentID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[j])
+			} else if value.Valid {
+				i.ParentID = new(uint)
+				*i.ParentID = uint(value.Int64)
+			}
 		default:
 			i.selectValues.Set(columns[j], values[j])
 		}
@@ -136,6 +306,51 @@ func (i *Item) QueryOwner() *UserQuery {
 	return NewItemClient(i.config).QueryOwner(i)
 }
 
+// QueryShares queries the "shares" edge of the Item entity.
+func (i *Item) QueryShares() *ItemShareQuery {
+	return NewItemClient(i.config).QueryShares(i)
+}
+
+// QueryRevisions queries the "revisions" edge of the Item entity.
+func (i *Item) QueryRevisions() *ItemRevisionQuery {
+	return NewItemClient(i.config).QueryRevisions(i)
+}
+
+// QueryTags queries the "tags" edge of the Item entity.
+func (i *Item) QueryTags() *TagQuery {
+	return NewItemClient(i.config).QueryTags(i)
+}
+
+// QueryAttachments queries the "attachments" edge of the Item entity.
+func (i *Item) QueryAttachments() *AttachmentQuery {
+	return NewItemClient(i.config).QueryAttachments(i)
+}
+
+// QueryComments queries the "comments" edge of the Item entity.
+func (i *Item) QueryComments() *CommentQuery {
+	return NewItemClient(i.config).QueryComments(i)
+}
+
+// QueryTransitions queries the "transitions" edge of the Item entity.
+func (i *Item) QueryTransitions() *ItemTransitionQuery {
+	return NewItemClient(i.config).QueryTransitions(i)
+}
+
+// QueryOwnershipTransfers queries the "ownership_transfers" edge of the Item entity.
+func (i *Item) QueryOwnershipTransfers() *OwnershipTransferQuery {
+	return NewItemClient(i.config).QueryOwnershipTransfers(i)
+}
+
+// QueryParent queries the "parent" edge of the Item entity.
+func (i *Item) QueryParent() *ItemQuery {
+	return NewItemClient(i.config).QueryParent(i)
+}
+
+// QueryChildren queries the "children" edge of the Item entity.
+func (i *Item) QueryChildren() *ItemQuery {
+	return NewItemClient(i.config).QueryChildren(i)
+}
+
 // Update returns a builder for updating this Item.
 // Note that you need to call Item.Unwrap() before calling this method if this Item
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -165,6 +380,14 @@ func (i *Item) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(i.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := i.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", i.Version))
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(i.Title)
 	builder.WriteString(", ")
@@ -173,9 +396,217 @@ func (i *Item) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("owner_id=")
 	builder.WriteString(fmt.Sprintf("%v", i.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(i.Status)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", i.Metadata))
+	builder.WriteString(", ")
+	if v := i.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedShares returns the Shares named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedShares(name string) ([]*ItemShare, error) {
+	if i.Edges.namedShares == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedShares[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedShares(name string, edges ...*ItemShare) {
+	if i.Edges.namedShares == nil {
+		i.Edges.namedShares = make(map[string][]*ItemShare)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedShares[name] = []*ItemShare{}
+	} else {
+		i.Edges.namedShares[name] = append(i.Edges.namedShares[name], edges...)
+	}
+}
+
+// NamedRevisions returns the Revisions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedRevisions(name string) ([]*ItemRevision, error) {
+	if i.Edges.namedRevisions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedRevisions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedRevisions(name string, edges ...*ItemRevision) {
+	if i.Edges.namedRevisions == nil {
+		i.Edges.namedRevisions = make(map[string][]*ItemRevision)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedRevisions[name] = []*ItemRevision{}
+	} else {
+		i.Edges.namedRevisions[name] = append(i.Edges.namedRevisions[name], edges...)
+	}
+}
+
+// NamedTags returns the Tags named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedTags(name string) ([]*Tag, error) {
+	if i.Edges.namedTags == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedTags[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedTags(name string, edges ...*Tag) {
+	if i.Edges.namedTags == nil {
+		i.Edges.namedTags = make(map[string][]*Tag)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedTags[name] = []*Tag{}
+	} else {
+		i.Edges.namedTags[name] = append(i.Edges.namedTags[name], edges...)
+	}
+}
+
+// NamedAttachments returns the Attachments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedAttachments(name string) ([]*Attachment, error) {
+	if i.Edges.namedAttachments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedAttachments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedAttachments(name string, edges ...*Attachment) {
+	if i.Edges.namedAttachments == nil {
+		i.Edges.namedAttachments = make(map[string][]*Attachment)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedAttachments[name] = []*Attachment{}
+	} else {
+		i.Edges.namedAttachments[name] = append(i.Edges.namedAttachments[name], edges...)
+	}
+}
+
+// NamedComments returns the Comments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedComments(name string) ([]*Comment, error) {
+	if i.Edges.namedComments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedComments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedComments(name string, edges ...*Comment) {
+	if i.Edges.namedComments == nil {
+		i.Edges.namedComments = make(map[string][]*Comment)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedComments[name] = []*Comment{}
+	} else {
+		i.Edges.namedComments[name] = append(i.Edges.namedComments[name], edges...)
+	}
+}
+
+// NamedTransitions returns the Transitions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedTransitions(name string) ([]*ItemTransition, error) {
+	if i.Edges.namedTransitions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedTransitions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedTransitions(name string, edges ...*ItemTransition) {
+	if i.Edges.namedTransitions == nil {
+		i.Edges.namedTransitions = make(map[string][]*ItemTransition)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedTransitions[name] = []*ItemTransition{}
+	} else {
+		i.Edges.namedTransitions[name] = append(i.Edges.namedTransitions[name], edges...)
+	}
+}
+
+// NamedOwnershipTransfers returns the OwnershipTransfers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedOwnershipTransfers(name string) ([]*OwnershipTransfer, error) {
+	if i.Edges.namedOwnershipTransfers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedOwnershipTransfers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedOwnershipTransfers(name string, edges ...*OwnershipTransfer) {
+	if i.Edges.namedOwnershipTransfers == nil {
+		i.Edges.namedOwnershipTransfers = make(map[string][]*OwnershipTransfer)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedOwnershipTransfers[name] = []*OwnershipTransfer{}
+	} else {
+		i.Edges.namedOwnershipTransfers[name] = append(i.Edges.namedOwnershipTransfers[name], edges...)
+	}
+}
+
+// NamedChildren returns the Children named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Item) NamedChildren(name string) ([]*Item, error) {
+	if i.Edges.namedChildren == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedChildren[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Item) appendNamedChildren(name string, edges ...*Item) {
+	if i.Edges.namedChildren == nil {
+		i.Edges.namedChildren = make(map[string][]*Item)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedChildren[name] = []*Item{}
+	} else {
+		i.Edges.namedChildren[name] = append(i.Edges.namedChildren[name], edges...)
+	}
+}
+
 // Items is a parsable slice of Item.
 type Items []*Item
@@ -10,7 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
@@ -49,6 +56,34 @@ func (ic *ItemCreate) SetNillableUpdateTime(t *time.Time) *ItemCreate {
 	return ic
 }
 
+// SetDeleteTime sets the "delete_time" field.
+func (ic *ItemCreate) SetDeleteTime(t time.Time) *ItemCreate {
+	ic.mutation.SetDeleteTime(t)
+	return ic
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableDeleteTime(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetDeleteTime(*t)
+	}
+	return ic
+}
+
+// SetVersion sets the "version" field.
+func (ic *ItemCreate) SetVersion(i int) *ItemCreate {
+	ic.mutation.SetVersion(i)
+	return ic
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableVersion(i *int) *ItemCreate {
+	if i != nil {
+		ic.SetVersion(*i)
+	}
+	return ic
+}
+
 // SetTitle sets the "title" field.
 func (ic *ItemCreate) SetTitle(s string) *ItemCreate {
 	ic.mutation.SetTitle(s)
@@ -67,6 +102,54 @@ func (ic *ItemCreate) SetOwnerID(u uint) *ItemCreate {
 	return ic
 }
 
+// SetStatus sets the "status" field.
+func (ic *ItemCreate) SetStatus(s string) *ItemCreate {
+	ic.mutation.SetStatus(s)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableStatus(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetStatus(*s)
+	}
+	return ic
+}
+
+// SetMetadata sets the "metadata" field.
+func (ic *ItemCreate) SetMetadata(m map[string]interface{}) *ItemCreate {
+	ic.mutation.SetMetadata(m)
+	return ic
+}
+
+// SetDueAt sets the "due_at" field.
+func (ic *ItemCreate) SetDueAt(t time.Time) *ItemCreate {
+	ic.mutation.SetDueAt(t)
+	return ic
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableDueAt(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetDueAt(*t)
+	}
+	return ic
+}
+
+// SetParentID sets the "parent_id" field.
+func (ic *ItemCreate) SetParentID(u uint) *ItemCreate {
+	ic.mutation.SetParentID(u)
+	return ic
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableParentID(u *uint) *ItemCreate {
+	if u != nil {
+		ic.SetParentID(*u)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *ItemCreate) SetID(u uint) *ItemCreate {
 	ic.mutation.SetID(u)
@@ -78,6 +161,131 @@ func (ic *ItemCreate) SetOwner(u *User) *ItemCreate {
 	return ic.SetOwnerID(u.ID)
 }
 
+// AddShareIDs adds the "shares" edge to the ItemShare entity by IDs.
+func (ic *ItemCreate) AddShareIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddShareIDs(ids...)
+	return ic
+}
+
+// AddShares adds the "shares" edges to the ItemShare entity.
+func (ic *ItemCreate) AddShares(i ...*ItemShare) *ItemCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddShareIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by IDs.
+func (ic *ItemCreate) AddRevisionIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddRevisionIDs(ids...)
+	return ic
+}
+
+// AddRevisions adds the "revisions" edges to the ItemRevision entity.
+func (ic *ItemCreate) AddRevisions(i ...*ItemRevision) *ItemCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (ic *ItemCreate) AddTagIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddTagIDs(ids...)
+	return ic
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (ic *ItemCreate) AddTags(t ...*Tag) *ItemCreate {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ic.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (ic *ItemCreate) AddAttachmentIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddAttachmentIDs(ids...)
+	return ic
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (ic *ItemCreate) AddAttachments(a ...*Attachment) *ItemCreate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ic.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (ic *ItemCreate) AddCommentIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddCommentIDs(ids...)
+	return ic
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (ic *ItemCreate) AddComments(c ...*Comment) *ItemCreate {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ic.AddCommentIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the ItemTransition entity by IDs.
+func (ic *ItemCreate) AddTransitionIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddTransitionIDs(ids...)
+	return ic
+}
+
+// AddTransitions adds the "transitions" edges to the ItemTransition entity.
+func (ic *ItemCreate) AddTransitions(i ...*ItemTransition) *ItemCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddTransitionIDs(ids...)
+}
+
+// AddOwnershipTransferIDs adds the "ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (ic *ItemCreate) AddOwnershipTransferIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddOwnershipTransferIDs(ids...)
+	return ic
+}
+
+// AddOwnershipTransfers adds the "ownership_transfers" edges to the OwnershipTransfer entity.
+func (ic *ItemCreate) AddOwnershipTransfers(o ...*OwnershipTransfer) *ItemCreate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ic.AddOwnershipTransferIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Item entity.
+func (ic *ItemCreate) SetParent(i *Item) *ItemCreate {
+	return ic.SetParentID(i.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by IDs.
+func (ic *ItemCreate) AddChildIDs(ids ...uint) *ItemCreate {
+	ic.mutation.AddChildIDs(ids...)
+	return ic
+}
+
+// AddChildren adds the "children" edges to the Item entity.
+func (ic *ItemCreate) AddChildren(i ...*Item) *ItemCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddChildIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (ic *ItemCreate) Mutation() *ItemMutation {
 	return ic.mutation
@@ -85,7 +293,9 @@ func (ic *ItemCreate) Mutation() *ItemMutation {
 
 // Save creates the Item in the database.
 func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
-	ic.defaults()
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks[*Item, ItemMutation](ctx, ic.sqlSave, ic.mutation, ic.hooks)
 }
 
@@ -112,15 +322,30 @@ func (ic *ItemCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ic *ItemCreate) defaults() {
+func (ic *ItemCreate) defaults() error {
 	if _, ok := ic.mutation.CreateTime(); !ok {
+		if item.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultCreateTime()
 		ic.mutation.SetCreateTime(v)
 	}
 	if _, ok := ic.mutation.UpdateTime(); !ok {
+		if item.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultUpdateTime()
 		ic.mutation.SetUpdateTime(v)
 	}
+	if _, ok := ic.mutation.Version(); !ok {
+		v := item.DefaultVersion
+		ic.mutation.SetVersion(v)
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		v := item.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -131,6 +356,9 @@ func (ic *ItemCreate) check() error {
 	if _, ok := ic.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Item.update_time"`)}
 	}
+	if _, ok := ic.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Item.version"`)}
+	}
 	if _, ok := ic.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Item.title"`)}
 	}
@@ -140,6 +368,14 @@ func (ic *ItemCreate) check() error {
 	if _, ok := ic.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Item.owner_id"`)}
 	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Item.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if _, ok := ic.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Item.owner"`)}
 	}
@@ -183,6 +419,14 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := ic.mutation.DeleteTime(); ok {
+		_spec.SetField(item.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := ic.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := ic.mutation.Title(); ok {
 		_spec.SetField(item.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -191,6 +435,18 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.Metadata(); ok {
+		_spec.SetField(item.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := ic.mutation.DueAt(); ok {
+		_spec.SetField(item.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
 	if nodes := ic.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -208,6 +464,151 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_node.OwnerID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.OwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,25 +4,53 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
 // ItemQuery is the builder for querying Item entities.
 type ItemQuery struct {
 	config
-	ctx        *QueryContext
-	order      []item.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Item
-	withOwner  *UserQuery
+	ctx                         *QueryContext
+	order                       []item.OrderOption
+	inters                      []Interceptor
+	predicates                  []predicate.Item
+	withOwner                   *UserQuery
+	withShares                  *ItemShareQuery
+	withRevisions               *ItemRevisionQuery
+	withTags                    *TagQuery
+	withAttachments             *AttachmentQuery
+	withComments                *CommentQuery
+	withTransitions             *ItemTransitionQuery
+	withOwnershipTransfers      *OwnershipTransferQuery
+	withParent                  *ItemQuery
+	withChildren                *ItemQuery
+	loadTotal                   []func(context.Context, []*Item) error
+	modifiers                   []func(*sql.Selector)
+	withNamedShares             map[string]*ItemShareQuery
+	withNamedRevisions          map[string]*ItemRevisionQuery
+	withNamedTags               map[string]*TagQuery
+	withNamedAttachments        map[string]*AttachmentQuery
+	withNamedComments           map[string]*CommentQuery
+	withNamedTransitions        map[string]*ItemTransitionQuery
+	withNamedOwnershipTransfers map[string]*OwnershipTransferQuery
+	withNamedChildren           map[string]*ItemQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -81,6 +109,204 @@ func (iq *ItemQuery) QueryOwner() *UserQuery {
 	return query
 }
 
+// QueryShares chains the current query on the "shares" edge.
+func (iq *ItemQuery) QueryShares() *ItemShareQuery {
+	query := (&ItemShareClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemshare.Table, itemshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.SharesTable, item.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (iq *ItemQuery) QueryRevisions() *ItemRevisionQuery {
+	query := (&ItemRevisionClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemrevision.Table, itemrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.RevisionsTable, item.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (iq *ItemQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, item.TagsTable, item.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (iq *ItemQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.AttachmentsTable, item.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (iq *ItemQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.CommentsTable, item.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransitions chains the current query on the "transitions" edge.
+func (iq *ItemQuery) QueryTransitions() *ItemTransitionQuery {
+	query := (&ItemTransitionClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemtransition.Table, itemtransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.TransitionsTable, item.TransitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOwnershipTransfers chains the current query on the "ownership_transfers" edge.
+func (iq *ItemQuery) QueryOwnershipTransfers() *OwnershipTransferQuery {
+	query := (&OwnershipTransferClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(ownershiptransfer.Table, ownershiptransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.OwnershipTransfersTable, item.OwnershipTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (iq *ItemQuery) QueryParent() *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.ParentTable, item.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (iq *ItemQuery) QueryChildren() *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.ChildrenTable, item.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Item entity from the query.
 // Returns a *NotFoundError when no Item was found.
 func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
@@ -268,12 +494,21 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		return nil
 	}
 	return &ItemQuery{
-		config:     iq.config,
-		ctx:        iq.ctx.Clone(),
-		order:      append([]item.OrderOption{}, iq.order...),
-		inters:     append([]Interceptor{}, iq.inters...),
-		predicates: append([]predicate.Item{}, iq.predicates...),
-		withOwner:  iq.withOwner.Clone(),
+		config:                 iq.config,
+		ctx:                    iq.ctx.Clone(),
+		order:                  append([]item.OrderOption{}, iq.order...),
+		inters:                 append([]Interceptor{}, iq.inters...),
+		predicates:             append([]predicate.Item{}, iq.predicates...),
+		withOwner:              iq.withOwner.Clone(),
+		withShares:             iq.withShares.Clone(),
+		withRevisions:          iq.withRevisions.Clone(),
+		withTags:               iq.withTags.Clone(),
+		withAttachments:        iq.withAttachments.Clone(),
+		withComments:           iq.withComments.Clone(),
+		withTransitions:        iq.withTransitions.Clone(),
+		withOwnershipTransfers: iq.withOwnershipTransfers.Clone(),
+		withParent:             iq.withParent.Clone(),
+		withChildren:           iq.withChildren.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -291,6 +526,105 @@ func (iq *ItemQuery) WithOwner(opts ...func(*UserQuery)) *ItemQuery {
 	return iq
 }
 
+// WithShares tells the query-builder to eager-load the nodes that are connected to
+// the "shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithShares(opts ...func(*ItemShareQuery)) *ItemQuery {
+	query := (&ItemShareClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withShares = query
+	return iq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithRevisions(opts ...func(*ItemRevisionQuery)) *ItemQuery {
+	query := (&ItemRevisionClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withRevisions = query
+	return iq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithTags(opts ...func(*TagQuery)) *ItemQuery {
+	query := (&TagClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withTags = query
+	return iq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithAttachments(opts ...func(*AttachmentQuery)) *ItemQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withAttachments = query
+	return iq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithComments(opts ...func(*CommentQuery)) *ItemQuery {
+	query := (&CommentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withComments = query
+	return iq
+}
+
+// WithTransitions tells the query-builder to eager-load the nodes that are connected to
+// the "transitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithTransitions(opts ...func(*ItemTransitionQuery)) *ItemQuery {
+	query := (&ItemTransitionClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withTransitions = query
+	return iq
+}
+
+// WithOwnershipTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "ownership_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithOwnershipTransfers(opts ...func(*OwnershipTransferQuery)) *ItemQuery {
+	query := (&OwnershipTransferClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withOwnershipTransfers = query
+	return iq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithParent(opts ...func(*ItemQuery)) *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withParent = query
+	return iq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithChildren(opts ...func(*ItemQuery)) *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withChildren = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -362,6 +696,12 @@ func (iq *ItemQuery) prepareQuery(ctx context.Context) error {
 		}
 		iq.sql = prev
 	}
+	if item.Policy == nil {
+		return errors.New("ent: uninitialized item.Policy (forgotten import ent/runtime?)")
+	}
+	if err := item.Policy.EvalQuery(ctx, iq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -369,8 +709,17 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 	var (
 		nodes       = []*Item{}
 		_spec       = iq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [10]bool{
 			iq.withOwner != nil,
+			iq.withShares != nil,
+			iq.withRevisions != nil,
+			iq.withTags != nil,
+			iq.withAttachments != nil,
+			iq.withComments != nil,
+			iq.withTransitions != nil,
+			iq.withOwnershipTransfers != nil,
+			iq.withParent != nil,
+			iq.withChildren != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -382,6 +731,9 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -397,6 +749,131 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			return nil, err
 		}
 	}
+	if query := iq.withShares; query != nil {
+		if err := iq.loadShares(ctx, query, nodes,
+			func(n *Item) { n.Edges.Shares = []*ItemShare{} },
+			func(n *Item, e *ItemShare) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withRevisions; query != nil {
+		if err := iq.loadRevisions(ctx, query, nodes,
+			func(n *Item) { n.Edges.Revisions = []*ItemRevision{} },
+			func(n *Item, e *ItemRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withTags; query != nil {
+		if err := iq.loadTags(ctx, query, nodes,
+			func(n *Item) { n.Edges.Tags = []*Tag{} },
+			func(n *Item, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withAttachments; query != nil {
+		if err := iq.loadAttachments(ctx, query, nodes,
+			func(n *Item) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Item, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withComments; query != nil {
+		if err := iq.loadComments(ctx, query, nodes,
+			func(n *Item) { n.Edges.Comments = []*Comment{} },
+			func(n *Item, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withTransitions; query != nil {
+		if err := iq.loadTransitions(ctx, query, nodes,
+			func(n *Item) { n.Edges.Transitions = []*ItemTransition{} },
+			func(n *Item, e *ItemTransition) { n.Edges.Transitions = append(n.Edges.Transitions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withOwnershipTransfers; query != nil {
+		if err := iq.loadOwnershipTransfers(ctx, query, nodes,
+			func(n *Item) { n.Edges.OwnershipTransfers = []*OwnershipTransfer{} },
+			func(n *Item, e *OwnershipTransfer) {
+				n.Edges.OwnershipTransfers = append(n.Edges.OwnershipTransfers, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withParent; query != nil {
+		if err := iq.loadParent(ctx, query, nodes, nil,
+			func(n *Item, e *Item) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withChildren; query != nil {
+		if err := iq.loadChildren(ctx, query, nodes,
+			func(n *Item) { n.Edges.Children = []*Item{} },
+			func(n *Item, e *Item) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedShares {
+		if err := iq.loadShares(ctx, query, nodes,
+			func(n *Item) { n.appendNamedShares(name) },
+			func(n *Item, e *ItemShare) { n.appendNamedShares(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedRevisions {
+		if err := iq.loadRevisions(ctx, query, nodes,
+			func(n *Item) { n.appendNamedRevisions(name) },
+			func(n *Item, e *ItemRevision) { n.appendNamedRevisions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedTags {
+		if err := iq.loadTags(ctx, query, nodes,
+			func(n *Item) { n.appendNamedTags(name) },
+			func(n *Item, e *Tag) { n.appendNamedTags(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedAttachments {
+		if err := iq.loadAttachments(ctx, query, nodes,
+			func(n *Item) { n.appendNamedAttachments(name) },
+			func(n *Item, e *Attachment) { n.appendNamedAttachments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedComments {
+		if err := iq.loadComments(ctx, query, nodes,
+			func(n *Item) { n.appendNamedComments(name) },
+			func(n *Item, e *Comment) { n.appendNamedComments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedTransitions {
+		if err := iq.loadTransitions(ctx, query, nodes,
+			func(n *Item) { n.appendNamedTransitions(name) },
+			func(n *Item, e *ItemTransition) { n.appendNamedTransitions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedOwnershipTransfers {
+		if err := iq.loadOwnershipTransfers(ctx, query, nodes,
+			func(n *Item) { n.appendNamedOwnershipTransfers(name) },
+			func(n *Item, e *OwnershipTransfer) { n.appendNamedOwnershipTransfers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range iq.withNamedChildren {
+		if err := iq.loadChildren(ctx, query, nodes,
+			func(n *Item) { n.appendNamedChildren(name) },
+			func(n *Item, e *Item) { n.appendNamedChildren(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range iq.loadTotal {
+		if err := iq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -429,9 +906,318 @@ func (iq *ItemQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*I
 	}
 	return nil
 }
+func (iq *ItemQuery) loadShares(ctx context.Context, query *ItemShareQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemshare.FieldItemID)
+	}
+	query.Where(predicate.ItemShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadRevisions(ctx context.Context, query *ItemRevisionQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemrevision.FieldItemID)
+	}
+	query.Where(predicate.ItemRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Item, init func(*Item), assign func(*Item, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint]*Item)
+	nids := make(map[uint]map[*Item]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(item.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(item.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(item.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(item.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint(values[0].(*sql.NullInt64).Int64)
+				inValue := uint(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Item]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (iq *ItemQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Item, init func(*Item), assign func(*Item, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldItemID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Item, init func(*Item), assign func(*Item, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldItemID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadTransitions(ctx context.Context, query *ItemTransitionQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemTransition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemtransition.FieldItemID)
+	}
+	query.Where(predicate.ItemTransition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.TransitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadOwnershipTransfers(ctx context.Context, query *OwnershipTransferQuery, nodes []*Item, init func(*Item), assign func(*Item, *OwnershipTransfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ownershiptransfer.FieldItemID)
+	}
+	query.Where(predicate.OwnershipTransfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.OwnershipTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadParent(ctx context.Context, query *ItemQuery, nodes []*Item, init func(*Item), assign func(*Item, *Item)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*Item)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (iq *ItemQuery) loadChildren(ctx context.Context, query *ItemQuery, nodes []*Item, init func(*Item), assign func(*Item, *Item)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(item.FieldParentID)
+	}
+	query.Where(predicate.Item(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	_spec.Node.Columns = iq.ctx.Fields
 	if len(iq.ctx.Fields) > 0 {
 		_spec.Unique = iq.ctx.Unique != nil && *iq.ctx.Unique
@@ -458,6 +1244,9 @@ func (iq *ItemQuery) querySpec() *sqlgraph.QuerySpec {
 		if iq.withOwner != nil {
 			_spec.Node.AddColumnOnce(item.FieldOwnerID)
 		}
+		if iq.withParent != nil {
+			_spec.Node.AddColumnOnce(item.FieldParentID)
+		}
 	}
 	if ps := iq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -497,6 +1286,9 @@ func (iq *ItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if iq.ctx.Unique != nil && *iq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
 	for _, p := range iq.predicates {
 		p(selector)
 	}
@@ -514,6 +1306,124 @@ func (iq *ItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iq *ItemQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemSelect {
+	iq.modifiers = append(iq.modifiers, modifiers...)
+	return iq.Select()
+}
+
+// WithNamedShares tells the query-builder to eager-load the nodes that are connected to the "shares"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedShares(name string, opts ...func(*ItemShareQuery)) *ItemQuery {
+	query := (&ItemShareClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedShares == nil {
+		iq.withNamedShares = make(map[string]*ItemShareQuery)
+	}
+	iq.withNamedShares[name] = query
+	return iq
+}
+
+// WithNamedRevisions tells the query-builder to eager-load the nodes that are connected to the "revisions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedRevisions(name string, opts ...func(*ItemRevisionQuery)) *ItemQuery {
+	query := (&ItemRevisionClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedRevisions == nil {
+		iq.withNamedRevisions = make(map[string]*ItemRevisionQuery)
+	}
+	iq.withNamedRevisions[name] = query
+	return iq
+}
+
+// WithNamedTags tells the query-builder to eager-load the nodes that are connected to the "tags"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedTags(name string, opts ...func(*TagQuery)) *ItemQuery {
+	query := (&TagClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedTags == nil {
+		iq.withNamedTags = make(map[string]*TagQuery)
+	}
+	iq.withNamedTags[name] = query
+	return iq
+}
+
+// WithNamedAttachments tells the query-builder to eager-load the nodes that are connected to the "attachments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedAttachments(name string, opts ...func(*AttachmentQuery)) *ItemQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedAttachments == nil {
+		iq.withNamedAttachments = make(map[string]*AttachmentQuery)
+	}
+	iq.withNamedAttachments[name] = query
+	return iq
+}
+
+// WithNamedComments tells the query-builder to eager-load the nodes that are connected to the "comments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedComments(name string, opts ...func(*CommentQuery)) *ItemQuery {
+	query := (&CommentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedComments == nil {
+		iq.withNamedComments = make(map[string]*CommentQuery)
+	}
+	iq.withNamedComments[name] = query
+	return iq
+}
+
+// WithNamedTransitions tells the query-builder to eager-load the nodes that are connected to the "transitions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedTransitions(name string, opts ...func(*ItemTransitionQuery)) *ItemQuery {
+	query := (&ItemTransitionClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedTransitions == nil {
+		iq.withNamedTransitions = make(map[string]*ItemTransitionQuery)
+	}
+	iq.withNamedTransitions[name] = query
+	return iq
+}
+
+// WithNamedOwnershipTransfers tells the query-builder to eager-load the nodes that are connected to the "ownership_transfers"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedOwnershipTransfers(name string, opts ...func(*OwnershipTransferQuery)) *ItemQuery {
+	query := (&OwnershipTransferClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedOwnershipTransfers == nil {
+		iq.withNamedOwnershipTransfers = make(map[string]*OwnershipTransferQuery)
+	}
+	iq.withNamedOwnershipTransfers[name] = query
+	return iq
+}
+
+// WithNamedChildren tells the query-builder to eager-load the nodes that are connected to the "children"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithNamedChildren(name string, opts ...func(*ItemQuery)) *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if iq.withNamedChildren == nil {
+		iq.withNamedChildren = make(map[string]*ItemQuery)
+	}
+	iq.withNamedChildren[name] = query
+	return iq
+}
+
 // ItemGroupBy is the group-by builder for Item entities.
 type ItemGroupBy struct {
 	selector
@@ -603,3 +1513,9 @@ func (is *ItemSelect) sqlScan(ctx context.Context, root *ItemQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (is *ItemSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemSelect {
+	is.modifiers = append(is.modifiers, modifiers...)
+	return is
+}
@@ -11,16 +11,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
 // ItemUpdate is the builder for updating Item entities.
 type ItemUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ItemMutation
+	hooks     []Hook
+	mutation  *ItemMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ItemUpdate builder.
@@ -35,6 +43,47 @@ func (iu *ItemUpdate) SetUpdateTime(t time.Time) *ItemUpdate {
 	return iu
 }
 
+// SetDeleteTime sets the "delete_time" field.
+func (iu *ItemUpdate) SetDeleteTime(t time.Time) *ItemUpdate {
+	iu.mutation.SetDeleteTime(t)
+	return iu
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableDeleteTime(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetDeleteTime(*t)
+	}
+	return iu
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (iu *ItemUpdate) ClearDeleteTime() *ItemUpdate {
+	iu.mutation.ClearDeleteTime()
+	return iu
+}
+
+// SetVersion sets the "version" field.
+func (iu *ItemUpdate) SetVersion(i int) *ItemUpdate {
+	iu.mutation.ResetVersion()
+	iu.mutation.SetVersion(i)
+	return iu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableVersion(i *int) *ItemUpdate {
+	if i != nil {
+		iu.SetVersion(*i)
+	}
+	return iu
+}
+
+// AddVersion adds i to the "version" field.
+func (iu *ItemUpdate) AddVersion(i int) *ItemUpdate {
+	iu.mutation.AddVersion(i)
+	return iu
+}
+
 // SetTitle sets the "title" field.
 func (iu *ItemUpdate) SetTitle(s string) *ItemUpdate {
 	iu.mutation.SetTitle(s)
@@ -53,11 +102,202 @@ func (iu *ItemUpdate) SetOwnerID(u uint) *ItemUpdate {
 	return iu
 }
 
+// SetStatus sets the "status" field.
+func (iu *ItemUpdate) SetStatus(s string) *ItemUpdate {
+	iu.mutation.SetStatus(s)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableStatus(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetStatus(*s)
+	}
+	return iu
+}
+
+// SetMetadata sets the "metadata" field.
+func (iu *ItemUpdate) SetMetadata(m map[string]interface{}) *ItemUpdate {
+	iu.mutation.SetMetadata(m)
+	return iu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (iu *ItemUpdate) ClearMetadata() *ItemUpdate {
+	iu.mutation.ClearMetadata()
+	return iu
+}
+
+// SetDueAt sets the "due_at" field.
+func (iu *ItemUpdate) SetDueAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetDueAt(t)
+	return iu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableDueAt(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetDueAt(*t)
+	}
+	return iu
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (iu *ItemUpdate) ClearDueAt() *ItemUpdate {
+	iu.mutation.ClearDueAt()
+	return iu
+}
+
+// SetParentID sets the "parent_id" field.
+func (iu *ItemUpdate) SetParentID(u uint) *ItemUpdate {
+	iu.mutation.SetParentID(u)
+	return iu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableParentID(u *uint) *ItemUpdate {
+	if u != nil {
+		iu.SetParentID(*u)
+	}
+	return iu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (iu *ItemUpdate) ClearParentID() *ItemUpdate {
+	iu.mutation.ClearParentID()
+	return iu
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (iu *ItemUpdate) SetOwner(u *User) *ItemUpdate {
 	return iu.SetOwnerID(u.ID)
 }
 
+// AddShareIDs adds the "shares" edge to the ItemShare entity by IDs.
+func (iu *ItemUpdate) AddShareIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddShareIDs(ids...)
+	return iu
+}
+
+// AddShares adds the "shares" edges to the ItemShare entity.
+func (iu *ItemUpdate) AddShares(i ...*ItemShare) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddShareIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by IDs.
+func (iu *ItemUpdate) AddRevisionIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddRevisionIDs(ids...)
+	return iu
+}
+
+// AddRevisions adds the "revisions" edges to the ItemRevision entity.
+func (iu *ItemUpdate) AddRevisions(i ...*ItemRevision) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (iu *ItemUpdate) AddTagIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddTagIDs(ids...)
+	return iu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (iu *ItemUpdate) AddTags(t ...*Tag) *ItemUpdate {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iu.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (iu *ItemUpdate) AddAttachmentIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddAttachmentIDs(ids...)
+	return iu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (iu *ItemUpdate) AddAttachments(a ...*Attachment) *ItemUpdate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iu.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (iu *ItemUpdate) AddCommentIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddCommentIDs(ids...)
+	return iu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (iu *ItemUpdate) AddComments(c ...*Comment) *ItemUpdate {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.AddCommentIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the ItemTransition entity by IDs.
+func (iu *ItemUpdate) AddTransitionIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddTransitionIDs(ids...)
+	return iu
+}
+
+// AddTransitions adds the "transitions" edges to the ItemTransition entity.
+func (iu *ItemUpdate) AddTransitions(i ...*ItemTransition) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddTransitionIDs(ids...)
+}
+
+// AddOwnershipTransferIDs adds the "ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (iu *ItemUpdate) AddOwnershipTransferIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddOwnershipTransferIDs(ids...)
+	return iu
+}
+
+// AddOwnershipTransfers adds the "ownership_transfers" edges to the OwnershipTransfer entity.
+func (iu *ItemUpdate) AddOwnershipTransfers(o ...*OwnershipTransfer) *ItemUpdate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return iu.AddOwnershipTransferIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Item entity.
+func (iu *ItemUpdate) SetParent(i *Item) *ItemUpdate {
+	return iu.SetParentID(i.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by IDs.
+func (iu *ItemUpdate) AddChildIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.AddChildIDs(ids...)
+	return iu
+}
+
+// AddChildren adds the "children" edges to the Item entity.
+func (iu *ItemUpdate) AddChildren(i ...*Item) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddChildIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
@@ -69,9 +309,185 @@ func (iu *ItemUpdate) ClearOwner() *ItemUpdate {
 	return iu
 }
 
+// ClearShares clears all "shares" edges to the ItemShare entity.
+func (iu *ItemUpdate) ClearShares() *ItemUpdate {
+	iu.mutation.ClearShares()
+	return iu
+}
+
+// RemoveShareIDs removes the "shares" edge to ItemShare entities by IDs.
+func (iu *ItemUpdate) RemoveShareIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveShareIDs(ids...)
+	return iu
+}
+
+// RemoveShares removes "shares" edges to ItemShare entities.
+func (iu *ItemUpdate) RemoveShares(i ...*ItemShare) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveShareIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ItemRevision entity.
+func (iu *ItemUpdate) ClearRevisions() *ItemUpdate {
+	iu.mutation.ClearRevisions()
+	return iu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ItemRevision entities by IDs.
+func (iu *ItemUpdate) RemoveRevisionIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveRevisionIDs(ids...)
+	return iu
+}
+
+// RemoveRevisions removes "revisions" edges to ItemRevision entities.
+func (iu *ItemUpdate) RemoveRevisions(i ...*ItemRevision) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (iu *ItemUpdate) ClearTags() *ItemUpdate {
+	iu.mutation.ClearTags()
+	return iu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (iu *ItemUpdate) RemoveTagIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveTagIDs(ids...)
+	return iu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (iu *ItemUpdate) RemoveTags(t ...*Tag) *ItemUpdate {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iu.RemoveTagIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (iu *ItemUpdate) ClearAttachments() *ItemUpdate {
+	iu.mutation.ClearAttachments()
+	return iu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (iu *ItemUpdate) RemoveAttachmentIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveAttachmentIDs(ids...)
+	return iu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (iu *ItemUpdate) RemoveAttachments(a ...*Attachment) *ItemUpdate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (iu *ItemUpdate) ClearComments() *ItemUpdate {
+	iu.mutation.ClearComments()
+	return iu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (iu *ItemUpdate) RemoveCommentIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveCommentIDs(ids...)
+	return iu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (iu *ItemUpdate) RemoveComments(c ...*Comment) *ItemUpdate {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.RemoveCommentIDs(ids...)
+}
+
+// ClearTransitions clears all "transitions" edges to the ItemTransition entity.
+func (iu *ItemUpdate) ClearTransitions() *ItemUpdate {
+	iu.mutation.ClearTransitions()
+	return iu
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to ItemTransition entities by IDs.
+func (iu *ItemUpdate) RemoveTransitionIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveTransitionIDs(ids...)
+	return iu
+}
+
+// RemoveTransitions removes "transitions" edges to ItemTransition entities.
+func (iu *ItemUpdate) RemoveTransitions(i ...*ItemTransition) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveTransitionIDs(ids...)
+}
+
+// ClearOwnershipTransfers clears all "ownership_transfers" edges to the OwnershipTransfer entity.
+func (iu *ItemUpdate) ClearOwnershipTransfers() *ItemUpdate {
+	iu.mutation.ClearOwnershipTransfers()
+	return iu
+}
+
+// RemoveOwnershipTransferIDs removes the "ownership_transfers" edge to OwnershipTransfer entities by IDs.
+func (iu *ItemUpdate) RemoveOwnershipTransferIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveOwnershipTransferIDs(ids...)
+	return iu
+}
+
+// RemoveOwnershipTransfers removes "ownership_transfers" edges to OwnershipTransfer entities.
+func (iu *ItemUpdate) RemoveOwnershipTransfers(o ...*OwnershipTransfer) *ItemUpdate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return iu.RemoveOwnershipTransferIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Item entity.
+func (iu *ItemUpdate) ClearParent() *ItemUpdate {
+	iu.mutation.ClearParent()
+	return iu
+}
+
+// ClearChildren clears all "children" edges to the Item entity.
+func (iu *ItemUpdate) ClearChildren() *ItemUpdate {
+	iu.mutation.ClearChildren()
+	return iu
+}
+
+// RemoveChildIDs removes the "children" edge to Item entities by IDs.
+func (iu *ItemUpdate) RemoveChildIDs(ids ...uint) *ItemUpdate {
+	iu.mutation.RemoveChildIDs(ids...)
+	return iu
+}
+
+// RemoveChildren removes "children" edges to Item entities.
+func (iu *ItemUpdate) RemoveChildren(i ...*Item) *ItemUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
-	iu.defaults()
+	if err := iu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks[int, ItemMutation](ctx, iu.sqlSave, iu.mutation, iu.hooks)
 }
 
@@ -98,21 +514,36 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (iu *ItemUpdate) defaults() {
+func (iu *ItemUpdate) defaults() error {
 	if _, ok := iu.mutation.UpdateTime(); !ok {
+		if item.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdateTime()
 		iu.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (iu *ItemUpdate) check() error {
+	if v, ok := iu.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if _, ok := iu.mutation.OwnerID(); iu.mutation.OwnerCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Item.owner"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iu *ItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemUpdate {
+	iu.modifiers = append(iu.modifiers, modifiers...)
+	return iu
+}
+
 func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := iu.check(); err != nil {
 		return n, err
@@ -128,12 +559,39 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.UpdateTime(); ok {
 		_spec.SetField(item.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := iu.mutation.DeleteTime(); ok {
+		_spec.SetField(item.FieldDeleteTime, field.TypeTime, value)
+	}
+	if iu.mutation.DeleteTimeCleared() {
+		_spec.ClearField(item.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := iu.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := iu.mutation.Title(); ok {
 		_spec.SetField(item.FieldTitle, field.TypeString, value)
 	}
 	if value, ok := iu.mutation.Description(); ok {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Metadata(); ok {
+		_spec.SetField(item.FieldMetadata, field.TypeJSON, value)
+	}
+	if iu.mutation.MetadataCleared() {
+		_spec.ClearField(item.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.DueAt(); ok {
+		_spec.SetField(item.FieldDueAt, field.TypeTime, value)
+	}
+	if iu.mutation.DueAtCleared() {
+		_spec.ClearField(item.FieldDueAt, field.TypeTime)
+	}
 	if iu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -163,66 +621,863 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{item.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if iu.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	iu.mutation.done = true
-	return n, nil
-}
-
-// ItemUpdateOne is the builder for updating a single Item entity.
-type ItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (iuo *ItemUpdateOne) SetUpdateTime(t time.Time) *ItemUpdateOne {
-	iuo.mutation.SetUpdateTime(t)
-	return iuo
-}
-
-// SetTitle sets the "title" field.
-func (iuo *ItemUpdateOne) SetTitle(s string) *ItemUpdateOne {
-	iuo.mutation.SetTitle(s)
-	return iuo
-}
-
-// SetDescription sets the "description" field.
-func (iuo *ItemUpdateOne) SetDescription(s string) *ItemUpdateOne {
-	iuo.mutation.SetDescription(s)
-	return iuo
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (iuo *ItemUpdateOne) SetOwnerID(u uint) *ItemUpdateOne {
-	iuo.mutation.SetOwnerID(u)
-	return iuo
-}
-
-// SetOwner sets the "owner" edge to the User entity.
-func (iuo *ItemUpdateOne) SetOwner(u *User) *ItemUpdateOne {
-	return iuo.SetOwnerID(u.ID)
-}
-
-// Mutation returns the ItemMutation object of the builder.
-func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
-	return iuo.mutation
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (iuo *ItemUpdateOne) ClearOwner() *ItemUpdateOne {
-	iuo.mutation.ClearOwner()
-	return iuo
-}
-
+	if nodes := iu.mutation.RemovedSharesIDs(); len(nodes) > 0 && !iu.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !iu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !iu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !iu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !iu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !iu.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.OwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedOwnershipTransfersIDs(); len(nodes) > 0 && !iu.mutation.OwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !iu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{item.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// ItemUpdateOne is the builder for updating a single Item entity.
+type ItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (iuo *ItemUpdateOne) SetUpdateTime(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetUpdateTime(t)
+	return iuo
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (iuo *ItemUpdateOne) SetDeleteTime(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetDeleteTime(t)
+	return iuo
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableDeleteTime(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetDeleteTime(*t)
+	}
+	return iuo
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (iuo *ItemUpdateOne) ClearDeleteTime() *ItemUpdateOne {
+	iuo.mutation.ClearDeleteTime()
+	return iuo
+}
+
+// SetVersion sets the "version" field.
+func (iuo *ItemUpdateOne) SetVersion(i int) *ItemUpdateOne {
+	iuo.mutation.ResetVersion()
+	iuo.mutation.SetVersion(i)
+	return iuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableVersion(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetVersion(*i)
+	}
+	return iuo
+}
+
+// AddVersion adds i to the "version" field.
+func (iuo *ItemUpdateOne) AddVersion(i int) *ItemUpdateOne {
+	iuo.mutation.AddVersion(i)
+	return iuo
+}
+
+// SetTitle sets the "title" field.
+func (iuo *ItemUpdateOne) SetTitle(s string) *ItemUpdateOne {
+	iuo.mutation.SetTitle(s)
+	return iuo
+}
+
+// SetDescription sets the "description" field.
+func (iuo *ItemUpdateOne) SetDescription(s string) *ItemUpdateOne {
+	iuo.mutation.SetDescription(s)
+	return iuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iuo *ItemUpdateOne) SetOwnerID(u uint) *ItemUpdateOne {
+	iuo.mutation.SetOwnerID(u)
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *ItemUpdateOne) SetStatus(s string) *ItemUpdateOne {
+	iuo.mutation.SetStatus(s)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableStatus(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetStatus(*s)
+	}
+	return iuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (iuo *ItemUpdateOne) SetMetadata(m map[string]interface{}) *ItemUpdateOne {
+	iuo.mutation.SetMetadata(m)
+	return iuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (iuo *ItemUpdateOne) ClearMetadata() *ItemUpdateOne {
+	iuo.mutation.ClearMetadata()
+	return iuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (iuo *ItemUpdateOne) SetDueAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetDueAt(t)
+	return iuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableDueAt(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetDueAt(*t)
+	}
+	return iuo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (iuo *ItemUpdateOne) ClearDueAt() *ItemUpdateOne {
+	iuo.mutation.ClearDueAt()
+	return iuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (iuo *ItemUpdateOne) SetParentID(u uint) *ItemUpdateOne {
+	iuo.mutation.SetParentID(u)
+	return iuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableParentID(u *uint) *ItemUpdateOne {
+	if u != nil {
+		iuo.SetParentID(*u)
+	}
+	return iuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (iuo *ItemUpdateOne) ClearParentID() *ItemUpdateOne {
+	iuo.mutation.ClearParentID()
+	return iuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (iuo *ItemUpdateOne) SetOwner(u *User) *ItemUpdateOne {
+	return iuo.SetOwnerID(u.ID)
+}
+
+// AddShareIDs adds the "shares" edge to the ItemShare entity by IDs.
+func (iuo *ItemUpdateOne) AddShareIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddShareIDs(ids...)
+	return iuo
+}
+
+// AddShares adds the "shares" edges to the ItemShare entity.
+func (iuo *ItemUpdateOne) AddShares(i ...*ItemShare) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddShareIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by IDs.
+func (iuo *ItemUpdateOne) AddRevisionIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddRevisionIDs(ids...)
+	return iuo
+}
+
+// AddRevisions adds the "revisions" edges to the ItemRevision entity.
+func (iuo *ItemUpdateOne) AddRevisions(i ...*ItemRevision) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (iuo *ItemUpdateOne) AddTagIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddTagIDs(ids...)
+	return iuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (iuo *ItemUpdateOne) AddTags(t ...*Tag) *ItemUpdateOne {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iuo.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (iuo *ItemUpdateOne) AddAttachmentIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddAttachmentIDs(ids...)
+	return iuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (iuo *ItemUpdateOne) AddAttachments(a ...*Attachment) *ItemUpdateOne {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iuo.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (iuo *ItemUpdateOne) AddCommentIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddCommentIDs(ids...)
+	return iuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (iuo *ItemUpdateOne) AddComments(c ...*Comment) *ItemUpdateOne {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.AddCommentIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the ItemTransition entity by IDs.
+func (iuo *ItemUpdateOne) AddTransitionIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddTransitionIDs(ids...)
+	return iuo
+}
+
+// AddTransitions adds the "transitions" edges to the ItemTransition entity.
+func (iuo *ItemUpdateOne) AddTransitions(i ...*ItemTransition) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddTransitionIDs(ids...)
+}
+
+// AddOwnershipTransferIDs adds the "ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (iuo *ItemUpdateOne) AddOwnershipTransferIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddOwnershipTransferIDs(ids...)
+	return iuo
+}
+
+// AddOwnershipTransfers adds the "ownership_transfers" edges to the OwnershipTransfer entity.
+func (iuo *ItemUpdateOne) AddOwnershipTransfers(o ...*OwnershipTransfer) *ItemUpdateOne {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return iuo.AddOwnershipTransferIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Item entity.
+func (iuo *ItemUpdateOne) SetParent(i *Item) *ItemUpdateOne {
+	return iuo.SetParentID(i.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by IDs.
+func (iuo *ItemUpdateOne) AddChildIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.AddChildIDs(ids...)
+	return iuo
+}
+
+// AddChildren adds the "children" edges to the Item entity.
+func (iuo *ItemUpdateOne) AddChildren(i ...*Item) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddChildIDs(ids...)
+}
+
+// Mutation returns the ItemMutation object of the builder.
+func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
+	return iuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (iuo *ItemUpdateOne) ClearOwner() *ItemUpdateOne {
+	iuo.mutation.ClearOwner()
+	return iuo
+}
+
+// ClearShares clears all "shares" edges to the ItemShare entity.
+func (iuo *ItemUpdateOne) ClearShares() *ItemUpdateOne {
+	iuo.mutation.ClearShares()
+	return iuo
+}
+
+// RemoveShareIDs removes the "shares" edge to ItemShare entities by IDs.
+func (iuo *ItemUpdateOne) RemoveShareIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveShareIDs(ids...)
+	return iuo
+}
+
+// RemoveShares removes "shares" edges to ItemShare entities.
+func (iuo *ItemUpdateOne) RemoveShares(i ...*ItemShare) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveShareIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ItemRevision entity.
+func (iuo *ItemUpdateOne) ClearRevisions() *ItemUpdateOne {
+	iuo.mutation.ClearRevisions()
+	return iuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ItemRevision entities by IDs.
+func (iuo *ItemUpdateOne) RemoveRevisionIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveRevisionIDs(ids...)
+	return iuo
+}
+
+// RemoveRevisions removes "revisions" edges to ItemRevision entities.
+func (iuo *ItemUpdateOne) RemoveRevisions(i ...*ItemRevision) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (iuo *ItemUpdateOne) ClearTags() *ItemUpdateOne {
+	iuo.mutation.ClearTags()
+	return iuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (iuo *ItemUpdateOne) RemoveTagIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveTagIDs(ids...)
+	return iuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (iuo *ItemUpdateOne) RemoveTags(t ...*Tag) *ItemUpdateOne {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iuo.RemoveTagIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (iuo *ItemUpdateOne) ClearAttachments() *ItemUpdateOne {
+	iuo.mutation.ClearAttachments()
+	return iuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (iuo *ItemUpdateOne) RemoveAttachmentIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveAttachmentIDs(ids...)
+	return iuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (iuo *ItemUpdateOne) RemoveAttachments(a ...*Attachment) *ItemUpdateOne {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (iuo *ItemUpdateOne) ClearComments() *ItemUpdateOne {
+	iuo.mutation.ClearComments()
+	return iuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (iuo *ItemUpdateOne) RemoveCommentIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveCommentIDs(ids...)
+	return iuo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (iuo *ItemUpdateOne) RemoveComments(c ...*Comment) *ItemUpdateOne {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.RemoveCommentIDs(ids...)
+}
+
+// ClearTransitions clears all "transitions" edges to the ItemTransition entity.
+func (iuo *ItemUpdateOne) ClearTransitions() *ItemUpdateOne {
+	iuo.mutation.ClearTransitions()
+	return iuo
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to ItemTransition entities by IDs.
+func (iuo *ItemUpdateOne) RemoveTransitionIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveTransitionIDs(ids...)
+	return iuo
+}
+
+// RemoveTransitions removes "transitions" edges to ItemTransition entities.
+func (iuo *ItemUpdateOne) RemoveTransitions(i ...*ItemTransition) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveTransitionIDs(ids...)
+}
+
+// ClearOwnershipTransfers clears all "ownership_transfers" edges to the OwnershipTransfer entity.
+func (iuo *ItemUpdateOne) ClearOwnershipTransfers() *ItemUpdateOne {
+	iuo.mutation.ClearOwnershipTransfers()
+	return iuo
+}
+
+// RemoveOwnershipTransferIDs removes the "ownership_transfers" edge to OwnershipTransfer entities by IDs.
+func (iuo *ItemUpdateOne) RemoveOwnershipTransferIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveOwnershipTransferIDs(ids...)
+	return iuo
+}
+
+// RemoveOwnershipTransfers removes "ownership_transfers" edges to OwnershipTransfer entities.
+func (iuo *ItemUpdateOne) RemoveOwnershipTransfers(o ...*OwnershipTransfer) *ItemUpdateOne {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return iuo.RemoveOwnershipTransferIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Item entity.
+func (iuo *ItemUpdateOne) ClearParent() *ItemUpdateOne {
+	iuo.mutation.ClearParent()
+	return iuo
+}
+
+// ClearChildren clears all "children" edges to the Item entity.
+func (iuo *ItemUpdateOne) ClearChildren() *ItemUpdateOne {
+	iuo.mutation.ClearChildren()
+	return iuo
+}
+
+// RemoveChildIDs removes the "children" edge to Item entities by IDs.
+func (iuo *ItemUpdateOne) RemoveChildIDs(ids ...uint) *ItemUpdateOne {
+	iuo.mutation.RemoveChildIDs(ids...)
+	return iuo
+}
+
+// RemoveChildren removes "children" edges to Item entities.
+func (iuo *ItemUpdateOne) RemoveChildren(i ...*Item) *ItemUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -238,7 +1493,9 @@ func (iuo *ItemUpdateOne) Select(field string, fields ...string) *ItemUpdateOne
 
 // Save executes the query and returns the updated Item entity.
 func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
-	iuo.defaults()
+	if err := iuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks[*Item, ItemMutation](ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
 }
 
@@ -265,21 +1522,36 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (iuo *ItemUpdateOne) defaults() {
+func (iuo *ItemUpdateOne) defaults() error {
 	if _, ok := iuo.mutation.UpdateTime(); !ok {
+		if item.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdateTime()
 		iuo.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (iuo *ItemUpdateOne) check() error {
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if _, ok := iuo.mutation.OwnerID(); iuo.mutation.OwnerCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Item.owner"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iuo *ItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemUpdateOne {
+	iuo.modifiers = append(iuo.modifiers, modifiers...)
+	return iuo
+}
+
 func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if err := iuo.check(); err != nil {
 		return _node, err
@@ -312,12 +1584,39 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if value, ok := iuo.mutation.UpdateTime(); ok {
 		_spec.SetField(item.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := iuo.mutation.DeleteTime(); ok {
+		_spec.SetField(item.FieldDeleteTime, field.TypeTime, value)
+	}
+	if iuo.mutation.DeleteTimeCleared() {
+		_spec.ClearField(item.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := iuo.mutation.Title(); ok {
 		_spec.SetField(item.FieldTitle, field.TypeString, value)
 	}
 	if value, ok := iuo.mutation.Description(); ok {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Metadata(); ok {
+		_spec.SetField(item.FieldMetadata, field.TypeJSON, value)
+	}
+	if iuo.mutation.MetadataCleared() {
+		_spec.ClearField(item.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.DueAt(); ok {
+		_spec.SetField(item.FieldDueAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DueAtCleared() {
+		_spec.ClearField(item.FieldDueAt, field.TypeTime)
+	}
 	if iuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -347,6 +1646,396 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedSharesIDs(); len(nodes) > 0 && !iuo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SharesTable,
+			Columns: []string{item.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !iuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !iuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !iuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !iuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !iuo.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TransitionsTable,
+			Columns: []string{item.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.OwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedOwnershipTransfersIDs(); len(nodes) > 0 && !iuo.mutation.OwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.OwnershipTransfersTable,
+			Columns: []string{item.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !iuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iuo.modifiers...)
 	_node = &Item{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// ItemImport is the model entity for the ItemImport schema.
+type ItemImport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uint `json:"owner_id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format itemimport.Format `json:"format,omitempty"`
+	// Status holds the value of the "status" field.
+	Status itemimport.Status `json:"status,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// ReadBytes holds the value of the "read_bytes" field.
+	ReadBytes int64 `json:"read_bytes,omitempty"`
+	// CreatedRows holds the value of the "created_rows" field.
+	CreatedRows int `json:"created_rows,omitempty"`
+	// FailedRows holds the value of the "failed_rows" field.
+	FailedRows int `json:"failed_rows,omitempty"`
+	// Errors holds the value of the "errors" field.
+	Errors []models.ItemImportRowError `json:"errors,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// FinishTime holds the value of the "finish_time" field.
+	FinishTime *time.Time `json:"finish_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemImportQuery when eager-loading is set.
+	Edges        ItemImportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemImportEdges holds the relations/edges for other nodes in the graph.
+type ItemImportEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemImportEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemImport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemimport.FieldErrors:
+			values[i] = new([]byte)
+		case itemimport.FieldID, itemimport.FieldOwnerID, itemimport.FieldSize, itemimport.FieldReadBytes, itemimport.FieldCreatedRows, itemimport.FieldFailedRows:
+			values[i] = new(sql.NullInt64)
+		case itemimport.FieldFormat, itemimport.FieldStatus, itemimport.FieldStorageKey, itemimport.FieldError:
+			values[i] = new(sql.NullString)
+		case itemimport.FieldCreateTime, itemimport.FieldUpdateTime, itemimport.FieldFinishTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemImport fields.
+func (ii *ItemImport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemimport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ii.ID = uint(value.Int64)
+		case itemimport.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ii.CreateTime = value.Time
+			}
+		case itemimport.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ii.UpdateTime = value.Time
+			}
+		case itemimport.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				ii.OwnerID = uint(value.Int64)
+			}
+		case itemimport.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				ii.Format = itemimport.Format(value.String)
+			}
+		case itemimport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ii.Status = itemimport.Status(value.String)
+			}
+		case itemimport.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				ii.StorageKey = value.String
+			}
+		case itemimport.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				ii.Size = value.Int64
+			}
+		case itemimport.FieldReadBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field read_bytes", values[i])
+			} else if value.Valid {
+				ii.ReadBytes = value.Int64
+			}
+		case itemimport.FieldCreatedRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_rows", values[i])
+			} else if value.Valid {
+				ii.CreatedRows = int(value.Int64)
+			}
+		case itemimport.FieldFailedRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_rows", values[i])
+			} else if value.Valid {
+				ii.FailedRows = int(value.Int64)
+			}
+		case itemimport.FieldErrors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ii.Errors); err != nil {
+					return fmt.Errorf("unmarshal field errors: %w", err)
+				}
+			}
+		case itemimport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ii.Error = new(string)
+				*ii.Error = value.String
+			}
+		case itemimport.FieldFinishTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finish_time", values[i])
+			} else if value.Valid {
+				ii.FinishTime = new(time.Time)
+				*ii.FinishTime = value.Time
+			}
+		default:
+			ii.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemImport.
+// This includes values selected through modifiers, order, etc.
+func (ii *ItemImport) Value(name string) (ent.Value, error) {
+	return ii.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ItemImport entity.
+func (ii *ItemImport) QueryOwner() *UserQuery {
+	return NewItemImportClient(ii.config).QueryOwner(ii)
+}
+
+// Update returns a builder for updating this ItemImport.
+// Note that you need to call ItemImport.Unwrap() before calling this method if this ItemImport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ii *ItemImport) Update() *ItemImportUpdateOne {
+	return NewItemImportClient(ii.config).UpdateOne(ii)
+}
+
+// Unwrap unwraps the ItemImport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ii *ItemImport) Unwrap() *ItemImport {
+	_tx, ok := ii.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemImport is not a transactional entity")
+	}
+	ii.config.driver = _tx.drv
+	return ii
+}
+
+// String implements the fmt.Stringer.
+func (ii *ItemImport) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemImport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ii.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ii.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ii.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", ii.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Format))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Status))
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(ii.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Size))
+	builder.WriteString(", ")
+	builder.WriteString("read_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", ii.ReadBytes))
+	builder.WriteString(", ")
+	builder.WriteString("created_rows=")
+	builder.WriteString(fmt.Sprintf("%v", ii.CreatedRows))
+	builder.WriteString(", ")
+	builder.WriteString("failed_rows=")
+	builder.WriteString(fmt.Sprintf("%v", ii.FailedRows))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Errors))
+	builder.WriteString(", ")
+	if v := ii.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ii.FinishTime; v != nil {
+		builder.WriteString("finish_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemImports is a parsable slice of ItemImport.
+type ItemImports []*ItemImport
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemimport
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the itemimport type in the database.
+	Label = "item_import"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStorageKey holds the string denoting the storage_key field in the database.
+	FieldStorageKey = "storage_key"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldReadBytes holds the string denoting the read_bytes field in the database.
+	FieldReadBytes = "read_bytes"
+	// FieldCreatedRows holds the string denoting the created_rows field in the database.
+	FieldCreatedRows = "created_rows"
+	// FieldFailedRows holds the string denoting the failed_rows field in the database.
+	FieldFailedRows = "failed_rows"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldFinishTime holds the string denoting the finish_time field in the database.
+	FieldFinishTime = "finish_time"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// Table holds the table name of the itemimport in the database.
+	Table = "item_imports"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "item_imports"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "owner_id"
+)
+
+// Columns holds all SQL columns for itemimport fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldOwnerID,
+	FieldFormat,
+	FieldStatus,
+	FieldStorageKey,
+	FieldSize,
+	FieldReadBytes,
+	FieldCreatedRows,
+	FieldFailedRows,
+	FieldErrors,
+	FieldError,
+	FieldFinishTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(int64) error
+	// DefaultReadBytes holds the default value on creation for the "read_bytes" field.
+	DefaultReadBytes int64
+	// ReadBytesValidator is a validator for the "read_bytes" field. It is called by the builders before save.
+	ReadBytesValidator func(int64) error
+	// DefaultCreatedRows holds the default value on creation for the "created_rows" field.
+	DefaultCreatedRows int
+	// CreatedRowsValidator is a validator for the "created_rows" field. It is called by the builders before save.
+	CreatedRowsValidator func(int) error
+	// DefaultFailedRows holds the default value on creation for the "failed_rows" field.
+	DefaultFailedRows int
+	// FailedRowsValidator is a validator for the "failed_rows" field. It is called by the builders before save.
+	FailedRowsValidator func(int) error
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// Format values.
+const (
+	FormatCsv    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatNdjson Format = "ndjson"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatCsv, FormatJSON, FormatNdjson:
+		return nil
+	default:
+		return fmt.Errorf("itemimport: invalid enum value for format field: %q", f)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("itemimport: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ItemImport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStorageKey orders the results by the storage_key field.
+func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByReadBytes orders the results by the read_bytes field.
+func ByReadBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadBytes, opts...).ToFunc()
+}
+
+// ByCreatedRows orders the results by the created_rows field.
+func ByCreatedRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedRows, opts...).ToFunc()
+}
+
+// ByFailedRows orders the results by the failed_rows field.
+func ByFailedRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedRows, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByFinishTime orders the results by the finish_time field.
+func ByFinishTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishTime, opts...).ToFunc()
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Format) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Format) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Format(str)
+	if err := FormatValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Format", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
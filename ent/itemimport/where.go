@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemimport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldSize, v))
+}
+
+// ReadBytes applies equality check predicate on the "read_bytes" field. It's identical to ReadBytesEQ.
+func ReadBytes(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldReadBytes, v))
+}
+
+// CreatedRows applies equality check predicate on the "created_rows" field. It's identical to CreatedRowsEQ.
+func CreatedRows(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldCreatedRows, v))
+}
+
+// FailedRows applies equality check predicate on the "failed_rows" field. It's identical to FailedRowsEQ.
+func FailedRows(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldFailedRows, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldError, v))
+}
+
+// FinishTime applies equality check predicate on the "finish_time" field. It's identical to FinishTimeEQ.
+func FinishTime(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uint) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldSize, v))
+}
+
+// ReadBytesEQ applies the EQ predicate on the "read_bytes" field.
+func ReadBytesEQ(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldReadBytes, v))
+}
+
+// ReadBytesNEQ applies the NEQ predicate on the "read_bytes" field.
+func ReadBytesNEQ(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldReadBytes, v))
+}
+
+// ReadBytesIn applies the In predicate on the "read_bytes" field.
+func ReadBytesIn(vs ...int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldReadBytes, vs...))
+}
+
+// ReadBytesNotIn applies the NotIn predicate on the "read_bytes" field.
+func ReadBytesNotIn(vs ...int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldReadBytes, vs...))
+}
+
+// ReadBytesGT applies the GT predicate on the "read_bytes" field.
+func ReadBytesGT(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldReadBytes, v))
+}
+
+// ReadBytesGTE applies the GTE predicate on the "read_bytes" field.
+func ReadBytesGTE(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldReadBytes, v))
+}
+
+// ReadBytesLT applies the LT predicate on the "read_bytes" field.
+func ReadBytesLT(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldReadBytes, v))
+}
+
+// ReadBytesLTE applies the LTE predicate on the "read_bytes" field.
+func ReadBytesLTE(v int64) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldReadBytes, v))
+}
+
+// CreatedRowsEQ applies the EQ predicate on the "created_rows" field.
+func CreatedRowsEQ(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldCreatedRows, v))
+}
+
+// CreatedRowsNEQ applies the NEQ predicate on the "created_rows" field.
+func CreatedRowsNEQ(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldCreatedRows, v))
+}
+
+// CreatedRowsIn applies the In predicate on the "created_rows" field.
+func CreatedRowsIn(vs ...int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldCreatedRows, vs...))
+}
+
+// CreatedRowsNotIn applies the NotIn predicate on the "created_rows" field.
+func CreatedRowsNotIn(vs ...int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldCreatedRows, vs...))
+}
+
+// CreatedRowsGT applies the GT predicate on the "created_rows" field.
+func CreatedRowsGT(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldCreatedRows, v))
+}
+
+// CreatedRowsGTE applies the GTE predicate on the "created_rows" field.
+func CreatedRowsGTE(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldCreatedRows, v))
+}
+
+// CreatedRowsLT applies the LT predicate on the "created_rows" field.
+func CreatedRowsLT(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldCreatedRows, v))
+}
+
+// CreatedRowsLTE applies the LTE predicate on the "created_rows" field.
+func CreatedRowsLTE(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldCreatedRows, v))
+}
+
+// FailedRowsEQ applies the EQ predicate on the "failed_rows" field.
+func FailedRowsEQ(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldFailedRows, v))
+}
+
+// FailedRowsNEQ applies the NEQ predicate on the "failed_rows" field.
+func FailedRowsNEQ(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldFailedRows, v))
+}
+
+// FailedRowsIn applies the In predicate on the "failed_rows" field.
+func FailedRowsIn(vs ...int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldFailedRows, vs...))
+}
+
+// FailedRowsNotIn applies the NotIn predicate on the "failed_rows" field.
+func FailedRowsNotIn(vs ...int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldFailedRows, vs...))
+}
+
+// FailedRowsGT applies the GT predicate on the "failed_rows" field.
+func FailedRowsGT(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldFailedRows, v))
+}
+
+// FailedRowsGTE applies the GTE predicate on the "failed_rows" field.
+func FailedRowsGTE(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldFailedRows, v))
+}
+
+// FailedRowsLT applies the LT predicate on the "failed_rows" field.
+func FailedRowsLT(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldFailedRows, v))
+}
+
+// FailedRowsLTE applies the LTE predicate on the "failed_rows" field.
+func FailedRowsLTE(v int) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldFailedRows, v))
+}
+
+// ErrorsIsNil applies the IsNil predicate on the "errors" field.
+func ErrorsIsNil() predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIsNull(FieldErrors))
+}
+
+// ErrorsNotNil applies the NotNil predicate on the "errors" field.
+func ErrorsNotNil() predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotNull(FieldErrors))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldContainsFold(FieldError, v))
+}
+
+// FinishTimeEQ applies the EQ predicate on the "finish_time" field.
+func FinishTimeEQ(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldEQ(FieldFinishTime, v))
+}
+
+// FinishTimeNEQ applies the NEQ predicate on the "finish_time" field.
+func FinishTimeNEQ(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNEQ(FieldFinishTime, v))
+}
+
+// FinishTimeIn applies the In predicate on the "finish_time" field.
+func FinishTimeIn(vs ...time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeNotIn applies the NotIn predicate on the "finish_time" field.
+func FinishTimeNotIn(vs ...time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotIn(FieldFinishTime, vs...))
+}
+
+// FinishTimeGT applies the GT predicate on the "finish_time" field.
+func FinishTimeGT(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGT(FieldFinishTime, v))
+}
+
+// FinishTimeGTE applies the GTE predicate on the "finish_time" field.
+func FinishTimeGTE(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldGTE(FieldFinishTime, v))
+}
+
+// FinishTimeLT applies the LT predicate on the "finish_time" field.
+func FinishTimeLT(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLT(FieldFinishTime, v))
+}
+
+// FinishTimeLTE applies the LTE predicate on the "finish_time" field.
+func FinishTimeLTE(v time.Time) predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldLTE(FieldFinishTime, v))
+}
+
+// FinishTimeIsNil applies the IsNil predicate on the "finish_time" field.
+func FinishTimeIsNil() predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldIsNull(FieldFinishTime))
+}
+
+// FinishTimeNotNil applies the NotNil predicate on the "finish_time" field.
+func FinishTimeNotNil() predicate.ItemImport {
+	return predicate.ItemImport(sql.FieldNotNull(FieldFinishTime))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.ItemImport {
+	return predicate.ItemImport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.ItemImport {
+	return predicate.ItemImport(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemImport) predicate.ItemImport {
+	return predicate.ItemImport(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemImport) predicate.ItemImport {
+	return predicate.ItemImport(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemImport) predicate.ItemImport {
+	return predicate.ItemImport(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
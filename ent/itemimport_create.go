@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// ItemImportCreate is the builder for creating a ItemImport entity.
+type ItemImportCreate struct {
+	config
+	mutation *ItemImportMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (iic *ItemImportCreate) SetCreateTime(t time.Time) *ItemImportCreate {
+	iic.mutation.SetCreateTime(t)
+	return iic
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableCreateTime(t *time.Time) *ItemImportCreate {
+	if t != nil {
+		iic.SetCreateTime(*t)
+	}
+	return iic
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (iic *ItemImportCreate) SetUpdateTime(t time.Time) *ItemImportCreate {
+	iic.mutation.SetUpdateTime(t)
+	return iic
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableUpdateTime(t *time.Time) *ItemImportCreate {
+	if t != nil {
+		iic.SetUpdateTime(*t)
+	}
+	return iic
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iic *ItemImportCreate) SetOwnerID(u uint) *ItemImportCreate {
+	iic.mutation.SetOwnerID(u)
+	return iic
+}
+
+// SetFormat sets the "format" field.
+func (iic *ItemImportCreate) SetFormat(i itemimport.Format) *ItemImportCreate {
+	iic.mutation.SetFormat(i)
+	return iic
+}
+
+// SetStatus sets the "status" field.
+func (iic *ItemImportCreate) SetStatus(i itemimport.Status) *ItemImportCreate {
+	iic.mutation.SetStatus(i)
+	return iic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableStatus(i *itemimport.Status) *ItemImportCreate {
+	if i != nil {
+		iic.SetStatus(*i)
+	}
+	return iic
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (iic *ItemImportCreate) SetStorageKey(s string) *ItemImportCreate {
+	iic.mutation.SetStorageKey(s)
+	return iic
+}
+
+// SetSize sets the "size" field.
+func (iic *ItemImportCreate) SetSize(i int64) *ItemImportCreate {
+	iic.mutation.SetSize(i)
+	return iic
+}
+
+// SetReadBytes sets the "read_bytes" field.
+func (iic *ItemImportCreate) SetReadBytes(i int64) *ItemImportCreate {
+	iic.mutation.SetReadBytes(i)
+	return iic
+}
+
+// SetNillableReadBytes sets the "read_bytes" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableReadBytes(i *int64) *ItemImportCreate {
+	if i != nil {
+		iic.SetReadBytes(*i)
+	}
+	return iic
+}
+
+// SetCreatedRows sets the "created_rows" field.
+func (iic *ItemImportCreate) SetCreatedRows(i int) *ItemImportCreate {
+	iic.mutation.SetCreatedRows(i)
+	return iic
+}
+
+// SetNillableCreatedRows sets the "created_rows" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableCreatedRows(i *int) *ItemImportCreate {
+	if i != nil {
+		iic.SetCreatedRows(*i)
+	}
+	return iic
+}
+
+// SetFailedRows sets the "failed_rows" field.
+func (iic *ItemImportCreate) SetFailedRows(i int) *ItemImportCreate {
+	iic.mutation.SetFailedRows(i)
+	return iic
+}
+
+// SetNillableFailedRows sets the "failed_rows" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableFailedRows(i *int) *ItemImportCreate {
+	if i != nil {
+		iic.SetFailedRows(*i)
+	}
+	return iic
+}
+
+// SetErrors sets the "errors" field.
+func (iic *ItemImportCreate) SetErrors(mire []models.ItemImportRowError) *ItemImportCreate {
+	iic.mutation.SetErrors(mire)
+	return iic
+}
+
+// SetError sets the "error" field.
+func (iic *ItemImportCreate) SetError(s string) *ItemImportCreate {
+	iic.mutation.SetError(s)
+	return iic
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableError(s *string) *ItemImportCreate {
+	if s != nil {
+		iic.SetError(*s)
+	}
+	return iic
+}
+
+// SetFinishTime sets the "finish_time" field.
+func (iic *ItemImportCreate) SetFinishTime(t time.Time) *ItemImportCreate {
+	iic.mutation.SetFinishTime(t)
+	return iic
+}
+
+// SetNillableFinishTime sets the "finish_time" field if the given value is not nil.
+func (iic *ItemImportCreate) SetNillableFinishTime(t *time.Time) *ItemImportCreate {
+	if t != nil {
+		iic.SetFinishTime(*t)
+	}
+	return iic
+}
+
+// SetID sets the "id" field.
+func (iic *ItemImportCreate) SetID(u uint) *ItemImportCreate {
+	iic.mutation.SetID(u)
+	return iic
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (iic *ItemImportCreate) SetOwner(u *User) *ItemImportCreate {
+	return iic.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ItemImportMutation object of the builder.
+func (iic *ItemImportCreate) Mutation() *ItemImportMutation {
+	return iic.mutation
+}
+
+// Save creates the ItemImport in the database.
+func (iic *ItemImportCreate) Save(ctx context.Context) (*ItemImport, error) {
+	if err := iic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*ItemImport, ItemImportMutation](ctx, iic.sqlSave, iic.mutation, iic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iic *ItemImportCreate) SaveX(ctx context.Context) *ItemImport {
+	v, err := iic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iic *ItemImportCreate) Exec(ctx context.Context) error {
+	_, err := iic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iic *ItemImportCreate) ExecX(ctx context.Context) {
+	if err := iic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iic *ItemImportCreate) defaults() error {
+	if _, ok := iic.mutation.CreateTime(); !ok {
+		if itemimport.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemimport.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := itemimport.DefaultCreateTime()
+		iic.mutation.SetCreateTime(v)
+	}
+	if _, ok := iic.mutation.UpdateTime(); !ok {
+		if itemimport.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemimport.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := itemimport.DefaultUpdateTime()
+		iic.mutation.SetUpdateTime(v)
+	}
+	if _, ok := iic.mutation.Status(); !ok {
+		v := itemimport.DefaultStatus
+		iic.mutation.SetStatus(v)
+	}
+	if _, ok := iic.mutation.ReadBytes(); !ok {
+		v := itemimport.DefaultReadBytes
+		iic.mutation.SetReadBytes(v)
+	}
+	if _, ok := iic.mutation.CreatedRows(); !ok {
+		v := itemimport.DefaultCreatedRows
+		iic.mutation.SetCreatedRows(v)
+	}
+	if _, ok := iic.mutation.FailedRows(); !ok {
+		v := itemimport.DefaultFailedRows
+		iic.mutation.SetFailedRows(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iic *ItemImportCreate) check() error {
+	if _, ok := iic.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ItemImport.create_time"`)}
+	}
+	if _, ok := iic.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ItemImport.update_time"`)}
+	}
+	if _, ok := iic.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "ItemImport.owner_id"`)}
+	}
+	if _, ok := iic.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ItemImport.format"`)}
+	}
+	if v, ok := iic.mutation.Format(); ok {
+		if err := itemimport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ItemImport.format": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ItemImport.status"`)}
+	}
+	if v, ok := iic.mutation.Status(); ok {
+		if err := itemimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ItemImport.status": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "ItemImport.storage_key"`)}
+	}
+	if _, ok := iic.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ItemImport.size"`)}
+	}
+	if v, ok := iic.mutation.Size(); ok {
+		if err := itemimport.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ItemImport.size": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.ReadBytes(); !ok {
+		return &ValidationError{Name: "read_bytes", err: errors.New(`ent: missing required field "ItemImport.read_bytes"`)}
+	}
+	if v, ok := iic.mutation.ReadBytes(); ok {
+		if err := itemimport.ReadBytesValidator(v); err != nil {
+			return &ValidationError{Name: "read_bytes", err: fmt.Errorf(`ent: validator failed for field "ItemImport.read_bytes": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.CreatedRows(); !ok {
+		return &ValidationError{Name: "created_rows", err: errors.New(`ent: missing required field "ItemImport.created_rows"`)}
+	}
+	if v, ok := iic.mutation.CreatedRows(); ok {
+		if err := itemimport.CreatedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "created_rows", err: fmt.Errorf(`ent: validator failed for field "ItemImport.created_rows": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.FailedRows(); !ok {
+		return &ValidationError{Name: "failed_rows", err: errors.New(`ent: missing required field "ItemImport.failed_rows"`)}
+	}
+	if v, ok := iic.mutation.FailedRows(); ok {
+		if err := itemimport.FailedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "failed_rows", err: fmt.Errorf(`ent: validator failed for field "ItemImport.failed_rows": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ItemImport.owner"`)}
+	}
+	return nil
+}
+
+func (iic *ItemImportCreate) sqlSave(ctx context.Context) (*ItemImport, error) {
+	if err := iic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	iic.mutation.id = &_node.ID
+	iic.mutation.done = true
+	return _node, nil
+}
+
+func (iic *ItemImportCreate) createSpec() (*ItemImport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemImport{config: iic.config}
+		_spec = sqlgraph.NewCreateSpec(itemimport.Table, sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint))
+	)
+	if id, ok := iic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iic.mutation.CreateTime(); ok {
+		_spec.SetField(itemimport.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := iic.mutation.UpdateTime(); ok {
+		_spec.SetField(itemimport.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := iic.mutation.Format(); ok {
+		_spec.SetField(itemimport.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := iic.mutation.Status(); ok {
+		_spec.SetField(itemimport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := iic.mutation.StorageKey(); ok {
+		_spec.SetField(itemimport.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := iic.mutation.Size(); ok {
+		_spec.SetField(itemimport.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := iic.mutation.ReadBytes(); ok {
+		_spec.SetField(itemimport.FieldReadBytes, field.TypeInt64, value)
+		_node.ReadBytes = value
+	}
+	if value, ok := iic.mutation.CreatedRows(); ok {
+		_spec.SetField(itemimport.FieldCreatedRows, field.TypeInt, value)
+		_node.CreatedRows = value
+	}
+	if value, ok := iic.mutation.FailedRows(); ok {
+		_spec.SetField(itemimport.FieldFailedRows, field.TypeInt, value)
+		_node.FailedRows = value
+	}
+	if value, ok := iic.mutation.Errors(); ok {
+		_spec.SetField(itemimport.FieldErrors, field.TypeJSON, value)
+		_node.Errors = value
+	}
+	if value, ok := iic.mutation.Error(); ok {
+		_spec.SetField(itemimport.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := iic.mutation.FinishTime(); ok {
+		_spec.SetField(itemimport.FieldFinishTime, field.TypeTime, value)
+		_node.FinishTime = &value
+	}
+	if nodes := iic.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemimport.OwnerTable,
+			Columns: []string{itemimport.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemImportCreateBulk is the builder for creating many ItemImport entities in bulk.
+type ItemImportCreateBulk struct {
+	config
+	builders []*ItemImportCreate
+}
+
+// Save creates the ItemImport entities in the database.
+func (iicb *ItemImportCreateBulk) Save(ctx context.Context) ([]*ItemImport, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iicb.builders))
+	nodes := make([]*ItemImport, len(iicb.builders))
+	mutators := make([]Mutator, len(iicb.builders))
+	for i := range iicb.builders {
+		func(i int, root context.Context) {
+			builder := iicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemImportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicb *ItemImportCreateBulk) SaveX(ctx context.Context) []*ItemImport {
+	v, err := iicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicb *ItemImportCreateBulk) Exec(ctx context.Context) error {
+	_, err := iicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicb *ItemImportCreateBulk) ExecX(ctx context.Context) {
+	if err := iicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
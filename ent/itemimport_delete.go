@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ItemImportDelete is the builder for deleting a ItemImport entity.
+type ItemImportDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemImportMutation
+}
+
+// Where appends a list predicates to the ItemImportDelete builder.
+func (iid *ItemImportDelete) Where(ps ...predicate.ItemImport) *ItemImportDelete {
+	iid.mutation.Where(ps...)
+	return iid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iid *ItemImportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ItemImportMutation](ctx, iid.sqlExec, iid.mutation, iid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iid *ItemImportDelete) ExecX(ctx context.Context) int {
+	n, err := iid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iid *ItemImportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemimport.Table, sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint))
+	if ps := iid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iid.mutation.done = true
+	return affected, err
+}
+
+// ItemImportDeleteOne is the builder for deleting a single ItemImport entity.
+type ItemImportDeleteOne struct {
+	iid *ItemImportDelete
+}
+
+// Where appends a list predicates to the ItemImportDelete builder.
+func (iido *ItemImportDeleteOne) Where(ps ...predicate.ItemImport) *ItemImportDeleteOne {
+	iido.iid.mutation.Where(ps...)
+	return iido
+}
+
+// Exec executes the deletion query.
+func (iido *ItemImportDeleteOne) Exec(ctx context.Context) error {
+	n, err := iido.iid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemimport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iido *ItemImportDeleteOne) ExecX(ctx context.Context) {
+	if err := iido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
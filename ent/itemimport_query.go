@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemImportQuery is the builder for querying ItemImport entities.
+type ItemImportQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemimport.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemImport
+	withOwner  *UserQuery
+	loadTotal  []func(context.Context, []*ItemImport) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemImportQuery builder.
+func (iiq *ItemImportQuery) Where(ps ...predicate.ItemImport) *ItemImportQuery {
+	iiq.predicates = append(iiq.predicates, ps...)
+	return iiq
+}
+
+// Limit the number of records to be returned by this query.
+func (iiq *ItemImportQuery) Limit(limit int) *ItemImportQuery {
+	iiq.ctx.Limit = &limit
+	return iiq
+}
+
+// Offset to start from.
+func (iiq *ItemImportQuery) Offset(offset int) *ItemImportQuery {
+	iiq.ctx.Offset = &offset
+	return iiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iiq *ItemImportQuery) Unique(unique bool) *ItemImportQuery {
+	iiq.ctx.Unique = &unique
+	return iiq
+}
+
+// Order specifies how the records should be ordered.
+func (iiq *ItemImportQuery) Order(o ...itemimport.OrderOption) *ItemImportQuery {
+	iiq.order = append(iiq.order, o...)
+	return iiq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (iiq *ItemImportQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: iiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemimport.Table, itemimport.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemimport.OwnerTable, itemimport.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemImport entity from the query.
+// Returns a *NotFoundError when no ItemImport was found.
+func (iiq *ItemImportQuery) First(ctx context.Context) (*ItemImport, error) {
+	nodes, err := iiq.Limit(1).All(setContextOp(ctx, iiq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemimport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iiq *ItemImportQuery) FirstX(ctx context.Context) *ItemImport {
+	node, err := iiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemImport ID from the query.
+// Returns a *NotFoundError when no ItemImport ID was found.
+func (iiq *ItemImportQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = iiq.Limit(1).IDs(setContextOp(ctx, iiq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemimport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iiq *ItemImportQuery) FirstIDX(ctx context.Context) uint {
+	id, err := iiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemImport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemImport entity is found.
+// Returns a *NotFoundError when no ItemImport entities are found.
+func (iiq *ItemImportQuery) Only(ctx context.Context) (*ItemImport, error) {
+	nodes, err := iiq.Limit(2).All(setContextOp(ctx, iiq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemimport.Label}
+	default:
+		return nil, &NotSingularError{itemimport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iiq *ItemImportQuery) OnlyX(ctx context.Context) *ItemImport {
+	node, err := iiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemImport ID in the query.
+// Returns a *NotSingularError when more than one ItemImport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iiq *ItemImportQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = iiq.Limit(2).IDs(setContextOp(ctx, iiq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemimport.Label}
+	default:
+		err = &NotSingularError{itemimport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iiq *ItemImportQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := iiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemImports.
+func (iiq *ItemImportQuery) All(ctx context.Context) ([]*ItemImport, error) {
+	ctx = setContextOp(ctx, iiq.ctx, "All")
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemImport, *ItemImportQuery]()
+	return withInterceptors[[]*ItemImport](ctx, iiq, qr, iiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iiq *ItemImportQuery) AllX(ctx context.Context) []*ItemImport {
+	nodes, err := iiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemImport IDs.
+func (iiq *ItemImportQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if iiq.ctx.Unique == nil && iiq.path != nil {
+		iiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iiq.ctx, "IDs")
+	if err = iiq.Select(itemimport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iiq *ItemImportQuery) IDsX(ctx context.Context) []uint {
+	ids, err := iiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iiq *ItemImportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iiq.ctx, "Count")
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iiq, querierCount[*ItemImportQuery](), iiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iiq *ItemImportQuery) CountX(ctx context.Context) int {
+	count, err := iiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iiq *ItemImportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iiq.ctx, "Exist")
+	switch _, err := iiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iiq *ItemImportQuery) ExistX(ctx context.Context) bool {
+	exist, err := iiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemImportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iiq *ItemImportQuery) Clone() *ItemImportQuery {
+	if iiq == nil {
+		return nil
+	}
+	return &ItemImportQuery{
+		config:     iiq.config,
+		ctx:        iiq.ctx.Clone(),
+		order:      append([]itemimport.OrderOption{}, iiq.order...),
+		inters:     append([]Interceptor{}, iiq.inters...),
+		predicates: append([]predicate.ItemImport{}, iiq.predicates...),
+		withOwner:  iiq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  iiq.sql.Clone(),
+		path: iiq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (iiq *ItemImportQuery) WithOwner(opts ...func(*UserQuery)) *ItemImportQuery {
+	query := (&UserClient{config: iiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iiq.withOwner = query
+	return iiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemImport.Query().
+//		GroupBy(itemimport.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iiq *ItemImportQuery) GroupBy(field string, fields ...string) *ItemImportGroupBy {
+	iiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemImportGroupBy{build: iiq}
+	grbuild.flds = &iiq.ctx.Fields
+	grbuild.label = itemimport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ItemImport.Query().
+//		Select(itemimport.FieldCreateTime).
+//		Scan(ctx, &v)
+func (iiq *ItemImportQuery) Select(fields ...string) *ItemImportSelect {
+	iiq.ctx.Fields = append(iiq.ctx.Fields, fields...)
+	sbuild := &ItemImportSelect{ItemImportQuery: iiq}
+	sbuild.label = itemimport.Label
+	sbuild.flds, sbuild.scan = &iiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemImportSelect configured with the given aggregations.
+func (iiq *ItemImportQuery) Aggregate(fns ...AggregateFunc) *ItemImportSelect {
+	return iiq.Select().Aggregate(fns...)
+}
+
+func (iiq *ItemImportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iiq.ctx.Fields {
+		if !itemimport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iiq.path != nil {
+		prev, err := iiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iiq.sql = prev
+	}
+	if itemimport.Policy == nil {
+		return errors.New("ent: uninitialized itemimport.Policy (forgotten import ent/runtime?)")
+	}
+	if err := itemimport.Policy.EvalQuery(ctx, iiq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (iiq *ItemImportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemImport, error) {
+	var (
+		nodes       = []*ItemImport{}
+		_spec       = iiq.querySpec()
+		loadedTypes = [1]bool{
+			iiq.withOwner != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemImport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemImport{config: iiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iiq.withOwner; query != nil {
+		if err := iiq.loadOwner(ctx, query, nodes, nil,
+			func(n *ItemImport, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range iiq.loadTotal {
+		if err := iiq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iiq *ItemImportQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*ItemImport, init func(*ItemImport), assign func(*ItemImport, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemImport)
+	for i := range nodes {
+		fk := nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (iiq *ItemImportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iiq.querySpec()
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	_spec.Node.Columns = iiq.ctx.Fields
+	if len(iiq.ctx.Fields) > 0 {
+		_spec.Unique = iiq.ctx.Unique != nil && *iiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iiq.driver, _spec)
+}
+
+func (iiq *ItemImportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemimport.Table, itemimport.Columns, sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint))
+	_spec.From = iiq.sql
+	if unique := iiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemimport.FieldID)
+		for i := range fields {
+			if fields[i] != itemimport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if iiq.withOwner != nil {
+			_spec.Node.AddColumnOnce(itemimport.FieldOwnerID)
+		}
+	}
+	if ps := iiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iiq *ItemImportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iiq.driver.Dialect())
+	t1 := builder.Table(itemimport.Table)
+	columns := iiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemimport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iiq.sql != nil {
+		selector = iiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iiq.ctx.Unique != nil && *iiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iiq.modifiers {
+		m(selector)
+	}
+	for _, p := range iiq.predicates {
+		p(selector)
+	}
+	for _, p := range iiq.order {
+		p(selector)
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iiq *ItemImportQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemImportSelect {
+	iiq.modifiers = append(iiq.modifiers, modifiers...)
+	return iiq.Select()
+}
+
+// ItemImportGroupBy is the group-by builder for ItemImport entities.
+type ItemImportGroupBy struct {
+	selector
+	build *ItemImportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iigb *ItemImportGroupBy) Aggregate(fns ...AggregateFunc) *ItemImportGroupBy {
+	iigb.fns = append(iigb.fns, fns...)
+	return iigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iigb *ItemImportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iigb.build.ctx, "GroupBy")
+	if err := iigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemImportQuery, *ItemImportGroupBy](ctx, iigb.build, iigb, iigb.build.inters, v)
+}
+
+func (iigb *ItemImportGroupBy) sqlScan(ctx context.Context, root *ItemImportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iigb.fns))
+	for _, fn := range iigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iigb.flds)+len(iigb.fns))
+		for _, f := range *iigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemImportSelect is the builder for selecting fields of ItemImport entities.
+type ItemImportSelect struct {
+	*ItemImportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iis *ItemImportSelect) Aggregate(fns ...AggregateFunc) *ItemImportSelect {
+	iis.fns = append(iis.fns, fns...)
+	return iis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iis *ItemImportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iis.ctx, "Select")
+	if err := iis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemImportQuery, *ItemImportSelect](ctx, iis.ItemImportQuery, iis, iis.inters, v)
+}
+
+func (iis *ItemImportSelect) sqlScan(ctx context.Context, root *ItemImportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iis.fns))
+	for _, fn := range iis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iis *ItemImportSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemImportSelect {
+	iis.modifiers = append(iis.modifiers, modifiers...)
+	return iis
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// ItemImportUpdate is the builder for updating ItemImport entities.
+type ItemImportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemImportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemImportUpdate builder.
+func (iiu *ItemImportUpdate) Where(ps ...predicate.ItemImport) *ItemImportUpdate {
+	iiu.mutation.Where(ps...)
+	return iiu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (iiu *ItemImportUpdate) SetUpdateTime(t time.Time) *ItemImportUpdate {
+	iiu.mutation.SetUpdateTime(t)
+	return iiu
+}
+
+// SetStatus sets the "status" field.
+func (iiu *ItemImportUpdate) SetStatus(i itemimport.Status) *ItemImportUpdate {
+	iiu.mutation.SetStatus(i)
+	return iiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiu *ItemImportUpdate) SetNillableStatus(i *itemimport.Status) *ItemImportUpdate {
+	if i != nil {
+		iiu.SetStatus(*i)
+	}
+	return iiu
+}
+
+// SetReadBytes sets the "read_bytes" field.
+func (iiu *ItemImportUpdate) SetReadBytes(i int64) *ItemImportUpdate {
+	iiu.mutation.ResetReadBytes()
+	iiu.mutation.SetReadBytes(i)
+	return iiu
+}
+
+// SetNillableReadBytes sets the "read_bytes" field if the given value is not nil.
+func (iiu *ItemImportUpdate) SetNillableReadBytes(i *int64) *ItemImportUpdate {
+	if i != nil {
+		iiu.SetReadBytes(*i)
+	}
+	return iiu
+}
+
+// AddReadBytes adds i to the "read_bytes" field.
+func (iiu *ItemImportUpdate) AddReadBytes(i int64) *ItemImportUpdate {
+	iiu.mutation.AddReadBytes(i)
+	return iiu
+}
+
+// SetCreatedRows sets the "created_rows" field.
+func (iiu *ItemImportUpdate) SetCreatedRows(i int) *ItemImportUpdate {
+	iiu.mutation.ResetCreatedRows()
+	iiu.mutation.SetCreatedRows(i)
+	return iiu
+}
+
+// SetNillableCreatedRows sets the "created_rows" field if the given value is not nil.
+func (iiu *ItemImportUpdate) SetNillableCreatedRows(i *int) *ItemImportUpdate {
+	if i != nil {
+		iiu.SetCreatedRows(*i)
+	}
+	return iiu
+}
+
+// AddCreatedRows adds i to the "created_rows" field.
+func (iiu *ItemImportUpdate) AddCreatedRows(i int) *ItemImportUpdate {
+	iiu.mutation.AddCreatedRows(i)
+	return iiu
+}
+
+// SetFailedRows sets the "failed_rows" field.
+func (iiu *ItemImportUpdate) SetFailedRows(i int) *ItemImportUpdate {
+	iiu.mutation.ResetFailedRows()
+	iiu.mutation.SetFailedRows(i)
+	return iiu
+}
+
+// SetNillableFailedRows sets the "failed_rows" field if the given value is not nil.
+func (iiu *ItemImportUpdate) SetNillableFailedRows(i *int) *ItemImportUpdate {
+	if i != nil {
+		iiu.SetFailedRows(*i)
+	}
+	return iiu
+}
+
+// AddFailedRows adds i to the "failed_rows" field.
+func (iiu *ItemImportUpdate) AddFailedRows(i int) *ItemImportUpdate {
+	iiu.mutation.AddFailedRows(i)
+	return iiu
+}
+
+// SetErrors sets the "errors" field.
+func (iiu *ItemImportUpdate) SetErrors(mire []models.ItemImportRowError) *ItemImportUpdate {
+	iiu.mutation.SetErrors(mire)
+	return iiu
+}
+
+// AppendErrors appends mire to the "errors" field.
+func (iiu *ItemImportUpdate) AppendErrors(mire []models.ItemImportRowError) *ItemImportUpdate {
+	iiu.mutation.AppendErrors(mire)
+	return iiu
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (iiu *ItemImportUpdate) ClearErrors() *ItemImportUpdate {
+	iiu.mutation.ClearErrors()
+	return iiu
+}
+
+// SetError sets the "error" field.
+func (iiu *ItemImportUpdate) SetError(s string) *ItemImportUpdate {
+	iiu.mutation.SetError(s)
+	return iiu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iiu *ItemImportUpdate) SetNillableError(s *string) *ItemImportUpdate {
+	if s != nil {
+		iiu.SetError(*s)
+	}
+	return iiu
+}
+
+// ClearError clears the value of the "error" field.
+func (iiu *ItemImportUpdate) ClearError() *ItemImportUpdate {
+	iiu.mutation.ClearError()
+	return iiu
+}
+
+// SetFinishTime sets the "finish_time" field.
+func (iiu *ItemImportUpdate) SetFinishTime(t time.Time) *ItemImportUpdate {
+	iiu.mutation.SetFinishTime(t)
+	return iiu
+}
+
+// SetNillableFinishTime sets the "finish_time" field if the given value is not nil.
+func (iiu *ItemImportUpdate) SetNillableFinishTime(t *time.Time) *ItemImportUpdate {
+	if t != nil {
+		iiu.SetFinishTime(*t)
+	}
+	return iiu
+}
+
+// ClearFinishTime clears the value of the "finish_time" field.
+func (iiu *ItemImportUpdate) ClearFinishTime() *ItemImportUpdate {
+	iiu.mutation.ClearFinishTime()
+	return iiu
+}
+
+// Mutation returns the ItemImportMutation object of the builder.
+func (iiu *ItemImportUpdate) Mutation() *ItemImportMutation {
+	return iiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iiu *ItemImportUpdate) Save(ctx context.Context) (int, error) {
+	if err := iiu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, ItemImportMutation](ctx, iiu.sqlSave, iiu.mutation, iiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiu *ItemImportUpdate) SaveX(ctx context.Context) int {
+	affected, err := iiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iiu *ItemImportUpdate) Exec(ctx context.Context) error {
+	_, err := iiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiu *ItemImportUpdate) ExecX(ctx context.Context) {
+	if err := iiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiu *ItemImportUpdate) defaults() error {
+	if _, ok := iiu.mutation.UpdateTime(); !ok {
+		if itemimport.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemimport.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := itemimport.UpdateDefaultUpdateTime()
+		iiu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiu *ItemImportUpdate) check() error {
+	if v, ok := iiu.mutation.Status(); ok {
+		if err := itemimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ItemImport.status": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.ReadBytes(); ok {
+		if err := itemimport.ReadBytesValidator(v); err != nil {
+			return &ValidationError{Name: "read_bytes", err: fmt.Errorf(`ent: validator failed for field "ItemImport.read_bytes": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.CreatedRows(); ok {
+		if err := itemimport.CreatedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "created_rows", err: fmt.Errorf(`ent: validator failed for field "ItemImport.created_rows": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.FailedRows(); ok {
+		if err := itemimport.FailedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "failed_rows", err: fmt.Errorf(`ent: validator failed for field "ItemImport.failed_rows": %w`, err)}
+		}
+	}
+	if _, ok := iiu.mutation.OwnerID(); iiu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemImport.owner"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiu *ItemImportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemImportUpdate {
+	iiu.modifiers = append(iiu.modifiers, modifiers...)
+	return iiu
+}
+
+func (iiu *ItemImportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemimport.Table, itemimport.Columns, sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint))
+	if ps := iiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiu.mutation.UpdateTime(); ok {
+		_spec.SetField(itemimport.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := iiu.mutation.Status(); ok {
+		_spec.SetField(itemimport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iiu.mutation.ReadBytes(); ok {
+		_spec.SetField(itemimport.FieldReadBytes, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.AddedReadBytes(); ok {
+		_spec.AddField(itemimport.FieldReadBytes, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.CreatedRows(); ok {
+		_spec.SetField(itemimport.FieldCreatedRows, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.AddedCreatedRows(); ok {
+		_spec.AddField(itemimport.FieldCreatedRows, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.FailedRows(); ok {
+		_spec.SetField(itemimport.FieldFailedRows, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.AddedFailedRows(); ok {
+		_spec.AddField(itemimport.FieldFailedRows, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.Errors(); ok {
+		_spec.SetField(itemimport.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := iiu.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, itemimport.FieldErrors, value)
+		})
+	}
+	if iiu.mutation.ErrorsCleared() {
+		_spec.ClearField(itemimport.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := iiu.mutation.Error(); ok {
+		_spec.SetField(itemimport.FieldError, field.TypeString, value)
+	}
+	if iiu.mutation.ErrorCleared() {
+		_spec.ClearField(itemimport.FieldError, field.TypeString)
+	}
+	if value, ok := iiu.mutation.FinishTime(); ok {
+		_spec.SetField(itemimport.FieldFinishTime, field.TypeTime, value)
+	}
+	if iiu.mutation.FinishTimeCleared() {
+		_spec.ClearField(itemimport.FieldFinishTime, field.TypeTime)
+	}
+	_spec.AddModifiers(iiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iiu.mutation.done = true
+	return n, nil
+}
+
+// ItemImportUpdateOne is the builder for updating a single ItemImport entity.
+type ItemImportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemImportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (iiuo *ItemImportUpdateOne) SetUpdateTime(t time.Time) *ItemImportUpdateOne {
+	iiuo.mutation.SetUpdateTime(t)
+	return iiuo
+}
+
+// SetStatus sets the "status" field.
+func (iiuo *ItemImportUpdateOne) SetStatus(i itemimport.Status) *ItemImportUpdateOne {
+	iiuo.mutation.SetStatus(i)
+	return iiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiuo *ItemImportUpdateOne) SetNillableStatus(i *itemimport.Status) *ItemImportUpdateOne {
+	if i != nil {
+		iiuo.SetStatus(*i)
+	}
+	return iiuo
+}
+
+// SetReadBytes sets the "read_bytes" field.
+func (iiuo *ItemImportUpdateOne) SetReadBytes(i int64) *ItemImportUpdateOne {
+	iiuo.mutation.ResetReadBytes()
+	iiuo.mutation.SetReadBytes(i)
+	return iiuo
+}
+
+// SetNillableReadBytes sets the "read_bytes" field if the given value is not nil.
+func (iiuo *ItemImportUpdateOne) SetNillableReadBytes(i *int64) *ItemImportUpdateOne {
+	if i != nil {
+		iiuo.SetReadBytes(*i)
+	}
+	return iiuo
+}
+
+// AddReadBytes adds i to the "read_bytes" field.
+func (iiuo *ItemImportUpdateOne) AddReadBytes(i int64) *ItemImportUpdateOne {
+	iiuo.mutation.AddReadBytes(i)
+	return iiuo
+}
+
+// SetCreatedRows sets the "created_rows" field.
+func (iiuo *ItemImportUpdateOne) SetCreatedRows(i int) *ItemImportUpdateOne {
+	iiuo.mutation.ResetCreatedRows()
+	iiuo.mutation.SetCreatedRows(i)
+	return iiuo
+}
+
+// SetNillableCreatedRows sets the "created_rows" field if the given value is not nil.
+func (iiuo *ItemImportUpdateOne) SetNillableCreatedRows(i *int) *ItemImportUpdateOne {
+	if i != nil {
+		iiuo.SetCreatedRows(*i)
+	}
+	return iiuo
+}
+
+// AddCreatedRows adds i to the "created_rows" field.
+func (iiuo *ItemImportUpdateOne) AddCreatedRows(i int) *ItemImportUpdateOne {
+	iiuo.mutation.AddCreatedRows(i)
+	return iiuo
+}
+
+// SetFailedRows sets the "failed_rows" field.
+func (iiuo *ItemImportUpdateOne) SetFailedRows(i int) *ItemImportUpdateOne {
+	iiuo.mutation.ResetFailedRows()
+	iiuo.mutation.SetFailedRows(i)
+	return iiuo
+}
+
+// SetNillableFailedRows sets the "failed_rows" field if the given value is not nil.
+func (iiuo *ItemImportUpdateOne) SetNillableFailedRows(i *int) *ItemImportUpdateOne {
+	if i != nil {
+		iiuo.SetFailedRows(*i)
+	}
+	return iiuo
+}
+
+// AddFailedRows adds i to the "failed_rows" field.
+func (iiuo *ItemImportUpdateOne) AddFailedRows(i int) *ItemImportUpdateOne {
+	iiuo.mutation.AddFailedRows(i)
+	return iiuo
+}
+
+// SetErrors sets the "errors" field.
+func (iiuo *ItemImportUpdateOne) SetErrors(mire []models.ItemImportRowError) *ItemImportUpdateOne {
+	iiuo.mutation.SetErrors(mire)
+	return iiuo
+}
+
+// AppendErrors appends mire to the "errors" field.
+func (iiuo *ItemImportUpdateOne) AppendErrors(mire []models.ItemImportRowError) *ItemImportUpdateOne {
+	iiuo.mutation.AppendErrors(mire)
+	return iiuo
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (iiuo *ItemImportUpdateOne) ClearErrors() *ItemImportUpdateOne {
+	iiuo.mutation.ClearErrors()
+	return iiuo
+}
+
+// SetError sets the "error" field.
+func (iiuo *ItemImportUpdateOne) SetError(s string) *ItemImportUpdateOne {
+	iiuo.mutation.SetError(s)
+	return iiuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iiuo *ItemImportUpdateOne) SetNillableError(s *string) *ItemImportUpdateOne {
+	if s != nil {
+		iiuo.SetError(*s)
+	}
+	return iiuo
+}
+
+// ClearError clears the value of the "error" field.
+func (iiuo *ItemImportUpdateOne) ClearError() *ItemImportUpdateOne {
+	iiuo.mutation.ClearError()
+	return iiuo
+}
+
+// SetFinishTime sets the "finish_time" field.
+func (iiuo *ItemImportUpdateOne) SetFinishTime(t time.Time) *ItemImportUpdateOne {
+	iiuo.mutation.SetFinishTime(t)
+	return iiuo
+}
+
+// SetNillableFinishTime sets the "finish_time" field if the given value is not nil.
+func (iiuo *ItemImportUpdateOne) SetNillableFinishTime(t *time.Time) *ItemImportUpdateOne {
+	if t != nil {
+		iiuo.SetFinishTime(*t)
+	}
+	return iiuo
+}
+
+// ClearFinishTime clears the value of the "finish_time" field.
+func (iiuo *ItemImportUpdateOne) ClearFinishTime() *ItemImportUpdateOne {
+	iiuo.mutation.ClearFinishTime()
+	return iiuo
+}
+
+// Mutation returns the ItemImportMutation object of the builder.
+func (iiuo *ItemImportUpdateOne) Mutation() *ItemImportMutation {
+	return iiuo.mutation
+}
+
+// Where appends a list predicates to the ItemImportUpdate builder.
+func (iiuo *ItemImportUpdateOne) Where(ps ...predicate.ItemImport) *ItemImportUpdateOne {
+	iiuo.mutation.Where(ps...)
+	return iiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iiuo *ItemImportUpdateOne) Select(field string, fields ...string) *ItemImportUpdateOne {
+	iiuo.fields = append([]string{field}, fields...)
+	return iiuo
+}
+
+// Save executes the query and returns the updated ItemImport entity.
+func (iiuo *ItemImportUpdateOne) Save(ctx context.Context) (*ItemImport, error) {
+	if err := iiuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*ItemImport, ItemImportMutation](ctx, iiuo.sqlSave, iiuo.mutation, iiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiuo *ItemImportUpdateOne) SaveX(ctx context.Context) *ItemImport {
+	node, err := iiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iiuo *ItemImportUpdateOne) Exec(ctx context.Context) error {
+	_, err := iiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiuo *ItemImportUpdateOne) ExecX(ctx context.Context) {
+	if err := iiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiuo *ItemImportUpdateOne) defaults() error {
+	if _, ok := iiuo.mutation.UpdateTime(); !ok {
+		if itemimport.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemimport.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := itemimport.UpdateDefaultUpdateTime()
+		iiuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiuo *ItemImportUpdateOne) check() error {
+	if v, ok := iiuo.mutation.Status(); ok {
+		if err := itemimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ItemImport.status": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.ReadBytes(); ok {
+		if err := itemimport.ReadBytesValidator(v); err != nil {
+			return &ValidationError{Name: "read_bytes", err: fmt.Errorf(`ent: validator failed for field "ItemImport.read_bytes": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.CreatedRows(); ok {
+		if err := itemimport.CreatedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "created_rows", err: fmt.Errorf(`ent: validator failed for field "ItemImport.created_rows": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.FailedRows(); ok {
+		if err := itemimport.FailedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "failed_rows", err: fmt.Errorf(`ent: validator failed for field "ItemImport.failed_rows": %w`, err)}
+		}
+	}
+	if _, ok := iiuo.mutation.OwnerID(); iiuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemImport.owner"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiuo *ItemImportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemImportUpdateOne {
+	iiuo.modifiers = append(iiuo.modifiers, modifiers...)
+	return iiuo
+}
+
+func (iiuo *ItemImportUpdateOne) sqlSave(ctx context.Context) (_node *ItemImport, err error) {
+	if err := iiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemimport.Table, itemimport.Columns, sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint))
+	id, ok := iiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemImport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemimport.FieldID)
+		for _, f := range fields {
+			if !itemimport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemimport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiuo.mutation.UpdateTime(); ok {
+		_spec.SetField(itemimport.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := iiuo.mutation.Status(); ok {
+		_spec.SetField(itemimport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iiuo.mutation.ReadBytes(); ok {
+		_spec.SetField(itemimport.FieldReadBytes, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.AddedReadBytes(); ok {
+		_spec.AddField(itemimport.FieldReadBytes, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.CreatedRows(); ok {
+		_spec.SetField(itemimport.FieldCreatedRows, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.AddedCreatedRows(); ok {
+		_spec.AddField(itemimport.FieldCreatedRows, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.FailedRows(); ok {
+		_spec.SetField(itemimport.FieldFailedRows, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.AddedFailedRows(); ok {
+		_spec.AddField(itemimport.FieldFailedRows, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.Errors(); ok {
+		_spec.SetField(itemimport.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := iiuo.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, itemimport.FieldErrors, value)
+		})
+	}
+	if iiuo.mutation.ErrorsCleared() {
+		_spec.ClearField(itemimport.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := iiuo.mutation.Error(); ok {
+		_spec.SetField(itemimport.FieldError, field.TypeString, value)
+	}
+	if iiuo.mutation.ErrorCleared() {
+		_spec.ClearField(itemimport.FieldError, field.TypeString)
+	}
+	if value, ok := iiuo.mutation.FinishTime(); ok {
+		_spec.SetField(itemimport.FieldFinishTime, field.TypeTime, value)
+	}
+	if iiuo.mutation.FinishTimeCleared() {
+		_spec.ClearField(itemimport.FieldFinishTime, field.TypeTime)
+	}
+	_spec.AddModifiers(iiuo.modifiers...)
+	_node = &ItemImport{config: iiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// ItemRevision is the model entity for the ItemRevision schema.
+type ItemRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uint `json:"item_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uint `json:"user_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action itemrevision.Action `json:"action,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot models.ItemSnapshot `json:"snapshot,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []models.FieldChange `json:"changes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemRevisionQuery when eager-loading is set.
+	Edges        ItemRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemRevisionEdges holds the relations/edges for other nodes in the graph.
+type ItemRevisionEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemRevisionEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemRevisionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemrevision.FieldSnapshot, itemrevision.FieldChanges:
+			values[i] = new([]byte)
+		case itemrevision.FieldID, itemrevision.FieldItemID, itemrevision.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case itemrevision.FieldAction:
+			values[i] = new(sql.NullString)
+		case itemrevision.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemRevision fields.
+func (ir *ItemRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ir.ID = uint(value.Int64)
+		case itemrevision.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ir.CreateTime = value.Time
+			}
+		case itemrevision.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ir.ItemID = uint(value.Int64)
+			}
+		case itemrevision.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ir.UserID = new(uint)
+				*ir.UserID = uint(value.Int64)
+			}
+		case itemrevision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ir.Action = itemrevision.Action(value.String)
+			}
+		case itemrevision.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case itemrevision.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		default:
+			ir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemRevision.
+// This includes values selected through modifiers, order, etc.
+func (ir *ItemRevision) Value(name string) (ent.Value, error) {
+	return ir.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemRevision entity.
+func (ir *ItemRevision) QueryItem() *ItemQuery {
+	return NewItemRevisionClient(ir.config).QueryItem(ir)
+}
+
+// QueryUser queries the "user" edge of the ItemRevision entity.
+func (ir *ItemRevision) QueryUser() *UserQuery {
+	return NewItemRevisionClient(ir.config).QueryUser(ir)
+}
+
+// Update returns a builder for updating this ItemRevision.
+// Note that you need to call ItemRevision.Unwrap() before calling this method if this ItemRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *ItemRevision) Update() *ItemRevisionUpdateOne {
+	return NewItemRevisionClient(ir.config).UpdateOne(ir)
+}
+
+// Unwrap unwraps the ItemRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *ItemRevision) Unwrap() *ItemRevision {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemRevision is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *ItemRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ir.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ir.ItemID))
+	builder.WriteString(", ")
+	if v := ir.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Action))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Changes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemRevisions is a parsable slice of ItemRevision.
+type ItemRevisions []*ItemRevision
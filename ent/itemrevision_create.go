@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// ItemRevisionCreate is the builder for creating a ItemRevision entity.
+type ItemRevisionCreate struct {
+	config
+	mutation *ItemRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (irc *ItemRevisionCreate) SetCreateTime(t time.Time) *ItemRevisionCreate {
+	irc.mutation.SetCreateTime(t)
+	return irc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableCreateTime(t *time.Time) *ItemRevisionCreate {
+	if t != nil {
+		irc.SetCreateTime(*t)
+	}
+	return irc
+}
+
+// SetItemID sets the "item_id" field.
+func (irc *ItemRevisionCreate) SetItemID(u uint) *ItemRevisionCreate {
+	irc.mutation.SetItemID(u)
+	return irc
+}
+
+// SetUserID sets the "user_id" field.
+func (irc *ItemRevisionCreate) SetUserID(u uint) *ItemRevisionCreate {
+	irc.mutation.SetUserID(u)
+	return irc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableUserID(u *uint) *ItemRevisionCreate {
+	if u != nil {
+		irc.SetUserID(*u)
+	}
+	return irc
+}
+
+// SetAction sets the "action" field.
+func (irc *ItemRevisionCreate) SetAction(i itemrevision.Action) *ItemRevisionCreate {
+	irc.mutation.SetAction(i)
+	return irc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (irc *ItemRevisionCreate) SetSnapshot(ms models.ItemSnapshot) *ItemRevisionCreate {
+	irc.mutation.SetSnapshot(ms)
+	return irc
+}
+
+// SetChanges sets the "changes" field.
+func (irc *ItemRevisionCreate) SetChanges(mc []models.FieldChange) *ItemRevisionCreate {
+	irc.mutation.SetChanges(mc)
+	return irc
+}
+
+// SetID sets the "id" field.
+func (irc *ItemRevisionCreate) SetID(u uint) *ItemRevisionCreate {
+	irc.mutation.SetID(u)
+	return irc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (irc *ItemRevisionCreate) SetItem(i *Item) *ItemRevisionCreate {
+	return irc.SetItemID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (irc *ItemRevisionCreate) SetUser(u *User) *ItemRevisionCreate {
+	return irc.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (irc *ItemRevisionCreate) Mutation() *ItemRevisionMutation {
+	return irc.mutation
+}
+
+// Save creates the ItemRevision in the database.
+func (irc *ItemRevisionCreate) Save(ctx context.Context) (*ItemRevision, error) {
+	if err := irc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*ItemRevision, ItemRevisionMutation](ctx, irc.sqlSave, irc.mutation, irc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *ItemRevisionCreate) SaveX(ctx context.Context) *ItemRevision {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *ItemRevisionCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *ItemRevisionCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *ItemRevisionCreate) defaults() error {
+	if _, ok := irc.mutation.CreateTime(); !ok {
+		if itemrevision.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemrevision.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := itemrevision.DefaultCreateTime()
+		irc.mutation.SetCreateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *ItemRevisionCreate) check() error {
+	if _, ok := irc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ItemRevision.create_time"`)}
+	}
+	if _, ok := irc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemRevision.item_id"`)}
+	}
+	if _, ok := irc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ItemRevision.action"`)}
+	}
+	if v, ok := irc.mutation.Action(); ok {
+		if err := itemrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ItemRevision.action": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "ItemRevision.snapshot"`)}
+	}
+	if _, ok := irc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "ItemRevision.changes"`)}
+	}
+	if _, ok := irc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemRevision.item"`)}
+	}
+	return nil
+}
+
+func (irc *ItemRevisionCreate) sqlSave(ctx context.Context) (*ItemRevision, error) {
+	if err := irc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	irc.mutation.id = &_node.ID
+	irc.mutation.done = true
+	return _node, nil
+}
+
+func (irc *ItemRevisionCreate) createSpec() (*ItemRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemRevision{config: irc.config}
+		_spec = sqlgraph.NewCreateSpec(itemrevision.Table, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint))
+	)
+	if id, ok := irc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := irc.mutation.CreateTime(); ok {
+		_spec.SetField(itemrevision.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := irc.mutation.Action(); ok {
+		_spec.SetField(itemrevision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := irc.mutation.Snapshot(); ok {
+		_spec.SetField(itemrevision.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := irc.mutation.Changes(); ok {
+		_spec.SetField(itemrevision.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if nodes := irc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemrevision.ItemTable,
+			Columns: []string{itemrevision.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := irc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemrevision.UserTable,
+			Columns: []string{itemrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemRevisionCreateBulk is the builder for creating many ItemRevision entities in bulk.
+type ItemRevisionCreateBulk struct {
+	config
+	builders []*ItemRevisionCreate
+}
+
+// Save creates the ItemRevision entities in the database.
+func (ircb *ItemRevisionCreateBulk) Save(ctx context.Context) ([]*ItemRevision, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*ItemRevision, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *ItemRevisionCreateBulk) SaveX(ctx context.Context) []*ItemRevision {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *ItemRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *ItemRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ItemRevisionDelete is the builder for deleting a ItemRevision entity.
+type ItemRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemRevisionMutation
+}
+
+// Where appends a list predicates to the ItemRevisionDelete builder.
+func (ird *ItemRevisionDelete) Where(ps ...predicate.ItemRevision) *ItemRevisionDelete {
+	ird.mutation.Where(ps...)
+	return ird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ird *ItemRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ItemRevisionMutation](ctx, ird.sqlExec, ird.mutation, ird.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ird *ItemRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := ird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ird *ItemRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemrevision.Table, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint))
+	if ps := ird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ird.mutation.done = true
+	return affected, err
+}
+
+// ItemRevisionDeleteOne is the builder for deleting a single ItemRevision entity.
+type ItemRevisionDeleteOne struct {
+	ird *ItemRevisionDelete
+}
+
+// Where appends a list predicates to the ItemRevisionDelete builder.
+func (irdo *ItemRevisionDeleteOne) Where(ps ...predicate.ItemRevision) *ItemRevisionDeleteOne {
+	irdo.ird.mutation.Where(ps...)
+	return irdo
+}
+
+// Exec executes the deletion query.
+func (irdo *ItemRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := irdo.ird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irdo *ItemRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := irdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemRevisionQuery is the builder for querying ItemRevision entities.
+type ItemRevisionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemrevision.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemRevision
+	withItem   *ItemQuery
+	withUser   *UserQuery
+	loadTotal  []func(context.Context, []*ItemRevision) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemRevisionQuery builder.
+func (irq *ItemRevisionQuery) Where(ps ...predicate.ItemRevision) *ItemRevisionQuery {
+	irq.predicates = append(irq.predicates, ps...)
+	return irq
+}
+
+// Limit the number of records to be returned by this query.
+func (irq *ItemRevisionQuery) Limit(limit int) *ItemRevisionQuery {
+	irq.ctx.Limit = &limit
+	return irq
+}
+
+// Offset to start from.
+func (irq *ItemRevisionQuery) Offset(offset int) *ItemRevisionQuery {
+	irq.ctx.Offset = &offset
+	return irq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (irq *ItemRevisionQuery) Unique(unique bool) *ItemRevisionQuery {
+	irq.ctx.Unique = &unique
+	return irq
+}
+
+// Order specifies how the records should be ordered.
+func (irq *ItemRevisionQuery) Order(o ...itemrevision.OrderOption) *ItemRevisionQuery {
+	irq.order = append(irq.order, o...)
+	return irq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (irq *ItemRevisionQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: irq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := irq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemrevision.Table, itemrevision.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemrevision.ItemTable, itemrevision.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(irq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (irq *ItemRevisionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: irq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := irq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemrevision.Table, itemrevision.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemrevision.UserTable, itemrevision.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(irq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemRevision entity from the query.
+// Returns a *NotFoundError when no ItemRevision was found.
+func (irq *ItemRevisionQuery) First(ctx context.Context) (*ItemRevision, error) {
+	nodes, err := irq.Limit(1).All(setContextOp(ctx, irq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemrevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (irq *ItemRevisionQuery) FirstX(ctx context.Context) *ItemRevision {
+	node, err := irq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemRevision ID from the query.
+// Returns a *NotFoundError when no ItemRevision ID was found.
+func (irq *ItemRevisionQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = irq.Limit(1).IDs(setContextOp(ctx, irq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (irq *ItemRevisionQuery) FirstIDX(ctx context.Context) uint {
+	id, err := irq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemRevision entity is found.
+// Returns a *NotFoundError when no ItemRevision entities are found.
+func (irq *ItemRevisionQuery) Only(ctx context.Context) (*ItemRevision, error) {
+	nodes, err := irq.Limit(2).All(setContextOp(ctx, irq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemrevision.Label}
+	default:
+		return nil, &NotSingularError{itemrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (irq *ItemRevisionQuery) OnlyX(ctx context.Context) *ItemRevision {
+	node, err := irq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemRevision ID in the query.
+// Returns a *NotSingularError when more than one ItemRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (irq *ItemRevisionQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = irq.Limit(2).IDs(setContextOp(ctx, irq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemrevision.Label}
+	default:
+		err = &NotSingularError{itemrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (irq *ItemRevisionQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := irq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemRevisions.
+func (irq *ItemRevisionQuery) All(ctx context.Context) ([]*ItemRevision, error) {
+	ctx = setContextOp(ctx, irq.ctx, "All")
+	if err := irq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemRevision, *ItemRevisionQuery]()
+	return withInterceptors[[]*ItemRevision](ctx, irq, qr, irq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (irq *ItemRevisionQuery) AllX(ctx context.Context) []*ItemRevision {
+	nodes, err := irq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemRevision IDs.
+func (irq *ItemRevisionQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if irq.ctx.Unique == nil && irq.path != nil {
+		irq.Unique(true)
+	}
+	ctx = setContextOp(ctx, irq.ctx, "IDs")
+	if err = irq.Select(itemrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (irq *ItemRevisionQuery) IDsX(ctx context.Context) []uint {
+	ids, err := irq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (irq *ItemRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, irq.ctx, "Count")
+	if err := irq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, irq, querierCount[*ItemRevisionQuery](), irq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (irq *ItemRevisionQuery) CountX(ctx context.Context) int {
+	count, err := irq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (irq *ItemRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, irq.ctx, "Exist")
+	switch _, err := irq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (irq *ItemRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := irq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (irq *ItemRevisionQuery) Clone() *ItemRevisionQuery {
+	if irq == nil {
+		return nil
+	}
+	return &ItemRevisionQuery{
+		config:     irq.config,
+		ctx:        irq.ctx.Clone(),
+		order:      append([]itemrevision.OrderOption{}, irq.order...),
+		inters:     append([]Interceptor{}, irq.inters...),
+		predicates: append([]predicate.ItemRevision{}, irq.predicates...),
+		withItem:   irq.withItem.Clone(),
+		withUser:   irq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  irq.sql.Clone(),
+		path: irq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (irq *ItemRevisionQuery) WithItem(opts ...func(*ItemQuery)) *ItemRevisionQuery {
+	query := (&ItemClient{config: irq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	irq.withItem = query
+	return irq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (irq *ItemRevisionQuery) WithUser(opts ...func(*UserQuery)) *ItemRevisionQuery {
+	query := (&UserClient{config: irq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	irq.withUser = query
+	return irq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemRevision.Query().
+//		GroupBy(itemrevision.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (irq *ItemRevisionQuery) GroupBy(field string, fields ...string) *ItemRevisionGroupBy {
+	irq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemRevisionGroupBy{build: irq}
+	grbuild.flds = &irq.ctx.Fields
+	grbuild.label = itemrevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ItemRevision.Query().
+//		Select(itemrevision.FieldCreateTime).
+//		Scan(ctx, &v)
+func (irq *ItemRevisionQuery) Select(fields ...string) *ItemRevisionSelect {
+	irq.ctx.Fields = append(irq.ctx.Fields, fields...)
+	sbuild := &ItemRevisionSelect{ItemRevisionQuery: irq}
+	sbuild.label = itemrevision.Label
+	sbuild.flds, sbuild.scan = &irq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemRevisionSelect configured with the given aggregations.
+func (irq *ItemRevisionQuery) Aggregate(fns ...AggregateFunc) *ItemRevisionSelect {
+	return irq.Select().Aggregate(fns...)
+}
+
+func (irq *ItemRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range irq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, irq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range irq.ctx.Fields {
+		if !itemrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if irq.path != nil {
+		prev, err := irq.path(ctx)
+		if err != nil {
+			return err
+		}
+		irq.sql = prev
+	}
+	if itemrevision.Policy == nil {
+		return errors.New("ent: uninitialized itemrevision.Policy (forgotten import ent/runtime?)")
+	}
+	if err := itemrevision.Policy.EvalQuery(ctx, irq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (irq *ItemRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemRevision, error) {
+	var (
+		nodes       = []*ItemRevision{}
+		_spec       = irq.querySpec()
+		loadedTypes = [2]bool{
+			irq.withItem != nil,
+			irq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemRevision{config: irq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(irq.modifiers) > 0 {
+		_spec.Modifiers = irq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, irq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := irq.withItem; query != nil {
+		if err := irq.loadItem(ctx, query, nodes, nil,
+			func(n *ItemRevision, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := irq.withUser; query != nil {
+		if err := irq.loadUser(ctx, query, nodes, nil,
+			func(n *ItemRevision, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range irq.loadTotal {
+		if err := irq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (irq *ItemRevisionQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ItemRevision, init func(*ItemRevision), assign func(*ItemRevision, *Item)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemRevision)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (irq *ItemRevisionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ItemRevision, init func(*ItemRevision), assign func(*ItemRevision, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemRevision)
+	for i := range nodes {
+		if nodes[i].UserID == nil {
+			continue
+		}
+		fk := *nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (irq *ItemRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := irq.querySpec()
+	if len(irq.modifiers) > 0 {
+		_spec.Modifiers = irq.modifiers
+	}
+	_spec.Node.Columns = irq.ctx.Fields
+	if len(irq.ctx.Fields) > 0 {
+		_spec.Unique = irq.ctx.Unique != nil && *irq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, irq.driver, _spec)
+}
+
+func (irq *ItemRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemrevision.Table, itemrevision.Columns, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint))
+	_spec.From = irq.sql
+	if unique := irq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if irq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := irq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemrevision.FieldID)
+		for i := range fields {
+			if fields[i] != itemrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if irq.withItem != nil {
+			_spec.Node.AddColumnOnce(itemrevision.FieldItemID)
+		}
+		if irq.withUser != nil {
+			_spec.Node.AddColumnOnce(itemrevision.FieldUserID)
+		}
+	}
+	if ps := irq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := irq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := irq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := irq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (irq *ItemRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(irq.driver.Dialect())
+	t1 := builder.Table(itemrevision.Table)
+	columns := irq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemrevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if irq.sql != nil {
+		selector = irq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if irq.ctx.Unique != nil && *irq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range irq.modifiers {
+		m(selector)
+	}
+	for _, p := range irq.predicates {
+		p(selector)
+	}
+	for _, p := range irq.order {
+		p(selector)
+	}
+	if offset := irq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := irq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (irq *ItemRevisionQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemRevisionSelect {
+	irq.modifiers = append(irq.modifiers, modifiers...)
+	return irq.Select()
+}
+
+// ItemRevisionGroupBy is the group-by builder for ItemRevision entities.
+type ItemRevisionGroupBy struct {
+	selector
+	build *ItemRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (irgb *ItemRevisionGroupBy) Aggregate(fns ...AggregateFunc) *ItemRevisionGroupBy {
+	irgb.fns = append(irgb.fns, fns...)
+	return irgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irgb *ItemRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, irgb.build.ctx, "GroupBy")
+	if err := irgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemRevisionQuery, *ItemRevisionGroupBy](ctx, irgb.build, irgb, irgb.build.inters, v)
+}
+
+func (irgb *ItemRevisionGroupBy) sqlScan(ctx context.Context, root *ItemRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(irgb.fns))
+	for _, fn := range irgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*irgb.flds)+len(irgb.fns))
+		for _, f := range *irgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*irgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemRevisionSelect is the builder for selecting fields of ItemRevision entities.
+type ItemRevisionSelect struct {
+	*ItemRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (irs *ItemRevisionSelect) Aggregate(fns ...AggregateFunc) *ItemRevisionSelect {
+	irs.fns = append(irs.fns, fns...)
+	return irs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irs *ItemRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, irs.ctx, "Select")
+	if err := irs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemRevisionQuery, *ItemRevisionSelect](ctx, irs.ItemRevisionQuery, irs, irs.inters, v)
+}
+
+func (irs *ItemRevisionSelect) sqlScan(ctx context.Context, root *ItemRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(irs.fns))
+	for _, fn := range irs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*irs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (irs *ItemRevisionSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemRevisionSelect {
+	irs.modifiers = append(irs.modifiers, modifiers...)
+	return irs
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ItemRevisionUpdate is the builder for updating ItemRevision entities.
+type ItemRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemRevisionUpdate builder.
+func (iru *ItemRevisionUpdate) Where(ps ...predicate.ItemRevision) *ItemRevisionUpdate {
+	iru.mutation.Where(ps...)
+	return iru
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (iru *ItemRevisionUpdate) Mutation() *ItemRevisionMutation {
+	return iru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *ItemRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, ItemRevisionMutation](ctx, iru.sqlSave, iru.mutation, iru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *ItemRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *ItemRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *ItemRevisionUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iru *ItemRevisionUpdate) check() error {
+	if _, ok := iru.mutation.ItemID(); iru.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemRevision.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iru *ItemRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemRevisionUpdate {
+	iru.modifiers = append(iru.modifiers, modifiers...)
+	return iru
+}
+
+func (iru *ItemRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemrevision.Table, itemrevision.Columns, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint))
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(iru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iru.mutation.done = true
+	return n, nil
+}
+
+// ItemRevisionUpdateOne is the builder for updating a single ItemRevision entity.
+type ItemRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (iruo *ItemRevisionUpdateOne) Mutation() *ItemRevisionMutation {
+	return iruo.mutation
+}
+
+// Where appends a list predicates to the ItemRevisionUpdate builder.
+func (iruo *ItemRevisionUpdateOne) Where(ps ...predicate.ItemRevision) *ItemRevisionUpdateOne {
+	iruo.mutation.Where(ps...)
+	return iruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iruo *ItemRevisionUpdateOne) Select(field string, fields ...string) *ItemRevisionUpdateOne {
+	iruo.fields = append([]string{field}, fields...)
+	return iruo
+}
+
+// Save executes the query and returns the updated ItemRevision entity.
+func (iruo *ItemRevisionUpdateOne) Save(ctx context.Context) (*ItemRevision, error) {
+	return withHooks[*ItemRevision, ItemRevisionMutation](ctx, iruo.sqlSave, iruo.mutation, iruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *ItemRevisionUpdateOne) SaveX(ctx context.Context) *ItemRevision {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *ItemRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *ItemRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iruo *ItemRevisionUpdateOne) check() error {
+	if _, ok := iruo.mutation.ItemID(); iruo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemRevision.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iruo *ItemRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemRevisionUpdateOne {
+	iruo.modifiers = append(iruo.modifiers, modifiers...)
+	return iruo
+}
+
+func (iruo *ItemRevisionUpdateOne) sqlSave(ctx context.Context) (_node *ItemRevision, err error) {
+	if err := iruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemrevision.Table, itemrevision.Columns, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint))
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemrevision.FieldID)
+		for _, f := range fields {
+			if !itemrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(iruo.modifiers...)
+	_node = &ItemRevision{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemShare is the model entity for the ItemShare schema.
+type ItemShare struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uint `json:"item_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint `json:"user_id,omitempty"`
+	// Permission holds the value of the "permission" field.
+	Permission itemshare.Permission `json:"permission,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemShareQuery when eager-loading is set.
+	Edges        ItemShareEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemShareEdges holds the relations/edges for other nodes in the graph.
+type ItemShareEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemShareEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemShareEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemShare) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemshare.FieldID, itemshare.FieldItemID, itemshare.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case itemshare.FieldPermission:
+			values[i] = new(sql.NullString)
+		case itemshare.FieldCreateTime, itemshare.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemShare fields.
+func (is *ItemShare) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemshare.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			is.ID = uint(value.Int64)
+		case itemshare.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				is.CreateTime = value.Time
+			}
+		case itemshare.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				is.UpdateTime = value.Time
+			}
+		case itemshare.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				is.ItemID = uint(value.Int64)
+			}
+		case itemshare.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				is.UserID = uint(value.Int64)
+			}
+		case itemshare.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				is.Permission = itemshare.Permission(value.String)
+			}
+		default:
+			is.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemShare.
+// This includes values selected through modifiers, order, etc.
+func (is *ItemShare) Value(name string) (ent.Value, error) {
+	return is.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemShare entity.
+func (is *ItemShare) QueryItem() *ItemQuery {
+	return NewItemShareClient(is.config).QueryItem(is)
+}
+
+// QueryUser queries the "user" edge of the ItemShare entity.
+func (is *ItemShare) QueryUser() *UserQuery {
+	return NewItemShareClient(is.config).QueryUser(is)
+}
+
+// Update returns a builder for updating this ItemShare.
+// Note that you need to call ItemShare.Unwrap() before calling this method if this ItemShare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (is *ItemShare) Update() *ItemShareUpdateOne {
+	return NewItemShareClient(is.config).UpdateOne(is)
+}
+
+// Unwrap unwraps the ItemShare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (is *ItemShare) Unwrap() *ItemShare {
+	_tx, ok := is.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemShare is not a transactional entity")
+	}
+	is.config.driver = _tx.drv
+	return is
+}
+
+// String implements the fmt.Stringer.
+func (is *ItemShare) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemShare(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", is.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(is.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(is.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", is.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", is.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(fmt.Sprintf("%v", is.Permission))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemShares is a parsable slice of ItemShare.
+type ItemShares []*ItemShare
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemShareCreate is the builder for creating a ItemShare entity.
+type ItemShareCreate struct {
+	config
+	mutation *ItemShareMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (isc *ItemShareCreate) SetCreateTime(t time.Time) *ItemShareCreate {
+	isc.mutation.SetCreateTime(t)
+	return isc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (isc *ItemShareCreate) SetNillableCreateTime(t *time.Time) *ItemShareCreate {
+	if t != nil {
+		isc.SetCreateTime(*t)
+	}
+	return isc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (isc *ItemShareCreate) SetUpdateTime(t time.Time) *ItemShareCreate {
+	isc.mutation.SetUpdateTime(t)
+	return isc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (isc *ItemShareCreate) SetNillableUpdateTime(t *time.Time) *ItemShareCreate {
+	if t != nil {
+		isc.SetUpdateTime(*t)
+	}
+	return isc
+}
+
+// SetItemID sets the "item_id" field.
+func (isc *ItemShareCreate) SetItemID(u uint) *ItemShareCreate {
+	isc.mutation.SetItemID(u)
+	return isc
+}
+
+// SetUserID sets the "user_id" field.
+func (isc *ItemShareCreate) SetUserID(u uint) *ItemShareCreate {
+	isc.mutation.SetUserID(u)
+	return isc
+}
+
+// SetPermission sets the "permission" field.
+func (isc *ItemShareCreate) SetPermission(i itemshare.Permission) *ItemShareCreate {
+	isc.mutation.SetPermission(i)
+	return isc
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (isc *ItemShareCreate) SetNillablePermission(i *itemshare.Permission) *ItemShareCreate {
+	if i != nil {
+		isc.SetPermission(*i)
+	}
+	return isc
+}
+
+// SetID sets the "id" field.
+func (isc *ItemShareCreate) SetID(u uint) *ItemShareCreate {
+	isc.mutation.SetID(u)
+	return isc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (isc *ItemShareCreate) SetItem(i *Item) *ItemShareCreate {
+	return isc.SetItemID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (isc *ItemShareCreate) SetUser(u *User) *ItemShareCreate {
+	return isc.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemShareMutation object of the builder.
+func (isc *ItemShareCreate) Mutation() *ItemShareMutation {
+	return isc.mutation
+}
+
+// Save creates the ItemShare in the database.
+func (isc *ItemShareCreate) Save(ctx context.Context) (*ItemShare, error) {
+	if err := isc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*ItemShare, ItemShareMutation](ctx, isc.sqlSave, isc.mutation, isc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (isc *ItemShareCreate) SaveX(ctx context.Context) *ItemShare {
+	v, err := isc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (isc *ItemShareCreate) Exec(ctx context.Context) error {
+	_, err := isc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isc *ItemShareCreate) ExecX(ctx context.Context) {
+	if err := isc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (isc *ItemShareCreate) defaults() error {
+	if _, ok := isc.mutation.CreateTime(); !ok {
+		if itemshare.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemshare.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := itemshare.DefaultCreateTime()
+		isc.mutation.SetCreateTime(v)
+	}
+	if _, ok := isc.mutation.UpdateTime(); !ok {
+		if itemshare.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemshare.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := itemshare.DefaultUpdateTime()
+		isc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := isc.mutation.Permission(); !ok {
+		v := itemshare.DefaultPermission
+		isc.mutation.SetPermission(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isc *ItemShareCreate) check() error {
+	if _, ok := isc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ItemShare.create_time"`)}
+	}
+	if _, ok := isc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ItemShare.update_time"`)}
+	}
+	if _, ok := isc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemShare.item_id"`)}
+	}
+	if _, ok := isc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ItemShare.user_id"`)}
+	}
+	if _, ok := isc.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`ent: missing required field "ItemShare.permission"`)}
+	}
+	if v, ok := isc.mutation.Permission(); ok {
+		if err := itemshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ItemShare.permission": %w`, err)}
+		}
+	}
+	if _, ok := isc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemShare.item"`)}
+	}
+	if _, ok := isc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ItemShare.user"`)}
+	}
+	return nil
+}
+
+func (isc *ItemShareCreate) sqlSave(ctx context.Context) (*ItemShare, error) {
+	if err := isc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := isc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, isc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	isc.mutation.id = &_node.ID
+	isc.mutation.done = true
+	return _node, nil
+}
+
+func (isc *ItemShareCreate) createSpec() (*ItemShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemShare{config: isc.config}
+		_spec = sqlgraph.NewCreateSpec(itemshare.Table, sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint))
+	)
+	if id, ok := isc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := isc.mutation.CreateTime(); ok {
+		_spec.SetField(itemshare.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := isc.mutation.UpdateTime(); ok {
+		_spec.SetField(itemshare.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := isc.mutation.Permission(); ok {
+		_spec.SetField(itemshare.FieldPermission, field.TypeEnum, value)
+		_node.Permission = value
+	}
+	if nodes := isc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.ItemTable,
+			Columns: []string{itemshare.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := isc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.UserTable,
+			Columns: []string{itemshare.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemShareCreateBulk is the builder for creating many ItemShare entities in bulk.
+type ItemShareCreateBulk struct {
+	config
+	builders []*ItemShareCreate
+}
+
+// Save creates the ItemShare entities in the database.
+func (iscb *ItemShareCreateBulk) Save(ctx context.Context) ([]*ItemShare, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iscb.builders))
+	nodes := make([]*ItemShare, len(iscb.builders))
+	mutators := make([]Mutator, len(iscb.builders))
+	for i := range iscb.builders {
+		func(i int, root context.Context) {
+			builder := iscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iscb *ItemShareCreateBulk) SaveX(ctx context.Context) []*ItemShare {
+	v, err := iscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iscb *ItemShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := iscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iscb *ItemShareCreateBulk) ExecX(ctx context.Context) {
+	if err := iscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
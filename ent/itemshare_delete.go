@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ItemShareDelete is the builder for deleting a ItemShare entity.
+type ItemShareDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemShareMutation
+}
+
+// Where appends a list predicates to the ItemShareDelete builder.
+func (isd *ItemShareDelete) Where(ps ...predicate.ItemShare) *ItemShareDelete {
+	isd.mutation.Where(ps...)
+	return isd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (isd *ItemShareDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ItemShareMutation](ctx, isd.sqlExec, isd.mutation, isd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isd *ItemShareDelete) ExecX(ctx context.Context) int {
+	n, err := isd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (isd *ItemShareDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemshare.Table, sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint))
+	if ps := isd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, isd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	isd.mutation.done = true
+	return affected, err
+}
+
+// ItemShareDeleteOne is the builder for deleting a single ItemShare entity.
+type ItemShareDeleteOne struct {
+	isd *ItemShareDelete
+}
+
+// Where appends a list predicates to the ItemShareDelete builder.
+func (isdo *ItemShareDeleteOne) Where(ps ...predicate.ItemShare) *ItemShareDeleteOne {
+	isdo.isd.mutation.Where(ps...)
+	return isdo
+}
+
+// Exec executes the deletion query.
+func (isdo *ItemShareDeleteOne) Exec(ctx context.Context) error {
+	n, err := isdo.isd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemshare.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isdo *ItemShareDeleteOne) ExecX(ctx context.Context) {
+	if err := isdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemShareQuery is the builder for querying ItemShare entities.
+type ItemShareQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemshare.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemShare
+	withItem   *ItemQuery
+	withUser   *UserQuery
+	loadTotal  []func(context.Context, []*ItemShare) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemShareQuery builder.
+func (isq *ItemShareQuery) Where(ps ...predicate.ItemShare) *ItemShareQuery {
+	isq.predicates = append(isq.predicates, ps...)
+	return isq
+}
+
+// Limit the number of records to be returned by this query.
+func (isq *ItemShareQuery) Limit(limit int) *ItemShareQuery {
+	isq.ctx.Limit = &limit
+	return isq
+}
+
+// Offset to start from.
+func (isq *ItemShareQuery) Offset(offset int) *ItemShareQuery {
+	isq.ctx.Offset = &offset
+	return isq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (isq *ItemShareQuery) Unique(unique bool) *ItemShareQuery {
+	isq.ctx.Unique = &unique
+	return isq
+}
+
+// Order specifies how the records should be ordered.
+func (isq *ItemShareQuery) Order(o ...itemshare.OrderOption) *ItemShareQuery {
+	isq.order = append(isq.order, o...)
+	return isq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (isq *ItemShareQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: isq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := isq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := isq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemshare.Table, itemshare.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemshare.ItemTable, itemshare.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(isq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (isq *ItemShareQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: isq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := isq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := isq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemshare.Table, itemshare.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemshare.UserTable, itemshare.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(isq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemShare entity from the query.
+// Returns a *NotFoundError when no ItemShare was found.
+func (isq *ItemShareQuery) First(ctx context.Context) (*ItemShare, error) {
+	nodes, err := isq.Limit(1).All(setContextOp(ctx, isq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemshare.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (isq *ItemShareQuery) FirstX(ctx context.Context) *ItemShare {
+	node, err := isq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemShare ID from the query.
+// Returns a *NotFoundError when no ItemShare ID was found.
+func (isq *ItemShareQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = isq.Limit(1).IDs(setContextOp(ctx, isq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemshare.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (isq *ItemShareQuery) FirstIDX(ctx context.Context) uint {
+	id, err := isq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemShare entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemShare entity is found.
+// Returns a *NotFoundError when no ItemShare entities are found.
+func (isq *ItemShareQuery) Only(ctx context.Context) (*ItemShare, error) {
+	nodes, err := isq.Limit(2).All(setContextOp(ctx, isq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemshare.Label}
+	default:
+		return nil, &NotSingularError{itemshare.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (isq *ItemShareQuery) OnlyX(ctx context.Context) *ItemShare {
+	node, err := isq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemShare ID in the query.
+// Returns a *NotSingularError when more than one ItemShare ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (isq *ItemShareQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = isq.Limit(2).IDs(setContextOp(ctx, isq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemshare.Label}
+	default:
+		err = &NotSingularError{itemshare.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (isq *ItemShareQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := isq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemShares.
+func (isq *ItemShareQuery) All(ctx context.Context) ([]*ItemShare, error) {
+	ctx = setContextOp(ctx, isq.ctx, "All")
+	if err := isq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemShare, *ItemShareQuery]()
+	return withInterceptors[[]*ItemShare](ctx, isq, qr, isq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (isq *ItemShareQuery) AllX(ctx context.Context) []*ItemShare {
+	nodes, err := isq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemShare IDs.
+func (isq *ItemShareQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if isq.ctx.Unique == nil && isq.path != nil {
+		isq.Unique(true)
+	}
+	ctx = setContextOp(ctx, isq.ctx, "IDs")
+	if err = isq.Select(itemshare.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (isq *ItemShareQuery) IDsX(ctx context.Context) []uint {
+	ids, err := isq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (isq *ItemShareQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, isq.ctx, "Count")
+	if err := isq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, isq, querierCount[*ItemShareQuery](), isq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (isq *ItemShareQuery) CountX(ctx context.Context) int {
+	count, err := isq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (isq *ItemShareQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, isq.ctx, "Exist")
+	switch _, err := isq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (isq *ItemShareQuery) ExistX(ctx context.Context) bool {
+	exist, err := isq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemShareQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (isq *ItemShareQuery) Clone() *ItemShareQuery {
+	if isq == nil {
+		return nil
+	}
+	return &ItemShareQuery{
+		config:     isq.config,
+		ctx:        isq.ctx.Clone(),
+		order:      append([]itemshare.OrderOption{}, isq.order...),
+		inters:     append([]Interceptor{}, isq.inters...),
+		predicates: append([]predicate.ItemShare{}, isq.predicates...),
+		withItem:   isq.withItem.Clone(),
+		withUser:   isq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  isq.sql.Clone(),
+		path: isq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (isq *ItemShareQuery) WithItem(opts ...func(*ItemQuery)) *ItemShareQuery {
+	query := (&ItemClient{config: isq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	isq.withItem = query
+	return isq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (isq *ItemShareQuery) WithUser(opts ...func(*UserQuery)) *ItemShareQuery {
+	query := (&UserClient{config: isq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	isq.withUser = query
+	return isq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemShare.Query().
+//		GroupBy(itemshare.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (isq *ItemShareQuery) GroupBy(field string, fields ...string) *ItemShareGroupBy {
+	isq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemShareGroupBy{build: isq}
+	grbuild.flds = &isq.ctx.Fields
+	grbuild.label = itemshare.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ItemShare.Query().
+//		Select(itemshare.FieldCreateTime).
+//		Scan(ctx, &v)
+func (isq *ItemShareQuery) Select(fields ...string) *ItemShareSelect {
+	isq.ctx.Fields = append(isq.ctx.Fields, fields...)
+	sbuild := &ItemShareSelect{ItemShareQuery: isq}
+	sbuild.label = itemshare.Label
+	sbuild.flds, sbuild.scan = &isq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemShareSelect configured with the given aggregations.
+func (isq *ItemShareQuery) Aggregate(fns ...AggregateFunc) *ItemShareSelect {
+	return isq.Select().Aggregate(fns...)
+}
+
+func (isq *ItemShareQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range isq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, isq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range isq.ctx.Fields {
+		if !itemshare.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if isq.path != nil {
+		prev, err := isq.path(ctx)
+		if err != nil {
+			return err
+		}
+		isq.sql = prev
+	}
+	if itemshare.Policy == nil {
+		return errors.New("ent: uninitialized itemshare.Policy (forgotten import ent/runtime?)")
+	}
+	if err := itemshare.Policy.EvalQuery(ctx, isq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (isq *ItemShareQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemShare, error) {
+	var (
+		nodes       = []*ItemShare{}
+		_spec       = isq.querySpec()
+		loadedTypes = [2]bool{
+			isq.withItem != nil,
+			isq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemShare).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemShare{config: isq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(isq.modifiers) > 0 {
+		_spec.Modifiers = isq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, isq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := isq.withItem; query != nil {
+		if err := isq.loadItem(ctx, query, nodes, nil,
+			func(n *ItemShare, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := isq.withUser; query != nil {
+		if err := isq.loadUser(ctx, query, nodes, nil,
+			func(n *ItemShare, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range isq.loadTotal {
+		if err := isq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (isq *ItemShareQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ItemShare, init func(*ItemShare), assign func(*ItemShare, *Item)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemShare)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (isq *ItemShareQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ItemShare, init func(*ItemShare), assign func(*ItemShare, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemShare)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (isq *ItemShareQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := isq.querySpec()
+	if len(isq.modifiers) > 0 {
+		_spec.Modifiers = isq.modifiers
+	}
+	_spec.Node.Columns = isq.ctx.Fields
+	if len(isq.ctx.Fields) > 0 {
+		_spec.Unique = isq.ctx.Unique != nil && *isq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, isq.driver, _spec)
+}
+
+func (isq *ItemShareQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemshare.Table, itemshare.Columns, sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint))
+	_spec.From = isq.sql
+	if unique := isq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if isq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := isq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemshare.FieldID)
+		for i := range fields {
+			if fields[i] != itemshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if isq.withItem != nil {
+			_spec.Node.AddColumnOnce(itemshare.FieldItemID)
+		}
+		if isq.withUser != nil {
+			_spec.Node.AddColumnOnce(itemshare.FieldUserID)
+		}
+	}
+	if ps := isq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := isq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := isq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := isq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (isq *ItemShareQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(isq.driver.Dialect())
+	t1 := builder.Table(itemshare.Table)
+	columns := isq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemshare.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if isq.sql != nil {
+		selector = isq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if isq.ctx.Unique != nil && *isq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range isq.modifiers {
+		m(selector)
+	}
+	for _, p := range isq.predicates {
+		p(selector)
+	}
+	for _, p := range isq.order {
+		p(selector)
+	}
+	if offset := isq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := isq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (isq *ItemShareQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemShareSelect {
+	isq.modifiers = append(isq.modifiers, modifiers...)
+	return isq.Select()
+}
+
+// ItemShareGroupBy is the group-by builder for ItemShare entities.
+type ItemShareGroupBy struct {
+	selector
+	build *ItemShareQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (isgb *ItemShareGroupBy) Aggregate(fns ...AggregateFunc) *ItemShareGroupBy {
+	isgb.fns = append(isgb.fns, fns...)
+	return isgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (isgb *ItemShareGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, isgb.build.ctx, "GroupBy")
+	if err := isgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemShareQuery, *ItemShareGroupBy](ctx, isgb.build, isgb, isgb.build.inters, v)
+}
+
+func (isgb *ItemShareGroupBy) sqlScan(ctx context.Context, root *ItemShareQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(isgb.fns))
+	for _, fn := range isgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*isgb.flds)+len(isgb.fns))
+		for _, f := range *isgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*isgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := isgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemShareSelect is the builder for selecting fields of ItemShare entities.
+type ItemShareSelect struct {
+	*ItemShareQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iss *ItemShareSelect) Aggregate(fns ...AggregateFunc) *ItemShareSelect {
+	iss.fns = append(iss.fns, fns...)
+	return iss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iss *ItemShareSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iss.ctx, "Select")
+	if err := iss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemShareQuery, *ItemShareSelect](ctx, iss.ItemShareQuery, iss, iss.inters, v)
+}
+
+func (iss *ItemShareSelect) sqlScan(ctx context.Context, root *ItemShareQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iss.fns))
+	for _, fn := range iss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iss *ItemShareSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemShareSelect {
+	iss.modifiers = append(iss.modifiers, modifiers...)
+	return iss
+}
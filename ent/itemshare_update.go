@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemShareUpdate is the builder for updating ItemShare entities.
+type ItemShareUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemShareUpdate builder.
+func (isu *ItemShareUpdate) Where(ps ...predicate.ItemShare) *ItemShareUpdate {
+	isu.mutation.Where(ps...)
+	return isu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (isu *ItemShareUpdate) SetUpdateTime(t time.Time) *ItemShareUpdate {
+	isu.mutation.SetUpdateTime(t)
+	return isu
+}
+
+// SetItemID sets the "item_id" field.
+func (isu *ItemShareUpdate) SetItemID(u uint) *ItemShareUpdate {
+	isu.mutation.SetItemID(u)
+	return isu
+}
+
+// SetUserID sets the "user_id" field.
+func (isu *ItemShareUpdate) SetUserID(u uint) *ItemShareUpdate {
+	isu.mutation.SetUserID(u)
+	return isu
+}
+
+// SetPermission sets the "permission" field.
+func (isu *ItemShareUpdate) SetPermission(i itemshare.Permission) *ItemShareUpdate {
+	isu.mutation.SetPermission(i)
+	return isu
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (isu *ItemShareUpdate) SetNillablePermission(i *itemshare.Permission) *ItemShareUpdate {
+	if i != nil {
+		isu.SetPermission(*i)
+	}
+	return isu
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (isu *ItemShareUpdate) SetItem(i *Item) *ItemShareUpdate {
+	return isu.SetItemID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (isu *ItemShareUpdate) SetUser(u *User) *ItemShareUpdate {
+	return isu.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemShareMutation object of the builder.
+func (isu *ItemShareUpdate) Mutation() *ItemShareMutation {
+	return isu.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (isu *ItemShareUpdate) ClearItem() *ItemShareUpdate {
+	isu.mutation.ClearItem()
+	return isu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (isu *ItemShareUpdate) ClearUser() *ItemShareUpdate {
+	isu.mutation.ClearUser()
+	return isu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (isu *ItemShareUpdate) Save(ctx context.Context) (int, error) {
+	if err := isu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, ItemShareMutation](ctx, isu.sqlSave, isu.mutation, isu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (isu *ItemShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := isu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (isu *ItemShareUpdate) Exec(ctx context.Context) error {
+	_, err := isu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isu *ItemShareUpdate) ExecX(ctx context.Context) {
+	if err := isu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (isu *ItemShareUpdate) defaults() error {
+	if _, ok := isu.mutation.UpdateTime(); !ok {
+		if itemshare.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemshare.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := itemshare.UpdateDefaultUpdateTime()
+		isu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isu *ItemShareUpdate) check() error {
+	if v, ok := isu.mutation.Permission(); ok {
+		if err := itemshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ItemShare.permission": %w`, err)}
+		}
+	}
+	if _, ok := isu.mutation.ItemID(); isu.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemShare.item"`)
+	}
+	if _, ok := isu.mutation.UserID(); isu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemShare.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (isu *ItemShareUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemShareUpdate {
+	isu.modifiers = append(isu.modifiers, modifiers...)
+	return isu
+}
+
+func (isu *ItemShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := isu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemshare.Table, itemshare.Columns, sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint))
+	if ps := isu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := isu.mutation.UpdateTime(); ok {
+		_spec.SetField(itemshare.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := isu.mutation.Permission(); ok {
+		_spec.SetField(itemshare.FieldPermission, field.TypeEnum, value)
+	}
+	if isu.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.ItemTable,
+			Columns: []string{itemshare.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := isu.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.ItemTable,
+			Columns: []string{itemshare.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if isu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.UserTable,
+			Columns: []string{itemshare.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := isu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.UserTable,
+			Columns: []string{itemshare.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(isu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, isu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	isu.mutation.done = true
+	return n, nil
+}
+
+// ItemShareUpdateOne is the builder for updating a single ItemShare entity.
+type ItemShareUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (isuo *ItemShareUpdateOne) SetUpdateTime(t time.Time) *ItemShareUpdateOne {
+	isuo.mutation.SetUpdateTime(t)
+	return isuo
+}
+
+// SetItemID sets the "item_id" field.
+func (isuo *ItemShareUpdateOne) SetItemID(u uint) *ItemShareUpdateOne {
+	isuo.mutation.SetItemID(u)
+	return isuo
+}
+
+// SetUserID sets the "user_id" field.
+func (isuo *ItemShareUpdateOne) SetUserID(u uint) *ItemShareUpdateOne {
+	isuo.mutation.SetUserID(u)
+	return isuo
+}
+
+// SetPermission sets the "permission" field.
+func (isuo *ItemShareUpdateOne) SetPermission(i itemshare.Permission) *ItemShareUpdateOne {
+	isuo.mutation.SetPermission(i)
+	return isuo
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (isuo *ItemShareUpdateOne) SetNillablePermission(i *itemshare.Permission) *ItemShareUpdateOne {
+	if i != nil {
+		isuo.SetPermission(*i)
+	}
+	return isuo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (isuo *ItemShareUpdateOne) SetItem(i *Item) *ItemShareUpdateOne {
+	return isuo.SetItemID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (isuo *ItemShareUpdateOne) SetUser(u *User) *ItemShareUpdateOne {
+	return isuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemShareMutation object of the builder.
+func (isuo *ItemShareUpdateOne) Mutation() *ItemShareMutation {
+	return isuo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (isuo *ItemShareUpdateOne) ClearItem() *ItemShareUpdateOne {
+	isuo.mutation.ClearItem()
+	return isuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (isuo *ItemShareUpdateOne) ClearUser() *ItemShareUpdateOne {
+	isuo.mutation.ClearUser()
+	return isuo
+}
+
+// Where appends a list predicates to the ItemShareUpdate builder.
+func (isuo *ItemShareUpdateOne) Where(ps ...predicate.ItemShare) *ItemShareUpdateOne {
+	isuo.mutation.Where(ps...)
+	return isuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (isuo *ItemShareUpdateOne) Select(field string, fields ...string) *ItemShareUpdateOne {
+	isuo.fields = append([]string{field}, fields...)
+	return isuo
+}
+
+// Save executes the query and returns the updated ItemShare entity.
+func (isuo *ItemShareUpdateOne) Save(ctx context.Context) (*ItemShare, error) {
+	if err := isuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*ItemShare, ItemShareMutation](ctx, isuo.sqlSave, isuo.mutation, isuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (isuo *ItemShareUpdateOne) SaveX(ctx context.Context) *ItemShare {
+	node, err := isuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (isuo *ItemShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := isuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isuo *ItemShareUpdateOne) ExecX(ctx context.Context) {
+	if err := isuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (isuo *ItemShareUpdateOne) defaults() error {
+	if _, ok := isuo.mutation.UpdateTime(); !ok {
+		if itemshare.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemshare.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := itemshare.UpdateDefaultUpdateTime()
+		isuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isuo *ItemShareUpdateOne) check() error {
+	if v, ok := isuo.mutation.Permission(); ok {
+		if err := itemshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ItemShare.permission": %w`, err)}
+		}
+	}
+	if _, ok := isuo.mutation.ItemID(); isuo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemShare.item"`)
+	}
+	if _, ok := isuo.mutation.UserID(); isuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemShare.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (isuo *ItemShareUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemShareUpdateOne {
+	isuo.modifiers = append(isuo.modifiers, modifiers...)
+	return isuo
+}
+
+func (isuo *ItemShareUpdateOne) sqlSave(ctx context.Context) (_node *ItemShare, err error) {
+	if err := isuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemshare.Table, itemshare.Columns, sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint))
+	id, ok := isuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := isuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemshare.FieldID)
+		for _, f := range fields {
+			if !itemshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := isuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := isuo.mutation.UpdateTime(); ok {
+		_spec.SetField(itemshare.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := isuo.mutation.Permission(); ok {
+		_spec.SetField(itemshare.FieldPermission, field.TypeEnum, value)
+	}
+	if isuo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.ItemTable,
+			Columns: []string{itemshare.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := isuo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.ItemTable,
+			Columns: []string{itemshare.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if isuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.UserTable,
+			Columns: []string{itemshare.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := isuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemshare.UserTable,
+			Columns: []string{itemshare.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(isuo.modifiers...)
+	_node = &ItemShare{config: isuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, isuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	isuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemTransition is the model entity for the ItemTransition schema.
+type ItemTransition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uint `json:"item_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uint `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// Comment holds the value of the "comment" field.
+	Comment *string `json:"comment,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemTransitionQuery when eager-loading is set.
+	Edges        ItemTransitionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemTransitionEdges holds the relations/edges for other nodes in the graph.
+type ItemTransitionEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemTransitionEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemTransitionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemTransition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemtransition.FieldID, itemtransition.FieldItemID, itemtransition.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case itemtransition.FieldName, itemtransition.FieldFromStatus, itemtransition.FieldToStatus, itemtransition.FieldComment:
+			values[i] = new(sql.NullString)
+		case itemtransition.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemTransition fields.
+func (it *ItemTransition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemtransition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			it.ID = uint(value.Int64)
+		case itemtransition.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				it.CreateTime = value.Time
+			}
+		case itemtransition.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				it.ItemID = uint(value.Int64)
+			}
+		case itemtransition.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				it.UserID = new(uint)
+				*it.UserID = uint(value.Int64)
+			}
+		case itemtransition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				it.Name = value.String
+			}
+		case itemtransition.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				it.FromStatus = value.String
+			}
+		case itemtransition.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				it.ToStatus = value.String
+			}
+		case itemtransition.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				it.Comment = new(string)
+				*it.Comment = value.String
+			}
+		default:
+			it.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemTransition.
+// This includes values selected through modifiers, order, etc.
+func (it *ItemTransition) Value(name string) (ent.Value, error) {
+	return it.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemTransition entity.
+func (it *ItemTransition) QueryItem() *ItemQuery {
+	return NewItemTransitionClient(it.config).QueryItem(it)
+}
+
+// QueryUser queries the "user" edge of the ItemTransition entity.
+func (it *ItemTransition) QueryUser() *UserQuery {
+	return NewItemTransitionClient(it.config).QueryUser(it)
+}
+
+// Update returns a builder for updating this ItemTransition.
+// Note that you need to call ItemTransition.Unwrap() before calling this method if this ItemTransition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (it *ItemTransition) Update() *ItemTransitionUpdateOne {
+	return NewItemTransitionClient(it.config).UpdateOne(it)
+}
+
+// Unwrap unwraps the ItemTransition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (it *ItemTransition) Unwrap() *ItemTransition {
+	_tx, ok := it.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemTransition is not a transactional entity")
+	}
+	it.config.driver = _tx.drv
+	return it
+}
+
+// String implements the fmt.Stringer.
+func (it *ItemTransition) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemTransition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", it.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(it.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", it.ItemID))
+	builder.WriteString(", ")
+	if v := it.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(it.Name)
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(it.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(it.ToStatus)
+	builder.WriteString(", ")
+	if v := it.Comment; v != nil {
+		builder.WriteString("comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemTransitions is a parsable slice of ItemTransition.
+type ItemTransitions []*ItemTransition
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemTransitionCreate is the builder for creating a ItemTransition entity.
+type ItemTransitionCreate struct {
+	config
+	mutation *ItemTransitionMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (itc *ItemTransitionCreate) SetCreateTime(t time.Time) *ItemTransitionCreate {
+	itc.mutation.SetCreateTime(t)
+	return itc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (itc *ItemTransitionCreate) SetNillableCreateTime(t *time.Time) *ItemTransitionCreate {
+	if t != nil {
+		itc.SetCreateTime(*t)
+	}
+	return itc
+}
+
+// SetItemID sets the "item_id" field.
+func (itc *ItemTransitionCreate) SetItemID(u uint) *ItemTransitionCreate {
+	itc.mutation.SetItemID(u)
+	return itc
+}
+
+// SetUserID sets the "user_id" field.
+func (itc *ItemTransitionCreate) SetUserID(u uint) *ItemTransitionCreate {
+	itc.mutation.SetUserID(u)
+	return itc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (itc *ItemTransitionCreate) SetNillableUserID(u *uint) *ItemTransitionCreate {
+	if u != nil {
+		itc.SetUserID(*u)
+	}
+	return itc
+}
+
+// SetName sets the "name" field.
+func (itc *ItemTransitionCreate) SetName(s string) *ItemTransitionCreate {
+	itc.mutation.SetName(s)
+	return itc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (itc *ItemTransitionCreate) SetFromStatus(s string) *ItemTransitionCreate {
+	itc.mutation.SetFromStatus(s)
+	return itc
+}
+
+// SetToStatus sets the "to_status" field.
+func (itc *ItemTransitionCreate) SetToStatus(s string) *ItemTransitionCreate {
+	itc.mutation.SetToStatus(s)
+	return itc
+}
+
+// SetComment sets the "comment" field.
+func (itc *ItemTransitionCreate) SetComment(s string) *ItemTransitionCreate {
+	itc.mutation.SetComment(s)
+	return itc
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (itc *ItemTransitionCreate) SetNillableComment(s *string) *ItemTransitionCreate {
+	if s != nil {
+		itc.SetComment(*s)
+	}
+	return itc
+}
+
+// SetID sets the "id" field.
+func (itc *ItemTransitionCreate) SetID(u uint) *ItemTransitionCreate {
+	itc.mutation.SetID(u)
+	return itc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (itc *ItemTransitionCreate) SetItem(i *Item) *ItemTransitionCreate {
+	return itc.SetItemID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (itc *ItemTransitionCreate) SetUser(u *User) *ItemTransitionCreate {
+	return itc.SetUserID(u.ID)
+}
+
+// Mutation returns the ItemTransitionMutation object of the builder.
+func (itc *ItemTransitionCreate) Mutation() *ItemTransitionMutation {
+	return itc.mutation
+}
+
+// Save creates the ItemTransition in the database.
+func (itc *ItemTransitionCreate) Save(ctx context.Context) (*ItemTransition, error) {
+	if err := itc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*ItemTransition, ItemTransitionMutation](ctx, itc.sqlSave, itc.mutation, itc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (itc *ItemTransitionCreate) SaveX(ctx context.Context) *ItemTransition {
+	v, err := itc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itc *ItemTransitionCreate) Exec(ctx context.Context) error {
+	_, err := itc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itc *ItemTransitionCreate) ExecX(ctx context.Context) {
+	if err := itc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (itc *ItemTransitionCreate) defaults() error {
+	if _, ok := itc.mutation.CreateTime(); !ok {
+		if itemtransition.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized itemtransition.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := itemtransition.DefaultCreateTime()
+		itc.mutation.SetCreateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itc *ItemTransitionCreate) check() error {
+	if _, ok := itc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ItemTransition.create_time"`)}
+	}
+	if _, ok := itc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemTransition.item_id"`)}
+	}
+	if _, ok := itc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ItemTransition.name"`)}
+	}
+	if _, ok := itc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "ItemTransition.from_status"`)}
+	}
+	if _, ok := itc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "ItemTransition.to_status"`)}
+	}
+	if _, ok := itc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemTransition.item"`)}
+	}
+	return nil
+}
+
+func (itc *ItemTransitionCreate) sqlSave(ctx context.Context) (*ItemTransition, error) {
+	if err := itc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := itc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, itc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	itc.mutation.id = &_node.ID
+	itc.mutation.done = true
+	return _node, nil
+}
+
+func (itc *ItemTransitionCreate) createSpec() (*ItemTransition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemTransition{config: itc.config}
+		_spec = sqlgraph.NewCreateSpec(itemtransition.Table, sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint))
+	)
+	if id, ok := itc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := itc.mutation.CreateTime(); ok {
+		_spec.SetField(itemtransition.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := itc.mutation.Name(); ok {
+		_spec.SetField(itemtransition.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := itc.mutation.FromStatus(); ok {
+		_spec.SetField(itemtransition.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := itc.mutation.ToStatus(); ok {
+		_spec.SetField(itemtransition.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := itc.mutation.Comment(); ok {
+		_spec.SetField(itemtransition.FieldComment, field.TypeString, value)
+		_node.Comment = &value
+	}
+	if nodes := itc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtransition.ItemTable,
+			Columns: []string{itemtransition.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := itc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtransition.UserTable,
+			Columns: []string{itemtransition.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemTransitionCreateBulk is the builder for creating many ItemTransition entities in bulk.
+type ItemTransitionCreateBulk struct {
+	config
+	builders []*ItemTransitionCreate
+}
+
+// Save creates the ItemTransition entities in the database.
+func (itcb *ItemTransitionCreateBulk) Save(ctx context.Context) ([]*ItemTransition, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(itcb.builders))
+	nodes := make([]*ItemTransition, len(itcb.builders))
+	mutators := make([]Mutator, len(itcb.builders))
+	for i := range itcb.builders {
+		func(i int, root context.Context) {
+			builder := itcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemTransitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, itcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, itcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, itcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itcb *ItemTransitionCreateBulk) SaveX(ctx context.Context) []*ItemTransition {
+	v, err := itcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itcb *ItemTransitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := itcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itcb *ItemTransitionCreateBulk) ExecX(ctx context.Context) {
+	if err := itcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
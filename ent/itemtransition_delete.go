@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ItemTransitionDelete is the builder for deleting a ItemTransition entity.
+type ItemTransitionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemTransitionMutation
+}
+
+// Where appends a list predicates to the ItemTransitionDelete builder.
+func (itd *ItemTransitionDelete) Where(ps ...predicate.ItemTransition) *ItemTransitionDelete {
+	itd.mutation.Where(ps...)
+	return itd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (itd *ItemTransitionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ItemTransitionMutation](ctx, itd.sqlExec, itd.mutation, itd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itd *ItemTransitionDelete) ExecX(ctx context.Context) int {
+	n, err := itd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (itd *ItemTransitionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemtransition.Table, sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint))
+	if ps := itd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, itd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	itd.mutation.done = true
+	return affected, err
+}
+
+// ItemTransitionDeleteOne is the builder for deleting a single ItemTransition entity.
+type ItemTransitionDeleteOne struct {
+	itd *ItemTransitionDelete
+}
+
+// Where appends a list predicates to the ItemTransitionDelete builder.
+func (itdo *ItemTransitionDeleteOne) Where(ps ...predicate.ItemTransition) *ItemTransitionDeleteOne {
+	itdo.itd.mutation.Where(ps...)
+	return itdo
+}
+
+// Exec executes the deletion query.
+func (itdo *ItemTransitionDeleteOne) Exec(ctx context.Context) error {
+	n, err := itdo.itd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemtransition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itdo *ItemTransitionDeleteOne) ExecX(ctx context.Context) {
+	if err := itdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// ItemTransitionQuery is the builder for querying ItemTransition entities.
+type ItemTransitionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemtransition.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemTransition
+	withItem   *ItemQuery
+	withUser   *UserQuery
+	loadTotal  []func(context.Context, []*ItemTransition) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemTransitionQuery builder.
+func (itq *ItemTransitionQuery) Where(ps ...predicate.ItemTransition) *ItemTransitionQuery {
+	itq.predicates = append(itq.predicates, ps...)
+	return itq
+}
+
+// Limit the number of records to be returned by this query.
+func (itq *ItemTransitionQuery) Limit(limit int) *ItemTransitionQuery {
+	itq.ctx.Limit = &limit
+	return itq
+}
+
+// Offset to start from.
+func (itq *ItemTransitionQuery) Offset(offset int) *ItemTransitionQuery {
+	itq.ctx.Offset = &offset
+	return itq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (itq *ItemTransitionQuery) Unique(unique bool) *ItemTransitionQuery {
+	itq.ctx.Unique = &unique
+	return itq
+}
+
+// Order specifies how the records should be ordered.
+func (itq *ItemTransitionQuery) Order(o ...itemtransition.OrderOption) *ItemTransitionQuery {
+	itq.order = append(itq.order, o...)
+	return itq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (itq *ItemTransitionQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: itq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := itq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := itq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtransition.Table, itemtransition.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtransition.ItemTable, itemtransition.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(itq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (itq *ItemTransitionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: itq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := itq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := itq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtransition.Table, itemtransition.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtransition.UserTable, itemtransition.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(itq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemTransition entity from the query.
+// Returns a *NotFoundError when no ItemTransition was found.
+func (itq *ItemTransitionQuery) First(ctx context.Context) (*ItemTransition, error) {
+	nodes, err := itq.Limit(1).All(setContextOp(ctx, itq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemtransition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (itq *ItemTransitionQuery) FirstX(ctx context.Context) *ItemTransition {
+	node, err := itq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemTransition ID from the query.
+// Returns a *NotFoundError when no ItemTransition ID was found.
+func (itq *ItemTransitionQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = itq.Limit(1).IDs(setContextOp(ctx, itq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemtransition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (itq *ItemTransitionQuery) FirstIDX(ctx context.Context) uint {
+	id, err := itq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemTransition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemTransition entity is found.
+// Returns a *NotFoundError when no ItemTransition entities are found.
+func (itq *ItemTransitionQuery) Only(ctx context.Context) (*ItemTransition, error) {
+	nodes, err := itq.Limit(2).All(setContextOp(ctx, itq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemtransition.Label}
+	default:
+		return nil, &NotSingularError{itemtransition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (itq *ItemTransitionQuery) OnlyX(ctx context.Context) *ItemTransition {
+	node, err := itq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemTransition ID in the query.
+// Returns a *NotSingularError when more than one ItemTransition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (itq *ItemTransitionQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = itq.Limit(2).IDs(setContextOp(ctx, itq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemtransition.Label}
+	default:
+		err = &NotSingularError{itemtransition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (itq *ItemTransitionQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := itq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemTransitions.
+func (itq *ItemTransitionQuery) All(ctx context.Context) ([]*ItemTransition, error) {
+	ctx = setContextOp(ctx, itq.ctx, "All")
+	if err := itq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemTransition, *ItemTransitionQuery]()
+	return withInterceptors[[]*ItemTransition](ctx, itq, qr, itq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (itq *ItemTransitionQuery) AllX(ctx context.Context) []*ItemTransition {
+	nodes, err := itq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemTransition IDs.
+func (itq *ItemTransitionQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if itq.ctx.Unique == nil && itq.path != nil {
+		itq.Unique(true)
+	}
+	ctx = setContextOp(ctx, itq.ctx, "IDs")
+	if err = itq.Select(itemtransition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (itq *ItemTransitionQuery) IDsX(ctx context.Context) []uint {
+	ids, err := itq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (itq *ItemTransitionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, itq.ctx, "Count")
+	if err := itq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, itq, querierCount[*ItemTransitionQuery](), itq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (itq *ItemTransitionQuery) CountX(ctx context.Context) int {
+	count, err := itq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (itq *ItemTransitionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, itq.ctx, "Exist")
+	switch _, err := itq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (itq *ItemTransitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := itq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemTransitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (itq *ItemTransitionQuery) Clone() *ItemTransitionQuery {
+	if itq == nil {
+		return nil
+	}
+	return &ItemTransitionQuery{
+		config:     itq.config,
+		ctx:        itq.ctx.Clone(),
+		order:      append([]itemtransition.OrderOption{}, itq.order...),
+		inters:     append([]Interceptor{}, itq.inters...),
+		predicates: append([]predicate.ItemTransition{}, itq.predicates...),
+		withItem:   itq.withItem.Clone(),
+		withUser:   itq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  itq.sql.Clone(),
+		path: itq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (itq *ItemTransitionQuery) WithItem(opts ...func(*ItemQuery)) *ItemTransitionQuery {
+	query := (&ItemClient{config: itq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	itq.withItem = query
+	return itq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (itq *ItemTransitionQuery) WithUser(opts ...func(*UserQuery)) *ItemTransitionQuery {
+	query := (&UserClient{config: itq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	itq.withUser = query
+	return itq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemTransition.Query().
+//		GroupBy(itemtransition.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (itq *ItemTransitionQuery) GroupBy(field string, fields ...string) *ItemTransitionGroupBy {
+	itq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemTransitionGroupBy{build: itq}
+	grbuild.flds = &itq.ctx.Fields
+	grbuild.label = itemtransition.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ItemTransition.Query().
+//		Select(itemtransition.FieldCreateTime).
+//		Scan(ctx, &v)
+func (itq *ItemTransitionQuery) Select(fields ...string) *ItemTransitionSelect {
+	itq.ctx.Fields = append(itq.ctx.Fields, fields...)
+	sbuild := &ItemTransitionSelect{ItemTransitionQuery: itq}
+	sbuild.label = itemtransition.Label
+	sbuild.flds, sbuild.scan = &itq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemTransitionSelect configured with the given aggregations.
+func (itq *ItemTransitionQuery) Aggregate(fns ...AggregateFunc) *ItemTransitionSelect {
+	return itq.Select().Aggregate(fns...)
+}
+
+func (itq *ItemTransitionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range itq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, itq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range itq.ctx.Fields {
+		if !itemtransition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if itq.path != nil {
+		prev, err := itq.path(ctx)
+		if err != nil {
+			return err
+		}
+		itq.sql = prev
+	}
+	if itemtransition.Policy == nil {
+		return errors.New("ent: uninitialized itemtransition.Policy (forgotten import ent/runtime?)")
+	}
+	if err := itemtransition.Policy.EvalQuery(ctx, itq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (itq *ItemTransitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemTransition, error) {
+	var (
+		nodes       = []*ItemTransition{}
+		_spec       = itq.querySpec()
+		loadedTypes = [2]bool{
+			itq.withItem != nil,
+			itq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemTransition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemTransition{config: itq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, itq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := itq.withItem; query != nil {
+		if err := itq.loadItem(ctx, query, nodes, nil,
+			func(n *ItemTransition, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := itq.withUser; query != nil {
+		if err := itq.loadUser(ctx, query, nodes, nil,
+			func(n *ItemTransition, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range itq.loadTotal {
+		if err := itq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (itq *ItemTransitionQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ItemTransition, init func(*ItemTransition), assign func(*ItemTransition, *Item)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemTransition)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (itq *ItemTransitionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ItemTransition, init func(*ItemTransition), assign func(*ItemTransition, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ItemTransition)
+	for i := range nodes {
+		if nodes[i].UserID == nil {
+			continue
+		}
+		fk := *nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (itq *ItemTransitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := itq.querySpec()
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
+	_spec.Node.Columns = itq.ctx.Fields
+	if len(itq.ctx.Fields) > 0 {
+		_spec.Unique = itq.ctx.Unique != nil && *itq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, itq.driver, _spec)
+}
+
+func (itq *ItemTransitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemtransition.Table, itemtransition.Columns, sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint))
+	_spec.From = itq.sql
+	if unique := itq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if itq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := itq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtransition.FieldID)
+		for i := range fields {
+			if fields[i] != itemtransition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if itq.withItem != nil {
+			_spec.Node.AddColumnOnce(itemtransition.FieldItemID)
+		}
+		if itq.withUser != nil {
+			_spec.Node.AddColumnOnce(itemtransition.FieldUserID)
+		}
+	}
+	if ps := itq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := itq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := itq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := itq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (itq *ItemTransitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(itq.driver.Dialect())
+	t1 := builder.Table(itemtransition.Table)
+	columns := itq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemtransition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if itq.sql != nil {
+		selector = itq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if itq.ctx.Unique != nil && *itq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range itq.modifiers {
+		m(selector)
+	}
+	for _, p := range itq.predicates {
+		p(selector)
+	}
+	for _, p := range itq.order {
+		p(selector)
+	}
+	if offset := itq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := itq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (itq *ItemTransitionQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemTransitionSelect {
+	itq.modifiers = append(itq.modifiers, modifiers...)
+	return itq.Select()
+}
+
+// ItemTransitionGroupBy is the group-by builder for ItemTransition entities.
+type ItemTransitionGroupBy struct {
+	selector
+	build *ItemTransitionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (itgb *ItemTransitionGroupBy) Aggregate(fns ...AggregateFunc) *ItemTransitionGroupBy {
+	itgb.fns = append(itgb.fns, fns...)
+	return itgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (itgb *ItemTransitionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, itgb.build.ctx, "GroupBy")
+	if err := itgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemTransitionQuery, *ItemTransitionGroupBy](ctx, itgb.build, itgb, itgb.build.inters, v)
+}
+
+func (itgb *ItemTransitionGroupBy) sqlScan(ctx context.Context, root *ItemTransitionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(itgb.fns))
+	for _, fn := range itgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*itgb.flds)+len(itgb.fns))
+		for _, f := range *itgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*itgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := itgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemTransitionSelect is the builder for selecting fields of ItemTransition entities.
+type ItemTransitionSelect struct {
+	*ItemTransitionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (its *ItemTransitionSelect) Aggregate(fns ...AggregateFunc) *ItemTransitionSelect {
+	its.fns = append(its.fns, fns...)
+	return its
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (its *ItemTransitionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, its.ctx, "Select")
+	if err := its.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemTransitionQuery, *ItemTransitionSelect](ctx, its.ItemTransitionQuery, its, its.inters, v)
+}
+
+func (its *ItemTransitionSelect) sqlScan(ctx context.Context, root *ItemTransitionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(its.fns))
+	for _, fn := range its.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*its.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := its.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (its *ItemTransitionSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemTransitionSelect {
+	its.modifiers = append(its.modifiers, modifiers...)
+	return its
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ItemTransitionUpdate is the builder for updating ItemTransition entities.
+type ItemTransitionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemTransitionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemTransitionUpdate builder.
+func (itu *ItemTransitionUpdate) Where(ps ...predicate.ItemTransition) *ItemTransitionUpdate {
+	itu.mutation.Where(ps...)
+	return itu
+}
+
+// Mutation returns the ItemTransitionMutation object of the builder.
+func (itu *ItemTransitionUpdate) Mutation() *ItemTransitionMutation {
+	return itu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (itu *ItemTransitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, ItemTransitionMutation](ctx, itu.sqlSave, itu.mutation, itu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itu *ItemTransitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := itu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (itu *ItemTransitionUpdate) Exec(ctx context.Context) error {
+	_, err := itu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itu *ItemTransitionUpdate) ExecX(ctx context.Context) {
+	if err := itu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itu *ItemTransitionUpdate) check() error {
+	if _, ok := itu.mutation.ItemID(); itu.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemTransition.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (itu *ItemTransitionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemTransitionUpdate {
+	itu.modifiers = append(itu.modifiers, modifiers...)
+	return itu
+}
+
+func (itu *ItemTransitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := itu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemtransition.Table, itemtransition.Columns, sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint))
+	if ps := itu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if itu.mutation.CommentCleared() {
+		_spec.ClearField(itemtransition.FieldComment, field.TypeString)
+	}
+	_spec.AddModifiers(itu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, itu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemtransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	itu.mutation.done = true
+	return n, nil
+}
+
+// ItemTransitionUpdateOne is the builder for updating a single ItemTransition entity.
+type ItemTransitionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemTransitionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ItemTransitionMutation object of the builder.
+func (ituo *ItemTransitionUpdateOne) Mutation() *ItemTransitionMutation {
+	return ituo.mutation
+}
+
+// Where appends a list predicates to the ItemTransitionUpdate builder.
+func (ituo *ItemTransitionUpdateOne) Where(ps ...predicate.ItemTransition) *ItemTransitionUpdateOne {
+	ituo.mutation.Where(ps...)
+	return ituo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ituo *ItemTransitionUpdateOne) Select(field string, fields ...string) *ItemTransitionUpdateOne {
+	ituo.fields = append([]string{field}, fields...)
+	return ituo
+}
+
+// Save executes the query and returns the updated ItemTransition entity.
+func (ituo *ItemTransitionUpdateOne) Save(ctx context.Context) (*ItemTransition, error) {
+	return withHooks[*ItemTransition, ItemTransitionMutation](ctx, ituo.sqlSave, ituo.mutation, ituo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ituo *ItemTransitionUpdateOne) SaveX(ctx context.Context) *ItemTransition {
+	node, err := ituo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ituo *ItemTransitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ituo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ituo *ItemTransitionUpdateOne) ExecX(ctx context.Context) {
+	if err := ituo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ituo *ItemTransitionUpdateOne) check() error {
+	if _, ok := ituo.mutation.ItemID(); ituo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ItemTransition.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ituo *ItemTransitionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemTransitionUpdateOne {
+	ituo.modifiers = append(ituo.modifiers, modifiers...)
+	return ituo
+}
+
+func (ituo *ItemTransitionUpdateOne) sqlSave(ctx context.Context) (_node *ItemTransition, err error) {
+	if err := ituo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemtransition.Table, itemtransition.Columns, sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint))
+	id, ok := ituo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemTransition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ituo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtransition.FieldID)
+		for _, f := range fields {
+			if !itemtransition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemtransition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ituo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ituo.mutation.CommentCleared() {
+		_spec.ClearField(itemtransition.FieldComment, field.TypeString)
+	}
+	_spec.AddModifiers(ituo.modifiers...)
+	_node = &ItemTransition{config: ituo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ituo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemtransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ituo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// MetadataSchema is the model entity for the MetadataSchema schema.
+type MetadataSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *uint `json:"owner_id,omitempty"`
+	// Definition holds the value of the "definition" field.
+	Definition map[string]interface{} `json:"definition,omitempty"`
+	// IndexedKeys holds the value of the "indexed_keys" field.
+	IndexedKeys []string `json:"indexed_keys,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MetadataSchemaQuery when eager-loading is set.
+	Edges        MetadataSchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MetadataSchemaEdges holds the relations/edges for other nodes in the graph.
+type MetadataSchemaEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MetadataSchemaEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MetadataSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case metadataschema.FieldDefinition, metadataschema.FieldIndexedKeys:
+			values[i] = new([]byte)
+		case metadataschema.FieldID, metadataschema.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case metadataschema.FieldCreateTime, metadataschema.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MetadataSchema fields.
+func (ms *MetadataSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case metadataschema.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ms.ID = uint(value.Int64)
+		case metadataschema.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ms.CreateTime = value.Time
+			}
+		case metadataschema.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ms.UpdateTime = value.Time
+			}
+		case metadataschema.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				ms.OwnerID = new(uint)
+				*ms.OwnerID = uint(value.Int64)
+			}
+		case metadataschema.FieldDefinition:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field definition", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ms.Definition); err != nil {
+					return fmt.Errorf("unmarshal field definition: %w", err)
+				}
+			}
+		case metadataschema.FieldIndexedKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field indexed_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ms.IndexedKeys); err != nil {
+					return fmt.Errorf("unmarshal field indexed_keys: %w", err)
+				}
+			}
+		default:
+			ms.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MetadataSchema.
+// This includes values selected through modifiers, order, etc.
+func (ms *MetadataSchema) Value(name string) (ent.Value, error) {
+	return ms.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the MetadataSchema entity.
+func (ms *MetadataSchema) QueryOwner() *UserQuery {
+	return NewMetadataSchemaClient(ms.config).QueryOwner(ms)
+}
+
+// Update returns a builder for updating this MetadataSchema.
+// Note that you need to call MetadataSchema.Unwrap() before calling this method if this MetadataSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ms *MetadataSchema) Update() *MetadataSchemaUpdateOne {
+	return NewMetadataSchemaClient(ms.config).UpdateOne(ms)
+}
+
+// Unwrap unwraps the MetadataSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ms *MetadataSchema) Unwrap() *MetadataSchema {
+	_tx, ok := ms.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MetadataSchema is not a transactional entity")
+	}
+	ms.config.driver = _tx.drv
+	return ms
+}
+
+// String implements the fmt.Stringer.
+func (ms *MetadataSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("MetadataSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ms.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ms.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ms.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ms.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("definition=")
+	builder.WriteString(fmt.Sprintf("%v", ms.Definition))
+	builder.WriteString(", ")
+	builder.WriteString("indexed_keys=")
+	builder.WriteString(fmt.Sprintf("%v", ms.IndexedKeys))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MetadataSchemas is a parsable slice of MetadataSchema.
+type MetadataSchemas []*MetadataSchema
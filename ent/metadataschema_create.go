@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// MetadataSchemaCreate is the builder for creating a MetadataSchema entity.
+type MetadataSchemaCreate struct {
+	config
+	mutation *MetadataSchemaMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (msc *MetadataSchemaCreate) SetCreateTime(t time.Time) *MetadataSchemaCreate {
+	msc.mutation.SetCreateTime(t)
+	return msc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (msc *MetadataSchemaCreate) SetNillableCreateTime(t *time.Time) *MetadataSchemaCreate {
+	if t != nil {
+		msc.SetCreateTime(*t)
+	}
+	return msc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (msc *MetadataSchemaCreate) SetUpdateTime(t time.Time) *MetadataSchemaCreate {
+	msc.mutation.SetUpdateTime(t)
+	return msc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (msc *MetadataSchemaCreate) SetNillableUpdateTime(t *time.Time) *MetadataSchemaCreate {
+	if t != nil {
+		msc.SetUpdateTime(*t)
+	}
+	return msc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (msc *MetadataSchemaCreate) SetOwnerID(u uint) *MetadataSchemaCreate {
+	msc.mutation.SetOwnerID(u)
+	return msc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (msc *MetadataSchemaCreate) SetNillableOwnerID(u *uint) *MetadataSchemaCreate {
+	if u != nil {
+		msc.SetOwnerID(*u)
+	}
+	return msc
+}
+
+// SetDefinition sets the "definition" field.
+func (msc *MetadataSchemaCreate) SetDefinition(m map[string]interface{}) *MetadataSchemaCreate {
+	msc.mutation.SetDefinition(m)
+	return msc
+}
+
+// SetIndexedKeys sets the "indexed_keys" field.
+func (msc *MetadataSchemaCreate) SetIndexedKeys(s []string) *MetadataSchemaCreate {
+	msc.mutation.SetIndexedKeys(s)
+	return msc
+}
+
+// SetID sets the "id" field.
+func (msc *MetadataSchemaCreate) SetID(u uint) *MetadataSchemaCreate {
+	msc.mutation.SetID(u)
+	return msc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (msc *MetadataSchemaCreate) SetOwner(u *User) *MetadataSchemaCreate {
+	return msc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MetadataSchemaMutation object of the builder.
+func (msc *MetadataSchemaCreate) Mutation() *MetadataSchemaMutation {
+	return msc.mutation
+}
+
+// Save creates the MetadataSchema in the database.
+func (msc *MetadataSchemaCreate) Save(ctx context.Context) (*MetadataSchema, error) {
+	if err := msc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*MetadataSchema, MetadataSchemaMutation](ctx, msc.sqlSave, msc.mutation, msc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (msc *MetadataSchemaCreate) SaveX(ctx context.Context) *MetadataSchema {
+	v, err := msc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msc *MetadataSchemaCreate) Exec(ctx context.Context) error {
+	_, err := msc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msc *MetadataSchemaCreate) ExecX(ctx context.Context) {
+	if err := msc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msc *MetadataSchemaCreate) defaults() error {
+	if _, ok := msc.mutation.CreateTime(); !ok {
+		if metadataschema.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized metadataschema.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := metadataschema.DefaultCreateTime()
+		msc.mutation.SetCreateTime(v)
+	}
+	if _, ok := msc.mutation.UpdateTime(); !ok {
+		if metadataschema.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized metadataschema.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := metadataschema.DefaultUpdateTime()
+		msc.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msc *MetadataSchemaCreate) check() error {
+	if _, ok := msc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "MetadataSchema.create_time"`)}
+	}
+	if _, ok := msc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "MetadataSchema.update_time"`)}
+	}
+	if _, ok := msc.mutation.Definition(); !ok {
+		return &ValidationError{Name: "definition", err: errors.New(`ent: missing required field "MetadataSchema.definition"`)}
+	}
+	return nil
+}
+
+func (msc *MetadataSchemaCreate) sqlSave(ctx context.Context) (*MetadataSchema, error) {
+	if err := msc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := msc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, msc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	msc.mutation.id = &_node.ID
+	msc.mutation.done = true
+	return _node, nil
+}
+
+func (msc *MetadataSchemaCreate) createSpec() (*MetadataSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MetadataSchema{config: msc.config}
+		_spec = sqlgraph.NewCreateSpec(metadataschema.Table, sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint))
+	)
+	if id, ok := msc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := msc.mutation.CreateTime(); ok {
+		_spec.SetField(metadataschema.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := msc.mutation.UpdateTime(); ok {
+		_spec.SetField(metadataschema.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := msc.mutation.Definition(); ok {
+		_spec.SetField(metadataschema.FieldDefinition, field.TypeJSON, value)
+		_node.Definition = value
+	}
+	if value, ok := msc.mutation.IndexedKeys(); ok {
+		_spec.SetField(metadataschema.FieldIndexedKeys, field.TypeJSON, value)
+		_node.IndexedKeys = value
+	}
+	if nodes := msc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   metadataschema.OwnerTable,
+			Columns: []string{metadataschema.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MetadataSchemaCreateBulk is the builder for creating many MetadataSchema entities in bulk.
+type MetadataSchemaCreateBulk struct {
+	config
+	builders []*MetadataSchemaCreate
+}
+
+// Save creates the MetadataSchema entities in the database.
+func (mscb *MetadataSchemaCreateBulk) Save(ctx context.Context) ([]*MetadataSchema, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mscb.builders))
+	nodes := make([]*MetadataSchema, len(mscb.builders))
+	mutators := make([]Mutator, len(mscb.builders))
+	for i := range mscb.builders {
+		func(i int, root context.Context) {
+			builder := mscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MetadataSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mscb *MetadataSchemaCreateBulk) SaveX(ctx context.Context) []*MetadataSchema {
+	v, err := mscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mscb *MetadataSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := mscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mscb *MetadataSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := mscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
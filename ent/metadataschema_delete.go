@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// MetadataSchemaDelete is the builder for deleting a MetadataSchema entity.
+type MetadataSchemaDelete struct {
+	config
+	hooks    []Hook
+	mutation *MetadataSchemaMutation
+}
+
+// Where appends a list predicates to the MetadataSchemaDelete builder.
+func (msd *MetadataSchemaDelete) Where(ps ...predicate.MetadataSchema) *MetadataSchemaDelete {
+	msd.mutation.Where(ps...)
+	return msd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (msd *MetadataSchemaDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, MetadataSchemaMutation](ctx, msd.sqlExec, msd.mutation, msd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msd *MetadataSchemaDelete) ExecX(ctx context.Context) int {
+	n, err := msd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (msd *MetadataSchemaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(metadataschema.Table, sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint))
+	if ps := msd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, msd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	msd.mutation.done = true
+	return affected, err
+}
+
+// MetadataSchemaDeleteOne is the builder for deleting a single MetadataSchema entity.
+type MetadataSchemaDeleteOne struct {
+	msd *MetadataSchemaDelete
+}
+
+// Where appends a list predicates to the MetadataSchemaDelete builder.
+func (msdo *MetadataSchemaDeleteOne) Where(ps ...predicate.MetadataSchema) *MetadataSchemaDeleteOne {
+	msdo.msd.mutation.Where(ps...)
+	return msdo
+}
+
+// Exec executes the deletion query.
+func (msdo *MetadataSchemaDeleteOne) Exec(ctx context.Context) error {
+	n, err := msdo.msd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{metadataschema.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msdo *MetadataSchemaDeleteOne) ExecX(ctx context.Context) {
+	if err := msdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// MetadataSchemaQuery is the builder for querying MetadataSchema entities.
+type MetadataSchemaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []metadataschema.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MetadataSchema
+	withOwner  *UserQuery
+	loadTotal  []func(context.Context, []*MetadataSchema) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MetadataSchemaQuery builder.
+func (msq *MetadataSchemaQuery) Where(ps ...predicate.MetadataSchema) *MetadataSchemaQuery {
+	msq.predicates = append(msq.predicates, ps...)
+	return msq
+}
+
+// Limit the number of records to be returned by this query.
+func (msq *MetadataSchemaQuery) Limit(limit int) *MetadataSchemaQuery {
+	msq.ctx.Limit = &limit
+	return msq
+}
+
+// Offset to start from.
+func (msq *MetadataSchemaQuery) Offset(offset int) *MetadataSchemaQuery {
+	msq.ctx.Offset = &offset
+	return msq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (msq *MetadataSchemaQuery) Unique(unique bool) *MetadataSchemaQuery {
+	msq.ctx.Unique = &unique
+	return msq
+}
+
+// Order specifies how the records should be ordered.
+func (msq *MetadataSchemaQuery) Order(o ...metadataschema.OrderOption) *MetadataSchemaQuery {
+	msq.order = append(msq.order, o...)
+	return msq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (msq *MetadataSchemaQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: msq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := msq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := msq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(metadataschema.Table, metadataschema.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, metadataschema.OwnerTable, metadataschema.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(msq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MetadataSchema entity from the query.
+// Returns a *NotFoundError when no MetadataSchema was found.
+func (msq *MetadataSchemaQuery) First(ctx context.Context) (*MetadataSchema, error) {
+	nodes, err := msq.Limit(1).All(setContextOp(ctx, msq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{metadataschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) FirstX(ctx context.Context) *MetadataSchema {
+	node, err := msq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MetadataSchema ID from the query.
+// Returns a *NotFoundError when no MetadataSchema ID was found.
+func (msq *MetadataSchemaQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = msq.Limit(1).IDs(setContextOp(ctx, msq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{metadataschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) FirstIDX(ctx context.Context) uint {
+	id, err := msq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MetadataSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MetadataSchema entity is found.
+// Returns a *NotFoundError when no MetadataSchema entities are found.
+func (msq *MetadataSchemaQuery) Only(ctx context.Context) (*MetadataSchema, error) {
+	nodes, err := msq.Limit(2).All(setContextOp(ctx, msq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{metadataschema.Label}
+	default:
+		return nil, &NotSingularError{metadataschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) OnlyX(ctx context.Context) *MetadataSchema {
+	node, err := msq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MetadataSchema ID in the query.
+// Returns a *NotSingularError when more than one MetadataSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (msq *MetadataSchemaQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = msq.Limit(2).IDs(setContextOp(ctx, msq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{metadataschema.Label}
+	default:
+		err = &NotSingularError{metadataschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := msq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MetadataSchemas.
+func (msq *MetadataSchemaQuery) All(ctx context.Context) ([]*MetadataSchema, error) {
+	ctx = setContextOp(ctx, msq.ctx, "All")
+	if err := msq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MetadataSchema, *MetadataSchemaQuery]()
+	return withInterceptors[[]*MetadataSchema](ctx, msq, qr, msq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) AllX(ctx context.Context) []*MetadataSchema {
+	nodes, err := msq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MetadataSchema IDs.
+func (msq *MetadataSchemaQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if msq.ctx.Unique == nil && msq.path != nil {
+		msq.Unique(true)
+	}
+	ctx = setContextOp(ctx, msq.ctx, "IDs")
+	if err = msq.Select(metadataschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) IDsX(ctx context.Context) []uint {
+	ids, err := msq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (msq *MetadataSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, msq.ctx, "Count")
+	if err := msq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, msq, querierCount[*MetadataSchemaQuery](), msq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) CountX(ctx context.Context) int {
+	count, err := msq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (msq *MetadataSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, msq.ctx, "Exist")
+	switch _, err := msq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (msq *MetadataSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := msq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MetadataSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (msq *MetadataSchemaQuery) Clone() *MetadataSchemaQuery {
+	if msq == nil {
+		return nil
+	}
+	return &MetadataSchemaQuery{
+		config:     msq.config,
+		ctx:        msq.ctx.Clone(),
+		order:      append([]metadataschema.OrderOption{}, msq.order...),
+		inters:     append([]Interceptor{}, msq.inters...),
+		predicates: append([]predicate.MetadataSchema{}, msq.predicates...),
+		withOwner:  msq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  msq.sql.Clone(),
+		path: msq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (msq *MetadataSchemaQuery) WithOwner(opts ...func(*UserQuery)) *MetadataSchemaQuery {
+	query := (&UserClient{config: msq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	msq.withOwner = query
+	return msq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MetadataSchema.Query().
+//		GroupBy(metadataschema.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (msq *MetadataSchemaQuery) GroupBy(field string, fields ...string) *MetadataSchemaGroupBy {
+	msq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MetadataSchemaGroupBy{build: msq}
+	grbuild.flds = &msq.ctx.Fields
+	grbuild.label = metadataschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.MetadataSchema.Query().
+//		Select(metadataschema.FieldCreateTime).
+//		Scan(ctx, &v)
+func (msq *MetadataSchemaQuery) Select(fields ...string) *MetadataSchemaSelect {
+	msq.ctx.Fields = append(msq.ctx.Fields, fields...)
+	sbuild := &MetadataSchemaSelect{MetadataSchemaQuery: msq}
+	sbuild.label = metadataschema.Label
+	sbuild.flds, sbuild.scan = &msq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MetadataSchemaSelect configured with the given aggregations.
+func (msq *MetadataSchemaQuery) Aggregate(fns ...AggregateFunc) *MetadataSchemaSelect {
+	return msq.Select().Aggregate(fns...)
+}
+
+func (msq *MetadataSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range msq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, msq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range msq.ctx.Fields {
+		if !metadataschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if msq.path != nil {
+		prev, err := msq.path(ctx)
+		if err != nil {
+			return err
+		}
+		msq.sql = prev
+	}
+	if metadataschema.Policy == nil {
+		return errors.New("ent: uninitialized metadataschema.Policy (forgotten import ent/runtime?)")
+	}
+	if err := metadataschema.Policy.EvalQuery(ctx, msq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msq *MetadataSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MetadataSchema, error) {
+	var (
+		nodes       = []*MetadataSchema{}
+		_spec       = msq.querySpec()
+		loadedTypes = [1]bool{
+			msq.withOwner != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MetadataSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MetadataSchema{config: msq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(msq.modifiers) > 0 {
+		_spec.Modifiers = msq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, msq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := msq.withOwner; query != nil {
+		if err := msq.loadOwner(ctx, query, nodes, nil,
+			func(n *MetadataSchema, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range msq.loadTotal {
+		if err := msq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (msq *MetadataSchemaQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*MetadataSchema, init func(*MetadataSchema), assign func(*MetadataSchema, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*MetadataSchema)
+	for i := range nodes {
+		if nodes[i].OwnerID == nil {
+			continue
+		}
+		fk := *nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (msq *MetadataSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := msq.querySpec()
+	if len(msq.modifiers) > 0 {
+		_spec.Modifiers = msq.modifiers
+	}
+	_spec.Node.Columns = msq.ctx.Fields
+	if len(msq.ctx.Fields) > 0 {
+		_spec.Unique = msq.ctx.Unique != nil && *msq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, msq.driver, _spec)
+}
+
+func (msq *MetadataSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(metadataschema.Table, metadataschema.Columns, sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint))
+	_spec.From = msq.sql
+	if unique := msq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if msq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := msq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metadataschema.FieldID)
+		for i := range fields {
+			if fields[i] != metadataschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if msq.withOwner != nil {
+			_spec.Node.AddColumnOnce(metadataschema.FieldOwnerID)
+		}
+	}
+	if ps := msq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := msq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (msq *MetadataSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(msq.driver.Dialect())
+	t1 := builder.Table(metadataschema.Table)
+	columns := msq.ctx.Fields
+	if len(columns) == 0 {
+		columns = metadataschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if msq.sql != nil {
+		selector = msq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if msq.ctx.Unique != nil && *msq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range msq.modifiers {
+		m(selector)
+	}
+	for _, p := range msq.predicates {
+		p(selector)
+	}
+	for _, p := range msq.order {
+		p(selector)
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (msq *MetadataSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *MetadataSchemaSelect {
+	msq.modifiers = append(msq.modifiers, modifiers...)
+	return msq.Select()
+}
+
+// MetadataSchemaGroupBy is the group-by builder for MetadataSchema entities.
+type MetadataSchemaGroupBy struct {
+	selector
+	build *MetadataSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (msgb *MetadataSchemaGroupBy) Aggregate(fns ...AggregateFunc) *MetadataSchemaGroupBy {
+	msgb.fns = append(msgb.fns, fns...)
+	return msgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (msgb *MetadataSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, msgb.build.ctx, "GroupBy")
+	if err := msgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MetadataSchemaQuery, *MetadataSchemaGroupBy](ctx, msgb.build, msgb, msgb.build.inters, v)
+}
+
+func (msgb *MetadataSchemaGroupBy) sqlScan(ctx context.Context, root *MetadataSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(msgb.fns))
+	for _, fn := range msgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*msgb.flds)+len(msgb.fns))
+		for _, f := range *msgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*msgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := msgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MetadataSchemaSelect is the builder for selecting fields of MetadataSchema entities.
+type MetadataSchemaSelect struct {
+	*MetadataSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mss *MetadataSchemaSelect) Aggregate(fns ...AggregateFunc) *MetadataSchemaSelect {
+	mss.fns = append(mss.fns, fns...)
+	return mss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mss *MetadataSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mss.ctx, "Select")
+	if err := mss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MetadataSchemaQuery, *MetadataSchemaSelect](ctx, mss.MetadataSchemaQuery, mss, mss.inters, v)
+}
+
+func (mss *MetadataSchemaSelect) sqlScan(ctx context.Context, root *MetadataSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mss.fns))
+	for _, fn := range mss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mss *MetadataSchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *MetadataSchemaSelect {
+	mss.modifiers = append(mss.modifiers, modifiers...)
+	return mss
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// MetadataSchemaUpdate is the builder for updating MetadataSchema entities.
+type MetadataSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MetadataSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MetadataSchemaUpdate builder.
+func (msu *MetadataSchemaUpdate) Where(ps ...predicate.MetadataSchema) *MetadataSchemaUpdate {
+	msu.mutation.Where(ps...)
+	return msu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (msu *MetadataSchemaUpdate) SetUpdateTime(t time.Time) *MetadataSchemaUpdate {
+	msu.mutation.SetUpdateTime(t)
+	return msu
+}
+
+// SetDefinition sets the "definition" field.
+func (msu *MetadataSchemaUpdate) SetDefinition(m map[string]interface{}) *MetadataSchemaUpdate {
+	msu.mutation.SetDefinition(m)
+	return msu
+}
+
+// SetIndexedKeys sets the "indexed_keys" field.
+func (msu *MetadataSchemaUpdate) SetIndexedKeys(s []string) *MetadataSchemaUpdate {
+	msu.mutation.SetIndexedKeys(s)
+	return msu
+}
+
+// AppendIndexedKeys appends s to the "indexed_keys" field.
+func (msu *MetadataSchemaUpdate) AppendIndexedKeys(s []string) *MetadataSchemaUpdate {
+	msu.mutation.AppendIndexedKeys(s)
+	return msu
+}
+
+// ClearIndexedKeys clears the value of the "indexed_keys" field.
+func (msu *MetadataSchemaUpdate) ClearIndexedKeys() *MetadataSchemaUpdate {
+	msu.mutation.ClearIndexedKeys()
+	return msu
+}
+
+// Mutation returns the MetadataSchemaMutation object of the builder.
+func (msu *MetadataSchemaUpdate) Mutation() *MetadataSchemaMutation {
+	return msu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (msu *MetadataSchemaUpdate) Save(ctx context.Context) (int, error) {
+	if err := msu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, MetadataSchemaMutation](ctx, msu.sqlSave, msu.mutation, msu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msu *MetadataSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := msu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (msu *MetadataSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := msu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msu *MetadataSchemaUpdate) ExecX(ctx context.Context) {
+	if err := msu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msu *MetadataSchemaUpdate) defaults() error {
+	if _, ok := msu.mutation.UpdateTime(); !ok {
+		if metadataschema.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized metadataschema.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := metadataschema.UpdateDefaultUpdateTime()
+		msu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (msu *MetadataSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MetadataSchemaUpdate {
+	msu.modifiers = append(msu.modifiers, modifiers...)
+	return msu
+}
+
+func (msu *MetadataSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(metadataschema.Table, metadataschema.Columns, sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint))
+	if ps := msu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msu.mutation.UpdateTime(); ok {
+		_spec.SetField(metadataschema.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := msu.mutation.Definition(); ok {
+		_spec.SetField(metadataschema.FieldDefinition, field.TypeJSON, value)
+	}
+	if value, ok := msu.mutation.IndexedKeys(); ok {
+		_spec.SetField(metadataschema.FieldIndexedKeys, field.TypeJSON, value)
+	}
+	if value, ok := msu.mutation.AppendedIndexedKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, metadataschema.FieldIndexedKeys, value)
+		})
+	}
+	if msu.mutation.IndexedKeysCleared() {
+		_spec.ClearField(metadataschema.FieldIndexedKeys, field.TypeJSON)
+	}
+	_spec.AddModifiers(msu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, msu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metadataschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	msu.mutation.done = true
+	return n, nil
+}
+
+// MetadataSchemaUpdateOne is the builder for updating a single MetadataSchema entity.
+type MetadataSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MetadataSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (msuo *MetadataSchemaUpdateOne) SetUpdateTime(t time.Time) *MetadataSchemaUpdateOne {
+	msuo.mutation.SetUpdateTime(t)
+	return msuo
+}
+
+// SetDefinition sets the "definition" field.
+func (msuo *MetadataSchemaUpdateOne) SetDefinition(m map[string]interface{}) *MetadataSchemaUpdateOne {
+	msuo.mutation.SetDefinition(m)
+	return msuo
+}
+
+// SetIndexedKeys sets the "indexed_keys" field.
+func (msuo *MetadataSchemaUpdateOne) SetIndexedKeys(s []string) *MetadataSchemaUpdateOne {
+	msuo.mutation.SetIndexedKeys(s)
+	return msuo
+}
+
+// AppendIndexedKeys appends s to the "indexed_keys" field.
+func (msuo *MetadataSchemaUpdateOne) AppendIndexedKeys(s []string) *MetadataSchemaUpdateOne {
+	msuo.mutation.AppendIndexedKeys(s)
+	return msuo
+}
+
+// ClearIndexedKeys clears the value of the "indexed_keys" field.
+func (msuo *MetadataSchemaUpdateOne) ClearIndexedKeys() *MetadataSchemaUpdateOne {
+	msuo.mutation.ClearIndexedKeys()
+	return msuo
+}
+
+// Mutation returns the MetadataSchemaMutation object of the builder.
+func (msuo *MetadataSchemaUpdateOne) Mutation() *MetadataSchemaMutation {
+	return msuo.mutation
+}
+
+// Where appends a list predicates to the MetadataSchemaUpdate builder.
+func (msuo *MetadataSchemaUpdateOne) Where(ps ...predicate.MetadataSchema) *MetadataSchemaUpdateOne {
+	msuo.mutation.Where(ps...)
+	return msuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (msuo *MetadataSchemaUpdateOne) Select(field string, fields ...string) *MetadataSchemaUpdateOne {
+	msuo.fields = append([]string{field}, fields...)
+	return msuo
+}
+
+// Save executes the query and returns the updated MetadataSchema entity.
+func (msuo *MetadataSchemaUpdateOne) Save(ctx context.Context) (*MetadataSchema, error) {
+	if err := msuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*MetadataSchema, MetadataSchemaMutation](ctx, msuo.sqlSave, msuo.mutation, msuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msuo *MetadataSchemaUpdateOne) SaveX(ctx context.Context) *MetadataSchema {
+	node, err := msuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (msuo *MetadataSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := msuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msuo *MetadataSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := msuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msuo *MetadataSchemaUpdateOne) defaults() error {
+	if _, ok := msuo.mutation.UpdateTime(); !ok {
+		if metadataschema.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized metadataschema.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := metadataschema.UpdateDefaultUpdateTime()
+		msuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (msuo *MetadataSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MetadataSchemaUpdateOne {
+	msuo.modifiers = append(msuo.modifiers, modifiers...)
+	return msuo
+}
+
+func (msuo *MetadataSchemaUpdateOne) sqlSave(ctx context.Context) (_node *MetadataSchema, err error) {
+	_spec := sqlgraph.NewUpdateSpec(metadataschema.Table, metadataschema.Columns, sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint))
+	id, ok := msuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MetadataSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := msuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metadataschema.FieldID)
+		for _, f := range fields {
+			if !metadataschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != metadataschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := msuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msuo.mutation.UpdateTime(); ok {
+		_spec.SetField(metadataschema.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := msuo.mutation.Definition(); ok {
+		_spec.SetField(metadataschema.FieldDefinition, field.TypeJSON, value)
+	}
+	if value, ok := msuo.mutation.IndexedKeys(); ok {
+		_spec.SetField(metadataschema.FieldIndexedKeys, field.TypeJSON, value)
+	}
+	if value, ok := msuo.mutation.AppendedIndexedKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, metadataschema.FieldIndexedKeys, value)
+		})
+	}
+	if msuo.mutation.IndexedKeysCleared() {
+		_spec.ClearField(metadataschema.FieldIndexedKeys, field.TypeJSON)
+	}
+	_spec.AddModifiers(msuo.modifiers...)
+	_node = &MetadataSchema{config: msuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, msuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metadataschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	msuo.mutation.done = true
+	return _node, nil
+}
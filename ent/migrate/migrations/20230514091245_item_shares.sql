@@ -0,0 +1,4 @@
+-- Create "item_shares" table
+CREATE TABLE "item_shares" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "permission" character varying NOT NULL DEFAULT 'viewer', "item_id" bigint NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_shares_items_shares" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "item_shares_users_shared_items" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "itemshare_item_id_user_id" to table: "item_shares"
+CREATE UNIQUE INDEX "itemshare_item_id_user_id" ON "item_shares" ("item_id", "user_id");
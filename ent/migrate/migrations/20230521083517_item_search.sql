@@ -0,0 +1,4 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english', coalesce("title", '')), 'A') || setweight(to_tsvector('english', coalesce("description", '')), 'B')) STORED;
+-- Create index "item_search_vector" to table: "items"
+CREATE INDEX "item_search_vector" ON "items" USING GIN ("search_vector");
@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "delete_time" timestamptz NULL;
+-- Modify "items" table
+ALTER TABLE "items" DROP CONSTRAINT "items_users_items", ADD COLUMN "delete_time" timestamptz NULL, ADD CONSTRAINT "items_users_items" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
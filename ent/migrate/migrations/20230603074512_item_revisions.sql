@@ -0,0 +1,4 @@
+-- Create "item_revisions" table
+CREATE TABLE "item_revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "action" character varying NOT NULL, "snapshot" jsonb NOT NULL, "changes" jsonb NOT NULL, "item_id" bigint NOT NULL, "user_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "item_revisions_items_revisions" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "item_revisions_users_item_revisions" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "itemrevision_item_id" to table: "item_revisions"
+CREATE INDEX "itemrevision_item_id" ON "item_revisions" ("item_id");
@@ -0,0 +1,4 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,6 @@
+-- Create "tags" table
+CREATE TABLE "tags" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "color" character varying NOT NULL DEFAULT '#808080', "owner_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "tags_users_tags" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "tag_owner_id_name" to table: "tags"
+CREATE UNIQUE INDEX "tag_owner_id_name" ON "tags" ("owner_id", "name");
+-- Create "tag_items" table
+CREATE TABLE "tag_items" ("tag_id" bigint NOT NULL, "item_id" bigint NOT NULL, PRIMARY KEY ("tag_id", "item_id"), CONSTRAINT "tag_items_item_id" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "tag_items_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,6 @@
+-- Create "attachments" table
+CREATE TABLE "attachments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "filename" character varying NOT NULL, "content_type" character varying NOT NULL, "size" bigint NOT NULL, "checksum" character varying NOT NULL, "storage_key" character varying NOT NULL, "item_id" bigint NOT NULL, "uploader_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "attachments_items_attachments" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "attachments_users_attachments" FOREIGN KEY ("uploader_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "attachments_storage_key_key" to table: "attachments"
+CREATE UNIQUE INDEX "attachments_storage_key_key" ON "attachments" ("storage_key");
+-- Create index "attachment_item_id" to table: "attachments"
+CREATE INDEX "attachment_item_id" ON "attachments" ("item_id");
@@ -0,0 +1,2 @@
+-- Modify "attachments" table
+ALTER TABLE "attachments" ADD COLUMN "processing_status" character varying NULL, ADD COLUMN "width" bigint NULL, ADD COLUMN "height" bigint NULL, ADD COLUMN "thumbnails" jsonb NULL;
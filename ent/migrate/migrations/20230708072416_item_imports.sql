@@ -0,0 +1,2 @@
+-- Create "item_imports" table
+CREATE TABLE "item_imports" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "format" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "storage_key" character varying NOT NULL, "size" bigint NOT NULL, "read_bytes" bigint NOT NULL DEFAULT 0, "created_rows" bigint NOT NULL DEFAULT 0, "failed_rows" bigint NOT NULL DEFAULT 0, "errors" jsonb NULL, "error" character varying NULL, "finish_time" timestamptz NULL, "owner_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_imports_users_item_imports" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- Create "comments" table
+CREATE TABLE "comments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "delete_time" timestamptz NULL, "body" text NOT NULL, "edited_at" timestamptz NULL, "item_id" bigint NOT NULL, "author_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "comments_items_comments" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "comments_users_comments" FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "comment_item_id" to table: "comments"
+CREATE INDEX "comment_item_id" ON "comments" ("item_id");
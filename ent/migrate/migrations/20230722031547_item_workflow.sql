@@ -0,0 +1,6 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "status" character varying NOT NULL DEFAULT 'draft';
+-- Create "item_transitions" table
+CREATE TABLE "item_transitions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "name" character varying NOT NULL, "from_status" character varying NOT NULL, "to_status" character varying NOT NULL, "comment" character varying NULL, "item_id" bigint NOT NULL, "user_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "item_transitions_items_transitions" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "item_transitions_users_item_transitions" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "itemtransition_item_id" to table: "item_transitions"
+CREATE INDEX "itemtransition_item_id" ON "item_transitions" ("item_id");
@@ -0,0 +1,8 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "metadata" jsonb NULL;
+-- Create index "item_metadata" to table: "items"
+CREATE INDEX "item_metadata" ON "items" USING GIN ("metadata" jsonb_path_ops);
+-- Create "metadata_schemas" table
+CREATE TABLE "metadata_schemas" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "definition" jsonb NOT NULL, "indexed_keys" jsonb NULL, "owner_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "metadata_schemas_users_metadata_schemas" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "metadataschema_owner_id" to table: "metadata_schemas"
+CREATE UNIQUE INDEX "metadataschema_owner_id" ON "metadata_schemas" ("owner_id");
@@ -0,0 +1,8 @@
+-- Create "ownership_transfers" table
+CREATE TABLE "ownership_transfers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "item_id" bigint NOT NULL, "from_user_id" bigint NOT NULL, "to_user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "ownership_transfers_items_ownership_transfers" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "ownership_transfers_users_received_ownership_transfers" FOREIGN KEY ("to_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "ownership_transfers_users_sent_ownership_transfers" FOREIGN KEY ("from_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "ownershiptransfer_item_id" to table: "ownership_transfers"
+CREATE INDEX "ownershiptransfer_item_id" ON "ownership_transfers" ("item_id");
+-- Create index "ownershiptransfer_from_user_id" to table: "ownership_transfers"
+CREATE INDEX "ownershiptransfer_from_user_id" ON "ownership_transfers" ("from_user_id");
+-- Create index "ownershiptransfer_to_user_id" to table: "ownership_transfers"
+CREATE INDEX "ownershiptransfer_to_user_id" ON "ownership_transfers" ("to_user_id");
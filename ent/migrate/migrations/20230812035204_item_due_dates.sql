@@ -0,0 +1,6 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "due_at" timestamptz NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "calendar_token" character varying NULL;
+-- Create index "users_calendar_token_key" to table: "users"
+CREATE UNIQUE INDEX "users_calendar_token_key" ON "users" ("calendar_token");
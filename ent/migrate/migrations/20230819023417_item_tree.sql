@@ -0,0 +1,4 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "parent_id" bigint NULL, ADD CONSTRAINT "items_items_children" FOREIGN KEY ("parent_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "item_parent_id" to table: "items"
+CREATE INDEX "item_parent_id" ON "items" ("parent_id");
@@ -0,0 +1,4 @@
+-- Cancel the pending transfers sent concurrently, the latest transfer of each item stays pending
+UPDATE "ownership_transfers" AS "t" SET "status" = 'cancelled', "update_time" = now() WHERE "t"."status" = 'pending' AND EXISTS (SELECT 1 FROM "ownership_transfers" AS "o" WHERE "o"."item_id" = "t"."item_id" AND "o"."status" = 'pending' AND "o"."id" > "t"."id");
+-- Create index "ownershiptransfer_item_id_pending" to table: "ownership_transfers"
+CREATE UNIQUE INDEX "ownershiptransfer_item_id_pending" ON "ownership_transfers" ("item_id") WHERE (status = 'pending');
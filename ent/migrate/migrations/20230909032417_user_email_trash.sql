@@ -0,0 +1,4 @@
+-- Drop index "users_email_key" from table: "users"
+DROP INDEX "users_email_key";
+-- Create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email") WHERE (delete_time IS NULL);
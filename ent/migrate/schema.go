@@ -3,18 +3,107 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "processing_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "processed", "failed"}},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "thumbnails", Type: field.TypeJSON, Nullable: true},
+		{Name: "item_id", Type: field.TypeUint},
+		{Name: "uploader_id", Type: field.TypeUint, Nullable: true},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_items_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[12]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "attachments_users_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[12]},
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "item_id", Type: field.TypeUint},
+		{Name: "author_id", Type: field.TypeUint, Nullable: true},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_items_comments",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[6]},
+			},
+		},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "draft"},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUint, Nullable: true},
 		{Name: "owner_id", Type: field.TypeUint},
 	}
 	// ItemsTable holds the schema information for the "items" table.
@@ -23,11 +112,287 @@ var (
 		Columns:    ItemsColumns,
 		PrimaryKey: []*schema.Column{ItemsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "items_items_children",
+				Columns:    []*schema.Column{ItemsColumns[10]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "items_users_items",
-				Columns:    []*schema.Column{ItemsColumns[5]},
+				Columns:    []*schema.Column{ItemsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "item_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[10]},
+			},
+		},
+	}
+	// ItemImportsColumns holds the columns for the "item_imports" table.
+	ItemImportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "json", "ndjson"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "failed"}, Default: "pending"},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "read_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "created_rows", Type: field.TypeInt, Default: 0},
+		{Name: "failed_rows", Type: field.TypeInt, Default: 0},
+		{Name: "errors", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "finish_time", Type: field.TypeTime, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUint},
+	}
+	// ItemImportsTable holds the schema information for the "item_imports" table.
+	ItemImportsTable = &schema.Table{
+		Name:       "item_imports",
+		Columns:    ItemImportsColumns,
+		PrimaryKey: []*schema.Column{ItemImportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_imports_users_item_imports",
+				Columns:    []*schema.Column{ItemImportsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ItemRevisionsColumns holds the columns for the "item_revisions" table.
+	ItemRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore", "transfer"}},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "item_id", Type: field.TypeUint},
+		{Name: "user_id", Type: field.TypeUint, Nullable: true},
+	}
+	// ItemRevisionsTable holds the schema information for the "item_revisions" table.
+	ItemRevisionsTable = &schema.Table{
+		Name:       "item_revisions",
+		Columns:    ItemRevisionsColumns,
+		PrimaryKey: []*schema.Column{ItemRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_revisions_items_revisions",
+				Columns:    []*schema.Column{ItemRevisionsColumns[5]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_revisions_users_item_revisions",
+				Columns:    []*schema.Column{ItemRevisionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemrevision_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{ItemRevisionsColumns[5]},
+			},
+		},
+	}
+	// ItemSharesColumns holds the columns for the "item_shares" table.
+	ItemSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "permission", Type: field.TypeEnum, Enums: []string{"viewer", "editor"}, Default: "viewer"},
+		{Name: "item_id", Type: field.TypeUint},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// ItemSharesTable holds the schema information for the "item_shares" table.
+	ItemSharesTable = &schema.Table{
+		Name:       "item_shares",
+		Columns:    ItemSharesColumns,
+		PrimaryKey: []*schema.Column{ItemSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_shares_items_shares",
+				Columns:    []*schema.Column{ItemSharesColumns[4]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_shares_users_shared_items",
+				Columns:    []*schema.Column{ItemSharesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemshare_item_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ItemSharesColumns[4], ItemSharesColumns[5]},
+			},
+		},
+	}
+	// ItemTransitionsColumns holds the columns for the "item_transitions" table.
+	ItemTransitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "from_status", Type: field.TypeString},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "comment", Type: field.TypeString, Nullable: true},
+		{Name: "item_id", Type: field.TypeUint},
+		{Name: "user_id", Type: field.TypeUint, Nullable: true},
+	}
+	// ItemTransitionsTable holds the schema information for the "item_transitions" table.
+	ItemTransitionsTable = &schema.Table{
+		Name:       "item_transitions",
+		Columns:    ItemTransitionsColumns,
+		PrimaryKey: []*schema.Column{ItemTransitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_transitions_items_transitions",
+				Columns:    []*schema.Column{ItemTransitionsColumns[6]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_transitions_users_item_transitions",
+				Columns:    []*schema.Column{ItemTransitionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemtransition_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{ItemTransitionsColumns[6]},
+			},
+		},
+	}
+	// MetadataSchemasColumns holds the columns for the "metadata_schemas" table.
+	MetadataSchemasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "definition", Type: field.TypeJSON},
+		{Name: "indexed_keys", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUint, Nullable: true},
+	}
+	// MetadataSchemasTable holds the schema information for the "metadata_schemas" table.
+	MetadataSchemasTable = &schema.Table{
+		Name:       "metadata_schemas",
+		Columns:    MetadataSchemasColumns,
+		PrimaryKey: []*schema.Column{MetadataSchemasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "metadata_schemas_users_metadata_schemas",
+				Columns:    []*schema.Column{MetadataSchemasColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "metadataschema_owner_id",
+				Unique:  true,
+				Columns: []*schema.Column{MetadataSchemasColumns[5]},
+			},
+		},
+	}
+	// OwnershipTransfersColumns holds the columns for the "ownership_transfers" table.
+	OwnershipTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "cancelled"}, Default: "pending"},
+		{Name: "item_id", Type: field.TypeUint},
+		{Name: "from_user_id", Type: field.TypeUint},
+		{Name: "to_user_id", Type: field.TypeUint},
+	}
+	// OwnershipTransfersTable holds the schema information for the "ownership_transfers" table.
+	OwnershipTransfersTable = &schema.Table{
+		Name:       "ownership_transfers",
+		Columns:    OwnershipTransfersColumns,
+		PrimaryKey: []*schema.Column{OwnershipTransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ownership_transfers_items_ownership_transfers",
+				Columns:    []*schema.Column{OwnershipTransfersColumns[4]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ownership_transfers_users_sent_ownership_transfers",
+				Columns:    []*schema.Column{OwnershipTransfersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ownership_transfers_users_received_ownership_transfers",
+				Columns:    []*schema.Column{OwnershipTransfersColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ownershiptransfer_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{OwnershipTransfersColumns[4]},
+			},
+			{
+				Name:    "ownershiptransfer_item_id_pending",
+				Unique:  true,
+				Columns: []*schema.Column{OwnershipTransfersColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'pending'",
+				},
+			},
+			{
+				Name:    "ownershiptransfer_from_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OwnershipTransfersColumns[5]},
+			},
+			{
+				Name:    "ownershiptransfer_to_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OwnershipTransfersColumns[6]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString, Default: "#808080"},
+		{Name: "owner_id", Type: field.TypeUint},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_users_tags",
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_owner_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[5], TagsColumns[3]},
 			},
 		},
 	}
@@ -36,8 +401,10 @@ var (
 		{Name: "id", Type: field.TypeUint, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "name", Type: field.TypeString},
-		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
 		{Name: "password", Type: field.TypeString},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "is_super_user", Type: field.TypeBool, Default: false},
@@ -45,20 +412,86 @@ var (
 		{Name: "verification_code", Type: field.TypeString, Nullable: true},
 		{Name: "password_reset_token", Type: field.TypeString, Nullable: true},
 		{Name: "password_reset_at", Type: field.TypeTime, Nullable: true},
+		{Name: "calendar_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "plan", Type: field.TypeString, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "users_email_key",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "delete_time IS NULL",
+				},
+			},
+		},
+	}
+	// TagItemsColumns holds the columns for the "tag_items" table.
+	TagItemsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUint},
+		{Name: "item_id", Type: field.TypeUint},
+	}
+	// TagItemsTable holds the schema information for the "tag_items" table.
+	TagItemsTable = &schema.Table{
+		Name:       "tag_items",
+		Columns:    TagItemsColumns,
+		PrimaryKey: []*schema.Column{TagItemsColumns[0], TagItemsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_items_tag_id",
+				Columns:    []*schema.Column{TagItemsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_items_item_id",
+				Columns:    []*schema.Column{TagItemsColumns[1]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentsTable,
+		CommentsTable,
 		ItemsTable,
+		ItemImportsTable,
+		ItemRevisionsTable,
+		ItemSharesTable,
+		ItemTransitionsTable,
+		MetadataSchemasTable,
+		OwnershipTransfersTable,
+		TagsTable,
 		UsersTable,
+		TagItemsTable,
 	}
 )
 
 func init() {
-	ItemsTable.ForeignKeys[0].RefTable = UsersTable
+	AttachmentsTable.ForeignKeys[0].RefTable = ItemsTable
+	AttachmentsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentsTable.ForeignKeys[0].RefTable = ItemsTable
+	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	ItemsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemsTable.ForeignKeys[1].RefTable = UsersTable
+	ItemImportsTable.ForeignKeys[0].RefTable = UsersTable
+	ItemRevisionsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	ItemSharesTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemSharesTable.ForeignKeys[1].RefTable = UsersTable
+	ItemTransitionsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemTransitionsTable.ForeignKeys[1].RefTable = UsersTable
+	MetadataSchemasTable.ForeignKeys[0].RefTable = UsersTable
+	OwnershipTransfersTable.ForeignKeys[0].RefTable = ItemsTable
+	OwnershipTransfersTable.ForeignKeys[1].RefTable = UsersTable
+	OwnershipTransfersTable.ForeignKeys[2].RefTable = UsersTable
+	TagsTable.ForeignKeys[0].RefTable = UsersTable
+	TagItemsTable.ForeignKeys[0].RefTable = TagsTable
+	TagItemsTable.ForeignKeys[1].RefTable = ItemsTable
 }
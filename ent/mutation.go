@@ -11,9 +11,19 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
 )
 
 const (
@@ -25,39 +35,61 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeItem = "Item"
-	TypeUser = "User"
+	TypeAttachment        = "Attachment"
+	TypeComment           = "Comment"
+	TypeItem              = "Item"
+	TypeItemImport        = "ItemImport"
+	TypeItemRevision      = "ItemRevision"
+	TypeItemShare         = "ItemShare"
+	TypeItemTransition    = "ItemTransition"
+	TypeMetadataSchema    = "MetadataSchema"
+	TypeOwnershipTransfer = "OwnershipTransfer"
+	TypeTag               = "Tag"
+	TypeUser              = "User"
 )
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// AttachmentMutation represents an operation that mutates the Attachment nodes in the graph.
+type AttachmentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint
-	create_time   *time.Time
-	update_time   *time.Time
-	title         *string
-	description   *string
-	clearedFields map[string]struct{}
-	owner         *uint
-	clearedowner  bool
-	done          bool
-	oldValue      func(context.Context) (*Item, error)
-	predicates    []predicate.Item
-}
-
-var _ ent.Mutation = (*ItemMutation)(nil)
-
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
-
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+	op                Op
+	typ               string
+	id                *uint
+	create_time       *time.Time
+	update_time       *time.Time
+	filename          *string
+	content_type      *string
+	size              *int64
+	addsize           *int64
+	checksum          *string
+	storage_key       *string
+	processing_status *attachment.ProcessingStatus
+	width             *int
+	addwidth          *int
+	height            *int
+	addheight         *int
+	thumbnails        *[]models.ItemAttachmentThumbnail
+	appendthumbnails  []models.ItemAttachmentThumbnail
+	clearedFields     map[string]struct{}
+	item              *uint
+	cleareditem       bool
+	uploader          *uint
+	cleareduploader   bool
+	done              bool
+	oldValue          func(context.Context) (*Attachment, error)
+	predicates        []predicate.Attachment
+}
+
+var _ ent.Mutation = (*AttachmentMutation)(nil)
+
+// attachmentOption allows management of the mutation configuration using functional options.
+type attachmentOption func(*AttachmentMutation)
+
+// newAttachmentMutation creates new mutation for the Attachment entity.
+func newAttachmentMutation(c config, op Op, opts ...attachmentOption) *AttachmentMutation {
+	m := &AttachmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeAttachment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -66,20 +98,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id uint) itemOption {
-	return func(m *ItemMutation) {
+// withAttachmentID sets the ID field of the mutation.
+func withAttachmentID(id uint) attachmentOption {
+	return func(m *AttachmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *Attachment
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*Attachment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().Attachment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -88,10 +120,10 @@ func withItemID(id uint) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withAttachment sets the old Attachment of the mutation.
+func withAttachment(node *Attachment) attachmentOption {
+	return func(m *AttachmentMutation) {
+		m.oldValue = func(context.Context) (*Attachment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -100,7 +132,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m AttachmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -108,7 +140,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m AttachmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -118,14 +150,14 @@ func (m ItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Item entities.
-func (m *ItemMutation) SetID(id uint) {
+// operation is only accepted on creation of Attachment entities.
+func (m *AttachmentMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id uint, exists bool) {
+func (m *AttachmentMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -136,7 +168,7 @@ func (m *ItemMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *AttachmentMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -145,19 +177,19 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Attachment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ItemMutation) SetCreateTime(t time.Time) {
+func (m *AttachmentMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ItemMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AttachmentMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -165,10 +197,10 @@ func (m *ItemMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AttachmentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -183,17 +215,17 @@ func (m *ItemMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ItemMutation) ResetCreateTime() {
+func (m *AttachmentMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ItemMutation) SetUpdateTime(t time.Time) {
+func (m *AttachmentMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ItemMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AttachmentMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -201,10 +233,10 @@ func (m *ItemMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AttachmentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -219,1037 +251,10595 @@ func (m *ItemMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ItemMutation) ResetUpdateTime() {
+func (m *AttachmentMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *ItemMutation) SetTitle(s string) {
-	m.title = &s
+// SetItemID sets the "item_id" field.
+func (m *AttachmentMutation) SetItemID(u uint) {
+	m.item = &u
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ItemMutation) Title() (r string, exists bool) {
-	v := m.title
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *AttachmentMutation) ItemID() (r uint, exists bool) {
+	v := m.item
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldItemID returns the old "item_id" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AttachmentMutation) OldItemID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldItemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.ItemID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ItemMutation) ResetTitle() {
-	m.title = nil
+// ResetItemID resets all changes to the "item_id" field.
+func (m *AttachmentMutation) ResetItemID() {
+	m.item = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ItemMutation) SetDescription(s string) {
-	m.description = &s
+// SetUploaderID sets the "uploader_id" field.
+func (m *AttachmentMutation) SetUploaderID(u uint) {
+	m.uploader = &u
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ItemMutation) Description() (r string, exists bool) {
-	v := m.description
+// UploaderID returns the value of the "uploader_id" field in the mutation.
+func (m *AttachmentMutation) UploaderID() (r uint, exists bool) {
+	v := m.uploader
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldUploaderID returns the old "uploader_id" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AttachmentMutation) OldUploaderID(ctx context.Context) (v *uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploaderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldUploaderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploaderID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.UploaderID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ItemMutation) ResetDescription() {
-	m.description = nil
+// ClearUploaderID clears the value of the "uploader_id" field.
+func (m *AttachmentMutation) ClearUploaderID() {
+	m.uploader = nil
+	m.clearedFields[attachment.FieldUploaderID] = struct{}{}
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *ItemMutation) SetOwnerID(u uint) {
-	m.owner = &u
+// UploaderIDCleared returns if the "uploader_id" field was cleared in this mutation.
+func (m *AttachmentMutation) UploaderIDCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldUploaderID]
+	return ok
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *ItemMutation) OwnerID() (r uint, exists bool) {
-	v := m.owner
+// ResetUploaderID resets all changes to the "uploader_id" field.
+func (m *AttachmentMutation) ResetUploaderID() {
+	m.uploader = nil
+	delete(m.clearedFields, attachment.FieldUploaderID)
+}
+
+// SetFilename sets the "filename" field.
+func (m *AttachmentMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *AttachmentMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldOwnerID(ctx context.Context) (v uint, err error) {
+func (m *AttachmentMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.Filename, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *ItemMutation) ResetOwnerID() {
-	m.owner = nil
+// ResetFilename resets all changes to the "filename" field.
+func (m *AttachmentMutation) ResetFilename() {
+	m.filename = nil
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *ItemMutation) ClearOwner() {
-	m.clearedowner = true
+// SetContentType sets the "content_type" field.
+func (m *AttachmentMutation) SetContentType(s string) {
+	m.content_type = &s
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *ItemMutation) OwnerCleared() bool {
-	return m.clearedowner
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *AttachmentMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) OwnerIDs() (ids []uint) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldContentType returns the old "content_type" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *ItemMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetContentType resets all changes to the "content_type" field.
+func (m *AttachmentMutation) ResetContentType() {
+	m.content_type = nil
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
-	m.predicates = append(m.predicates, ps...)
+// SetSize sets the "size" field.
+func (m *AttachmentMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Size returns the value of the "size" field in the mutation.
+func (m *AttachmentMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ItemMutation) Op() Op {
-	return m.op
+// OldSize returns the old "size" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
-	m.op = op
+// AddSize adds i to the "size" field.
+func (m *AttachmentMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
-	return m.typ
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AttachmentMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.create_time != nil {
-		fields = append(fields, item.FieldCreateTime)
+// ResetSize resets all changes to the "size" field.
+func (m *AttachmentMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *AttachmentMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *AttachmentMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
 	}
-	if m.update_time != nil {
-		fields = append(fields, item.FieldUpdateTime)
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
 	}
-	if m.title != nil {
-		fields = append(fields, item.FieldTitle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
 	}
-	if m.description != nil {
-		fields = append(fields, item.FieldDescription)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
 	}
-	if m.owner != nil {
-		fields = append(fields, item.FieldOwnerID)
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *AttachmentMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *AttachmentMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *AttachmentMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case item.FieldCreateTime:
-		return m.CreateTime()
-	case item.FieldUpdateTime:
-		return m.UpdateTime()
-	case item.FieldTitle:
-		return m.Title()
-	case item.FieldDescription:
-		return m.Description()
-	case item.FieldOwnerID:
-		return m.OwnerID()
+// OldStorageKey returns the old "storage_key" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case item.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case item.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case item.FieldTitle:
-		return m.OldTitle(ctx)
-	case item.FieldDescription:
-		return m.OldDescription(ctx)
-	case item.FieldOwnerID:
-		return m.OldOwnerID(ctx)
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *AttachmentMutation) ResetStorageKey() {
+	m.storage_key = nil
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (m *AttachmentMutation) SetProcessingStatus(as attachment.ProcessingStatus) {
+	m.processing_status = &as
+}
+
+// ProcessingStatus returns the value of the "processing_status" field in the mutation.
+func (m *AttachmentMutation) ProcessingStatus() (r attachment.ProcessingStatus, exists bool) {
+	v := m.processing_status
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case item.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case item.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case item.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case item.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case item.FieldOwnerID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerID(v)
-		return nil
+// OldProcessingStatus returns the old "processing_status" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldProcessingStatus(ctx context.Context) (v *attachment.ProcessingStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessingStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessingStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessingStatus: %w", err)
+	}
+	return oldValue.ProcessingStatus, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ItemMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (m *AttachmentMutation) ClearProcessingStatus() {
+	m.processing_status = nil
+	m.clearedFields[attachment.FieldProcessingStatus] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// ProcessingStatusCleared returns if the "processing_status" field was cleared in this mutation.
+func (m *AttachmentMutation) ProcessingStatusCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldProcessingStatus]
+	return ok
+}
+
+// ResetProcessingStatus resets all changes to the "processing_status" field.
+func (m *AttachmentMutation) ResetProcessingStatus() {
+	m.processing_status = nil
+	delete(m.clearedFields, attachment.FieldProcessingStatus)
+}
+
+// SetWidth sets the "width" field.
+func (m *AttachmentMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *AttachmentMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldWidth returns the old "width" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldWidth(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ItemMutation) ClearedFields() []string {
-	return nil
+// AddWidth adds i to the "width" field.
+func (m *AttachmentMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *AttachmentMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWidth clears the value of the "width" field.
+func (m *AttachmentMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[attachment.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *AttachmentMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldWidth]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Item nullable field %s", name)
+// ResetWidth resets all changes to the "width" field.
+func (m *AttachmentMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, attachment.FieldWidth)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
-	switch name {
-	case item.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case item.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case item.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case item.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case item.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
+// SetHeight sets the "height" field.
+func (m *AttachmentMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *AttachmentMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.owner != nil {
-		edges = append(edges, item.EdgeOwner)
+// OldHeight returns the old "height" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldHeight(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
+// AddHeight adds i to the "height" field.
+func (m *AttachmentMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *AttachmentMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ClearHeight clears the value of the "height" field.
+func (m *AttachmentMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[attachment.FieldHeight] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedowner {
-		edges = append(edges, item.EdgeOwner)
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *AttachmentMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldHeight]
+	return ok
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *AttachmentMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, attachment.FieldHeight)
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (m *AttachmentMutation) SetThumbnails(mat []models.ItemAttachmentThumbnail) {
+	m.thumbnails = &mat
+	m.appendthumbnails = nil
+}
+
+// Thumbnails returns the value of the "thumbnails" field in the mutation.
+func (m *AttachmentMutation) Thumbnails() (r []models.ItemAttachmentThumbnail, exists bool) {
+	v := m.thumbnails
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
-	switch name {
-	case item.EdgeOwner:
-		return m.clearedowner
+// OldThumbnails returns the old "thumbnails" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldThumbnails(ctx context.Context) (v []models.ItemAttachmentThumbnail, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnails is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnails: %w", err)
+	}
+	return oldValue.Thumbnails, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
-	switch name {
-	case item.EdgeOwner:
-		m.ClearOwner()
-		return nil
+// AppendThumbnails adds mat to the "thumbnails" field.
+func (m *AttachmentMutation) AppendThumbnails(mat []models.ItemAttachmentThumbnail) {
+	m.appendthumbnails = append(m.appendthumbnails, mat...)
+}
+
+// AppendedThumbnails returns the list of values that were appended to the "thumbnails" field in this mutation.
+func (m *AttachmentMutation) AppendedThumbnails() ([]models.ItemAttachmentThumbnail, bool) {
+	if len(m.appendthumbnails) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return m.appendthumbnails, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
-	switch name {
-	case item.EdgeOwner:
-		m.ResetOwner()
-		return nil
+// ClearThumbnails clears the value of the "thumbnails" field.
+func (m *AttachmentMutation) ClearThumbnails() {
+	m.thumbnails = nil
+	m.appendthumbnails = nil
+	m.clearedFields[attachment.FieldThumbnails] = struct{}{}
+}
+
+// ThumbnailsCleared returns if the "thumbnails" field was cleared in this mutation.
+func (m *AttachmentMutation) ThumbnailsCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldThumbnails]
+	return ok
+}
+
+// ResetThumbnails resets all changes to the "thumbnails" field.
+func (m *AttachmentMutation) ResetThumbnails() {
+	m.thumbnails = nil
+	m.appendthumbnails = nil
+	delete(m.clearedFields, attachment.FieldThumbnails)
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *AttachmentMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *AttachmentMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *AttachmentMutation) ItemIDs() (ids []uint) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *uint
-	create_time          *time.Time
-	update_time          *time.Time
-	name                 *string
-	email                *string
-	password             *string
-	is_active            *bool
-	is_super_user        *bool
-	verified             *bool
-	verification_code    *string
-	password_reset_token *string
-	password_reset_at    *time.Time
-	clearedFields        map[string]struct{}
-	items                map[uint]struct{}
-	removeditems         map[uint]struct{}
-	cleareditems         bool
-	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+// ResetItem resets all changes to the "item" edge.
+func (m *AttachmentMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// ClearUploader clears the "uploader" edge to the User entity.
+func (m *AttachmentMutation) ClearUploader() {
+	m.cleareduploader = true
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
+func (m *AttachmentMutation) UploaderCleared() bool {
+	return m.UploaderIDCleared() || m.cleareduploader
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// UploaderIDs returns the "uploader" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploaderID instead. It exists only for internal usage by the builders.
+func (m *AttachmentMutation) UploaderIDs() (ids []uint) {
+	if id := m.uploader; id != nil {
+		ids = append(ids, *id)
 	}
-	for _, opt := range opts {
-		opt(m)
+	return
+}
+
+// ResetUploader resets all changes to the "uploader" edge.
+func (m *AttachmentMutation) ResetUploader() {
+	m.uploader = nil
+	m.cleareduploader = false
+}
+
+// Where appends a list predicates to the AttachmentMutation builder.
+func (m *AttachmentMutation) Where(ps ...predicate.Attachment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AttachmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AttachmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Attachment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return m
+	m.Where(p...)
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uint) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
+// Op returns the operation name.
+func (m *AttachmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AttachmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Attachment).
+func (m *AttachmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttachmentMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, attachment.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, attachment.FieldUpdateTime)
+	}
+	if m.item != nil {
+		fields = append(fields, attachment.FieldItemID)
+	}
+	if m.uploader != nil {
+		fields = append(fields, attachment.FieldUploaderID)
+	}
+	if m.filename != nil {
+		fields = append(fields, attachment.FieldFilename)
+	}
+	if m.content_type != nil {
+		fields = append(fields, attachment.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, attachment.FieldSize)
+	}
+	if m.checksum != nil {
+		fields = append(fields, attachment.FieldChecksum)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, attachment.FieldStorageKey)
+	}
+	if m.processing_status != nil {
+		fields = append(fields, attachment.FieldProcessingStatus)
+	}
+	if m.width != nil {
+		fields = append(fields, attachment.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, attachment.FieldHeight)
+	}
+	if m.thumbnails != nil {
+		fields = append(fields, attachment.FieldThumbnails)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttachmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attachment.FieldCreateTime:
+		return m.CreateTime()
+	case attachment.FieldUpdateTime:
+		return m.UpdateTime()
+	case attachment.FieldItemID:
+		return m.ItemID()
+	case attachment.FieldUploaderID:
+		return m.UploaderID()
+	case attachment.FieldFilename:
+		return m.Filename()
+	case attachment.FieldContentType:
+		return m.ContentType()
+	case attachment.FieldSize:
+		return m.Size()
+	case attachment.FieldChecksum:
+		return m.Checksum()
+	case attachment.FieldStorageKey:
+		return m.StorageKey()
+	case attachment.FieldProcessingStatus:
+		return m.ProcessingStatus()
+	case attachment.FieldWidth:
+		return m.Width()
+	case attachment.FieldHeight:
+		return m.Height()
+	case attachment.FieldThumbnails:
+		return m.Thumbnails()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttachmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attachment.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case attachment.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case attachment.FieldItemID:
+		return m.OldItemID(ctx)
+	case attachment.FieldUploaderID:
+		return m.OldUploaderID(ctx)
+	case attachment.FieldFilename:
+		return m.OldFilename(ctx)
+	case attachment.FieldContentType:
+		return m.OldContentType(ctx)
+	case attachment.FieldSize:
+		return m.OldSize(ctx)
+	case attachment.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case attachment.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case attachment.FieldProcessingStatus:
+		return m.OldProcessingStatus(ctx)
+	case attachment.FieldWidth:
+		return m.OldWidth(ctx)
+	case attachment.FieldHeight:
+		return m.OldHeight(ctx)
+	case attachment.FieldThumbnails:
+		return m.OldThumbnails(ctx)
+	}
+	return nil, fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attachment.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.id = &id
+		m.SetCreateTime(v)
+		return nil
+	case attachment.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case attachment.FieldItemID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case attachment.FieldUploaderID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploaderID(v)
+		return nil
+	case attachment.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case attachment.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case attachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case attachment.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case attachment.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case attachment.FieldProcessingStatus:
+		v, ok := value.(attachment.ProcessingStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessingStatus(v)
+		return nil
+	case attachment.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case attachment.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case attachment.FieldThumbnails:
+		v, ok := value.([]models.ItemAttachmentThumbnail)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnails(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttachmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, attachment.FieldSize)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, attachment.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, attachment.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttachmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attachment.FieldSize:
+		return m.AddedSize()
+	case attachment.FieldWidth:
+		return m.AddedWidth()
+	case attachment.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case attachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case attachment.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case attachment.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttachmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(attachment.FieldUploaderID) {
+		fields = append(fields, attachment.FieldUploaderID)
+	}
+	if m.FieldCleared(attachment.FieldProcessingStatus) {
+		fields = append(fields, attachment.FieldProcessingStatus)
+	}
+	if m.FieldCleared(attachment.FieldWidth) {
+		fields = append(fields, attachment.FieldWidth)
+	}
+	if m.FieldCleared(attachment.FieldHeight) {
+		fields = append(fields, attachment.FieldHeight)
+	}
+	if m.FieldCleared(attachment.FieldThumbnails) {
+		fields = append(fields, attachment.FieldThumbnails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttachmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttachmentMutation) ClearField(name string) error {
+	switch name {
+	case attachment.FieldUploaderID:
+		m.ClearUploaderID()
+		return nil
+	case attachment.FieldProcessingStatus:
+		m.ClearProcessingStatus()
+		return nil
+	case attachment.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case attachment.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case attachment.FieldThumbnails:
+		m.ClearThumbnails()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttachmentMutation) ResetField(name string) error {
+	switch name {
+	case attachment.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case attachment.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case attachment.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case attachment.FieldUploaderID:
+		m.ResetUploaderID()
+		return nil
+	case attachment.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case attachment.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case attachment.FieldSize:
+		m.ResetSize()
+		return nil
+	case attachment.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case attachment.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case attachment.FieldProcessingStatus:
+		m.ResetProcessingStatus()
+		return nil
+	case attachment.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case attachment.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case attachment.FieldThumbnails:
+		m.ResetThumbnails()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttachmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, attachment.EdgeItem)
+	}
+	if m.uploader != nil {
+		edges = append(edges, attachment.EdgeUploader)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttachmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attachment.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case attachment.EdgeUploader:
+		if id := m.uploader; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttachmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttachmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttachmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, attachment.EdgeItem)
+	}
+	if m.cleareduploader {
+		edges = append(edges, attachment.EdgeUploader)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttachmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attachment.EdgeItem:
+		return m.cleareditem
+	case attachment.EdgeUploader:
+		return m.cleareduploader
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttachmentMutation) ClearEdge(name string) error {
+	switch name {
+	case attachment.EdgeItem:
+		m.ClearItem()
+		return nil
+	case attachment.EdgeUploader:
+		m.ClearUploader()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttachmentMutation) ResetEdge(name string) error {
+	switch name {
+	case attachment.EdgeItem:
+		m.ResetItem()
+		return nil
+	case attachment.EdgeUploader:
+		m.ResetUploader()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment edge %s", name)
+}
+
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	body          *string
+	edited_at     *time.Time
+	clearedFields map[string]struct{}
+	item          *uint
+	cleareditem   bool
+	author        *uint
+	clearedauthor bool
+	done          bool
+	oldValue      func(context.Context) (*Comment, error)
+	predicates    []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id uint) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *CommentMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *CommentMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *CommentMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *CommentMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *CommentMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *CommentMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *CommentMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *CommentMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *CommentMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[comment.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *CommentMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *CommentMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, comment.FieldDeleteTime)
+}
+
+// SetItemID sets the "item_id" field.
+func (m *CommentMutation) SetItemID(u uint) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *CommentMutation) ItemID() (r uint, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldItemID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *CommentMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *CommentMutation) SetAuthorID(u uint) {
+	m.author = &u
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *CommentMutation) AuthorID() (r uint, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthorID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *CommentMutation) ClearAuthorID() {
+	m.author = nil
+	m.clearedFields[comment.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *CommentMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *CommentMutation) ResetAuthorID() {
+	m.author = nil
+	delete(m.clearedFields, comment.FieldAuthorID)
+}
+
+// SetBody sets the "body" field.
+func (m *CommentMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CommentMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CommentMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *CommentMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *CommentMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *CommentMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[comment.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *CommentMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *CommentMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, comment.FieldEditedAt)
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *CommentMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *CommentMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ItemIDs() (ids []uint) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *CommentMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *CommentMutation) ClearAuthor() {
+	m.clearedauthor = true
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *CommentMutation) AuthorCleared() bool {
+	return m.AuthorIDCleared() || m.clearedauthor
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) AuthorIDs() (ids []uint) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *CommentMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, comment.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, comment.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, comment.FieldDeleteTime)
+	}
+	if m.item != nil {
+		fields = append(fields, comment.FieldItemID)
+	}
+	if m.author != nil {
+		fields = append(fields, comment.FieldAuthorID)
+	}
+	if m.body != nil {
+		fields = append(fields, comment.FieldBody)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldCreateTime:
+		return m.CreateTime()
+	case comment.FieldUpdateTime:
+		return m.UpdateTime()
+	case comment.FieldDeleteTime:
+		return m.DeleteTime()
+	case comment.FieldItemID:
+		return m.ItemID()
+	case comment.FieldAuthorID:
+		return m.AuthorID()
+	case comment.FieldBody:
+		return m.Body()
+	case comment.FieldEditedAt:
+		return m.EditedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case comment.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case comment.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case comment.FieldItemID:
+		return m.OldItemID(ctx)
+	case comment.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case comment.FieldBody:
+		return m.OldBody(ctx)
+	case comment.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case comment.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case comment.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case comment.FieldItemID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case comment.FieldAuthorID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case comment.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case comment.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldDeleteTime) {
+		fields = append(fields, comment.FieldDeleteTime)
+	}
+	if m.FieldCleared(comment.FieldAuthorID) {
+		fields = append(fields, comment.FieldAuthorID)
+	}
+	if m.FieldCleared(comment.FieldEditedAt) {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case comment.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case comment.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case comment.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case comment.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case comment.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case comment.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case comment.FieldBody:
+		m.ResetBody()
+		return nil
+	case comment.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, comment.EdgeItem)
+	}
+	if m.author != nil {
+		edges = append(edges, comment.EdgeAuthor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, comment.EdgeItem)
+	}
+	if m.clearedauthor {
+		edges = append(edges, comment.EdgeAuthor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case comment.EdgeItem:
+		return m.cleareditem
+	case comment.EdgeAuthor:
+		return m.clearedauthor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	switch name {
+	case comment.EdgeItem:
+		m.ClearItem()
+		return nil
+	case comment.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	switch name {
+	case comment.EdgeItem:
+		m.ResetItem()
+		return nil
+	case comment.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uint
+	create_time                *time.Time
+	update_time                *time.Time
+	delete_time                *time.Time
+	version                    *int
+	addversion                 *int
+	title                      *string
+	description                *string
+	status                     *string
+	metadata                   *map[string]interface{}
+	due_at                     *time.Time
+	clearedFields              map[string]struct{}
+	owner                      *uint
+	clearedowner               bool
+	shares                     map[uint]struct{}
+	removedshares              map[uint]struct{}
+	clearedshares              bool
+	revisions                  map[uint]struct{}
+	removedrevisions           map[uint]struct{}
+	clearedrevisions           bool
+	tags                       map[uint]struct{}
+	removedtags                map[uint]struct{}
+	clearedtags                bool
+	attachments                map[uint]struct{}
+	removedattachments         map[uint]struct{}
+	clearedattachments         bool
+	comments                   map[uint]struct{}
+	removedcomments            map[uint]struct{}
+	clearedcomments            bool
+	transitions                map[uint]struct{}
+	removedtransitions         map[uint]struct{}
+	clearedtransitions         bool
+	ownership_transfers        map[uint]struct{}
+	removedownership_transfers map[uint]struct{}
+	clearedownership_transfers bool
+	parent                     *uint
+	clearedparent              bool
+	children                   map[uint]struct{}
+	removedchildren            map[uint]struct{}
+	clearedchildren            bool
+	done                       bool
+	oldValue                   func(context.Context) (*Item, error)
+	predicates                 []predicate.Item
+}
+
+var _ ent.Mutation = (*ItemMutation)(nil)
+
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
+
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemID sets the ID field of the mutation.
+func withItemID(id uint) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Item entities.
+func (m *ItemMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ItemMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ItemMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ItemMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ItemMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ItemMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ItemMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *ItemMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *ItemMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *ItemMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[item.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *ItemMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[item.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *ItemMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, item.FieldDeleteTime)
+}
+
+// SetVersion sets the "version" field.
+func (m *ItemMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ItemMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ItemMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ItemMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ItemMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ItemMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ItemMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ItemMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ItemMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ItemMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ItemMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *ItemMutation) SetOwnerID(u uint) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *ItemMutation) OwnerID() (r uint, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldOwnerID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *ItemMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ItemMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ItemMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ItemMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ItemMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ItemMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ItemMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[item.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ItemMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[item.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ItemMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, item.FieldMetadata)
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *ItemMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *ItemMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *ItemMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[item.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *ItemMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[item.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *ItemMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, item.FieldDueAt)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *ItemMutation) SetParentID(u uint) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *ItemMutation) ParentID() (r uint, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldParentID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *ItemMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[item.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *ItemMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *ItemMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, item.FieldParentID)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ItemMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ItemMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) OwnerIDs() (ids []uint) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ItemMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddShareIDs adds the "shares" edge to the ItemShare entity by ids.
+func (m *ItemMutation) AddShareIDs(ids ...uint) {
+	if m.shares == nil {
+		m.shares = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the ItemShare entity.
+func (m *ItemMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the ItemShare entity was cleared.
+func (m *ItemMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the ItemShare entity by IDs.
+func (m *ItemMutation) RemoveShareIDs(ids ...uint) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the ItemShare entity.
+func (m *ItemMutation) RemovedSharesIDs() (ids []uint) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *ItemMutation) SharesIDs() (ids []uint) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *ItemMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by ids.
+func (m *ItemMutation) AddRevisionIDs(ids ...uint) {
+	if m.revisions == nil {
+		m.revisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the ItemRevision entity.
+func (m *ItemMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the ItemRevision entity was cleared.
+func (m *ItemMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the ItemRevision entity by IDs.
+func (m *ItemMutation) RemoveRevisionIDs(ids ...uint) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the ItemRevision entity.
+func (m *ItemMutation) RemovedRevisionsIDs() (ids []uint) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *ItemMutation) RevisionsIDs() (ids []uint) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *ItemMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *ItemMutation) AddTagIDs(ids ...uint) {
+	if m.tags == nil {
+		m.tags = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *ItemMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *ItemMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *ItemMutation) RemoveTagIDs(ids ...uint) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *ItemMutation) RemovedTagsIDs() (ids []uint) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *ItemMutation) TagsIDs() (ids []uint) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *ItemMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *ItemMutation) AddAttachmentIDs(ids ...uint) {
+	if m.attachments == nil {
+		m.attachments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *ItemMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *ItemMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *ItemMutation) RemoveAttachmentIDs(ids ...uint) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *ItemMutation) RemovedAttachmentsIDs() (ids []uint) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *ItemMutation) AttachmentsIDs() (ids []uint) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *ItemMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *ItemMutation) AddCommentIDs(ids ...uint) {
+	if m.comments == nil {
+		m.comments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *ItemMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *ItemMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *ItemMutation) RemoveCommentIDs(ids ...uint) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *ItemMutation) RemovedCommentsIDs() (ids []uint) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *ItemMutation) CommentsIDs() (ids []uint) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *ItemMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddTransitionIDs adds the "transitions" edge to the ItemTransition entity by ids.
+func (m *ItemMutation) AddTransitionIDs(ids ...uint) {
+	if m.transitions == nil {
+		m.transitions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.transitions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransitions clears the "transitions" edge to the ItemTransition entity.
+func (m *ItemMutation) ClearTransitions() {
+	m.clearedtransitions = true
+}
+
+// TransitionsCleared reports if the "transitions" edge to the ItemTransition entity was cleared.
+func (m *ItemMutation) TransitionsCleared() bool {
+	return m.clearedtransitions
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to the ItemTransition entity by IDs.
+func (m *ItemMutation) RemoveTransitionIDs(ids ...uint) {
+	if m.removedtransitions == nil {
+		m.removedtransitions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.transitions, ids[i])
+		m.removedtransitions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransitions returns the removed IDs of the "transitions" edge to the ItemTransition entity.
+func (m *ItemMutation) RemovedTransitionsIDs() (ids []uint) {
+	for id := range m.removedtransitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransitionsIDs returns the "transitions" edge IDs in the mutation.
+func (m *ItemMutation) TransitionsIDs() (ids []uint) {
+	for id := range m.transitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransitions resets all changes to the "transitions" edge.
+func (m *ItemMutation) ResetTransitions() {
+	m.transitions = nil
+	m.clearedtransitions = false
+	m.removedtransitions = nil
+}
+
+// AddOwnershipTransferIDs adds the "ownership_transfers" edge to the OwnershipTransfer entity by ids.
+func (m *ItemMutation) AddOwnershipTransferIDs(ids ...uint) {
+	if m.ownership_transfers == nil {
+		m.ownership_transfers = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.ownership_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOwnershipTransfers clears the "ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *ItemMutation) ClearOwnershipTransfers() {
+	m.clearedownership_transfers = true
+}
+
+// OwnershipTransfersCleared reports if the "ownership_transfers" edge to the OwnershipTransfer entity was cleared.
+func (m *ItemMutation) OwnershipTransfersCleared() bool {
+	return m.clearedownership_transfers
+}
+
+// RemoveOwnershipTransferIDs removes the "ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (m *ItemMutation) RemoveOwnershipTransferIDs(ids ...uint) {
+	if m.removedownership_transfers == nil {
+		m.removedownership_transfers = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.ownership_transfers, ids[i])
+		m.removedownership_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOwnershipTransfers returns the removed IDs of the "ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *ItemMutation) RemovedOwnershipTransfersIDs() (ids []uint) {
+	for id := range m.removedownership_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OwnershipTransfersIDs returns the "ownership_transfers" edge IDs in the mutation.
+func (m *ItemMutation) OwnershipTransfersIDs() (ids []uint) {
+	for id := range m.ownership_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOwnershipTransfers resets all changes to the "ownership_transfers" edge.
+func (m *ItemMutation) ResetOwnershipTransfers() {
+	m.ownership_transfers = nil
+	m.clearedownership_transfers = false
+	m.removedownership_transfers = nil
+}
+
+// ClearParent clears the "parent" edge to the Item entity.
+func (m *ItemMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Item entity was cleared.
+func (m *ItemMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) ParentIDs() (ids []uint) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *ItemMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by ids.
+func (m *ItemMutation) AddChildIDs(ids ...uint) {
+	if m.children == nil {
+		m.children = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Item entity.
+func (m *ItemMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Item entity was cleared.
+func (m *ItemMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Item entity by IDs.
+func (m *ItemMutation) RemoveChildIDs(ids ...uint) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Item entity.
+func (m *ItemMutation) RemovedChildrenIDs() (ids []uint) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *ItemMutation) ChildrenIDs() (ids []uint) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *ItemMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, item.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, item.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, item.FieldDeleteTime)
+	}
+	if m.version != nil {
+		fields = append(fields, item.FieldVersion)
+	}
+	if m.title != nil {
+		fields = append(fields, item.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.owner != nil {
+		fields = append(fields, item.FieldOwnerID)
+	}
+	if m.status != nil {
+		fields = append(fields, item.FieldStatus)
+	}
+	if m.metadata != nil {
+		fields = append(fields, item.FieldMetadata)
+	}
+	if m.due_at != nil {
+		fields = append(fields, item.FieldDueAt)
+	}
+	if m.parent != nil {
+		fields = append(fields, item.FieldParentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldCreateTime:
+		return m.CreateTime()
+	case item.FieldUpdateTime:
+		return m.UpdateTime()
+	case item.FieldDeleteTime:
+		return m.DeleteTime()
+	case item.FieldVersion:
+		return m.Version()
+	case item.FieldTitle:
+		return m.Title()
+	case item.FieldDescription:
+		return m.Description()
+	case item.FieldOwnerID:
+		return m.OwnerID()
+	case item.FieldStatus:
+		return m.Status()
+	case item.FieldMetadata:
+		return m.Metadata()
+	case item.FieldDueAt:
+		return m.DueAt()
+	case item.FieldParentID:
+		return m.ParentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case item.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case item.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case item.FieldVersion:
+		return m.OldVersion(ctx)
+	case item.FieldTitle:
+		return m.OldTitle(ctx)
+	case item.FieldDescription:
+		return m.OldDescription(ctx)
+	case item.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case item.FieldStatus:
+		return m.OldStatus(ctx)
+	case item.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case item.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case item.FieldParentID:
+		return m.OldParentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case item.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case item.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case item.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case item.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case item.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case item.FieldOwnerID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case item.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case item.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case item.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case item.FieldParentID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, item.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldDeleteTime) {
+		fields = append(fields, item.FieldDeleteTime)
+	}
+	if m.FieldCleared(item.FieldMetadata) {
+		fields = append(fields, item.FieldMetadata)
+	}
+	if m.FieldCleared(item.FieldDueAt) {
+		fields = append(fields, item.FieldDueAt)
+	}
+	if m.FieldCleared(item.FieldParentID) {
+		fields = append(fields, item.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case item.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case item.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case item.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case item.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case item.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case item.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case item.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case item.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case item.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case item.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case item.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case item.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case item.FieldParentID:
+		m.ResetParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.owner != nil {
+		edges = append(edges, item.EdgeOwner)
+	}
+	if m.shares != nil {
+		edges = append(edges, item.EdgeShares)
+	}
+	if m.revisions != nil {
+		edges = append(edges, item.EdgeRevisions)
+	}
+	if m.tags != nil {
+		edges = append(edges, item.EdgeTags)
+	}
+	if m.attachments != nil {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	if m.comments != nil {
+		edges = append(edges, item.EdgeComments)
+	}
+	if m.transitions != nil {
+		edges = append(edges, item.EdgeTransitions)
+	}
+	if m.ownership_transfers != nil {
+		edges = append(edges, item.EdgeOwnershipTransfers)
+	}
+	if m.parent != nil {
+		edges = append(edges, item.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, item.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeTransitions:
+		ids := make([]ent.Value, 0, len(m.transitions))
+		for id := range m.transitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.ownership_transfers))
+		for id := range m.ownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedshares != nil {
+		edges = append(edges, item.EdgeShares)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, item.EdgeRevisions)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, item.EdgeTags)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, item.EdgeComments)
+	}
+	if m.removedtransitions != nil {
+		edges = append(edges, item.EdgeTransitions)
+	}
+	if m.removedownership_transfers != nil {
+		edges = append(edges, item.EdgeOwnershipTransfers)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, item.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeTransitions:
+		ids := make([]ent.Value, 0, len(m.removedtransitions))
+		for id := range m.removedtransitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.removedownership_transfers))
+		for id := range m.removedownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedowner {
+		edges = append(edges, item.EdgeOwner)
+	}
+	if m.clearedshares {
+		edges = append(edges, item.EdgeShares)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, item.EdgeRevisions)
+	}
+	if m.clearedtags {
+		edges = append(edges, item.EdgeTags)
+	}
+	if m.clearedattachments {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	if m.clearedcomments {
+		edges = append(edges, item.EdgeComments)
+	}
+	if m.clearedtransitions {
+		edges = append(edges, item.EdgeTransitions)
+	}
+	if m.clearedownership_transfers {
+		edges = append(edges, item.EdgeOwnershipTransfers)
+	}
+	if m.clearedparent {
+		edges = append(edges, item.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, item.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeOwner:
+		return m.clearedowner
+	case item.EdgeShares:
+		return m.clearedshares
+	case item.EdgeRevisions:
+		return m.clearedrevisions
+	case item.EdgeTags:
+		return m.clearedtags
+	case item.EdgeAttachments:
+		return m.clearedattachments
+	case item.EdgeComments:
+		return m.clearedcomments
+	case item.EdgeTransitions:
+		return m.clearedtransitions
+	case item.EdgeOwnershipTransfers:
+		return m.clearedownership_transfers
+	case item.EdgeParent:
+		return m.clearedparent
+	case item.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case item.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case item.EdgeShares:
+		m.ResetShares()
+		return nil
+	case item.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case item.EdgeTags:
+		m.ResetTags()
+		return nil
+	case item.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case item.EdgeComments:
+		m.ResetComments()
+		return nil
+	case item.EdgeTransitions:
+		m.ResetTransitions()
+		return nil
+	case item.EdgeOwnershipTransfers:
+		m.ResetOwnershipTransfers()
+		return nil
+	case item.EdgeParent:
+		m.ResetParent()
+		return nil
+	case item.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// ItemImportMutation represents an operation that mutates the ItemImport nodes in the graph.
+type ItemImportMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uint
+	create_time     *time.Time
+	update_time     *time.Time
+	format          *itemimport.Format
+	status          *itemimport.Status
+	storage_key     *string
+	size            *int64
+	addsize         *int64
+	read_bytes      *int64
+	addread_bytes   *int64
+	created_rows    *int
+	addcreated_rows *int
+	failed_rows     *int
+	addfailed_rows  *int
+	errors          *[]models.ItemImportRowError
+	appenderrors    []models.ItemImportRowError
+	error           *string
+	finish_time     *time.Time
+	clearedFields   map[string]struct{}
+	owner           *uint
+	clearedowner    bool
+	done            bool
+	oldValue        func(context.Context) (*ItemImport, error)
+	predicates      []predicate.ItemImport
+}
+
+var _ ent.Mutation = (*ItemImportMutation)(nil)
+
+// itemimportOption allows management of the mutation configuration using functional options.
+type itemimportOption func(*ItemImportMutation)
+
+// newItemImportMutation creates new mutation for the ItemImport entity.
+func newItemImportMutation(c config, op Op, opts ...itemimportOption) *ItemImportMutation {
+	m := &ItemImportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemImport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemImportID sets the ID field of the mutation.
+func withItemImportID(id uint) itemimportOption {
+	return func(m *ItemImportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemImport
+		)
+		m.oldValue = func(ctx context.Context) (*ItemImport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemImport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemImport sets the old ItemImport of the mutation.
+func withItemImport(node *ItemImport) itemimportOption {
+	return func(m *ItemImportMutation) {
+		m.oldValue = func(context.Context) (*ItemImport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemImportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemImportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemImport entities.
+func (m *ItemImportMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemImportMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemImportMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemImport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ItemImportMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ItemImportMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ItemImportMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ItemImportMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ItemImportMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ItemImportMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *ItemImportMutation) SetOwnerID(u uint) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *ItemImportMutation) OwnerID() (r uint, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldOwnerID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *ItemImportMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *ItemImportMutation) SetFormat(i itemimport.Format) {
+	m.format = &i
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ItemImportMutation) Format() (r itemimport.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldFormat(ctx context.Context) (v itemimport.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *ItemImportMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ItemImportMutation) SetStatus(i itemimport.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ItemImportMutation) Status() (r itemimport.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldStatus(ctx context.Context) (v itemimport.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ItemImportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *ItemImportMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *ItemImportMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageKey returns the old "storage_key" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
+}
+
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *ItemImportMutation) ResetStorageKey() {
+	m.storage_key = nil
+}
+
+// SetSize sets the "size" field.
+func (m *ItemImportMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *ItemImportMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *ItemImportMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ItemImportMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *ItemImportMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetReadBytes sets the "read_bytes" field.
+func (m *ItemImportMutation) SetReadBytes(i int64) {
+	m.read_bytes = &i
+	m.addread_bytes = nil
+}
+
+// ReadBytes returns the value of the "read_bytes" field in the mutation.
+func (m *ItemImportMutation) ReadBytes() (r int64, exists bool) {
+	v := m.read_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadBytes returns the old "read_bytes" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldReadBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadBytes: %w", err)
+	}
+	return oldValue.ReadBytes, nil
+}
+
+// AddReadBytes adds i to the "read_bytes" field.
+func (m *ItemImportMutation) AddReadBytes(i int64) {
+	if m.addread_bytes != nil {
+		*m.addread_bytes += i
+	} else {
+		m.addread_bytes = &i
+	}
+}
+
+// AddedReadBytes returns the value that was added to the "read_bytes" field in this mutation.
+func (m *ItemImportMutation) AddedReadBytes() (r int64, exists bool) {
+	v := m.addread_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReadBytes resets all changes to the "read_bytes" field.
+func (m *ItemImportMutation) ResetReadBytes() {
+	m.read_bytes = nil
+	m.addread_bytes = nil
+}
+
+// SetCreatedRows sets the "created_rows" field.
+func (m *ItemImportMutation) SetCreatedRows(i int) {
+	m.created_rows = &i
+	m.addcreated_rows = nil
+}
+
+// CreatedRows returns the value of the "created_rows" field in the mutation.
+func (m *ItemImportMutation) CreatedRows() (r int, exists bool) {
+	v := m.created_rows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedRows returns the old "created_rows" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldCreatedRows(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedRows is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedRows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedRows: %w", err)
+	}
+	return oldValue.CreatedRows, nil
+}
+
+// AddCreatedRows adds i to the "created_rows" field.
+func (m *ItemImportMutation) AddCreatedRows(i int) {
+	if m.addcreated_rows != nil {
+		*m.addcreated_rows += i
+	} else {
+		m.addcreated_rows = &i
+	}
+}
+
+// AddedCreatedRows returns the value that was added to the "created_rows" field in this mutation.
+func (m *ItemImportMutation) AddedCreatedRows() (r int, exists bool) {
+	v := m.addcreated_rows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedRows resets all changes to the "created_rows" field.
+func (m *ItemImportMutation) ResetCreatedRows() {
+	m.created_rows = nil
+	m.addcreated_rows = nil
+}
+
+// SetFailedRows sets the "failed_rows" field.
+func (m *ItemImportMutation) SetFailedRows(i int) {
+	m.failed_rows = &i
+	m.addfailed_rows = nil
+}
+
+// FailedRows returns the value of the "failed_rows" field in the mutation.
+func (m *ItemImportMutation) FailedRows() (r int, exists bool) {
+	v := m.failed_rows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedRows returns the old "failed_rows" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldFailedRows(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedRows is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedRows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedRows: %w", err)
+	}
+	return oldValue.FailedRows, nil
+}
+
+// AddFailedRows adds i to the "failed_rows" field.
+func (m *ItemImportMutation) AddFailedRows(i int) {
+	if m.addfailed_rows != nil {
+		*m.addfailed_rows += i
+	} else {
+		m.addfailed_rows = &i
+	}
+}
+
+// AddedFailedRows returns the value that was added to the "failed_rows" field in this mutation.
+func (m *ItemImportMutation) AddedFailedRows() (r int, exists bool) {
+	v := m.addfailed_rows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedRows resets all changes to the "failed_rows" field.
+func (m *ItemImportMutation) ResetFailedRows() {
+	m.failed_rows = nil
+	m.addfailed_rows = nil
+}
+
+// SetErrors sets the "errors" field.
+func (m *ItemImportMutation) SetErrors(mire []models.ItemImportRowError) {
+	m.errors = &mire
+	m.appenderrors = nil
+}
+
+// Errors returns the value of the "errors" field in the mutation.
+func (m *ItemImportMutation) Errors() (r []models.ItemImportRowError, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrors returns the old "errors" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldErrors(ctx context.Context) (v []models.ItemImportRowError, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	}
+	return oldValue.Errors, nil
+}
+
+// AppendErrors adds mire to the "errors" field.
+func (m *ItemImportMutation) AppendErrors(mire []models.ItemImportRowError) {
+	m.appenderrors = append(m.appenderrors, mire...)
+}
+
+// AppendedErrors returns the list of values that were appended to the "errors" field in this mutation.
+func (m *ItemImportMutation) AppendedErrors() ([]models.ItemImportRowError, bool) {
+	if len(m.appenderrors) == 0 {
+		return nil, false
+	}
+	return m.appenderrors, true
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (m *ItemImportMutation) ClearErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	m.clearedFields[itemimport.FieldErrors] = struct{}{}
+}
+
+// ErrorsCleared returns if the "errors" field was cleared in this mutation.
+func (m *ItemImportMutation) ErrorsCleared() bool {
+	_, ok := m.clearedFields[itemimport.FieldErrors]
+	return ok
+}
+
+// ResetErrors resets all changes to the "errors" field.
+func (m *ItemImportMutation) ResetErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	delete(m.clearedFields, itemimport.FieldErrors)
+}
+
+// SetError sets the "error" field.
+func (m *ItemImportMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ItemImportMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ItemImportMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[itemimport.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ItemImportMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[itemimport.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ItemImportMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, itemimport.FieldError)
+}
+
+// SetFinishTime sets the "finish_time" field.
+func (m *ItemImportMutation) SetFinishTime(t time.Time) {
+	m.finish_time = &t
+}
+
+// FinishTime returns the value of the "finish_time" field in the mutation.
+func (m *ItemImportMutation) FinishTime() (r time.Time, exists bool) {
+	v := m.finish_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishTime returns the old "finish_time" field's value of the ItemImport entity.
+// If the ItemImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemImportMutation) OldFinishTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishTime: %w", err)
+	}
+	return oldValue.FinishTime, nil
+}
+
+// ClearFinishTime clears the value of the "finish_time" field.
+func (m *ItemImportMutation) ClearFinishTime() {
+	m.finish_time = nil
+	m.clearedFields[itemimport.FieldFinishTime] = struct{}{}
+}
+
+// FinishTimeCleared returns if the "finish_time" field was cleared in this mutation.
+func (m *ItemImportMutation) FinishTimeCleared() bool {
+	_, ok := m.clearedFields[itemimport.FieldFinishTime]
+	return ok
+}
+
+// ResetFinishTime resets all changes to the "finish_time" field.
+func (m *ItemImportMutation) ResetFinishTime() {
+	m.finish_time = nil
+	delete(m.clearedFields, itemimport.FieldFinishTime)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ItemImportMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ItemImportMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ItemImportMutation) OwnerIDs() (ids []uint) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ItemImportMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the ItemImportMutation builder.
+func (m *ItemImportMutation) Where(ps ...predicate.ItemImport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemImportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemImportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemImport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemImportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemImportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemImport).
+func (m *ItemImportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemImportMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, itemimport.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, itemimport.FieldUpdateTime)
+	}
+	if m.owner != nil {
+		fields = append(fields, itemimport.FieldOwnerID)
+	}
+	if m.format != nil {
+		fields = append(fields, itemimport.FieldFormat)
+	}
+	if m.status != nil {
+		fields = append(fields, itemimport.FieldStatus)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, itemimport.FieldStorageKey)
+	}
+	if m.size != nil {
+		fields = append(fields, itemimport.FieldSize)
+	}
+	if m.read_bytes != nil {
+		fields = append(fields, itemimport.FieldReadBytes)
+	}
+	if m.created_rows != nil {
+		fields = append(fields, itemimport.FieldCreatedRows)
+	}
+	if m.failed_rows != nil {
+		fields = append(fields, itemimport.FieldFailedRows)
+	}
+	if m.errors != nil {
+		fields = append(fields, itemimport.FieldErrors)
+	}
+	if m.error != nil {
+		fields = append(fields, itemimport.FieldError)
+	}
+	if m.finish_time != nil {
+		fields = append(fields, itemimport.FieldFinishTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemImportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemimport.FieldCreateTime:
+		return m.CreateTime()
+	case itemimport.FieldUpdateTime:
+		return m.UpdateTime()
+	case itemimport.FieldOwnerID:
+		return m.OwnerID()
+	case itemimport.FieldFormat:
+		return m.Format()
+	case itemimport.FieldStatus:
+		return m.Status()
+	case itemimport.FieldStorageKey:
+		return m.StorageKey()
+	case itemimport.FieldSize:
+		return m.Size()
+	case itemimport.FieldReadBytes:
+		return m.ReadBytes()
+	case itemimport.FieldCreatedRows:
+		return m.CreatedRows()
+	case itemimport.FieldFailedRows:
+		return m.FailedRows()
+	case itemimport.FieldErrors:
+		return m.Errors()
+	case itemimport.FieldError:
+		return m.Error()
+	case itemimport.FieldFinishTime:
+		return m.FinishTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemImportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemimport.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case itemimport.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case itemimport.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case itemimport.FieldFormat:
+		return m.OldFormat(ctx)
+	case itemimport.FieldStatus:
+		return m.OldStatus(ctx)
+	case itemimport.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case itemimport.FieldSize:
+		return m.OldSize(ctx)
+	case itemimport.FieldReadBytes:
+		return m.OldReadBytes(ctx)
+	case itemimport.FieldCreatedRows:
+		return m.OldCreatedRows(ctx)
+	case itemimport.FieldFailedRows:
+		return m.OldFailedRows(ctx)
+	case itemimport.FieldErrors:
+		return m.OldErrors(ctx)
+	case itemimport.FieldError:
+		return m.OldError(ctx)
+	case itemimport.FieldFinishTime:
+		return m.OldFinishTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemImport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemImportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemimport.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case itemimport.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case itemimport.FieldOwnerID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case itemimport.FieldFormat:
+		v, ok := value.(itemimport.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case itemimport.FieldStatus:
+		v, ok := value.(itemimport.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case itemimport.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case itemimport.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case itemimport.FieldReadBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadBytes(v)
+		return nil
+	case itemimport.FieldCreatedRows:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedRows(v)
+		return nil
+	case itemimport.FieldFailedRows:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedRows(v)
+		return nil
+	case itemimport.FieldErrors:
+		v, ok := value.([]models.ItemImportRowError)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	case itemimport.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case itemimport.FieldFinishTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemImport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemImportMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, itemimport.FieldSize)
+	}
+	if m.addread_bytes != nil {
+		fields = append(fields, itemimport.FieldReadBytes)
+	}
+	if m.addcreated_rows != nil {
+		fields = append(fields, itemimport.FieldCreatedRows)
+	}
+	if m.addfailed_rows != nil {
+		fields = append(fields, itemimport.FieldFailedRows)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemImportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case itemimport.FieldSize:
+		return m.AddedSize()
+	case itemimport.FieldReadBytes:
+		return m.AddedReadBytes()
+	case itemimport.FieldCreatedRows:
+		return m.AddedCreatedRows()
+	case itemimport.FieldFailedRows:
+		return m.AddedFailedRows()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemImportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case itemimport.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case itemimport.FieldReadBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReadBytes(v)
+		return nil
+	case itemimport.FieldCreatedRows:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedRows(v)
+		return nil
+	case itemimport.FieldFailedRows:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedRows(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemImport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemImportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemimport.FieldErrors) {
+		fields = append(fields, itemimport.FieldErrors)
+	}
+	if m.FieldCleared(itemimport.FieldError) {
+		fields = append(fields, itemimport.FieldError)
+	}
+	if m.FieldCleared(itemimport.FieldFinishTime) {
+		fields = append(fields, itemimport.FieldFinishTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemImportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemImportMutation) ClearField(name string) error {
+	switch name {
+	case itemimport.FieldErrors:
+		m.ClearErrors()
+		return nil
+	case itemimport.FieldError:
+		m.ClearError()
+		return nil
+	case itemimport.FieldFinishTime:
+		m.ClearFinishTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemImport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemImportMutation) ResetField(name string) error {
+	switch name {
+	case itemimport.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case itemimport.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case itemimport.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case itemimport.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case itemimport.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case itemimport.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case itemimport.FieldSize:
+		m.ResetSize()
+		return nil
+	case itemimport.FieldReadBytes:
+		m.ResetReadBytes()
+		return nil
+	case itemimport.FieldCreatedRows:
+		m.ResetCreatedRows()
+		return nil
+	case itemimport.FieldFailedRows:
+		m.ResetFailedRows()
+		return nil
+	case itemimport.FieldErrors:
+		m.ResetErrors()
+		return nil
+	case itemimport.FieldError:
+		m.ResetError()
+		return nil
+	case itemimport.FieldFinishTime:
+		m.ResetFinishTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemImport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemImportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, itemimport.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemImportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemimport.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemImportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemImportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemImportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, itemimport.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemImportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemimport.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemImportMutation) ClearEdge(name string) error {
+	switch name {
+	case itemimport.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemImport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemImportMutation) ResetEdge(name string) error {
+	switch name {
+	case itemimport.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemImport edge %s", name)
+}
+
+// ItemRevisionMutation represents an operation that mutates the ItemRevision nodes in the graph.
+type ItemRevisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	create_time   *time.Time
+	action        *itemrevision.Action
+	snapshot      *models.ItemSnapshot
+	changes       *[]models.FieldChange
+	appendchanges []models.FieldChange
+	clearedFields map[string]struct{}
+	item          *uint
+	cleareditem   bool
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ItemRevision, error)
+	predicates    []predicate.ItemRevision
+}
+
+var _ ent.Mutation = (*ItemRevisionMutation)(nil)
+
+// itemrevisionOption allows management of the mutation configuration using functional options.
+type itemrevisionOption func(*ItemRevisionMutation)
+
+// newItemRevisionMutation creates new mutation for the ItemRevision entity.
+func newItemRevisionMutation(c config, op Op, opts ...itemrevisionOption) *ItemRevisionMutation {
+	m := &ItemRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemRevisionID sets the ID field of the mutation.
+func withItemRevisionID(id uint) itemrevisionOption {
+	return func(m *ItemRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemRevision
+		)
+		m.oldValue = func(ctx context.Context) (*ItemRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemRevision sets the old ItemRevision of the mutation.
+func withItemRevision(node *ItemRevision) itemrevisionOption {
+	return func(m *ItemRevisionMutation) {
+		m.oldValue = func(context.Context) (*ItemRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemRevision entities.
+func (m *ItemRevisionMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemRevisionMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemRevisionMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ItemRevisionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ItemRevisionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ItemRevision entity.
+// If the ItemRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemRevisionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ItemRevisionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ItemRevisionMutation) SetItemID(u uint) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ItemRevisionMutation) ItemID() (r uint, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the ItemRevision entity.
+// If the ItemRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemRevisionMutation) OldItemID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ItemRevisionMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ItemRevisionMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ItemRevisionMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ItemRevision entity.
+// If the ItemRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemRevisionMutation) OldUserID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ItemRevisionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[itemrevision.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ItemRevisionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[itemrevision.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ItemRevisionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, itemrevision.FieldUserID)
+}
+
+// SetAction sets the "action" field.
+func (m *ItemRevisionMutation) SetAction(i itemrevision.Action) {
+	m.action = &i
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ItemRevisionMutation) Action() (r itemrevision.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ItemRevision entity.
+// If the ItemRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemRevisionMutation) OldAction(ctx context.Context) (v itemrevision.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ItemRevisionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (m *ItemRevisionMutation) SetSnapshot(ms models.ItemSnapshot) {
+	m.snapshot = &ms
+}
+
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *ItemRevisionMutation) Snapshot() (r models.ItemSnapshot, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshot returns the old "snapshot" field's value of the ItemRevision entity.
+// If the ItemRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemRevisionMutation) OldSnapshot(ctx context.Context) (v models.ItemSnapshot, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
+}
+
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *ItemRevisionMutation) ResetSnapshot() {
+	m.snapshot = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *ItemRevisionMutation) SetChanges(mc []models.FieldChange) {
+	m.changes = &mc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *ItemRevisionMutation) Changes() (r []models.FieldChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the ItemRevision entity.
+// If the ItemRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemRevisionMutation) OldChanges(ctx context.Context) (v []models.FieldChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds mc to the "changes" field.
+func (m *ItemRevisionMutation) AppendChanges(mc []models.FieldChange) {
+	m.appendchanges = append(m.appendchanges, mc...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *ItemRevisionMutation) AppendedChanges() ([]models.FieldChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *ItemRevisionMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ItemRevisionMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ItemRevisionMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ItemRevisionMutation) ItemIDs() (ids []uint) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ItemRevisionMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ItemRevisionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ItemRevisionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ItemRevisionMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ItemRevisionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ItemRevisionMutation builder.
+func (m *ItemRevisionMutation) Where(ps ...predicate.ItemRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemRevision).
+func (m *ItemRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, itemrevision.FieldCreateTime)
+	}
+	if m.item != nil {
+		fields = append(fields, itemrevision.FieldItemID)
+	}
+	if m.user != nil {
+		fields = append(fields, itemrevision.FieldUserID)
+	}
+	if m.action != nil {
+		fields = append(fields, itemrevision.FieldAction)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, itemrevision.FieldSnapshot)
+	}
+	if m.changes != nil {
+		fields = append(fields, itemrevision.FieldChanges)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemrevision.FieldCreateTime:
+		return m.CreateTime()
+	case itemrevision.FieldItemID:
+		return m.ItemID()
+	case itemrevision.FieldUserID:
+		return m.UserID()
+	case itemrevision.FieldAction:
+		return m.Action()
+	case itemrevision.FieldSnapshot:
+		return m.Snapshot()
+	case itemrevision.FieldChanges:
+		return m.Changes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemrevision.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case itemrevision.FieldItemID:
+		return m.OldItemID(ctx)
+	case itemrevision.FieldUserID:
+		return m.OldUserID(ctx)
+	case itemrevision.FieldAction:
+		return m.OldAction(ctx)
+	case itemrevision.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case itemrevision.FieldChanges:
+		return m.OldChanges(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemrevision.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case itemrevision.FieldItemID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case itemrevision.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case itemrevision.FieldAction:
+		v, ok := value.(itemrevision.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case itemrevision.FieldSnapshot:
+		v, ok := value.(models.ItemSnapshot)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case itemrevision.FieldChanges:
+		v, ok := value.([]models.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemRevisionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ItemRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemrevision.FieldUserID) {
+		fields = append(fields, itemrevision.FieldUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemRevisionMutation) ClearField(name string) error {
+	switch name {
+	case itemrevision.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemRevisionMutation) ResetField(name string) error {
+	switch name {
+	case itemrevision.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case itemrevision.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case itemrevision.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case itemrevision.FieldAction:
+		m.ResetAction()
+		return nil
+	case itemrevision.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case itemrevision.FieldChanges:
+		m.ResetChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, itemrevision.EdgeItem)
+	}
+	if m.user != nil {
+		edges = append(edges, itemrevision.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemrevision.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case itemrevision.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, itemrevision.EdgeItem)
+	}
+	if m.cleareduser {
+		edges = append(edges, itemrevision.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemrevision.EdgeItem:
+		return m.cleareditem
+	case itemrevision.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case itemrevision.EdgeItem:
+		m.ClearItem()
+		return nil
+	case itemrevision.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case itemrevision.EdgeItem:
+		m.ResetItem()
+		return nil
+	case itemrevision.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemRevision edge %s", name)
+}
+
+// ItemShareMutation represents an operation that mutates the ItemShare nodes in the graph.
+type ItemShareMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	create_time   *time.Time
+	update_time   *time.Time
+	permission    *itemshare.Permission
+	clearedFields map[string]struct{}
+	item          *uint
+	cleareditem   bool
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ItemShare, error)
+	predicates    []predicate.ItemShare
+}
+
+var _ ent.Mutation = (*ItemShareMutation)(nil)
+
+// itemshareOption allows management of the mutation configuration using functional options.
+type itemshareOption func(*ItemShareMutation)
+
+// newItemShareMutation creates new mutation for the ItemShare entity.
+func newItemShareMutation(c config, op Op, opts ...itemshareOption) *ItemShareMutation {
+	m := &ItemShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemShare,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemShareID sets the ID field of the mutation.
+func withItemShareID(id uint) itemshareOption {
+	return func(m *ItemShareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemShare
+		)
+		m.oldValue = func(ctx context.Context) (*ItemShare, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemShare.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemShare sets the old ItemShare of the mutation.
+func withItemShare(node *ItemShare) itemshareOption {
+	return func(m *ItemShareMutation) {
+		m.oldValue = func(context.Context) (*ItemShare, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemShare entities.
+func (m *ItemShareMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemShareMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemShareMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemShare.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ItemShareMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ItemShareMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ItemShare entity.
+// If the ItemShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemShareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ItemShareMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ItemShareMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ItemShareMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ItemShare entity.
+// If the ItemShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemShareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ItemShareMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ItemShareMutation) SetItemID(u uint) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ItemShareMutation) ItemID() (r uint, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the ItemShare entity.
+// If the ItemShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemShareMutation) OldItemID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ItemShareMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ItemShareMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ItemShareMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ItemShare entity.
+// If the ItemShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemShareMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ItemShareMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetPermission sets the "permission" field.
+func (m *ItemShareMutation) SetPermission(i itemshare.Permission) {
+	m.permission = &i
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *ItemShareMutation) Permission() (r itemshare.Permission, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermission returns the old "permission" field's value of the ItemShare entity.
+// If the ItemShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemShareMutation) OldPermission(ctx context.Context) (v itemshare.Permission, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
+	}
+	return oldValue.Permission, nil
+}
+
+// ResetPermission resets all changes to the "permission" field.
+func (m *ItemShareMutation) ResetPermission() {
+	m.permission = nil
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ItemShareMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ItemShareMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ItemShareMutation) ItemIDs() (ids []uint) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ItemShareMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ItemShareMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ItemShareMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ItemShareMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ItemShareMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ItemShareMutation builder.
+func (m *ItemShareMutation) Where(ps ...predicate.ItemShare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemShare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemShareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemShareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemShare).
+func (m *ItemShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemShareMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, itemshare.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, itemshare.FieldUpdateTime)
+	}
+	if m.item != nil {
+		fields = append(fields, itemshare.FieldItemID)
+	}
+	if m.user != nil {
+		fields = append(fields, itemshare.FieldUserID)
+	}
+	if m.permission != nil {
+		fields = append(fields, itemshare.FieldPermission)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemshare.FieldCreateTime:
+		return m.CreateTime()
+	case itemshare.FieldUpdateTime:
+		return m.UpdateTime()
+	case itemshare.FieldItemID:
+		return m.ItemID()
+	case itemshare.FieldUserID:
+		return m.UserID()
+	case itemshare.FieldPermission:
+		return m.Permission()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemshare.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case itemshare.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case itemshare.FieldItemID:
+		return m.OldItemID(ctx)
+	case itemshare.FieldUserID:
+		return m.OldUserID(ctx)
+	case itemshare.FieldPermission:
+		return m.OldPermission(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemShare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemshare.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case itemshare.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case itemshare.FieldItemID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case itemshare.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case itemshare.FieldPermission:
+		v, ok := value.(itemshare.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemShare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemShareMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemShareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ItemShare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemShareMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemShareMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ItemShare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemShareMutation) ResetField(name string) error {
+	switch name {
+	case itemshare.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case itemshare.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case itemshare.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case itemshare.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case itemshare.FieldPermission:
+		m.ResetPermission()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemShare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, itemshare.EdgeItem)
+	}
+	if m.user != nil {
+		edges = append(edges, itemshare.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemShareMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemshare.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case itemshare.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemShareMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, itemshare.EdgeItem)
+	}
+	if m.cleareduser {
+		edges = append(edges, itemshare.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemShareMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemshare.EdgeItem:
+		return m.cleareditem
+	case itemshare.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemShareMutation) ClearEdge(name string) error {
+	switch name {
+	case itemshare.EdgeItem:
+		m.ClearItem()
+		return nil
+	case itemshare.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemShare unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemShareMutation) ResetEdge(name string) error {
+	switch name {
+	case itemshare.EdgeItem:
+		m.ResetItem()
+		return nil
+	case itemshare.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemShare edge %s", name)
+}
+
+// ItemTransitionMutation represents an operation that mutates the ItemTransition nodes in the graph.
+type ItemTransitionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	create_time   *time.Time
+	name          *string
+	from_status   *string
+	to_status     *string
+	comment       *string
+	clearedFields map[string]struct{}
+	item          *uint
+	cleareditem   bool
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ItemTransition, error)
+	predicates    []predicate.ItemTransition
+}
+
+var _ ent.Mutation = (*ItemTransitionMutation)(nil)
+
+// itemtransitionOption allows management of the mutation configuration using functional options.
+type itemtransitionOption func(*ItemTransitionMutation)
+
+// newItemTransitionMutation creates new mutation for the ItemTransition entity.
+func newItemTransitionMutation(c config, op Op, opts ...itemtransitionOption) *ItemTransitionMutation {
+	m := &ItemTransitionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemTransition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemTransitionID sets the ID field of the mutation.
+func withItemTransitionID(id uint) itemtransitionOption {
+	return func(m *ItemTransitionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemTransition
+		)
+		m.oldValue = func(ctx context.Context) (*ItemTransition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemTransition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemTransition sets the old ItemTransition of the mutation.
+func withItemTransition(node *ItemTransition) itemtransitionOption {
+	return func(m *ItemTransitionMutation) {
+		m.oldValue = func(context.Context) (*ItemTransition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemTransitionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemTransitionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemTransition entities.
+func (m *ItemTransitionMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemTransitionMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemTransitionMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemTransition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ItemTransitionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ItemTransitionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ItemTransitionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ItemTransitionMutation) SetItemID(u uint) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ItemTransitionMutation) ItemID() (r uint, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldItemID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ItemTransitionMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ItemTransitionMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ItemTransitionMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldUserID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ItemTransitionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[itemtransition.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ItemTransitionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[itemtransition.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ItemTransitionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, itemtransition.FieldUserID)
+}
+
+// SetName sets the "name" field.
+func (m *ItemTransitionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemTransitionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ItemTransitionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *ItemTransitionMutation) SetFromStatus(s string) {
+	m.from_status = &s
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *ItemTransitionMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldFromStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *ItemTransitionMutation) ResetFromStatus() {
+	m.from_status = nil
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *ItemTransitionMutation) SetToStatus(s string) {
+	m.to_status = &s
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *ItemTransitionMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldToStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *ItemTransitionMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetComment sets the "comment" field.
+func (m *ItemTransitionMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *ItemTransitionMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the ItemTransition entity.
+// If the ItemTransition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemTransitionMutation) OldComment(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *ItemTransitionMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[itemtransition.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *ItemTransitionMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[itemtransition.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *ItemTransitionMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, itemtransition.FieldComment)
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ItemTransitionMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ItemTransitionMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ItemTransitionMutation) ItemIDs() (ids []uint) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ItemTransitionMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ItemTransitionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ItemTransitionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ItemTransitionMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ItemTransitionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ItemTransitionMutation builder.
+func (m *ItemTransitionMutation) Where(ps ...predicate.ItemTransition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemTransitionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemTransitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemTransition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemTransitionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemTransitionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemTransition).
+func (m *ItemTransitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemTransitionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, itemtransition.FieldCreateTime)
+	}
+	if m.item != nil {
+		fields = append(fields, itemtransition.FieldItemID)
+	}
+	if m.user != nil {
+		fields = append(fields, itemtransition.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, itemtransition.FieldName)
+	}
+	if m.from_status != nil {
+		fields = append(fields, itemtransition.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, itemtransition.FieldToStatus)
+	}
+	if m.comment != nil {
+		fields = append(fields, itemtransition.FieldComment)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemTransitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemtransition.FieldCreateTime:
+		return m.CreateTime()
+	case itemtransition.FieldItemID:
+		return m.ItemID()
+	case itemtransition.FieldUserID:
+		return m.UserID()
+	case itemtransition.FieldName:
+		return m.Name()
+	case itemtransition.FieldFromStatus:
+		return m.FromStatus()
+	case itemtransition.FieldToStatus:
+		return m.ToStatus()
+	case itemtransition.FieldComment:
+		return m.Comment()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemTransitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemtransition.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case itemtransition.FieldItemID:
+		return m.OldItemID(ctx)
+	case itemtransition.FieldUserID:
+		return m.OldUserID(ctx)
+	case itemtransition.FieldName:
+		return m.OldName(ctx)
+	case itemtransition.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case itemtransition.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case itemtransition.FieldComment:
+		return m.OldComment(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemTransition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemTransitionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemtransition.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case itemtransition.FieldItemID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case itemtransition.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case itemtransition.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case itemtransition.FieldFromStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case itemtransition.FieldToStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case itemtransition.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemTransition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemTransitionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemTransitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemTransitionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ItemTransition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemTransitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemtransition.FieldUserID) {
+		fields = append(fields, itemtransition.FieldUserID)
+	}
+	if m.FieldCleared(itemtransition.FieldComment) {
+		fields = append(fields, itemtransition.FieldComment)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemTransitionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemTransitionMutation) ClearField(name string) error {
+	switch name {
+	case itemtransition.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case itemtransition.FieldComment:
+		m.ClearComment()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemTransition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemTransitionMutation) ResetField(name string) error {
+	switch name {
+	case itemtransition.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case itemtransition.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case itemtransition.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case itemtransition.FieldName:
+		m.ResetName()
+		return nil
+	case itemtransition.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case itemtransition.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case itemtransition.FieldComment:
+		m.ResetComment()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemTransition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemTransitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, itemtransition.EdgeItem)
+	}
+	if m.user != nil {
+		edges = append(edges, itemtransition.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemTransitionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemtransition.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case itemtransition.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemTransitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemTransitionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemTransitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, itemtransition.EdgeItem)
+	}
+	if m.cleareduser {
+		edges = append(edges, itemtransition.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemTransitionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemtransition.EdgeItem:
+		return m.cleareditem
+	case itemtransition.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemTransitionMutation) ClearEdge(name string) error {
+	switch name {
+	case itemtransition.EdgeItem:
+		m.ClearItem()
+		return nil
+	case itemtransition.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemTransition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemTransitionMutation) ResetEdge(name string) error {
+	switch name {
+	case itemtransition.EdgeItem:
+		m.ResetItem()
+		return nil
+	case itemtransition.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemTransition edge %s", name)
+}
+
+// MetadataSchemaMutation represents an operation that mutates the MetadataSchema nodes in the graph.
+type MetadataSchemaMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint
+	create_time        *time.Time
+	update_time        *time.Time
+	definition         *map[string]interface{}
+	indexed_keys       *[]string
+	appendindexed_keys []string
+	clearedFields      map[string]struct{}
+	owner              *uint
+	clearedowner       bool
+	done               bool
+	oldValue           func(context.Context) (*MetadataSchema, error)
+	predicates         []predicate.MetadataSchema
+}
+
+var _ ent.Mutation = (*MetadataSchemaMutation)(nil)
+
+// metadataschemaOption allows management of the mutation configuration using functional options.
+type metadataschemaOption func(*MetadataSchemaMutation)
+
+// newMetadataSchemaMutation creates new mutation for the MetadataSchema entity.
+func newMetadataSchemaMutation(c config, op Op, opts ...metadataschemaOption) *MetadataSchemaMutation {
+	m := &MetadataSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetadataSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMetadataSchemaID sets the ID field of the mutation.
+func withMetadataSchemaID(id uint) metadataschemaOption {
+	return func(m *MetadataSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MetadataSchema
+		)
+		m.oldValue = func(ctx context.Context) (*MetadataSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MetadataSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMetadataSchema sets the old MetadataSchema of the mutation.
+func withMetadataSchema(node *MetadataSchema) metadataschemaOption {
+	return func(m *MetadataSchemaMutation) {
+		m.oldValue = func(context.Context) (*MetadataSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetadataSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetadataSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MetadataSchema entities.
+func (m *MetadataSchemaMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MetadataSchemaMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MetadataSchemaMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MetadataSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MetadataSchemaMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MetadataSchemaMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the MetadataSchema entity.
+// If the MetadataSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataSchemaMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MetadataSchemaMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *MetadataSchemaMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MetadataSchemaMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the MetadataSchema entity.
+// If the MetadataSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataSchemaMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MetadataSchemaMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *MetadataSchemaMutation) SetOwnerID(u uint) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *MetadataSchemaMutation) OwnerID() (r uint, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the MetadataSchema entity.
+// If the MetadataSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataSchemaMutation) OldOwnerID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *MetadataSchemaMutation) ClearOwnerID() {
+	m.owner = nil
+	m.clearedFields[metadataschema.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *MetadataSchemaMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[metadataschema.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *MetadataSchemaMutation) ResetOwnerID() {
+	m.owner = nil
+	delete(m.clearedFields, metadataschema.FieldOwnerID)
+}
+
+// SetDefinition sets the "definition" field.
+func (m *MetadataSchemaMutation) SetDefinition(value map[string]interface{}) {
+	m.definition = &value
+}
+
+// Definition returns the value of the "definition" field in the mutation.
+func (m *MetadataSchemaMutation) Definition() (r map[string]interface{}, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinition returns the old "definition" field's value of the MetadataSchema entity.
+// If the MetadataSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataSchemaMutation) OldDefinition(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinition: %w", err)
+	}
+	return oldValue.Definition, nil
+}
+
+// ResetDefinition resets all changes to the "definition" field.
+func (m *MetadataSchemaMutation) ResetDefinition() {
+	m.definition = nil
+}
+
+// SetIndexedKeys sets the "indexed_keys" field.
+func (m *MetadataSchemaMutation) SetIndexedKeys(s []string) {
+	m.indexed_keys = &s
+	m.appendindexed_keys = nil
+}
+
+// IndexedKeys returns the value of the "indexed_keys" field in the mutation.
+func (m *MetadataSchemaMutation) IndexedKeys() (r []string, exists bool) {
+	v := m.indexed_keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndexedKeys returns the old "indexed_keys" field's value of the MetadataSchema entity.
+// If the MetadataSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataSchemaMutation) OldIndexedKeys(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndexedKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndexedKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndexedKeys: %w", err)
+	}
+	return oldValue.IndexedKeys, nil
+}
+
+// AppendIndexedKeys adds s to the "indexed_keys" field.
+func (m *MetadataSchemaMutation) AppendIndexedKeys(s []string) {
+	m.appendindexed_keys = append(m.appendindexed_keys, s...)
+}
+
+// AppendedIndexedKeys returns the list of values that were appended to the "indexed_keys" field in this mutation.
+func (m *MetadataSchemaMutation) AppendedIndexedKeys() ([]string, bool) {
+	if len(m.appendindexed_keys) == 0 {
+		return nil, false
+	}
+	return m.appendindexed_keys, true
+}
+
+// ClearIndexedKeys clears the value of the "indexed_keys" field.
+func (m *MetadataSchemaMutation) ClearIndexedKeys() {
+	m.indexed_keys = nil
+	m.appendindexed_keys = nil
+	m.clearedFields[metadataschema.FieldIndexedKeys] = struct{}{}
+}
+
+// IndexedKeysCleared returns if the "indexed_keys" field was cleared in this mutation.
+func (m *MetadataSchemaMutation) IndexedKeysCleared() bool {
+	_, ok := m.clearedFields[metadataschema.FieldIndexedKeys]
+	return ok
+}
+
+// ResetIndexedKeys resets all changes to the "indexed_keys" field.
+func (m *MetadataSchemaMutation) ResetIndexedKeys() {
+	m.indexed_keys = nil
+	m.appendindexed_keys = nil
+	delete(m.clearedFields, metadataschema.FieldIndexedKeys)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *MetadataSchemaMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *MetadataSchemaMutation) OwnerCleared() bool {
+	return m.OwnerIDCleared() || m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *MetadataSchemaMutation) OwnerIDs() (ids []uint) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *MetadataSchemaMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the MetadataSchemaMutation builder.
+func (m *MetadataSchemaMutation) Where(ps ...predicate.MetadataSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MetadataSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetadataSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MetadataSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MetadataSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MetadataSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MetadataSchema).
+func (m *MetadataSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MetadataSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, metadataschema.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, metadataschema.FieldUpdateTime)
+	}
+	if m.owner != nil {
+		fields = append(fields, metadataschema.FieldOwnerID)
+	}
+	if m.definition != nil {
+		fields = append(fields, metadataschema.FieldDefinition)
+	}
+	if m.indexed_keys != nil {
+		fields = append(fields, metadataschema.FieldIndexedKeys)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MetadataSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metadataschema.FieldCreateTime:
+		return m.CreateTime()
+	case metadataschema.FieldUpdateTime:
+		return m.UpdateTime()
+	case metadataschema.FieldOwnerID:
+		return m.OwnerID()
+	case metadataschema.FieldDefinition:
+		return m.Definition()
+	case metadataschema.FieldIndexedKeys:
+		return m.IndexedKeys()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MetadataSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case metadataschema.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case metadataschema.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case metadataschema.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case metadataschema.FieldDefinition:
+		return m.OldDefinition(ctx)
+	case metadataschema.FieldIndexedKeys:
+		return m.OldIndexedKeys(ctx)
+	}
+	return nil, fmt.Errorf("unknown MetadataSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetadataSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case metadataschema.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case metadataschema.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case metadataschema.FieldOwnerID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case metadataschema.FieldDefinition:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinition(v)
+		return nil
+	case metadataschema.FieldIndexedKeys:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndexedKeys(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MetadataSchemaMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MetadataSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetadataSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MetadataSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MetadataSchemaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(metadataschema.FieldOwnerID) {
+		fields = append(fields, metadataschema.FieldOwnerID)
+	}
+	if m.FieldCleared(metadataschema.FieldIndexedKeys) {
+		fields = append(fields, metadataschema.FieldIndexedKeys)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MetadataSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetadataSchemaMutation) ClearField(name string) error {
+	switch name {
+	case metadataschema.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	case metadataschema.FieldIndexedKeys:
+		m.ClearIndexedKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MetadataSchemaMutation) ResetField(name string) error {
+	switch name {
+	case metadataschema.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case metadataschema.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case metadataschema.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case metadataschema.FieldDefinition:
+		m.ResetDefinition()
+		return nil
+	case metadataschema.FieldIndexedKeys:
+		m.ResetIndexedKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MetadataSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, metadataschema.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MetadataSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case metadataschema.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MetadataSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MetadataSchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MetadataSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, metadataschema.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MetadataSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case metadataschema.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MetadataSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	case metadataschema.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MetadataSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case metadataschema.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataSchema edge %s", name)
+}
+
+// OwnershipTransferMutation represents an operation that mutates the OwnershipTransfer nodes in the graph.
+type OwnershipTransferMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uint
+	create_time      *time.Time
+	update_time      *time.Time
+	status           *ownershiptransfer.Status
+	clearedFields    map[string]struct{}
+	item             *uint
+	cleareditem      bool
+	from_user        *uint
+	clearedfrom_user bool
+	to_user          *uint
+	clearedto_user   bool
+	done             bool
+	oldValue         func(context.Context) (*OwnershipTransfer, error)
+	predicates       []predicate.OwnershipTransfer
+}
+
+var _ ent.Mutation = (*OwnershipTransferMutation)(nil)
+
+// ownershiptransferOption allows management of the mutation configuration using functional options.
+type ownershiptransferOption func(*OwnershipTransferMutation)
+
+// newOwnershipTransferMutation creates new mutation for the OwnershipTransfer entity.
+func newOwnershipTransferMutation(c config, op Op, opts ...ownershiptransferOption) *OwnershipTransferMutation {
+	m := &OwnershipTransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOwnershipTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOwnershipTransferID sets the ID field of the mutation.
+func withOwnershipTransferID(id uint) ownershiptransferOption {
+	return func(m *OwnershipTransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OwnershipTransfer
+		)
+		m.oldValue = func(ctx context.Context) (*OwnershipTransfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OwnershipTransfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOwnershipTransfer sets the old OwnershipTransfer of the mutation.
+func withOwnershipTransfer(node *OwnershipTransfer) ownershiptransferOption {
+	return func(m *OwnershipTransferMutation) {
+		m.oldValue = func(context.Context) (*OwnershipTransfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OwnershipTransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OwnershipTransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OwnershipTransfer entities.
+func (m *OwnershipTransferMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OwnershipTransferMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OwnershipTransferMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OwnershipTransfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OwnershipTransferMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OwnershipTransferMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OwnershipTransferMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OwnershipTransferMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OwnershipTransferMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OwnershipTransferMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *OwnershipTransferMutation) SetItemID(u uint) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *OwnershipTransferMutation) ItemID() (r uint, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldItemID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *OwnershipTransferMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (m *OwnershipTransferMutation) SetFromUserID(u uint) {
+	m.from_user = &u
+}
+
+// FromUserID returns the value of the "from_user_id" field in the mutation.
+func (m *OwnershipTransferMutation) FromUserID() (r uint, exists bool) {
+	v := m.from_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromUserID returns the old "from_user_id" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldFromUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromUserID: %w", err)
+	}
+	return oldValue.FromUserID, nil
+}
+
+// ResetFromUserID resets all changes to the "from_user_id" field.
+func (m *OwnershipTransferMutation) ResetFromUserID() {
+	m.from_user = nil
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (m *OwnershipTransferMutation) SetToUserID(u uint) {
+	m.to_user = &u
+}
+
+// ToUserID returns the value of the "to_user_id" field in the mutation.
+func (m *OwnershipTransferMutation) ToUserID() (r uint, exists bool) {
+	v := m.to_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToUserID returns the old "to_user_id" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldToUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToUserID: %w", err)
+	}
+	return oldValue.ToUserID, nil
+}
+
+// ResetToUserID resets all changes to the "to_user_id" field.
+func (m *OwnershipTransferMutation) ResetToUserID() {
+	m.to_user = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OwnershipTransferMutation) SetStatus(o ownershiptransfer.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OwnershipTransferMutation) Status() (r ownershiptransfer.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldStatus(ctx context.Context) (v ownershiptransfer.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OwnershipTransferMutation) ResetStatus() {
+	m.status = nil
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *OwnershipTransferMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *OwnershipTransferMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *OwnershipTransferMutation) ItemIDs() (ids []uint) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *OwnershipTransferMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearFromUser clears the "from_user" edge to the User entity.
+func (m *OwnershipTransferMutation) ClearFromUser() {
+	m.clearedfrom_user = true
+}
+
+// FromUserCleared reports if the "from_user" edge to the User entity was cleared.
+func (m *OwnershipTransferMutation) FromUserCleared() bool {
+	return m.clearedfrom_user
+}
+
+// FromUserIDs returns the "from_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromUserID instead. It exists only for internal usage by the builders.
+func (m *OwnershipTransferMutation) FromUserIDs() (ids []uint) {
+	if id := m.from_user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFromUser resets all changes to the "from_user" edge.
+func (m *OwnershipTransferMutation) ResetFromUser() {
+	m.from_user = nil
+	m.clearedfrom_user = false
+}
+
+// ClearToUser clears the "to_user" edge to the User entity.
+func (m *OwnershipTransferMutation) ClearToUser() {
+	m.clearedto_user = true
+}
+
+// ToUserCleared reports if the "to_user" edge to the User entity was cleared.
+func (m *OwnershipTransferMutation) ToUserCleared() bool {
+	return m.clearedto_user
+}
+
+// ToUserIDs returns the "to_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToUserID instead. It exists only for internal usage by the builders.
+func (m *OwnershipTransferMutation) ToUserIDs() (ids []uint) {
+	if id := m.to_user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetToUser resets all changes to the "to_user" edge.
+func (m *OwnershipTransferMutation) ResetToUser() {
+	m.to_user = nil
+	m.clearedto_user = false
+}
+
+// Where appends a list predicates to the OwnershipTransferMutation builder.
+func (m *OwnershipTransferMutation) Where(ps ...predicate.OwnershipTransfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OwnershipTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OwnershipTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OwnershipTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OwnershipTransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OwnershipTransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OwnershipTransfer).
+func (m *OwnershipTransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OwnershipTransferMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, ownershiptransfer.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, ownershiptransfer.FieldUpdateTime)
+	}
+	if m.item != nil {
+		fields = append(fields, ownershiptransfer.FieldItemID)
+	}
+	if m.from_user != nil {
+		fields = append(fields, ownershiptransfer.FieldFromUserID)
+	}
+	if m.to_user != nil {
+		fields = append(fields, ownershiptransfer.FieldToUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, ownershiptransfer.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OwnershipTransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ownershiptransfer.FieldCreateTime:
+		return m.CreateTime()
+	case ownershiptransfer.FieldUpdateTime:
+		return m.UpdateTime()
+	case ownershiptransfer.FieldItemID:
+		return m.ItemID()
+	case ownershiptransfer.FieldFromUserID:
+		return m.FromUserID()
+	case ownershiptransfer.FieldToUserID:
+		return m.ToUserID()
+	case ownershiptransfer.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OwnershipTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ownershiptransfer.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case ownershiptransfer.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case ownershiptransfer.FieldItemID:
+		return m.OldItemID(ctx)
+	case ownershiptransfer.FieldFromUserID:
+		return m.OldFromUserID(ctx)
+	case ownershiptransfer.FieldToUserID:
+		return m.OldToUserID(ctx)
+	case ownershiptransfer.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown OwnershipTransfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnershipTransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ownershiptransfer.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case ownershiptransfer.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case ownershiptransfer.FieldItemID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case ownershiptransfer.FieldFromUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromUserID(v)
+		return nil
+	case ownershiptransfer.FieldToUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToUserID(v)
+		return nil
+	case ownershiptransfer.FieldStatus:
+		v, ok := value.(ownershiptransfer.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OwnershipTransferMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OwnershipTransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnershipTransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OwnershipTransfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OwnershipTransferMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OwnershipTransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OwnershipTransferMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OwnershipTransfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OwnershipTransferMutation) ResetField(name string) error {
+	switch name {
+	case ownershiptransfer.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case ownershiptransfer.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case ownershiptransfer.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case ownershiptransfer.FieldFromUserID:
+		m.ResetFromUserID()
+		return nil
+	case ownershiptransfer.FieldToUserID:
+		m.ResetToUserID()
+		return nil
+	case ownershiptransfer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OwnershipTransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.item != nil {
+		edges = append(edges, ownershiptransfer.EdgeItem)
+	}
+	if m.from_user != nil {
+		edges = append(edges, ownershiptransfer.EdgeFromUser)
+	}
+	if m.to_user != nil {
+		edges = append(edges, ownershiptransfer.EdgeToUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OwnershipTransferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ownershiptransfer.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case ownershiptransfer.EdgeFromUser:
+		if id := m.from_user; id != nil {
+			return []ent.Value{*id}
+		}
+	case ownershiptransfer.EdgeToUser:
+		if id := m.to_user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OwnershipTransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OwnershipTransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OwnershipTransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareditem {
+		edges = append(edges, ownershiptransfer.EdgeItem)
+	}
+	if m.clearedfrom_user {
+		edges = append(edges, ownershiptransfer.EdgeFromUser)
+	}
+	if m.clearedto_user {
+		edges = append(edges, ownershiptransfer.EdgeToUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OwnershipTransferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ownershiptransfer.EdgeItem:
+		return m.cleareditem
+	case ownershiptransfer.EdgeFromUser:
+		return m.clearedfrom_user
+	case ownershiptransfer.EdgeToUser:
+		return m.clearedto_user
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OwnershipTransferMutation) ClearEdge(name string) error {
+	switch name {
+	case ownershiptransfer.EdgeItem:
+		m.ClearItem()
+		return nil
+	case ownershiptransfer.EdgeFromUser:
+		m.ClearFromUser()
+		return nil
+	case ownershiptransfer.EdgeToUser:
+		m.ClearToUser()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OwnershipTransferMutation) ResetEdge(name string) error {
+	switch name {
+	case ownershiptransfer.EdgeItem:
+		m.ResetItem()
+		return nil
+	case ownershiptransfer.EdgeFromUser:
+		m.ResetFromUser()
+		return nil
+	case ownershiptransfer.EdgeToUser:
+		m.ResetToUser()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	color         *string
+	clearedFields map[string]struct{}
+	owner         *uint
+	clearedowner  bool
+	items         map[uint]struct{}
+	removeditems  map[uint]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id uint) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TagMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TagMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TagMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TagMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TagMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TagMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *TagMutation) SetOwnerID(u uint) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *TagMutation) OwnerID() (r uint, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldOwnerID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *TagMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TagMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TagMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) OwnerIDs() (ids []uint) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TagMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *TagMutation) AddItemIDs(ids ...uint) {
+	if m.items == nil {
+		m.items = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *TagMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *TagMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *TagMutation) RemoveItemIDs(ids ...uint) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *TagMutation) RemovedItemsIDs() (ids []uint) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *TagMutation) ItemsIDs() (ids []uint) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *TagMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, tag.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, tag.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.owner != nil {
+		fields = append(fields, tag.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldCreateTime:
+		return m.CreateTime()
+	case tag.FieldUpdateTime:
+		return m.UpdateTime()
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tag.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tag.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tag.FieldOwnerID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tag.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldColor:
+		m.ResetColor()
+		return nil
+	case tag.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, tag.EdgeOwner)
+	}
+	if m.items != nil {
+		edges = append(edges, tag.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case tag.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, tag.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, tag.EdgeOwner)
+	}
+	if m.cleareditems {
+		edges = append(edges, tag.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeOwner:
+		return m.clearedowner
+	case tag.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	case tag.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case tag.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                                  Op
+	typ                                 string
+	id                                  *uint
+	create_time                         *time.Time
+	update_time                         *time.Time
+	delete_time                         *time.Time
+	version                             *int
+	addversion                          *int
+	name                                *string
+	email                               *string
+	password                            *string
+	is_active                           *bool
+	is_super_user                       *bool
+	verified                            *bool
+	verification_code                   *string
+	password_reset_token                *string
+	password_reset_at                   *time.Time
+	calendar_token                      *string
+	plan                                *string
+	clearedFields                       map[string]struct{}
+	items                               map[uint]struct{}
+	removeditems                        map[uint]struct{}
+	cleareditems                        bool
+	shared_items                        map[uint]struct{}
+	removedshared_items                 map[uint]struct{}
+	clearedshared_items                 bool
+	item_revisions                      map[uint]struct{}
+	removeditem_revisions               map[uint]struct{}
+	cleareditem_revisions               bool
+	tags                                map[uint]struct{}
+	removedtags                         map[uint]struct{}
+	clearedtags                         bool
+	attachments                         map[uint]struct{}
+	removedattachments                  map[uint]struct{}
+	clearedattachments                  bool
+	item_imports                        map[uint]struct{}
+	removeditem_imports                 map[uint]struct{}
+	cleareditem_imports                 bool
+	comments                            map[uint]struct{}
+	removedcomments                     map[uint]struct{}
+	clearedcomments                     bool
+	item_transitions                    map[uint]struct{}
+	removeditem_transitions             map[uint]struct{}
+	cleareditem_transitions             bool
+	metadata_schemas                    map[uint]struct{}
+	removedmetadata_schemas             map[uint]struct{}
+	clearedmetadata_schemas             bool
+	sent_ownership_transfers            map[uint]struct{}
+	removedsent_ownership_transfers     map[uint]struct{}
+	clearedsent_ownership_transfers     bool
+	received_ownership_transfers        map[uint]struct{}
+	removedreceived_ownership_transfers map[uint]struct{}
+	clearedreceived_ownership_transfers bool
+	done                                bool
+	oldValue                            func(context.Context) (*User, error)
+	predicates                          []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uint) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *UserMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *UserMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *UserMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *UserMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *UserMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *UserMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *UserMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[user.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *UserMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *UserMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, user.FieldDeleteTime)
+}
+
+// SetVersion sets the "version" field.
+func (m *UserMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *UserMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *UserMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *UserMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *UserMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *UserMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *UserMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *UserMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetIsSuperUser sets the "is_super_user" field.
+func (m *UserMutation) SetIsSuperUser(b bool) {
+	m.is_super_user = &b
+}
+
+// IsSuperUser returns the value of the "is_super_user" field in the mutation.
+func (m *UserMutation) IsSuperUser() (r bool, exists bool) {
+	v := m.is_super_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSuperUser returns the old "is_super_user" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsSuperUser(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSuperUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSuperUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSuperUser: %w", err)
+	}
+	return oldValue.IsSuperUser, nil
+}
+
+// ResetIsSuperUser resets all changes to the "is_super_user" field.
+func (m *UserMutation) ResetIsSuperUser() {
+	m.is_super_user = nil
+}
+
+// SetVerified sets the "verified" field.
+func (m *UserMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *UserMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *UserMutation) ResetVerified() {
+	m.verified = nil
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (m *UserMutation) SetVerificationCode(s string) {
+	m.verification_code = &s
+}
+
+// VerificationCode returns the value of the "verification_code" field in the mutation.
+func (m *UserMutation) VerificationCode() (r string, exists bool) {
+	v := m.verification_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationCode returns the old "verification_code" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVerificationCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationCode requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationCode: %w", err)
+	}
+	return oldValue.VerificationCode, nil
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (m *UserMutation) ClearVerificationCode() {
+	m.verification_code = nil
+	m.clearedFields[user.FieldVerificationCode] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// VerificationCodeCleared returns if the "verification_code" field was cleared in this mutation.
+func (m *UserMutation) VerificationCodeCleared() bool {
+	_, ok := m.clearedFields[user.FieldVerificationCode]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetVerificationCode resets all changes to the "verification_code" field.
+func (m *UserMutation) ResetVerificationCode() {
+	m.verification_code = nil
+	delete(m.clearedFields, user.FieldVerificationCode)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uint) {
-	m.id = &id
+// SetPasswordResetToken sets the "password_reset_token" field.
+func (m *UserMutation) SetPasswordResetToken(s string) {
+	m.password_reset_token = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
+// PasswordResetToken returns the value of the "password_reset_token" field in the mutation.
+func (m *UserMutation) PasswordResetToken() (r string, exists bool) {
+	v := m.password_reset_token
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldPasswordResetToken returns the old "password_reset_token" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordResetToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordResetToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordResetToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordResetToken: %w", err)
 	}
+	return oldValue.PasswordResetToken, nil
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *UserMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// ClearPasswordResetToken clears the value of the "password_reset_token" field.
+func (m *UserMutation) ClearPasswordResetToken() {
+	m.password_reset_token = nil
+	m.clearedFields[user.FieldPasswordResetToken] = struct{}{}
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// PasswordResetTokenCleared returns if the "password_reset_token" field was cleared in this mutation.
+func (m *UserMutation) PasswordResetTokenCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordResetToken]
+	return ok
+}
+
+// ResetPasswordResetToken resets all changes to the "password_reset_token" field.
+func (m *UserMutation) ResetPasswordResetToken() {
+	m.password_reset_token = nil
+	delete(m.clearedFields, user.FieldPasswordResetToken)
+}
+
+// SetPasswordResetAt sets the "password_reset_at" field.
+func (m *UserMutation) SetPasswordResetAt(t time.Time) {
+	m.password_reset_at = &t
+}
+
+// PasswordResetAt returns the value of the "password_reset_at" field in the mutation.
+func (m *UserMutation) PasswordResetAt() (r time.Time, exists bool) {
+	v := m.password_reset_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the User entity.
+// OldPasswordResetAt returns the old "password_reset_at" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldPasswordResetAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordResetAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldPasswordResetAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordResetAt: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.PasswordResetAt, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *UserMutation) ResetCreateTime() {
-	m.create_time = nil
+// ClearPasswordResetAt clears the value of the "password_reset_at" field.
+func (m *UserMutation) ClearPasswordResetAt() {
+	m.password_reset_at = nil
+	m.clearedFields[user.FieldPasswordResetAt] = struct{}{}
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *UserMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// PasswordResetAtCleared returns if the "password_reset_at" field was cleared in this mutation.
+func (m *UserMutation) PasswordResetAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordResetAt]
+	return ok
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// ResetPasswordResetAt resets all changes to the "password_reset_at" field.
+func (m *UserMutation) ResetPasswordResetAt() {
+	m.password_reset_at = nil
+	delete(m.clearedFields, user.FieldPasswordResetAt)
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (m *UserMutation) SetCalendarToken(s string) {
+	m.calendar_token = &s
+}
+
+// CalendarToken returns the value of the "calendar_token" field in the mutation.
+func (m *UserMutation) CalendarToken() (r string, exists bool) {
+	v := m.calendar_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the User entity.
+// OldCalendarToken returns the old "calendar_token" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCalendarToken(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldCalendarToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldCalendarToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldCalendarToken: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.CalendarToken, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *UserMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (m *UserMutation) ClearCalendarToken() {
+	m.calendar_token = nil
+	m.clearedFields[user.FieldCalendarToken] = struct{}{}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// CalendarTokenCleared returns if the "calendar_token" field was cleared in this mutation.
+func (m *UserMutation) CalendarTokenCleared() bool {
+	_, ok := m.clearedFields[user.FieldCalendarToken]
+	return ok
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetCalendarToken resets all changes to the "calendar_token" field.
+func (m *UserMutation) ResetCalendarToken() {
+	m.calendar_token = nil
+	delete(m.clearedFields, user.FieldCalendarToken)
+}
+
+// SetPlan sets the "plan" field.
+func (m *UserMutation) SetPlan(s string) {
+	m.plan = &s
+}
+
+// Plan returns the value of the "plan" field in the mutation.
+func (m *UserMutation) Plan() (r string, exists bool) {
+	v := m.plan
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
+// OldPlan returns the old "plan" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPlan(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlan is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPlan requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlan: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Plan, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ClearPlan clears the value of the "plan" field.
+func (m *UserMutation) ClearPlan() {
+	m.plan = nil
+	m.clearedFields[user.FieldPlan] = struct{}{}
+}
+
+// PlanCleared returns if the "plan" field was cleared in this mutation.
+func (m *UserMutation) PlanCleared() bool {
+	_, ok := m.clearedFields[user.FieldPlan]
+	return ok
+}
+
+// ResetPlan resets all changes to the "plan" field.
+func (m *UserMutation) ResetPlan() {
+	m.plan = nil
+	delete(m.clearedFields, user.FieldPlan)
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *UserMutation) AddItemIDs(ids ...uint) {
+	if m.items == nil {
+		m.items = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *UserMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *UserMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *UserMutation) RemoveItemIDs(ids ...uint) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *UserMutation) RemovedItemsIDs() (ids []uint) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *UserMutation) ItemsIDs() (ids []uint) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *UserMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// AddSharedItemIDs adds the "shared_items" edge to the ItemShare entity by ids.
+func (m *UserMutation) AddSharedItemIDs(ids ...uint) {
+	if m.shared_items == nil {
+		m.shared_items = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.shared_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSharedItems clears the "shared_items" edge to the ItemShare entity.
+func (m *UserMutation) ClearSharedItems() {
+	m.clearedshared_items = true
+}
+
+// SharedItemsCleared reports if the "shared_items" edge to the ItemShare entity was cleared.
+func (m *UserMutation) SharedItemsCleared() bool {
+	return m.clearedshared_items
+}
+
+// RemoveSharedItemIDs removes the "shared_items" edge to the ItemShare entity by IDs.
+func (m *UserMutation) RemoveSharedItemIDs(ids ...uint) {
+	if m.removedshared_items == nil {
+		m.removedshared_items = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.shared_items, ids[i])
+		m.removedshared_items[ids[i]] = struct{}{}
+	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// RemovedSharedItems returns the removed IDs of the "shared_items" edge to the ItemShare entity.
+func (m *UserMutation) RemovedSharedItemsIDs() (ids []uint) {
+	for id := range m.removedshared_items {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// SharedItemsIDs returns the "shared_items" edge IDs in the mutation.
+func (m *UserMutation) SharedItemsIDs() (ids []uint) {
+	for id := range m.shared_items {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+// ResetSharedItems resets all changes to the "shared_items" edge.
+func (m *UserMutation) ResetSharedItems() {
+	m.shared_items = nil
+	m.clearedshared_items = false
+	m.removedshared_items = nil
+}
+
+// AddItemRevisionIDs adds the "item_revisions" edge to the ItemRevision entity by ids.
+func (m *UserMutation) AddItemRevisionIDs(ids ...uint) {
+	if m.item_revisions == nil {
+		m.item_revisions = make(map[uint]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	for i := range ids {
+		m.item_revisions[ids[i]] = struct{}{}
 	}
-	return oldValue.Email, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearItemRevisions clears the "item_revisions" edge to the ItemRevision entity.
+func (m *UserMutation) ClearItemRevisions() {
+	m.cleareditem_revisions = true
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// ItemRevisionsCleared reports if the "item_revisions" edge to the ItemRevision entity was cleared.
+func (m *UserMutation) ItemRevisionsCleared() bool {
+	return m.cleareditem_revisions
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
+// RemoveItemRevisionIDs removes the "item_revisions" edge to the ItemRevision entity by IDs.
+func (m *UserMutation) RemoveItemRevisionIDs(ids ...uint) {
+	if m.removeditem_revisions == nil {
+		m.removeditem_revisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.item_revisions, ids[i])
+		m.removeditem_revisions[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+// RemovedItemRevisions returns the removed IDs of the "item_revisions" edge to the ItemRevision entity.
+func (m *UserMutation) RemovedItemRevisionsIDs() (ids []uint) {
+	for id := range m.removeditem_revisions {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+	return
+}
+
+// ItemRevisionsIDs returns the "item_revisions" edge IDs in the mutation.
+func (m *UserMutation) ItemRevisionsIDs() (ids []uint) {
+	for id := range m.item_revisions {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	return
+}
+
+// ResetItemRevisions resets all changes to the "item_revisions" edge.
+func (m *UserMutation) ResetItemRevisions() {
+	m.item_revisions = nil
+	m.cleareditem_revisions = false
+	m.removeditem_revisions = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddTagIDs(ids ...uint) {
+	if m.tags == nil {
+		m.tags = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return oldValue.Password, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *UserMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *UserMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *UserMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *UserMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveTagIDs(ids ...uint) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *UserMutation) RemovedTagsIDs() (ids []uint) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *UserMutation) TagsIDs() (ids []uint) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *UserMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *UserMutation) AddAttachmentIDs(ids ...uint) {
+	if m.attachments == nil {
+		m.attachments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
 	}
-	return oldValue.IsActive, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *UserMutation) ResetIsActive() {
-	m.is_active = nil
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *UserMutation) ClearAttachments() {
+	m.clearedattachments = true
 }
 
-// SetIsSuperUser sets the "is_super_user" field.
-func (m *UserMutation) SetIsSuperUser(b bool) {
-	m.is_super_user = &b
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *UserMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
 }
 
-// IsSuperUser returns the value of the "is_super_user" field in the mutation.
-func (m *UserMutation) IsSuperUser() (r bool, exists bool) {
-	v := m.is_super_user
-	if v == nil {
-		return
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *UserMutation) RemoveAttachmentIDs(ids ...uint) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsSuperUser returns the old "is_super_user" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsSuperUser(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSuperUser is only allowed on UpdateOne operations")
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *UserMutation) RemovedAttachmentsIDs() (ids []uint) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSuperUser requires an ID field in the mutation")
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *UserMutation) AttachmentsIDs() (ids []uint) {
+	for id := range m.attachments {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSuperUser: %w", err)
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *UserMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddItemImportIDs adds the "item_imports" edge to the ItemImport entity by ids.
+func (m *UserMutation) AddItemImportIDs(ids ...uint) {
+	if m.item_imports == nil {
+		m.item_imports = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.item_imports[ids[i]] = struct{}{}
 	}
-	return oldValue.IsSuperUser, nil
 }
 
-// ResetIsSuperUser resets all changes to the "is_super_user" field.
-func (m *UserMutation) ResetIsSuperUser() {
-	m.is_super_user = nil
+// ClearItemImports clears the "item_imports" edge to the ItemImport entity.
+func (m *UserMutation) ClearItemImports() {
+	m.cleareditem_imports = true
 }
 
-// SetVerified sets the "verified" field.
-func (m *UserMutation) SetVerified(b bool) {
-	m.verified = &b
+// ItemImportsCleared reports if the "item_imports" edge to the ItemImport entity was cleared.
+func (m *UserMutation) ItemImportsCleared() bool {
+	return m.cleareditem_imports
 }
 
-// Verified returns the value of the "verified" field in the mutation.
-func (m *UserMutation) Verified() (r bool, exists bool) {
-	v := m.verified
-	if v == nil {
-		return
+// RemoveItemImportIDs removes the "item_imports" edge to the ItemImport entity by IDs.
+func (m *UserMutation) RemoveItemImportIDs(ids ...uint) {
+	if m.removeditem_imports == nil {
+		m.removeditem_imports = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.item_imports, ids[i])
+		m.removeditem_imports[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldVerified returns the old "verified" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldVerified(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+// RemovedItemImports returns the removed IDs of the "item_imports" edge to the ItemImport entity.
+func (m *UserMutation) RemovedItemImportsIDs() (ids []uint) {
+	for id := range m.removeditem_imports {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerified requires an ID field in the mutation")
+	return
+}
+
+// ItemImportsIDs returns the "item_imports" edge IDs in the mutation.
+func (m *UserMutation) ItemImportsIDs() (ids []uint) {
+	for id := range m.item_imports {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	return
+}
+
+// ResetItemImports resets all changes to the "item_imports" edge.
+func (m *UserMutation) ResetItemImports() {
+	m.item_imports = nil
+	m.cleareditem_imports = false
+	m.removeditem_imports = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...uint) {
+	if m.comments == nil {
+		m.comments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
 	}
-	return oldValue.Verified, nil
 }
 
-// ResetVerified resets all changes to the "verified" field.
-func (m *UserMutation) ResetVerified() {
-	m.verified = nil
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// SetVerificationCode sets the "verification_code" field.
-func (m *UserMutation) SetVerificationCode(s string) {
-	m.verification_code = &s
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// VerificationCode returns the value of the "verification_code" field in the mutation.
-func (m *UserMutation) VerificationCode() (r string, exists bool) {
-	v := m.verification_code
-	if v == nil {
-		return
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...uint) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldVerificationCode returns the old "verification_code" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldVerificationCode(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerificationCode is only allowed on UpdateOne operations")
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []uint) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerificationCode requires an ID field in the mutation")
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []uint) {
+	for id := range m.comments {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerificationCode: %w", err)
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddItemTransitionIDs adds the "item_transitions" edge to the ItemTransition entity by ids.
+func (m *UserMutation) AddItemTransitionIDs(ids ...uint) {
+	if m.item_transitions == nil {
+		m.item_transitions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.item_transitions[ids[i]] = struct{}{}
 	}
-	return oldValue.VerificationCode, nil
 }
 
-// ClearVerificationCode clears the value of the "verification_code" field.
-func (m *UserMutation) ClearVerificationCode() {
-	m.verification_code = nil
-	m.clearedFields[user.FieldVerificationCode] = struct{}{}
+// ClearItemTransitions clears the "item_transitions" edge to the ItemTransition entity.
+func (m *UserMutation) ClearItemTransitions() {
+	m.cleareditem_transitions = true
 }
 
-// VerificationCodeCleared returns if the "verification_code" field was cleared in this mutation.
-func (m *UserMutation) VerificationCodeCleared() bool {
-	_, ok := m.clearedFields[user.FieldVerificationCode]
-	return ok
+// ItemTransitionsCleared reports if the "item_transitions" edge to the ItemTransition entity was cleared.
+func (m *UserMutation) ItemTransitionsCleared() bool {
+	return m.cleareditem_transitions
 }
 
-// ResetVerificationCode resets all changes to the "verification_code" field.
-func (m *UserMutation) ResetVerificationCode() {
-	m.verification_code = nil
-	delete(m.clearedFields, user.FieldVerificationCode)
+// RemoveItemTransitionIDs removes the "item_transitions" edge to the ItemTransition entity by IDs.
+func (m *UserMutation) RemoveItemTransitionIDs(ids ...uint) {
+	if m.removeditem_transitions == nil {
+		m.removeditem_transitions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.item_transitions, ids[i])
+		m.removeditem_transitions[ids[i]] = struct{}{}
+	}
 }
 
-// SetPasswordResetToken sets the "password_reset_token" field.
-func (m *UserMutation) SetPasswordResetToken(s string) {
-	m.password_reset_token = &s
+// RemovedItemTransitions returns the removed IDs of the "item_transitions" edge to the ItemTransition entity.
+func (m *UserMutation) RemovedItemTransitionsIDs() (ids []uint) {
+	for id := range m.removeditem_transitions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PasswordResetToken returns the value of the "password_reset_token" field in the mutation.
-func (m *UserMutation) PasswordResetToken() (r string, exists bool) {
-	v := m.password_reset_token
-	if v == nil {
-		return
+// ItemTransitionsIDs returns the "item_transitions" edge IDs in the mutation.
+func (m *UserMutation) ItemTransitionsIDs() (ids []uint) {
+	for id := range m.item_transitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemTransitions resets all changes to the "item_transitions" edge.
+func (m *UserMutation) ResetItemTransitions() {
+	m.item_transitions = nil
+	m.cleareditem_transitions = false
+	m.removeditem_transitions = nil
+}
+
+// AddMetadataSchemaIDs adds the "metadata_schemas" edge to the MetadataSchema entity by ids.
+func (m *UserMutation) AddMetadataSchemaIDs(ids ...uint) {
+	if m.metadata_schemas == nil {
+		m.metadata_schemas = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.metadata_schemas[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldPasswordResetToken returns the old "password_reset_token" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordResetToken(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordResetToken is only allowed on UpdateOne operations")
+// ClearMetadataSchemas clears the "metadata_schemas" edge to the MetadataSchema entity.
+func (m *UserMutation) ClearMetadataSchemas() {
+	m.clearedmetadata_schemas = true
+}
+
+// MetadataSchemasCleared reports if the "metadata_schemas" edge to the MetadataSchema entity was cleared.
+func (m *UserMutation) MetadataSchemasCleared() bool {
+	return m.clearedmetadata_schemas
+}
+
+// RemoveMetadataSchemaIDs removes the "metadata_schemas" edge to the MetadataSchema entity by IDs.
+func (m *UserMutation) RemoveMetadataSchemaIDs(ids ...uint) {
+	if m.removedmetadata_schemas == nil {
+		m.removedmetadata_schemas = make(map[uint]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordResetToken requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.metadata_schemas, ids[i])
+		m.removedmetadata_schemas[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordResetToken: %w", err)
+}
+
+// RemovedMetadataSchemas returns the removed IDs of the "metadata_schemas" edge to the MetadataSchema entity.
+func (m *UserMutation) RemovedMetadataSchemasIDs() (ids []uint) {
+	for id := range m.removedmetadata_schemas {
+		ids = append(ids, id)
 	}
-	return oldValue.PasswordResetToken, nil
+	return
 }
 
-// ClearPasswordResetToken clears the value of the "password_reset_token" field.
-func (m *UserMutation) ClearPasswordResetToken() {
-	m.password_reset_token = nil
-	m.clearedFields[user.FieldPasswordResetToken] = struct{}{}
+// MetadataSchemasIDs returns the "metadata_schemas" edge IDs in the mutation.
+func (m *UserMutation) MetadataSchemasIDs() (ids []uint) {
+	for id := range m.metadata_schemas {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PasswordResetTokenCleared returns if the "password_reset_token" field was cleared in this mutation.
-func (m *UserMutation) PasswordResetTokenCleared() bool {
-	_, ok := m.clearedFields[user.FieldPasswordResetToken]
-	return ok
+// ResetMetadataSchemas resets all changes to the "metadata_schemas" edge.
+func (m *UserMutation) ResetMetadataSchemas() {
+	m.metadata_schemas = nil
+	m.clearedmetadata_schemas = false
+	m.removedmetadata_schemas = nil
 }
 
-// ResetPasswordResetToken resets all changes to the "password_reset_token" field.
-func (m *UserMutation) ResetPasswordResetToken() {
-	m.password_reset_token = nil
-	delete(m.clearedFields, user.FieldPasswordResetToken)
+// AddSentOwnershipTransferIDs adds the "sent_ownership_transfers" edge to the OwnershipTransfer entity by ids.
+func (m *UserMutation) AddSentOwnershipTransferIDs(ids ...uint) {
+	if m.sent_ownership_transfers == nil {
+		m.sent_ownership_transfers = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.sent_ownership_transfers[ids[i]] = struct{}{}
+	}
 }
 
-// SetPasswordResetAt sets the "password_reset_at" field.
-func (m *UserMutation) SetPasswordResetAt(t time.Time) {
-	m.password_reset_at = &t
+// ClearSentOwnershipTransfers clears the "sent_ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *UserMutation) ClearSentOwnershipTransfers() {
+	m.clearedsent_ownership_transfers = true
 }
 
-// PasswordResetAt returns the value of the "password_reset_at" field in the mutation.
-func (m *UserMutation) PasswordResetAt() (r time.Time, exists bool) {
-	v := m.password_reset_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// SentOwnershipTransfersCleared reports if the "sent_ownership_transfers" edge to the OwnershipTransfer entity was cleared.
+func (m *UserMutation) SentOwnershipTransfersCleared() bool {
+	return m.clearedsent_ownership_transfers
 }
 
-// OldPasswordResetAt returns the old "password_reset_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordResetAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordResetAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordResetAt requires an ID field in the mutation")
+// RemoveSentOwnershipTransferIDs removes the "sent_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (m *UserMutation) RemoveSentOwnershipTransferIDs(ids ...uint) {
+	if m.removedsent_ownership_transfers == nil {
+		m.removedsent_ownership_transfers = make(map[uint]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordResetAt: %w", err)
+	for i := range ids {
+		delete(m.sent_ownership_transfers, ids[i])
+		m.removedsent_ownership_transfers[ids[i]] = struct{}{}
 	}
-	return oldValue.PasswordResetAt, nil
 }
 
-// ClearPasswordResetAt clears the value of the "password_reset_at" field.
-func (m *UserMutation) ClearPasswordResetAt() {
-	m.password_reset_at = nil
-	m.clearedFields[user.FieldPasswordResetAt] = struct{}{}
+// RemovedSentOwnershipTransfers returns the removed IDs of the "sent_ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *UserMutation) RemovedSentOwnershipTransfersIDs() (ids []uint) {
+	for id := range m.removedsent_ownership_transfers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PasswordResetAtCleared returns if the "password_reset_at" field was cleared in this mutation.
-func (m *UserMutation) PasswordResetAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldPasswordResetAt]
-	return ok
+// SentOwnershipTransfersIDs returns the "sent_ownership_transfers" edge IDs in the mutation.
+func (m *UserMutation) SentOwnershipTransfersIDs() (ids []uint) {
+	for id := range m.sent_ownership_transfers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetPasswordResetAt resets all changes to the "password_reset_at" field.
-func (m *UserMutation) ResetPasswordResetAt() {
-	m.password_reset_at = nil
-	delete(m.clearedFields, user.FieldPasswordResetAt)
+// ResetSentOwnershipTransfers resets all changes to the "sent_ownership_transfers" edge.
+func (m *UserMutation) ResetSentOwnershipTransfers() {
+	m.sent_ownership_transfers = nil
+	m.clearedsent_ownership_transfers = false
+	m.removedsent_ownership_transfers = nil
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *UserMutation) AddItemIDs(ids ...uint) {
-	if m.items == nil {
-		m.items = make(map[uint]struct{})
+// AddReceivedOwnershipTransferIDs adds the "received_ownership_transfers" edge to the OwnershipTransfer entity by ids.
+func (m *UserMutation) AddReceivedOwnershipTransferIDs(ids ...uint) {
+	if m.received_ownership_transfers == nil {
+		m.received_ownership_transfers = make(map[uint]struct{})
 	}
 	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+		m.received_ownership_transfers[ids[i]] = struct{}{}
 	}
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *UserMutation) ClearItems() {
-	m.cleareditems = true
+// ClearReceivedOwnershipTransfers clears the "received_ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *UserMutation) ClearReceivedOwnershipTransfers() {
+	m.clearedreceived_ownership_transfers = true
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *UserMutation) ItemsCleared() bool {
-	return m.cleareditems
+// ReceivedOwnershipTransfersCleared reports if the "received_ownership_transfers" edge to the OwnershipTransfer entity was cleared.
+func (m *UserMutation) ReceivedOwnershipTransfersCleared() bool {
+	return m.clearedreceived_ownership_transfers
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *UserMutation) RemoveItemIDs(ids ...uint) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[uint]struct{})
+// RemoveReceivedOwnershipTransferIDs removes the "received_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (m *UserMutation) RemoveReceivedOwnershipTransferIDs(ids ...uint) {
+	if m.removedreceived_ownership_transfers == nil {
+		m.removedreceived_ownership_transfers = make(map[uint]struct{})
 	}
 	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
+		delete(m.received_ownership_transfers, ids[i])
+		m.removedreceived_ownership_transfers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *UserMutation) RemovedItemsIDs() (ids []uint) {
-	for id := range m.removeditems {
+// RemovedReceivedOwnershipTransfers returns the removed IDs of the "received_ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *UserMutation) RemovedReceivedOwnershipTransfersIDs() (ids []uint) {
+	for id := range m.removedreceived_ownership_transfers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *UserMutation) ItemsIDs() (ids []uint) {
-	for id := range m.items {
+// ReceivedOwnershipTransfersIDs returns the "received_ownership_transfers" edge IDs in the mutation.
+func (m *UserMutation) ReceivedOwnershipTransfersIDs() (ids []uint) {
+	for id := range m.received_ownership_transfers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *UserMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ResetReceivedOwnershipTransfers resets all changes to the "received_ownership_transfers" edge.
+func (m *UserMutation) ResetReceivedOwnershipTransfers() {
+	m.received_ownership_transfers = nil
+	m.clearedreceived_ownership_transfers = false
+	m.removedreceived_ownership_transfers = nil
 }
 
 // Where appends a list predicates to the UserMutation builder.
@@ -1286,13 +10876,19 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 15)
 	if m.create_time != nil {
 		fields = append(fields, user.FieldCreateTime)
 	}
 	if m.update_time != nil {
 		fields = append(fields, user.FieldUpdateTime)
 	}
+	if m.delete_time != nil {
+		fields = append(fields, user.FieldDeleteTime)
+	}
+	if m.version != nil {
+		fields = append(fields, user.FieldVersion)
+	}
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
@@ -1320,6 +10916,12 @@ func (m *UserMutation) Fields() []string {
 	if m.password_reset_at != nil {
 		fields = append(fields, user.FieldPasswordResetAt)
 	}
+	if m.calendar_token != nil {
+		fields = append(fields, user.FieldCalendarToken)
+	}
+	if m.plan != nil {
+		fields = append(fields, user.FieldPlan)
+	}
 	return fields
 }
 
@@ -1332,6 +10934,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.CreateTime()
 	case user.FieldUpdateTime:
 		return m.UpdateTime()
+	case user.FieldDeleteTime:
+		return m.DeleteTime()
+	case user.FieldVersion:
+		return m.Version()
 	case user.FieldName:
 		return m.Name()
 	case user.FieldEmail:
@@ -1350,6 +10956,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.PasswordResetToken()
 	case user.FieldPasswordResetAt:
 		return m.PasswordResetAt()
+	case user.FieldCalendarToken:
+		return m.CalendarToken()
+	case user.FieldPlan:
+		return m.Plan()
 	}
 	return nil, false
 }
@@ -1363,6 +10973,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCreateTime(ctx)
 	case user.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
+	case user.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case user.FieldVersion:
+		return m.OldVersion(ctx)
 	case user.FieldName:
 		return m.OldName(ctx)
 	case user.FieldEmail:
@@ -1381,6 +10995,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldPasswordResetToken(ctx)
 	case user.FieldPasswordResetAt:
 		return m.OldPasswordResetAt(ctx)
+	case user.FieldCalendarToken:
+		return m.OldCalendarToken(ctx)
+	case user.FieldPlan:
+		return m.OldPlan(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -1404,6 +11022,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdateTime(v)
 		return nil
+	case user.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case user.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -1467,6 +11099,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPasswordResetAt(v)
 		return nil
+	case user.FieldCalendarToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCalendarToken(v)
+		return nil
+	case user.FieldPlan:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlan(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -1474,13 +11120,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, user.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
@@ -1489,6 +11143,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -1497,6 +11158,9 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(user.FieldDeleteTime) {
+		fields = append(fields, user.FieldDeleteTime)
+	}
 	if m.FieldCleared(user.FieldVerificationCode) {
 		fields = append(fields, user.FieldVerificationCode)
 	}
@@ -1506,6 +11170,12 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldPasswordResetAt) {
 		fields = append(fields, user.FieldPasswordResetAt)
 	}
+	if m.FieldCleared(user.FieldCalendarToken) {
+		fields = append(fields, user.FieldCalendarToken)
+	}
+	if m.FieldCleared(user.FieldPlan) {
+		fields = append(fields, user.FieldPlan)
+	}
 	return fields
 }
 
@@ -1520,6 +11190,9 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
 	switch name {
+	case user.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
 	case user.FieldVerificationCode:
 		m.ClearVerificationCode()
 		return nil
@@ -1529,6 +11202,12 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldPasswordResetAt:
 		m.ClearPasswordResetAt()
 		return nil
+	case user.FieldCalendarToken:
+		m.ClearCalendarToken()
+		return nil
+	case user.FieldPlan:
+		m.ClearPlan()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -1543,6 +11222,12 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
+	case user.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case user.FieldVersion:
+		m.ResetVersion()
+		return nil
 	case user.FieldName:
 		m.ResetName()
 		return nil
@@ -1570,16 +11255,52 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldPasswordResetAt:
 		m.ResetPasswordResetAt()
 		return nil
+	case user.FieldCalendarToken:
+		m.ResetCalendarToken()
+		return nil
+	case user.FieldPlan:
+		m.ResetPlan()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 11)
 	if m.items != nil {
 		edges = append(edges, user.EdgeItems)
 	}
+	if m.shared_items != nil {
+		edges = append(edges, user.EdgeSharedItems)
+	}
+	if m.item_revisions != nil {
+		edges = append(edges, user.EdgeItemRevisions)
+	}
+	if m.tags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.attachments != nil {
+		edges = append(edges, user.EdgeAttachments)
+	}
+	if m.item_imports != nil {
+		edges = append(edges, user.EdgeItemImports)
+	}
+	if m.comments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.item_transitions != nil {
+		edges = append(edges, user.EdgeItemTransitions)
+	}
+	if m.metadata_schemas != nil {
+		edges = append(edges, user.EdgeMetadataSchemas)
+	}
+	if m.sent_ownership_transfers != nil {
+		edges = append(edges, user.EdgeSentOwnershipTransfers)
+	}
+	if m.received_ownership_transfers != nil {
+		edges = append(edges, user.EdgeReceivedOwnershipTransfers)
+	}
 	return edges
 }
 
@@ -1593,16 +11314,106 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSharedItems:
+		ids := make([]ent.Value, 0, len(m.shared_items))
+		for id := range m.shared_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemRevisions:
+		ids := make([]ent.Value, 0, len(m.item_revisions))
+		for id := range m.item_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemImports:
+		ids := make([]ent.Value, 0, len(m.item_imports))
+		for id := range m.item_imports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemTransitions:
+		ids := make([]ent.Value, 0, len(m.item_transitions))
+		for id := range m.item_transitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMetadataSchemas:
+		ids := make([]ent.Value, 0, len(m.metadata_schemas))
+		for id := range m.metadata_schemas {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.sent_ownership_transfers))
+		for id := range m.sent_ownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.received_ownership_transfers))
+		for id := range m.received_ownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 11)
 	if m.removeditems != nil {
 		edges = append(edges, user.EdgeItems)
 	}
+	if m.removedshared_items != nil {
+		edges = append(edges, user.EdgeSharedItems)
+	}
+	if m.removeditem_revisions != nil {
+		edges = append(edges, user.EdgeItemRevisions)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, user.EdgeAttachments)
+	}
+	if m.removeditem_imports != nil {
+		edges = append(edges, user.EdgeItemImports)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.removeditem_transitions != nil {
+		edges = append(edges, user.EdgeItemTransitions)
+	}
+	if m.removedmetadata_schemas != nil {
+		edges = append(edges, user.EdgeMetadataSchemas)
+	}
+	if m.removedsent_ownership_transfers != nil {
+		edges = append(edges, user.EdgeSentOwnershipTransfers)
+	}
+	if m.removedreceived_ownership_transfers != nil {
+		edges = append(edges, user.EdgeReceivedOwnershipTransfers)
+	}
 	return edges
 }
 
@@ -1616,16 +11427,106 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSharedItems:
+		ids := make([]ent.Value, 0, len(m.removedshared_items))
+		for id := range m.removedshared_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemRevisions:
+		ids := make([]ent.Value, 0, len(m.removeditem_revisions))
+		for id := range m.removeditem_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemImports:
+		ids := make([]ent.Value, 0, len(m.removeditem_imports))
+		for id := range m.removeditem_imports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeItemTransitions:
+		ids := make([]ent.Value, 0, len(m.removeditem_transitions))
+		for id := range m.removeditem_transitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMetadataSchemas:
+		ids := make([]ent.Value, 0, len(m.removedmetadata_schemas))
+		for id := range m.removedmetadata_schemas {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.removedsent_ownership_transfers))
+		for id := range m.removedsent_ownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.removedreceived_ownership_transfers))
+		for id := range m.removedreceived_ownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 11)
 	if m.cleareditems {
 		edges = append(edges, user.EdgeItems)
 	}
+	if m.clearedshared_items {
+		edges = append(edges, user.EdgeSharedItems)
+	}
+	if m.cleareditem_revisions {
+		edges = append(edges, user.EdgeItemRevisions)
+	}
+	if m.clearedtags {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.clearedattachments {
+		edges = append(edges, user.EdgeAttachments)
+	}
+	if m.cleareditem_imports {
+		edges = append(edges, user.EdgeItemImports)
+	}
+	if m.clearedcomments {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.cleareditem_transitions {
+		edges = append(edges, user.EdgeItemTransitions)
+	}
+	if m.clearedmetadata_schemas {
+		edges = append(edges, user.EdgeMetadataSchemas)
+	}
+	if m.clearedsent_ownership_transfers {
+		edges = append(edges, user.EdgeSentOwnershipTransfers)
+	}
+	if m.clearedreceived_ownership_transfers {
+		edges = append(edges, user.EdgeReceivedOwnershipTransfers)
+	}
 	return edges
 }
 
@@ -1635,6 +11536,26 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
 	case user.EdgeItems:
 		return m.cleareditems
+	case user.EdgeSharedItems:
+		return m.clearedshared_items
+	case user.EdgeItemRevisions:
+		return m.cleareditem_revisions
+	case user.EdgeTags:
+		return m.clearedtags
+	case user.EdgeAttachments:
+		return m.clearedattachments
+	case user.EdgeItemImports:
+		return m.cleareditem_imports
+	case user.EdgeComments:
+		return m.clearedcomments
+	case user.EdgeItemTransitions:
+		return m.cleareditem_transitions
+	case user.EdgeMetadataSchemas:
+		return m.clearedmetadata_schemas
+	case user.EdgeSentOwnershipTransfers:
+		return m.clearedsent_ownership_transfers
+	case user.EdgeReceivedOwnershipTransfers:
+		return m.clearedreceived_ownership_transfers
 	}
 	return false
 }
@@ -1654,6 +11575,36 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeItems:
 		m.ResetItems()
 		return nil
+	case user.EdgeSharedItems:
+		m.ResetSharedItems()
+		return nil
+	case user.EdgeItemRevisions:
+		m.ResetItemRevisions()
+		return nil
+	case user.EdgeTags:
+		m.ResetTags()
+		return nil
+	case user.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case user.EdgeItemImports:
+		m.ResetItemImports()
+		return nil
+	case user.EdgeComments:
+		m.ResetComments()
+		return nil
+	case user.EdgeItemTransitions:
+		m.ResetItemTransitions()
+		return nil
+	case user.EdgeMetadataSchemas:
+		m.ResetMetadataSchemas()
+		return nil
+	case user.EdgeSentOwnershipTransfers:
+		m.ResetSentOwnershipTransfers()
+		return nil
+	case user.EdgeReceivedOwnershipTransfers:
+		m.ResetReceivedOwnershipTransfers()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// OwnershipTransfer is the model entity for the OwnershipTransfer schema.
+type OwnershipTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uint `json:"item_id,omitempty"`
+	// FromUserID holds the value of the "from_user_id" field.
+	FromUserID uint `json:"from_user_id,omitempty"`
+	// ToUserID holds the value of the "to_user_id" field.
+	ToUserID uint `json:"to_user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status ownershiptransfer.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OwnershipTransferQuery when eager-loading is set.
+	Edges        OwnershipTransferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OwnershipTransferEdges holds the relations/edges for other nodes in the graph.
+type OwnershipTransferEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// FromUser holds the value of the from_user edge.
+	FromUser *User `json:"from_user,omitempty"`
+	// ToUser holds the value of the to_user edge.
+	ToUser *User `json:"to_user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OwnershipTransferEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// FromUserOrErr returns the FromUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OwnershipTransferEdges) FromUserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.FromUser == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.FromUser, nil
+	}
+	return nil, &NotLoadedError{edge: "from_user"}
+}
+
+// ToUserOrErr returns the ToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OwnershipTransferEdges) ToUserOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.ToUser == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.ToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "to_user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OwnershipTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ownershiptransfer.FieldID, ownershiptransfer.FieldItemID, ownershiptransfer.FieldFromUserID, ownershiptransfer.FieldToUserID:
+			values[i] = new(sql.NullInt64)
+		case ownershiptransfer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case ownershiptransfer.FieldCreateTime, ownershiptransfer.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OwnershipTransfer fields.
+func (ot *OwnershipTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ownershiptransfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ot.ID = uint(value.Int64)
+		case ownershiptransfer.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ot.CreateTime = value.Time
+			}
+		case ownershiptransfer.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ot.UpdateTime = value.Time
+			}
+		case ownershiptransfer.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ot.ItemID = uint(value.Int64)
+			}
+		case ownershiptransfer.FieldFromUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_user_id", values[i])
+			} else if value.Valid {
+				ot.FromUserID = uint(value.Int64)
+			}
+		case ownershiptransfer.FieldToUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_user_id", values[i])
+			} else if value.Valid {
+				ot.ToUserID = uint(value.Int64)
+			}
+		case ownershiptransfer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ot.Status = ownershiptransfer.Status(value.String)
+			}
+		default:
+			ot.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OwnershipTransfer.
+// This includes values selected through modifiers, order, etc.
+func (ot *OwnershipTransfer) Value(name string) (ent.Value, error) {
+	return ot.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the OwnershipTransfer entity.
+func (ot *OwnershipTransfer) QueryItem() *ItemQuery {
+	return NewOwnershipTransferClient(ot.config).QueryItem(ot)
+}
+
+// QueryFromUser queries the "from_user" edge of the OwnershipTransfer entity.
+func (ot *OwnershipTransfer) QueryFromUser() *UserQuery {
+	return NewOwnershipTransferClient(ot.config).QueryFromUser(ot)
+}
+
+// QueryToUser queries the "to_user" edge of the OwnershipTransfer entity.
+func (ot *OwnershipTransfer) QueryToUser() *UserQuery {
+	return NewOwnershipTransferClient(ot.config).QueryToUser(ot)
+}
+
+// Update returns a builder for updating this OwnershipTransfer.
+// Note that you need to call OwnershipTransfer.Unwrap() before calling this method if this OwnershipTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ot *OwnershipTransfer) Update() *OwnershipTransferUpdateOne {
+	return NewOwnershipTransferClient(ot.config).UpdateOne(ot)
+}
+
+// Unwrap unwraps the OwnershipTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ot *OwnershipTransfer) Unwrap() *OwnershipTransfer {
+	_tx, ok := ot.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OwnershipTransfer is not a transactional entity")
+	}
+	ot.config.driver = _tx.drv
+	return ot
+}
+
+// String implements the fmt.Stringer.
+func (ot *OwnershipTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("OwnershipTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ot.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ot.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ot.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ot.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("from_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ot.FromUserID))
+	builder.WriteString(", ")
+	builder.WriteString("to_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ot.ToUserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OwnershipTransfers is a parsable slice of OwnershipTransfer.
+type OwnershipTransfers []*OwnershipTransfer
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ownershiptransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldItemID, v))
+}
+
+// FromUserID applies equality check predicate on the "from_user_id" field. It's identical to FromUserIDEQ.
+func FromUserID(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// ToUserID applies equality check predicate on the "to_user_id" field. It's identical to ToUserIDEQ.
+func ToUserID(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldToUserID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// FromUserIDEQ applies the EQ predicate on the "from_user_id" field.
+func FromUserIDEQ(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// FromUserIDNEQ applies the NEQ predicate on the "from_user_id" field.
+func FromUserIDNEQ(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldFromUserID, v))
+}
+
+// FromUserIDIn applies the In predicate on the "from_user_id" field.
+func FromUserIDIn(vs ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDNotIn applies the NotIn predicate on the "from_user_id" field.
+func FromUserIDNotIn(vs ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldFromUserID, vs...))
+}
+
+// ToUserIDEQ applies the EQ predicate on the "to_user_id" field.
+func ToUserIDEQ(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldToUserID, v))
+}
+
+// ToUserIDNEQ applies the NEQ predicate on the "to_user_id" field.
+func ToUserIDNEQ(v uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldToUserID, v))
+}
+
+// ToUserIDIn applies the In predicate on the "to_user_id" field.
+func ToUserIDIn(vs ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldToUserID, vs...))
+}
+
+// ToUserIDNotIn applies the NotIn predicate on the "to_user_id" field.
+func ToUserIDNotIn(vs ...uint) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldToUserID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFromUser applies the HasEdge predicate on the "from_user" edge.
+func HasFromUser() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FromUserTable, FromUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFromUserWith applies the HasEdge predicate on the "from_user" edge with a given conditions (other predicates).
+func HasFromUserWith(preds ...predicate.User) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newFromUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasToUser applies the HasEdge predicate on the "to_user" edge.
+func HasToUser() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ToUserTable, ToUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasToUserWith applies the HasEdge predicate on the "to_user" edge with a given conditions (other predicates).
+func HasToUserWith(preds ...predicate.User) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newToUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// OwnershipTransferCreate is the builder for creating a OwnershipTransfer entity.
+type OwnershipTransferCreate struct {
+	config
+	mutation *OwnershipTransferMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (otc *OwnershipTransferCreate) SetCreateTime(t time.Time) *OwnershipTransferCreate {
+	otc.mutation.SetCreateTime(t)
+	return otc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableCreateTime(t *time.Time) *OwnershipTransferCreate {
+	if t != nil {
+		otc.SetCreateTime(*t)
+	}
+	return otc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (otc *OwnershipTransferCreate) SetUpdateTime(t time.Time) *OwnershipTransferCreate {
+	otc.mutation.SetUpdateTime(t)
+	return otc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableUpdateTime(t *time.Time) *OwnershipTransferCreate {
+	if t != nil {
+		otc.SetUpdateTime(*t)
+	}
+	return otc
+}
+
+// SetItemID sets the "item_id" field.
+func (otc *OwnershipTransferCreate) SetItemID(u uint) *OwnershipTransferCreate {
+	otc.mutation.SetItemID(u)
+	return otc
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (otc *OwnershipTransferCreate) SetFromUserID(u uint) *OwnershipTransferCreate {
+	otc.mutation.SetFromUserID(u)
+	return otc
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (otc *OwnershipTransferCreate) SetToUserID(u uint) *OwnershipTransferCreate {
+	otc.mutation.SetToUserID(u)
+	return otc
+}
+
+// SetStatus sets the "status" field.
+func (otc *OwnershipTransferCreate) SetStatus(o ownershiptransfer.Status) *OwnershipTransferCreate {
+	otc.mutation.SetStatus(o)
+	return otc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otc *OwnershipTransferCreate) SetNillableStatus(o *ownershiptransfer.Status) *OwnershipTransferCreate {
+	if o != nil {
+		otc.SetStatus(*o)
+	}
+	return otc
+}
+
+// SetID sets the "id" field.
+func (otc *OwnershipTransferCreate) SetID(u uint) *OwnershipTransferCreate {
+	otc.mutation.SetID(u)
+	return otc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (otc *OwnershipTransferCreate) SetItem(i *Item) *OwnershipTransferCreate {
+	return otc.SetItemID(i.ID)
+}
+
+// SetFromUser sets the "from_user" edge to the User entity.
+func (otc *OwnershipTransferCreate) SetFromUser(u *User) *OwnershipTransferCreate {
+	return otc.SetFromUserID(u.ID)
+}
+
+// SetToUser sets the "to_user" edge to the User entity.
+func (otc *OwnershipTransferCreate) SetToUser(u *User) *OwnershipTransferCreate {
+	return otc.SetToUserID(u.ID)
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (otc *OwnershipTransferCreate) Mutation() *OwnershipTransferMutation {
+	return otc.mutation
+}
+
+// Save creates the OwnershipTransfer in the database.
+func (otc *OwnershipTransferCreate) Save(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := otc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*OwnershipTransfer, OwnershipTransferMutation](ctx, otc.sqlSave, otc.mutation, otc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (otc *OwnershipTransferCreate) SaveX(ctx context.Context) *OwnershipTransfer {
+	v, err := otc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otc *OwnershipTransferCreate) Exec(ctx context.Context) error {
+	_, err := otc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otc *OwnershipTransferCreate) ExecX(ctx context.Context) {
+	if err := otc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otc *OwnershipTransferCreate) defaults() error {
+	if _, ok := otc.mutation.CreateTime(); !ok {
+		if ownershiptransfer.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized ownershiptransfer.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := ownershiptransfer.DefaultCreateTime()
+		otc.mutation.SetCreateTime(v)
+	}
+	if _, ok := otc.mutation.UpdateTime(); !ok {
+		if ownershiptransfer.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized ownershiptransfer.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := ownershiptransfer.DefaultUpdateTime()
+		otc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := otc.mutation.Status(); !ok {
+		v := ownershiptransfer.DefaultStatus
+		otc.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otc *OwnershipTransferCreate) check() error {
+	if _, ok := otc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OwnershipTransfer.create_time"`)}
+	}
+	if _, ok := otc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OwnershipTransfer.update_time"`)}
+	}
+	if _, ok := otc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "OwnershipTransfer.item_id"`)}
+	}
+	if _, ok := otc.mutation.FromUserID(); !ok {
+		return &ValidationError{Name: "from_user_id", err: errors.New(`ent: missing required field "OwnershipTransfer.from_user_id"`)}
+	}
+	if _, ok := otc.mutation.ToUserID(); !ok {
+		return &ValidationError{Name: "to_user_id", err: errors.New(`ent: missing required field "OwnershipTransfer.to_user_id"`)}
+	}
+	if _, ok := otc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OwnershipTransfer.status"`)}
+	}
+	if v, ok := otc.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "OwnershipTransfer.item"`)}
+	}
+	if _, ok := otc.mutation.FromUserID(); !ok {
+		return &ValidationError{Name: "from_user", err: errors.New(`ent: missing required edge "OwnershipTransfer.from_user"`)}
+	}
+	if _, ok := otc.mutation.ToUserID(); !ok {
+		return &ValidationError{Name: "to_user", err: errors.New(`ent: missing required edge "OwnershipTransfer.to_user"`)}
+	}
+	return nil
+}
+
+func (otc *OwnershipTransferCreate) sqlSave(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := otc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := otc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, otc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	otc.mutation.id = &_node.ID
+	otc.mutation.done = true
+	return _node, nil
+}
+
+func (otc *OwnershipTransferCreate) createSpec() (*OwnershipTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnershipTransfer{config: otc.config}
+		_spec = sqlgraph.NewCreateSpec(ownershiptransfer.Table, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint))
+	)
+	if id, ok := otc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := otc.mutation.CreateTime(); ok {
+		_spec.SetField(ownershiptransfer.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := otc.mutation.UpdateTime(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := otc.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := otc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.ItemTable,
+			Columns: []string{ownershiptransfer.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := otc.mutation.FromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.FromUserTable,
+			Columns: []string{ownershiptransfer.FromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FromUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := otc.mutation.ToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.ToUserTable,
+			Columns: []string{ownershiptransfer.ToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ToUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OwnershipTransferCreateBulk is the builder for creating many OwnershipTransfer entities in bulk.
+type OwnershipTransferCreateBulk struct {
+	config
+	builders []*OwnershipTransferCreate
+}
+
+// Save creates the OwnershipTransfer entities in the database.
+func (otcb *OwnershipTransferCreateBulk) Save(ctx context.Context) ([]*OwnershipTransfer, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(otcb.builders))
+	nodes := make([]*OwnershipTransfer, len(otcb.builders))
+	mutators := make([]Mutator, len(otcb.builders))
+	for i := range otcb.builders {
+		func(i int, root context.Context) {
+			builder := otcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnershipTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, otcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, otcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, otcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otcb *OwnershipTransferCreateBulk) SaveX(ctx context.Context) []*OwnershipTransfer {
+	v, err := otcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otcb *OwnershipTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := otcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otcb *OwnershipTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := otcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
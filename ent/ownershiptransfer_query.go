@@ -0,0 +1,789 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+)
+
+// OwnershipTransferQuery is the builder for querying OwnershipTransfer entities.
+type OwnershipTransferQuery struct {
+	config
+	ctx          *QueryContext
+	order        []ownershiptransfer.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.OwnershipTransfer
+	withItem     *ItemQuery
+	withFromUser *UserQuery
+	withToUser   *UserQuery
+	loadTotal    []func(context.Context, []*OwnershipTransfer) error
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OwnershipTransferQuery builder.
+func (otq *OwnershipTransferQuery) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferQuery {
+	otq.predicates = append(otq.predicates, ps...)
+	return otq
+}
+
+// Limit the number of records to be returned by this query.
+func (otq *OwnershipTransferQuery) Limit(limit int) *OwnershipTransferQuery {
+	otq.ctx.Limit = &limit
+	return otq
+}
+
+// Offset to start from.
+func (otq *OwnershipTransferQuery) Offset(offset int) *OwnershipTransferQuery {
+	otq.ctx.Offset = &offset
+	return otq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (otq *OwnershipTransferQuery) Unique(unique bool) *OwnershipTransferQuery {
+	otq.ctx.Unique = &unique
+	return otq
+}
+
+// Order specifies how the records should be ordered.
+func (otq *OwnershipTransferQuery) Order(o ...ownershiptransfer.OrderOption) *OwnershipTransferQuery {
+	otq.order = append(otq.order, o...)
+	return otq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (otq *OwnershipTransferQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: otq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := otq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := otq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownershiptransfer.ItemTable, ownershiptransfer.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(otq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFromUser chains the current query on the "from_user" edge.
+func (otq *OwnershipTransferQuery) QueryFromUser() *UserQuery {
+	query := (&UserClient{config: otq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := otq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := otq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownershiptransfer.FromUserTable, ownershiptransfer.FromUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(otq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryToUser chains the current query on the "to_user" edge.
+func (otq *OwnershipTransferQuery) QueryToUser() *UserQuery {
+	query := (&UserClient{config: otq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := otq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := otq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownershiptransfer.Table, ownershiptransfer.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownershiptransfer.ToUserTable, ownershiptransfer.ToUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(otq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OwnershipTransfer entity from the query.
+// Returns a *NotFoundError when no OwnershipTransfer was found.
+func (otq *OwnershipTransferQuery) First(ctx context.Context) (*OwnershipTransfer, error) {
+	nodes, err := otq.Limit(1).All(setContextOp(ctx, otq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ownershiptransfer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) FirstX(ctx context.Context) *OwnershipTransfer {
+	node, err := otq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OwnershipTransfer ID from the query.
+// Returns a *NotFoundError when no OwnershipTransfer ID was found.
+func (otq *OwnershipTransferQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = otq.Limit(1).IDs(setContextOp(ctx, otq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ownershiptransfer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) FirstIDX(ctx context.Context) uint {
+	id, err := otq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OwnershipTransfer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OwnershipTransfer entity is found.
+// Returns a *NotFoundError when no OwnershipTransfer entities are found.
+func (otq *OwnershipTransferQuery) Only(ctx context.Context) (*OwnershipTransfer, error) {
+	nodes, err := otq.Limit(2).All(setContextOp(ctx, otq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ownershiptransfer.Label}
+	default:
+		return nil, &NotSingularError{ownershiptransfer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) OnlyX(ctx context.Context) *OwnershipTransfer {
+	node, err := otq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OwnershipTransfer ID in the query.
+// Returns a *NotSingularError when more than one OwnershipTransfer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (otq *OwnershipTransferQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = otq.Limit(2).IDs(setContextOp(ctx, otq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ownershiptransfer.Label}
+	default:
+		err = &NotSingularError{ownershiptransfer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := otq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OwnershipTransfers.
+func (otq *OwnershipTransferQuery) All(ctx context.Context) ([]*OwnershipTransfer, error) {
+	ctx = setContextOp(ctx, otq.ctx, "All")
+	if err := otq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OwnershipTransfer, *OwnershipTransferQuery]()
+	return withInterceptors[[]*OwnershipTransfer](ctx, otq, qr, otq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) AllX(ctx context.Context) []*OwnershipTransfer {
+	nodes, err := otq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OwnershipTransfer IDs.
+func (otq *OwnershipTransferQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if otq.ctx.Unique == nil && otq.path != nil {
+		otq.Unique(true)
+	}
+	ctx = setContextOp(ctx, otq.ctx, "IDs")
+	if err = otq.Select(ownershiptransfer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) IDsX(ctx context.Context) []uint {
+	ids, err := otq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (otq *OwnershipTransferQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, otq.ctx, "Count")
+	if err := otq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, otq, querierCount[*OwnershipTransferQuery](), otq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) CountX(ctx context.Context) int {
+	count, err := otq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (otq *OwnershipTransferQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, otq.ctx, "Exist")
+	switch _, err := otq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (otq *OwnershipTransferQuery) ExistX(ctx context.Context) bool {
+	exist, err := otq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OwnershipTransferQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (otq *OwnershipTransferQuery) Clone() *OwnershipTransferQuery {
+	if otq == nil {
+		return nil
+	}
+	return &OwnershipTransferQuery{
+		config:       otq.config,
+		ctx:          otq.ctx.Clone(),
+		order:        append([]ownershiptransfer.OrderOption{}, otq.order...),
+		inters:       append([]Interceptor{}, otq.inters...),
+		predicates:   append([]predicate.OwnershipTransfer{}, otq.predicates...),
+		withItem:     otq.withItem.Clone(),
+		withFromUser: otq.withFromUser.Clone(),
+		withToUser:   otq.withToUser.Clone(),
+		// clone intermediate query.
+		sql:  otq.sql.Clone(),
+		path: otq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (otq *OwnershipTransferQuery) WithItem(opts ...func(*ItemQuery)) *OwnershipTransferQuery {
+	query := (&ItemClient{config: otq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	otq.withItem = query
+	return otq
+}
+
+// WithFromUser tells the query-builder to eager-load the nodes that are connected to
+// the "from_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (otq *OwnershipTransferQuery) WithFromUser(opts ...func(*UserQuery)) *OwnershipTransferQuery {
+	query := (&UserClient{config: otq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	otq.withFromUser = query
+	return otq
+}
+
+// WithToUser tells the query-builder to eager-load the nodes that are connected to
+// the "to_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (otq *OwnershipTransferQuery) WithToUser(opts ...func(*UserQuery)) *OwnershipTransferQuery {
+	query := (&UserClient{config: otq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	otq.withToUser = query
+	return otq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OwnershipTransfer.Query().
+//		GroupBy(ownershiptransfer.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (otq *OwnershipTransferQuery) GroupBy(field string, fields ...string) *OwnershipTransferGroupBy {
+	otq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OwnershipTransferGroupBy{build: otq}
+	grbuild.flds = &otq.ctx.Fields
+	grbuild.label = ownershiptransfer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.OwnershipTransfer.Query().
+//		Select(ownershiptransfer.FieldCreateTime).
+//		Scan(ctx, &v)
+func (otq *OwnershipTransferQuery) Select(fields ...string) *OwnershipTransferSelect {
+	otq.ctx.Fields = append(otq.ctx.Fields, fields...)
+	sbuild := &OwnershipTransferSelect{OwnershipTransferQuery: otq}
+	sbuild.label = ownershiptransfer.Label
+	sbuild.flds, sbuild.scan = &otq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OwnershipTransferSelect configured with the given aggregations.
+func (otq *OwnershipTransferQuery) Aggregate(fns ...AggregateFunc) *OwnershipTransferSelect {
+	return otq.Select().Aggregate(fns...)
+}
+
+func (otq *OwnershipTransferQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range otq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, otq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range otq.ctx.Fields {
+		if !ownershiptransfer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if otq.path != nil {
+		prev, err := otq.path(ctx)
+		if err != nil {
+			return err
+		}
+		otq.sql = prev
+	}
+	if ownershiptransfer.Policy == nil {
+		return errors.New("ent: uninitialized ownershiptransfer.Policy (forgotten import ent/runtime?)")
+	}
+	if err := ownershiptransfer.Policy.EvalQuery(ctx, otq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (otq *OwnershipTransferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OwnershipTransfer, error) {
+	var (
+		nodes       = []*OwnershipTransfer{}
+		_spec       = otq.querySpec()
+		loadedTypes = [3]bool{
+			otq.withItem != nil,
+			otq.withFromUser != nil,
+			otq.withToUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OwnershipTransfer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OwnershipTransfer{config: otq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(otq.modifiers) > 0 {
+		_spec.Modifiers = otq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, otq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := otq.withItem; query != nil {
+		if err := otq.loadItem(ctx, query, nodes, nil,
+			func(n *OwnershipTransfer, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := otq.withFromUser; query != nil {
+		if err := otq.loadFromUser(ctx, query, nodes, nil,
+			func(n *OwnershipTransfer, e *User) { n.Edges.FromUser = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := otq.withToUser; query != nil {
+		if err := otq.loadToUser(ctx, query, nodes, nil,
+			func(n *OwnershipTransfer, e *User) { n.Edges.ToUser = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range otq.loadTotal {
+		if err := otq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (otq *OwnershipTransferQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*OwnershipTransfer, init func(*OwnershipTransfer), assign func(*OwnershipTransfer, *Item)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*OwnershipTransfer)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (otq *OwnershipTransferQuery) loadFromUser(ctx context.Context, query *UserQuery, nodes []*OwnershipTransfer, init func(*OwnershipTransfer), assign func(*OwnershipTransfer, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*OwnershipTransfer)
+	for i := range nodes {
+		fk := nodes[i].FromUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "from_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (otq *OwnershipTransferQuery) loadToUser(ctx context.Context, query *UserQuery, nodes []*OwnershipTransfer, init func(*OwnershipTransfer), assign func(*OwnershipTransfer, *User)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*OwnershipTransfer)
+	for i := range nodes {
+		fk := nodes[i].ToUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "to_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (otq *OwnershipTransferQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := otq.querySpec()
+	if len(otq.modifiers) > 0 {
+		_spec.Modifiers = otq.modifiers
+	}
+	_spec.Node.Columns = otq.ctx.Fields
+	if len(otq.ctx.Fields) > 0 {
+		_spec.Unique = otq.ctx.Unique != nil && *otq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, otq.driver, _spec)
+}
+
+func (otq *OwnershipTransferQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint))
+	_spec.From = otq.sql
+	if unique := otq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if otq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := otq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownershiptransfer.FieldID)
+		for i := range fields {
+			if fields[i] != ownershiptransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if otq.withItem != nil {
+			_spec.Node.AddColumnOnce(ownershiptransfer.FieldItemID)
+		}
+		if otq.withFromUser != nil {
+			_spec.Node.AddColumnOnce(ownershiptransfer.FieldFromUserID)
+		}
+		if otq.withToUser != nil {
+			_spec.Node.AddColumnOnce(ownershiptransfer.FieldToUserID)
+		}
+	}
+	if ps := otq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := otq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := otq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := otq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (otq *OwnershipTransferQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(otq.driver.Dialect())
+	t1 := builder.Table(ownershiptransfer.Table)
+	columns := otq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ownershiptransfer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if otq.sql != nil {
+		selector = otq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if otq.ctx.Unique != nil && *otq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range otq.modifiers {
+		m(selector)
+	}
+	for _, p := range otq.predicates {
+		p(selector)
+	}
+	for _, p := range otq.order {
+		p(selector)
+	}
+	if offset := otq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := otq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (otq *OwnershipTransferQuery) Modify(modifiers ...func(s *sql.Selector)) *OwnershipTransferSelect {
+	otq.modifiers = append(otq.modifiers, modifiers...)
+	return otq.Select()
+}
+
+// OwnershipTransferGroupBy is the group-by builder for OwnershipTransfer entities.
+type OwnershipTransferGroupBy struct {
+	selector
+	build *OwnershipTransferQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (otgb *OwnershipTransferGroupBy) Aggregate(fns ...AggregateFunc) *OwnershipTransferGroupBy {
+	otgb.fns = append(otgb.fns, fns...)
+	return otgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (otgb *OwnershipTransferGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, otgb.build.ctx, "GroupBy")
+	if err := otgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OwnershipTransferQuery, *OwnershipTransferGroupBy](ctx, otgb.build, otgb, otgb.build.inters, v)
+}
+
+func (otgb *OwnershipTransferGroupBy) sqlScan(ctx context.Context, root *OwnershipTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(otgb.fns))
+	for _, fn := range otgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*otgb.flds)+len(otgb.fns))
+		for _, f := range *otgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*otgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := otgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OwnershipTransferSelect is the builder for selecting fields of OwnershipTransfer entities.
+type OwnershipTransferSelect struct {
+	*OwnershipTransferQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ots *OwnershipTransferSelect) Aggregate(fns ...AggregateFunc) *OwnershipTransferSelect {
+	ots.fns = append(ots.fns, fns...)
+	return ots
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ots *OwnershipTransferSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ots.ctx, "Select")
+	if err := ots.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OwnershipTransferQuery, *OwnershipTransferSelect](ctx, ots.OwnershipTransferQuery, ots, ots.inters, v)
+}
+
+func (ots *OwnershipTransferSelect) sqlScan(ctx context.Context, root *OwnershipTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ots.fns))
+	for _, fn := range ots.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ots.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ots.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ots *OwnershipTransferSelect) Modify(modifiers ...func(s *sql.Selector)) *OwnershipTransferSelect {
+	ots.modifiers = append(ots.modifiers, modifiers...)
+	return ots
+}
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+)
+
+// OwnershipTransferUpdate is the builder for updating OwnershipTransfer entities.
+type OwnershipTransferUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OwnershipTransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OwnershipTransferUpdate builder.
+func (otu *OwnershipTransferUpdate) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferUpdate {
+	otu.mutation.Where(ps...)
+	return otu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (otu *OwnershipTransferUpdate) SetUpdateTime(t time.Time) *OwnershipTransferUpdate {
+	otu.mutation.SetUpdateTime(t)
+	return otu
+}
+
+// SetStatus sets the "status" field.
+func (otu *OwnershipTransferUpdate) SetStatus(o ownershiptransfer.Status) *OwnershipTransferUpdate {
+	otu.mutation.SetStatus(o)
+	return otu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otu *OwnershipTransferUpdate) SetNillableStatus(o *ownershiptransfer.Status) *OwnershipTransferUpdate {
+	if o != nil {
+		otu.SetStatus(*o)
+	}
+	return otu
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (otu *OwnershipTransferUpdate) Mutation() *OwnershipTransferMutation {
+	return otu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (otu *OwnershipTransferUpdate) Save(ctx context.Context) (int, error) {
+	if err := otu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, OwnershipTransferMutation](ctx, otu.sqlSave, otu.mutation, otu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otu *OwnershipTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := otu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (otu *OwnershipTransferUpdate) Exec(ctx context.Context) error {
+	_, err := otu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otu *OwnershipTransferUpdate) ExecX(ctx context.Context) {
+	if err := otu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otu *OwnershipTransferUpdate) defaults() error {
+	if _, ok := otu.mutation.UpdateTime(); !ok {
+		if ownershiptransfer.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized ownershiptransfer.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := ownershiptransfer.UpdateDefaultUpdateTime()
+		otu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otu *OwnershipTransferUpdate) check() error {
+	if v, ok := otu.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := otu.mutation.ItemID(); otu.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.item"`)
+	}
+	if _, ok := otu.mutation.FromUserID(); otu.mutation.FromUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.from_user"`)
+	}
+	if _, ok := otu.mutation.ToUserID(); otu.mutation.ToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.to_user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (otu *OwnershipTransferUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OwnershipTransferUpdate {
+	otu.modifiers = append(otu.modifiers, modifiers...)
+	return otu
+}
+
+func (otu *OwnershipTransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := otu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint))
+	if ps := otu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otu.mutation.UpdateTime(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := otu.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(otu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, otu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownershiptransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	otu.mutation.done = true
+	return n, nil
+}
+
+// OwnershipTransferUpdateOne is the builder for updating a single OwnershipTransfer entity.
+type OwnershipTransferUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OwnershipTransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (otuo *OwnershipTransferUpdateOne) SetUpdateTime(t time.Time) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetUpdateTime(t)
+	return otuo
+}
+
+// SetStatus sets the "status" field.
+func (otuo *OwnershipTransferUpdateOne) SetStatus(o ownershiptransfer.Status) *OwnershipTransferUpdateOne {
+	otuo.mutation.SetStatus(o)
+	return otuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otuo *OwnershipTransferUpdateOne) SetNillableStatus(o *ownershiptransfer.Status) *OwnershipTransferUpdateOne {
+	if o != nil {
+		otuo.SetStatus(*o)
+	}
+	return otuo
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (otuo *OwnershipTransferUpdateOne) Mutation() *OwnershipTransferMutation {
+	return otuo.mutation
+}
+
+// Where appends a list predicates to the OwnershipTransferUpdate builder.
+func (otuo *OwnershipTransferUpdateOne) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferUpdateOne {
+	otuo.mutation.Where(ps...)
+	return otuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (otuo *OwnershipTransferUpdateOne) Select(field string, fields ...string) *OwnershipTransferUpdateOne {
+	otuo.fields = append([]string{field}, fields...)
+	return otuo
+}
+
+// Save executes the query and returns the updated OwnershipTransfer entity.
+func (otuo *OwnershipTransferUpdateOne) Save(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := otuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*OwnershipTransfer, OwnershipTransferMutation](ctx, otuo.sqlSave, otuo.mutation, otuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otuo *OwnershipTransferUpdateOne) SaveX(ctx context.Context) *OwnershipTransfer {
+	node, err := otuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (otuo *OwnershipTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := otuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otuo *OwnershipTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := otuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otuo *OwnershipTransferUpdateOne) defaults() error {
+	if _, ok := otuo.mutation.UpdateTime(); !ok {
+		if ownershiptransfer.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized ownershiptransfer.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := ownershiptransfer.UpdateDefaultUpdateTime()
+		otuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otuo *OwnershipTransferUpdateOne) check() error {
+	if v, ok := otuo.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := otuo.mutation.ItemID(); otuo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.item"`)
+	}
+	if _, ok := otuo.mutation.FromUserID(); otuo.mutation.FromUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.from_user"`)
+	}
+	if _, ok := otuo.mutation.ToUserID(); otuo.mutation.ToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.to_user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (otuo *OwnershipTransferUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OwnershipTransferUpdateOne {
+	otuo.modifiers = append(otuo.modifiers, modifiers...)
+	return otuo
+}
+
+func (otuo *OwnershipTransferUpdateOne) sqlSave(ctx context.Context) (_node *OwnershipTransfer, err error) {
+	if err := otuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint))
+	id, ok := otuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OwnershipTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := otuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownershiptransfer.FieldID)
+		for _, f := range fields {
+			if !ownershiptransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ownershiptransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := otuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otuo.mutation.UpdateTime(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := otuo.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(otuo.modifiers...)
+	_node = &OwnershipTransfer{config: otuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, otuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownershiptransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	otuo.mutation.done = true
+	return _node, nil
+}
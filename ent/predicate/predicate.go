@@ -6,8 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
+// ItemImport is the predicate function for itemimport builders.
+type ItemImport func(*sql.Selector)
+
+// ItemRevision is the predicate function for itemrevision builders.
+type ItemRevision func(*sql.Selector)
+
+// ItemShare is the predicate function for itemshare builders.
+type ItemShare func(*sql.Selector)
+
+// ItemTransition is the predicate function for itemtransition builders.
+type ItemTransition func(*sql.Selector)
+
+// MetadataSchema is the predicate function for metadataschema builders.
+type MetadataSchema func(*sql.Selector)
+
+// OwnershipTransfer is the predicate function for ownershiptransfer builders.
+type OwnershipTransfer func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -2,58 +2,4 @@
 
 package ent
 
-import (
-	"time"
-
-	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
-	"github.com/hiennguyen9874/go-boilerplate-v2/ent/schema"
-	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	itemMixin := schema.Item{}.Mixin()
-	itemMixinFields0 := itemMixin[0].Fields()
-	_ = itemMixinFields0
-	itemFields := schema.Item{}.Fields()
-	_ = itemFields
-	// itemDescCreateTime is the schema descriptor for create_time field.
-	itemDescCreateTime := itemMixinFields0[0].Descriptor()
-	// item.DefaultCreateTime holds the default value on creation for the create_time field.
-	item.DefaultCreateTime = itemDescCreateTime.Default.(func() time.Time)
-	// itemDescUpdateTime is the schema descriptor for update_time field.
-	itemDescUpdateTime := itemMixinFields0[1].Descriptor()
-	// item.DefaultUpdateTime holds the default value on creation for the update_time field.
-	item.DefaultUpdateTime = itemDescUpdateTime.Default.(func() time.Time)
-	// item.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	item.UpdateDefaultUpdateTime = itemDescUpdateTime.UpdateDefault.(func() time.Time)
-	userMixin := schema.User{}.Mixin()
-	userMixinFields0 := userMixin[0].Fields()
-	_ = userMixinFields0
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescCreateTime is the schema descriptor for create_time field.
-	userDescCreateTime := userMixinFields0[0].Descriptor()
-	// user.DefaultCreateTime holds the default value on creation for the create_time field.
-	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
-	// userDescUpdateTime is the schema descriptor for update_time field.
-	userDescUpdateTime := userMixinFields0[1].Descriptor()
-	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
-	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
-	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
-	// userDescIsActive is the schema descriptor for is_active field.
-	userDescIsActive := userFields[4].Descriptor()
-	// user.DefaultIsActive holds the default value on creation for the is_active field.
-	user.DefaultIsActive = userDescIsActive.Default.(bool)
-	// userDescIsSuperUser is the schema descriptor for is_super_user field.
-	userDescIsSuperUser := userFields[5].Descriptor()
-	// user.DefaultIsSuperUser holds the default value on creation for the is_super_user field.
-	user.DefaultIsSuperUser = userDescIsSuperUser.Default.(bool)
-	// userDescVerified is the schema descriptor for verified field.
-	userDescVerified := userFields[6].Descriptor()
-	// user.DefaultVerified holds the default value on creation for the verified field.
-	user.DefaultVerified = userDescVerified.Default.(bool)
-}
+// The schema-stitching logic is generated in github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime/runtime.go
@@ -2,7 +2,384 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/schema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	attachmentMixin := schema.Attachment{}.Mixin()
+	attachment.Policy = privacy.NewPolicies(schema.Attachment{})
+	attachment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := attachment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	attachmentMixinFields0 := attachmentMixin[0].Fields()
+	_ = attachmentMixinFields0
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreateTime is the schema descriptor for create_time field.
+	attachmentDescCreateTime := attachmentMixinFields0[0].Descriptor()
+	// attachment.DefaultCreateTime holds the default value on creation for the create_time field.
+	attachment.DefaultCreateTime = attachmentDescCreateTime.Default.(func() time.Time)
+	// attachmentDescUpdateTime is the schema descriptor for update_time field.
+	attachmentDescUpdateTime := attachmentMixinFields0[1].Descriptor()
+	// attachment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	attachment.DefaultUpdateTime = attachmentDescUpdateTime.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	attachment.UpdateDefaultUpdateTime = attachmentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[3].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = attachmentDescFilename.Validators[0].(func(string) error)
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[5].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	commentMixin := schema.Comment{}.Mixin()
+	comment.Policy = privacy.NewPolicies(schema.Comment{})
+	comment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := comment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	commentMixinHooks1 := commentMixin[1].Hooks()
+
+	comment.Hooks[1] = commentMixinHooks1[0]
+	commentMixinInters1 := commentMixin[1].Interceptors()
+	comment.Interceptors[0] = commentMixinInters1[0]
+	commentMixinFields0 := commentMixin[0].Fields()
+	_ = commentMixinFields0
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreateTime is the schema descriptor for create_time field.
+	commentDescCreateTime := commentMixinFields0[0].Descriptor()
+	// comment.DefaultCreateTime holds the default value on creation for the create_time field.
+	comment.DefaultCreateTime = commentDescCreateTime.Default.(func() time.Time)
+	// commentDescUpdateTime is the schema descriptor for update_time field.
+	commentDescUpdateTime := commentMixinFields0[1].Descriptor()
+	// comment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	comment.DefaultUpdateTime = commentDescUpdateTime.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	comment.UpdateDefaultUpdateTime = commentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[3].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	itemMixin := schema.Item{}.Mixin()
+	item.Policy = privacy.NewPolicies(schema.Item{})
+	item.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := item.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	itemMixinHooks2 := itemMixin[2].Hooks()
+	itemMixinHooks3 := itemMixin[3].Hooks()
+	itemHooks := schema.Item{}.Hooks()
+
+	item.Hooks[1] = itemMixinHooks2[0]
+
+	item.Hooks[2] = itemMixinHooks3[0]
+
+	item.Hooks[3] = itemHooks[0]
+	itemMixinInters2 := itemMixin[2].Interceptors()
+	item.Interceptors[0] = itemMixinInters2[0]
+	itemMixinFields0 := itemMixin[0].Fields()
+	_ = itemMixinFields0
+	itemMixinFields1 := itemMixin[1].Fields()
+	_ = itemMixinFields1
+	itemMixinFields3 := itemMixin[3].Fields()
+	_ = itemMixinFields3
+	itemFields := schema.Item{}.Fields()
+	_ = itemFields
+	// itemDescCreateTime is the schema descriptor for create_time field.
+	itemDescCreateTime := itemMixinFields0[0].Descriptor()
+	// item.DefaultCreateTime holds the default value on creation for the create_time field.
+	item.DefaultCreateTime = itemDescCreateTime.Default.(func() time.Time)
+	// itemDescUpdateTime is the schema descriptor for update_time field.
+	itemDescUpdateTime := itemMixinFields1[0].Descriptor()
+	// item.DefaultUpdateTime holds the default value on creation for the update_time field.
+	item.DefaultUpdateTime = itemDescUpdateTime.Default.(func() time.Time)
+	// item.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	item.UpdateDefaultUpdateTime = itemDescUpdateTime.UpdateDefault.(func() time.Time)
+	// itemDescVersion is the schema descriptor for version field.
+	itemDescVersion := itemMixinFields3[0].Descriptor()
+	// item.DefaultVersion holds the default value on creation for the version field.
+	item.DefaultVersion = itemDescVersion.Default.(int)
+	// itemDescStatus is the schema descriptor for status field.
+	itemDescStatus := itemFields[4].Descriptor()
+	// item.DefaultStatus holds the default value on creation for the status field.
+	item.DefaultStatus = itemDescStatus.Default.(string)
+	// item.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	item.StatusValidator = itemDescStatus.Validators[0].(func(string) error)
+	itemimportMixin := schema.ItemImport{}.Mixin()
+	itemimport.Policy = privacy.NewPolicies(schema.ItemImport{})
+	itemimport.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := itemimport.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	itemimportMixinFields0 := itemimportMixin[0].Fields()
+	_ = itemimportMixinFields0
+	itemimportFields := schema.ItemImport{}.Fields()
+	_ = itemimportFields
+	// itemimportDescCreateTime is the schema descriptor for create_time field.
+	itemimportDescCreateTime := itemimportMixinFields0[0].Descriptor()
+	// itemimport.DefaultCreateTime holds the default value on creation for the create_time field.
+	itemimport.DefaultCreateTime = itemimportDescCreateTime.Default.(func() time.Time)
+	// itemimportDescUpdateTime is the schema descriptor for update_time field.
+	itemimportDescUpdateTime := itemimportMixinFields0[1].Descriptor()
+	// itemimport.DefaultUpdateTime holds the default value on creation for the update_time field.
+	itemimport.DefaultUpdateTime = itemimportDescUpdateTime.Default.(func() time.Time)
+	// itemimport.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	itemimport.UpdateDefaultUpdateTime = itemimportDescUpdateTime.UpdateDefault.(func() time.Time)
+	// itemimportDescSize is the schema descriptor for size field.
+	itemimportDescSize := itemimportFields[5].Descriptor()
+	// itemimport.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	itemimport.SizeValidator = itemimportDescSize.Validators[0].(func(int64) error)
+	// itemimportDescReadBytes is the schema descriptor for read_bytes field.
+	itemimportDescReadBytes := itemimportFields[6].Descriptor()
+	// itemimport.DefaultReadBytes holds the default value on creation for the read_bytes field.
+	itemimport.DefaultReadBytes = itemimportDescReadBytes.Default.(int64)
+	// itemimport.ReadBytesValidator is a validator for the "read_bytes" field. It is called by the builders before save.
+	itemimport.ReadBytesValidator = itemimportDescReadBytes.Validators[0].(func(int64) error)
+	// itemimportDescCreatedRows is the schema descriptor for created_rows field.
+	itemimportDescCreatedRows := itemimportFields[7].Descriptor()
+	// itemimport.DefaultCreatedRows holds the default value on creation for the created_rows field.
+	itemimport.DefaultCreatedRows = itemimportDescCreatedRows.Default.(int)
+	// itemimport.CreatedRowsValidator is a validator for the "created_rows" field. It is called by the builders before save.
+	itemimport.CreatedRowsValidator = itemimportDescCreatedRows.Validators[0].(func(int) error)
+	// itemimportDescFailedRows is the schema descriptor for failed_rows field.
+	itemimportDescFailedRows := itemimportFields[8].Descriptor()
+	// itemimport.DefaultFailedRows holds the default value on creation for the failed_rows field.
+	itemimport.DefaultFailedRows = itemimportDescFailedRows.Default.(int)
+	// itemimport.FailedRowsValidator is a validator for the "failed_rows" field. It is called by the builders before save.
+	itemimport.FailedRowsValidator = itemimportDescFailedRows.Validators[0].(func(int) error)
+	itemrevisionMixin := schema.ItemRevision{}.Mixin()
+	itemrevision.Policy = privacy.NewPolicies(schema.ItemRevision{})
+	itemrevision.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := itemrevision.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	itemrevisionMixinFields0 := itemrevisionMixin[0].Fields()
+	_ = itemrevisionMixinFields0
+	itemrevisionFields := schema.ItemRevision{}.Fields()
+	_ = itemrevisionFields
+	// itemrevisionDescCreateTime is the schema descriptor for create_time field.
+	itemrevisionDescCreateTime := itemrevisionMixinFields0[0].Descriptor()
+	// itemrevision.DefaultCreateTime holds the default value on creation for the create_time field.
+	itemrevision.DefaultCreateTime = itemrevisionDescCreateTime.Default.(func() time.Time)
+	itemshareMixin := schema.ItemShare{}.Mixin()
+	itemshare.Policy = privacy.NewPolicies(schema.ItemShare{})
+	itemshare.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := itemshare.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	itemshareMixinFields0 := itemshareMixin[0].Fields()
+	_ = itemshareMixinFields0
+	itemshareFields := schema.ItemShare{}.Fields()
+	_ = itemshareFields
+	// itemshareDescCreateTime is the schema descriptor for create_time field.
+	itemshareDescCreateTime := itemshareMixinFields0[0].Descriptor()
+	// itemshare.DefaultCreateTime holds the default value on creation for the create_time field.
+	itemshare.DefaultCreateTime = itemshareDescCreateTime.Default.(func() time.Time)
+	// itemshareDescUpdateTime is the schema descriptor for update_time field.
+	itemshareDescUpdateTime := itemshareMixinFields0[1].Descriptor()
+	// itemshare.DefaultUpdateTime holds the default value on creation for the update_time field.
+	itemshare.DefaultUpdateTime = itemshareDescUpdateTime.Default.(func() time.Time)
+	// itemshare.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	itemshare.UpdateDefaultUpdateTime = itemshareDescUpdateTime.UpdateDefault.(func() time.Time)
+	itemtransitionMixin := schema.ItemTransition{}.Mixin()
+	itemtransition.Policy = privacy.NewPolicies(schema.ItemTransition{})
+	itemtransition.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := itemtransition.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	itemtransitionMixinFields0 := itemtransitionMixin[0].Fields()
+	_ = itemtransitionMixinFields0
+	itemtransitionFields := schema.ItemTransition{}.Fields()
+	_ = itemtransitionFields
+	// itemtransitionDescCreateTime is the schema descriptor for create_time field.
+	itemtransitionDescCreateTime := itemtransitionMixinFields0[0].Descriptor()
+	// itemtransition.DefaultCreateTime holds the default value on creation for the create_time field.
+	itemtransition.DefaultCreateTime = itemtransitionDescCreateTime.Default.(func() time.Time)
+	metadataschemaMixin := schema.MetadataSchema{}.Mixin()
+	metadataschema.Policy = privacy.NewPolicies(schema.MetadataSchema{})
+	metadataschema.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := metadataschema.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	metadataschemaMixinFields0 := metadataschemaMixin[0].Fields()
+	_ = metadataschemaMixinFields0
+	metadataschemaFields := schema.MetadataSchema{}.Fields()
+	_ = metadataschemaFields
+	// metadataschemaDescCreateTime is the schema descriptor for create_time field.
+	metadataschemaDescCreateTime := metadataschemaMixinFields0[0].Descriptor()
+	// metadataschema.DefaultCreateTime holds the default value on creation for the create_time field.
+	metadataschema.DefaultCreateTime = metadataschemaDescCreateTime.Default.(func() time.Time)
+	// metadataschemaDescUpdateTime is the schema descriptor for update_time field.
+	metadataschemaDescUpdateTime := metadataschemaMixinFields0[1].Descriptor()
+	// metadataschema.DefaultUpdateTime holds the default value on creation for the update_time field.
+	metadataschema.DefaultUpdateTime = metadataschemaDescUpdateTime.Default.(func() time.Time)
+	// metadataschema.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	metadataschema.UpdateDefaultUpdateTime = metadataschemaDescUpdateTime.UpdateDefault.(func() time.Time)
+	ownershiptransferMixin := schema.OwnershipTransfer{}.Mixin()
+	ownershiptransfer.Policy = privacy.NewPolicies(schema.OwnershipTransfer{})
+	ownershiptransfer.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ownershiptransfer.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	ownershiptransferMixinFields0 := ownershiptransferMixin[0].Fields()
+	_ = ownershiptransferMixinFields0
+	ownershiptransferFields := schema.OwnershipTransfer{}.Fields()
+	_ = ownershiptransferFields
+	// ownershiptransferDescCreateTime is the schema descriptor for create_time field.
+	ownershiptransferDescCreateTime := ownershiptransferMixinFields0[0].Descriptor()
+	// ownershiptransfer.DefaultCreateTime holds the default value on creation for the create_time field.
+	ownershiptransfer.DefaultCreateTime = ownershiptransferDescCreateTime.Default.(func() time.Time)
+	// ownershiptransferDescUpdateTime is the schema descriptor for update_time field.
+	ownershiptransferDescUpdateTime := ownershiptransferMixinFields0[1].Descriptor()
+	// ownershiptransfer.DefaultUpdateTime holds the default value on creation for the update_time field.
+	ownershiptransfer.DefaultUpdateTime = ownershiptransferDescUpdateTime.Default.(func() time.Time)
+	// ownershiptransfer.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	ownershiptransfer.UpdateDefaultUpdateTime = ownershiptransferDescUpdateTime.UpdateDefault.(func() time.Time)
+	tagMixin := schema.Tag{}.Mixin()
+	tag.Policy = privacy.NewPolicies(schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreateTime is the schema descriptor for create_time field.
+	tagDescCreateTime := tagMixinFields0[0].Descriptor()
+	// tag.DefaultCreateTime holds the default value on creation for the create_time field.
+	tag.DefaultCreateTime = tagDescCreateTime.Default.(func() time.Time)
+	// tagDescUpdateTime is the schema descriptor for update_time field.
+	tagDescUpdateTime := tagMixinFields0[1].Descriptor()
+	// tag.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tag.DefaultUpdateTime = tagDescUpdateTime.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tag.UpdateDefaultUpdateTime = tagDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[2].Descriptor()
+	// tag.DefaultColor holds the default value on creation for the color field.
+	tag.DefaultColor = tagDescColor.Default.(string)
+	userMixin := schema.User{}.Mixin()
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userMixinHooks2 := userMixin[2].Hooks()
+	userMixinHooks3 := userMixin[3].Hooks()
+
+	user.Hooks[1] = userMixinHooks2[0]
+
+	user.Hooks[2] = userMixinHooks3[0]
+	userMixinInters2 := userMixin[2].Interceptors()
+	user.Interceptors[0] = userMixinInters2[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userMixinFields1 := userMixin[1].Fields()
+	_ = userMixinFields1
+	userMixinFields3 := userMixin[3].Fields()
+	_ = userMixinFields3
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userMixinFields0[0].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	// userDescUpdateTime is the schema descriptor for update_time field.
+	userDescUpdateTime := userMixinFields1[0].Descriptor()
+	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
+	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
+	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userMixinFields3[0].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(int)
+	// userDescIsActive is the schema descriptor for is_active field.
+	userDescIsActive := userFields[4].Descriptor()
+	// user.DefaultIsActive holds the default value on creation for the is_active field.
+	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescIsSuperUser is the schema descriptor for is_super_user field.
+	userDescIsSuperUser := userFields[5].Descriptor()
+	// user.DefaultIsSuperUser holds the default value on creation for the is_super_user field.
+	user.DefaultIsSuperUser = userDescIsSuperUser.Default.(bool)
+	// userDescVerified is the schema descriptor for verified field.
+	userDescVerified := userFields[6].Descriptor()
+	// user.DefaultVerified holds the default value on creation for the verified field.
+	user.DefaultVerified = userDescVerified.Default.(bool)
+}
 
 const (
 	Version = "v0.12.2"                                         // Version of ent codegen.
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// Attachment holds the schema definition for the Attachment entity. The file
+// content is kept in the blob storage (see pkg/storage) under storage_key.
+type Attachment struct {
+	ent.Schema
+}
+
+// Annotations of the Attachment, the attachments are not in the GraphQL api.
+func (Attachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("item_id"),
+		field.Uint("uploader_id").Optional().Nillable(),
+		field.String("filename").NotEmpty(),
+		field.String("content_type"),
+		field.Int64("size").NonNegative(),
+		// Hex encoded SHA-256 of the content.
+		field.String("checksum"),
+		field.String("storage_key").Unique().Immutable(),
+		// Images are processed by a worker task after the upload, the other
+		// attachments have no processing status.
+		field.Enum("processing_status").
+			Values(
+				models.ItemAttachmentStatusPending,
+				models.ItemAttachmentStatusProcessed,
+				models.ItemAttachmentStatusFailed,
+			).
+			Optional().
+			Nillable(),
+		field.Int("width").Optional().Nillable(),
+		field.Int("height").Optional().Nillable(),
+		field.JSON("thumbnails", []models.ItemAttachmentThumbnail{}).Optional(),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).Ref("attachments").Unique().Required().Field("item_id"),
+		edge.From("uploader", User.Type).Ref("attachments").Unique().Field("uploader_id"),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id"),
+	}
+}
+
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Policy defines the privacy policy of the Attachment.
+func (Attachment) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowAttachmentMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleAttachments(),
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// Comment holds the schema definition for the Comment entity, a message of a
+// user about an item.
+type Comment struct {
+	ent.Schema
+}
+
+// Annotations of the Comment, the comments are not in the GraphQL api.
+func (Comment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("item_id").Immutable(),
+		field.Uint("author_id").Optional().Nillable().Immutable(),
+		field.Text("body").NotEmpty(),
+		// Time of the last edit of the body, nil if the comment was never edited.
+		field.Time("edited_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).Ref("comments").Unique().Required().Immutable().Field("item_id"),
+		edge.From("author", User.Type).Ref("comments").Unique().Immutable().Field("author_id"),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id"),
+	}
+}
+
+func (Comment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		SoftDeleteMixin{},
+	}
+}
+
+// Policy defines the privacy policy of the Comment.
+func (Comment) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowCommentMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleComments(),
+		},
+	}
+}
@@ -1,10 +1,16 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
 )
 
 // Item holds the schema definition for the Item entity.
@@ -12,13 +18,45 @@ type Item struct {
 	ent.Schema
 }
 
+// Annotations of the Item, the items are Relay nodes of the GraphQL api.
+func (Item) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
 // Fields of the Item.
+//
+// The items table also has a generated "search_vector" tsvector column used for
+// full-text search. It is maintained by the migrations and read with raw SQL,
+// so it is not declared here.
 func (Item) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint("id"),
-		field.String("title"),
+		field.String("title").
+			Annotations(entgql.OrderField("TITLE")),
 		field.String("description"),
-		field.Uint("owner_id"),
+		// The owner and the parent ids are read through their edges in the
+		// GraphQL api.
+		field.Uint("owner_id").
+			Annotations(entgql.OrderField("OWNER_ID"), entgql.Skip(entgql.SkipType)),
+		// Status in the workflow (see internal/items/workflow), only changed by
+		// transitions. Items are created with the initial status of the
+		// configured workflow, the default is for the rows created before it.
+		field.String("status").NotEmpty().Default("draft").
+			Annotations(entgql.OrderField("STATUS")),
+		// Extra attributes, validated by the metadata schemas of the item owner
+		// (see internal/metadataSchemas).
+		field.JSON("metadata", map[string]interface{}{}).Optional().
+			Annotations(entgql.Type("Map")),
+		// The owner and the users the item is shared with are reminded before
+		// the due date (see config Reminder).
+		field.Time("due_at").Optional().Nillable(),
+		// Parent in the item tree, e.g. the project of a task. The use case
+		// keeps the tree free of cycles and within the configured depth.
+		field.Uint("parent_id").Optional().Nillable().
+			Annotations(entgql.Skip(entgql.SkipType)),
 	}
 }
 
@@ -26,13 +64,64 @@ func (Item) Fields() []ent.Field {
 func (Item) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("owner", User.Type).Ref("items").Unique().Required().Field("owner_id"),
+		edge.To("shares", ItemShare.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", ItemRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("tags", Tag.Type).Ref("items"),
+		edge.To("attachments", Attachment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transitions", ItemTransition.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ownership_transfers", OwnershipTransfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// The children of a deleted item are deleted or moved by the use
+		// case, the database only unlinks the children of purged items.
+		edge.To("children", Item.Type).
+			Annotations(entgql.Skip()).
+			From("parent").Unique().Field("parent_id").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the Item.
+func (Item) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id"),
 	}
 }
 
 func (Item) Mixin() []ent.Mixin {
 	return []ent.Mixin{
-		mixin.Time{},
-		// Or, mixin.CreateTime only for create_time
-		// and mixin.UpdateTime only for update_time.
+		// mixin.Time, with the GraphQL order of its fields.
+		mixin.AnnotateFields(mixin.CreateTime{}, entgql.OrderField("CREATE_TIME")),
+		mixin.AnnotateFields(mixin.UpdateTime{}, entgql.OrderField("UPDATE_TIME")),
+		SoftDeleteMixin{},
+		VersionMixin{},
+	}
+}
+
+// Hooks of the Item.
+func (Item) Hooks() []ent.Hook {
+	return []ent.Hook{
+		itemRevisionHook(),
+	}
+}
+
+// Policy defines the privacy policy of the Item.
+func (Item) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowItemMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleItems(),
+		},
 	}
 }
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// ItemImport holds the schema definition for the ItemImport entity, an import
+// of items run by the worker. The uploaded file is kept in the blob storage
+// under storage_key until the import finishes.
+type ItemImport struct {
+	ent.Schema
+}
+
+// Annotations of the ItemImport, the item imports are not in the GraphQL api.
+func (ItemImport) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the ItemImport.
+func (ItemImport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("owner_id").Immutable(),
+		field.Enum("format").
+			Values(models.ItemFormatCsv, models.ItemFormatJson, models.ItemFormatNdjson).
+			Immutable(),
+		field.Enum("status").
+			Values(
+				models.ItemImportStatusPending,
+				models.ItemImportStatusRunning,
+				models.ItemImportStatusSucceeded,
+				models.ItemImportStatusFailed,
+			).
+			Default(models.ItemImportStatusPending),
+		field.String("storage_key").Immutable(),
+		field.Int64("size").NonNegative().Immutable(),
+		field.Int64("read_bytes").NonNegative().Default(0),
+		field.Int("created_rows").NonNegative().Default(0),
+		field.Int("failed_rows").NonNegative().Default(0),
+		field.JSON("errors", []models.ItemImportRowError{}).Optional(),
+		// Error that stopped the import, if any.
+		field.String("error").Optional().Nillable(),
+		field.Time("finish_time").Optional().Nillable(),
+	}
+}
+
+// Edges of the ItemImport.
+func (ItemImport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("item_imports").Unique().Required().Immutable().Field("owner_id"),
+	}
+}
+
+func (ItemImport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Policy defines the privacy policy of the ItemImport.
+func (ItemImport) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowItemImportCreate(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterOwnItemImports(),
+		},
+	}
+}
@@ -0,0 +1,209 @@
+package schema
+
+import (
+	"context"
+	"entgo.io/contrib/entgql"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	gen "github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/hook"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+)
+
+// ItemRevision holds the schema definition for the ItemRevision entity.
+type ItemRevision struct {
+	ent.Schema
+}
+
+// Annotations of the ItemRevision, the item revisions are not in the GraphQL api.
+func (ItemRevision) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the ItemRevision.
+func (ItemRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("item_id").Immutable(),
+		field.Uint("user_id").Optional().Nillable().Immutable(),
+		field.Enum("action").
+			Values(
+				models.ItemRevisionActionCreate,
+				models.ItemRevisionActionUpdate,
+				models.ItemRevisionActionDelete,
+				models.ItemRevisionActionRestore,
+				models.ItemRevisionActionTransfer,
+			).
+			Immutable(),
+		field.JSON("snapshot", models.ItemSnapshot{}).Immutable(),
+		field.JSON("changes", []models.FieldChange{}).Immutable(),
+	}
+}
+
+// Edges of the ItemRevision.
+func (ItemRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).Ref("revisions").Unique().Required().Immutable().Field("item_id"),
+		edge.From("user", User.Type).Ref("item_revisions").Unique().Immutable().Field("user_id"),
+	}
+}
+
+// Indexes of the ItemRevision.
+func (ItemRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id"),
+	}
+}
+
+func (ItemRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Policy defines the privacy policy of the ItemRevision. Revisions are only
+// written by the item revision hook.
+func (ItemRevision) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleItemRevisions(),
+		},
+	}
+}
+
+// itemRevisionHook saves an ItemRevision with the new state of the item and
+// the changed fields after every create and update of items, soft deletes and
+// restores included.
+func itemRevisionHook() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.ItemFunc(func(ctx context.Context, m *gen.ItemMutation) (ent.Value, error) {
+				// The mutation already passed the item policy, read and write
+				// the revisions on its behalf.
+				allowCtx := SkipSoftDelete(privacy.DecisionContext(ctx, privacy.Allow))
+
+				var ids []uint
+				switch {
+				case m.Op().Is(ent.OpUpdateOne):
+					if id, ok := m.ID(); ok {
+						ids = []uint{id}
+					}
+				case m.Op().Is(ent.OpUpdate):
+					var err error
+					if ids, err = m.IDs(allowCtx); err != nil {
+						return nil, err
+					}
+				}
+
+				old := make(map[uint]*models.ItemSnapshot, len(ids))
+				if len(ids) > 0 {
+					db_objs, err := m.Client().Item.Query().Where(item.IDIn(ids...)).All(allowCtx)
+					if err != nil {
+						return nil, err
+					}
+					for _, db_obj := range db_objs {
+						old[db_obj.ID] = itemSnapshot(db_obj)
+					}
+				}
+
+				value, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				var db_objs []*gen.Item
+				if m.Op().Is(ent.OpCreate) {
+					db_obj, ok := value.(*gen.Item)
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation value %T", value)
+					}
+					db_objs = []*gen.Item{db_obj}
+				} else if len(ids) > 0 {
+					if db_objs, err = m.Client().Item.Query().Where(item.IDIn(ids...)).All(allowCtx); err != nil {
+						return nil, err
+					}
+				}
+
+				// The system has no id, elevated viewers keep the id of the
+				// user they act for.
+				var userId *uint
+				if v := viewer.FromContext(ctx); v != nil && v.Id != 0 {
+					userId = &v.Id
+				}
+
+				builders := make([]*gen.ItemRevisionCreate, 0, len(db_objs))
+				for _, db_obj := range db_objs {
+					from, to := old[db_obj.ID], itemSnapshot(db_obj)
+
+					changes := models.DiffItemSnapshots(from, to)
+					if len(changes) == 0 {
+						continue
+					}
+
+					builders = append(builders, m.Client().ItemRevision.Create().
+						SetItemID(db_obj.ID).
+						SetNillableUserID(userId).
+						SetAction(itemrevision.Action(itemRevisionAction(from, to))).
+						SetSnapshot(*to).
+						SetChanges(changes),
+					)
+				}
+
+				if len(builders) > 0 {
+					if _, err := m.Client().ItemRevision.CreateBulk(builders...).Save(allowCtx); err != nil {
+						return nil, err
+					}
+				}
+
+				return value, nil
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+}
+
+func itemSnapshot(db_obj *gen.Item) *models.ItemSnapshot {
+	return &models.ItemSnapshot{
+		Title:       db_obj.Title,
+		Description: db_obj.Description,
+		OwnerId:     db_obj.OwnerID,
+		Status:      db_obj.Status,
+		Metadata:    db_obj.Metadata,
+		DueAt:       db_obj.DueAt,
+		ParentId:    db_obj.ParentID,
+		DeleteTime:  db_obj.DeleteTime,
+	}
+}
+
+func itemRevisionAction(from *models.ItemSnapshot, to *models.ItemSnapshot) string {
+	switch {
+	case from == nil:
+		return models.ItemRevisionActionCreate
+	case from.DeleteTime == nil && to.DeleteTime != nil:
+		return models.ItemRevisionActionDelete
+	case from.DeleteTime != nil && to.DeleteTime == nil:
+		return models.ItemRevisionActionRestore
+	case from.OwnerId != to.OwnerId:
+		return models.ItemRevisionActionTransfer
+	default:
+		return models.ItemRevisionActionUpdate
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// ItemShare holds the schema definition for the ItemShare entity.
+type ItemShare struct {
+	ent.Schema
+}
+
+// Annotations of the ItemShare, the item shares are not in the GraphQL api.
+func (ItemShare) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the ItemShare.
+func (ItemShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("item_id"),
+		field.Uint("user_id"),
+		field.Enum("permission").Values("viewer", "editor").Default("viewer"),
+	}
+}
+
+// Edges of the ItemShare.
+func (ItemShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).Ref("shares").Unique().Required().Field("item_id"),
+		edge.From("user", User.Type).Ref("shared_items").Unique().Required().Field("user_id"),
+	}
+}
+
+// Indexes of the ItemShare.
+func (ItemShare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "user_id").Unique(),
+	}
+}
+
+func (ItemShare) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Policy defines the privacy policy of the ItemShare.
+func (ItemShare) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowItemShareMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleItemShares(),
+		},
+	}
+}
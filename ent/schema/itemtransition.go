@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// ItemTransition holds the schema definition for the ItemTransition entity, a
+// change of status of an item made through the workflow.
+type ItemTransition struct {
+	ent.Schema
+}
+
+// Annotations of the ItemTransition, the item transitions are not in the GraphQL api.
+func (ItemTransition) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the ItemTransition.
+func (ItemTransition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("item_id").Immutable(),
+		field.Uint("user_id").Optional().Nillable().Immutable(),
+		// Name of the transition in the workflow.
+		field.String("name").Immutable(),
+		field.String("from_status").Immutable(),
+		field.String("to_status").Immutable(),
+		field.String("comment").Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the ItemTransition.
+func (ItemTransition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).Ref("transitions").Unique().Required().Immutable().Field("item_id"),
+		edge.From("user", User.Type).Ref("item_transitions").Unique().Immutable().Field("user_id"),
+	}
+}
+
+// Indexes of the ItemTransition.
+func (ItemTransition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id"),
+	}
+}
+
+func (ItemTransition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Policy defines the privacy policy of the ItemTransition. Transitions are
+// recorded by the users who can see their item, the use case checks the
+// workflow.
+func (ItemTransition) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowItemTransitionCreate(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleItemTransitions(),
+		},
+	}
+}
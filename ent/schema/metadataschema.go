@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// MetadataSchema holds the schema definition for the MetadataSchema entity, a
+// JSON Schema the metadata of items must match. A schema without owner applies
+// to every item, the schema of an owner to the items of that owner only.
+type MetadataSchema struct {
+	ent.Schema
+}
+
+// Annotations of the MetadataSchema, the metadata schemas are not in the GraphQL api.
+func (MetadataSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the MetadataSchema.
+func (MetadataSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("owner_id").Optional().Nillable().Immutable(),
+		field.JSON("definition", map[string]interface{}{}),
+		// Top level properties of the definition that item lists can filter by.
+		field.Strings("indexed_keys").Optional(),
+	}
+}
+
+// Edges of the MetadataSchema.
+func (MetadataSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("metadata_schemas").Unique().Immutable().Field("owner_id"),
+	}
+}
+
+// Indexes of the MetadataSchema.
+func (MetadataSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id").Unique(),
+	}
+}
+
+func (MetadataSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Policy defines the privacy policy of the MetadataSchema. Schemas are managed
+// by admins, users can read the global schema and their own.
+func (MetadataSchema) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVisibleMetadataSchemas(),
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
+)
+
+// OwnershipTransfer holds the schema definition for the OwnershipTransfer
+// entity, the transfer of an item from its owner to another user. Transfers
+// requested by owners wait for the recipient, the ones made by super users
+// are accepted right away.
+type OwnershipTransfer struct {
+	ent.Schema
+}
+
+// Annotations of the OwnershipTransfer, the ownership transfers are not in the GraphQL api.
+func (OwnershipTransfer) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the OwnershipTransfer.
+func (OwnershipTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Uint("item_id").Immutable(),
+		field.Uint("from_user_id").Immutable(),
+		field.Uint("to_user_id").Immutable(),
+		field.Enum("status").
+			Values(
+				models.OwnershipTransferStatusPending,
+				models.OwnershipTransferStatusAccepted,
+				models.OwnershipTransferStatusDeclined,
+				models.OwnershipTransferStatusCancelled,
+			).
+			Default(models.OwnershipTransferStatusPending),
+	}
+}
+
+// Edges of the OwnershipTransfer.
+func (OwnershipTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).Ref("ownership_transfers").Unique().Required().Immutable().Field("item_id"),
+		edge.From("from_user", User.Type).Ref("sent_ownership_transfers").Unique().Required().Immutable().Field("from_user_id"),
+		edge.From("to_user", User.Type).Ref("received_ownership_transfers").Unique().Required().Immutable().Field("to_user_id"),
+	}
+}
+
+// Indexes of the OwnershipTransfer. An item has at most one pending
+// transfer.
+func (OwnershipTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id"),
+		index.Fields("item_id").
+			Unique().
+			StorageKey("ownershiptransfer_item_id_pending").
+			Annotations(entsql.IndexWhere("status = 'pending'")),
+		index.Fields("from_user_id"),
+		index.Fields("to_user_id"),
+	}
+}
+
+func (OwnershipTransfer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Policy defines the privacy policy of the OwnershipTransfer. Owners send
+// the transfers of their items, recipients accept or decline them and senders
+// cancel them.
+func (OwnershipTransfer) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowOwnershipTransferMutation(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterOwnOwnershipTransfers(),
+		},
+	}
+}
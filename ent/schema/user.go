@@ -1,10 +1,16 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/rule"
 )
 
 // User holds the schema definition for the User entity.
@@ -12,33 +18,103 @@ type User struct {
 	ent.Schema
 }
 
-// Fields of the User.
+// Annotations of the User, the users are Relay nodes of the GraphQL api.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Fields of the User. The secrets are not in the GraphQL api.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint("id"),
-		field.String("name"),
-		field.String("email").Unique(),
-		field.String("password"),
+		field.String("name").
+			Annotations(entgql.OrderField("NAME")),
+		field.String("email").
+			Annotations(entgql.OrderField("EMAIL")),
+		field.String("password").
+			Annotations(entgql.Skip()),
 		field.Bool("is_active").Default(true),
 		field.Bool("is_super_user").Default(false),
 		field.Bool("verified").Default(false),
-		field.String("verification_code").Optional().Nillable(),
-		field.String("password_reset_token").Optional().Nillable(),
-		field.Time("password_reset_at").Optional().Nillable(),
+		field.String("verification_code").Optional().Nillable().
+			Annotations(entgql.Skip()),
+		field.String("password_reset_token").Optional().Nillable().
+			Annotations(entgql.Skip()),
+		field.Time("password_reset_at").Optional().Nillable().
+			Annotations(entgql.Skip()),
+		// SHA-256 of the secret token of the calendar feed of the user.
+		field.String("calendar_token").Optional().Nillable().Unique().Sensitive().
+			Annotations(entgql.Skip()),
+		// Name of the quota plan of the user (see config.QuotaConfig), empty
+		// for the default plan.
+		field.String("plan").Optional(),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("items", Item.Type),
+		edge.To("items", Item.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.RelayConnection()),
+		edge.To("shared_items", ItemShare.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("item_revisions", ItemRevision.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("tags", Tag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("attachments", Attachment.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("item_imports", ItemImport.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("item_transitions", ItemTransition.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("metadata_schemas", MetadataSchema.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sent_ownership_transfers", OwnershipTransfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("received_ownership_transfers", OwnershipTransfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the User. The email of a trashed user can be registered again.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").
+			Unique().
+			StorageKey("users_email_key").
+			Annotations(entsql.IndexWhere("delete_time IS NULL")),
 	}
 }
 
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
-		mixin.Time{},
-		// Or, mixin.CreateTime only for create_time
-		// and mixin.UpdateTime only for update_time.
+		// mixin.Time, with the GraphQL order of its fields.
+		mixin.AnnotateFields(mixin.CreateTime{}, entgql.OrderField("CREATE_TIME")),
+		mixin.AnnotateFields(mixin.UpdateTime{}, entgql.OrderField("UPDATE_TIME")),
+		SoftDeleteMixin{},
+		VersionMixin{},
+	}
+}
+
+// Policy defines the privacy policy of the User.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowSelfUserUpdate(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterSelfUser(),
+		},
 	}
 }
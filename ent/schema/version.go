@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/hook"
+)
+
+// VersionMixin adds a version counter that is incremented by every update. It
+// is used for optimistic concurrency, conditional updates only apply when the
+// version is still the one the client read.
+type VersionMixin struct {
+	mixin.Schema
+}
+
+// Fields of the VersionMixin.
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Default(1),
+	}
+}
+
+// Hooks of the VersionMixin.
+func (VersionMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					mx, ok := m.(interface{ AddVersion(int) })
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+
+					mx.AddVersion(1)
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
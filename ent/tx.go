@@ -12,8 +12,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemImport is the client for interacting with the ItemImport builders.
+	ItemImport *ItemImportClient
+	// ItemRevision is the client for interacting with the ItemRevision builders.
+	ItemRevision *ItemRevisionClient
+	// ItemShare is the client for interacting with the ItemShare builders.
+	ItemShare *ItemShareClient
+	// ItemTransition is the client for interacting with the ItemTransition builders.
+	ItemTransition *ItemTransitionClient
+	// MetadataSchema is the client for interacting with the MetadataSchema builders.
+	MetadataSchema *MetadataSchemaClient
+	// OwnershipTransfer is the client for interacting with the OwnershipTransfer builders.
+	OwnershipTransfer *OwnershipTransferClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -147,7 +165,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.ItemImport = NewItemImportClient(tx.config)
+	tx.ItemRevision = NewItemRevisionClient(tx.config)
+	tx.ItemShare = NewItemShareClient(tx.config)
+	tx.ItemTransition = NewItemTransitionClient(tx.config)
+	tx.MetadataSchema = NewMetadataSchemaClient(tx.config)
+	tx.OwnershipTransfer = NewOwnershipTransferClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -158,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Item.QueryXXX(), the query will be executed
+// applies a query, for example: Attachment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
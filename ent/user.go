@@ -21,6 +21,10 @@ type User struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// DeleteTime holds the value of the "delete_time" field.
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Email holds the value of the "email" field.
@@ -39,6 +43,10 @@ type User struct {
 	PasswordResetToken *string `json:"password_reset_token,omitempty"`
 	// PasswordResetAt holds the value of the "password_reset_at" field.
 	PasswordResetAt *time.Time `json:"password_reset_at,omitempty"`
+	// CalendarToken holds the value of the "calendar_token" field.
+	CalendarToken *string `json:"-"`
+	// Plan holds the value of the "plan" field.
+	Plan string `json:"plan,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -49,9 +57,43 @@ type User struct {
 type UserEdges struct {
 	// Items holds the value of the items edge.
 	Items []*Item `json:"items,omitempty"`
+	// SharedItems holds the value of the shared_items edge.
+	SharedItems []*ItemShare `json:"shared_items,omitempty"`
+	// ItemRevisions holds the value of the item_revisions edge.
+	ItemRevisions []*ItemRevision `json:"item_revisions,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// ItemImports holds the value of the item_imports edge.
+	ItemImports []*ItemImport `json:"item_imports,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// ItemTransitions holds the value of the item_transitions edge.
+	ItemTransitions []*ItemTransition `json:"item_transitions,omitempty"`
+	// MetadataSchemas holds the value of the metadata_schemas edge.
+	MetadataSchemas []*MetadataSchema `json:"metadata_schemas,omitempty"`
+	// SentOwnershipTransfers holds the value of the sent_ownership_transfers edge.
+	SentOwnershipTransfers []*OwnershipTransfer `json:"sent_ownership_transfers,omitempty"`
+	// ReceivedOwnershipTransfers holds the value of the received_ownership_transfers edge.
+	ReceivedOwnershipTransfers []*OwnershipTransfer `json:"received_ownership_transfers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [11]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedItems                      map[string][]*Item
+	namedSharedItems                map[string][]*ItemShare
+	namedItemRevisions              map[string][]*ItemRevision
+	namedTags                       map[string][]*Tag
+	namedAttachments                map[string][]*Attachment
+	namedItemImports                map[string][]*ItemImport
+	namedComments                   map[string][]*Comment
+	namedItemTransitions            map[string][]*ItemTransition
+	namedMetadataSchemas            map[string][]*MetadataSchema
+	namedSentOwnershipTransfers     map[string][]*OwnershipTransfer
+	namedReceivedOwnershipTransfers map[string][]*OwnershipTransfer
 }
 
 // ItemsOrErr returns the Items value or an error if the edge
@@ -63,6 +105,96 @@ func (e UserEdges) ItemsOrErr() ([]*Item, error) {
 	return nil, &NotLoadedError{edge: "items"}
 }
 
+// SharedItemsOrErr returns the SharedItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SharedItemsOrErr() ([]*ItemShare, error) {
+	if e.loadedTypes[1] {
+		return e.SharedItems, nil
+	}
+	return nil, &NotLoadedError{edge: "shared_items"}
+}
+
+// ItemRevisionsOrErr returns the ItemRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ItemRevisionsOrErr() ([]*ItemRevision, error) {
+	if e.loadedTypes[2] {
+		return e.ItemRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "item_revisions"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[4] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// ItemImportsOrErr returns the ItemImports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ItemImportsOrErr() ([]*ItemImport, error) {
+	if e.loadedTypes[5] {
+		return e.ItemImports, nil
+	}
+	return nil, &NotLoadedError{edge: "item_imports"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[6] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ItemTransitionsOrErr returns the ItemTransitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ItemTransitionsOrErr() ([]*ItemTransition, error) {
+	if e.loadedTypes[7] {
+		return e.ItemTransitions, nil
+	}
+	return nil, &NotLoadedError{edge: "item_transitions"}
+}
+
+// MetadataSchemasOrErr returns the MetadataSchemas value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MetadataSchemasOrErr() ([]*MetadataSchema, error) {
+	if e.loadedTypes[8] {
+		return e.MetadataSchemas, nil
+	}
+	return nil, &NotLoadedError{edge: "metadata_schemas"}
+}
+
+// SentOwnershipTransfersOrErr returns the SentOwnershipTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentOwnershipTransfersOrErr() ([]*OwnershipTransfer, error) {
+	if e.loadedTypes[9] {
+		return e.SentOwnershipTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_ownership_transfers"}
+}
+
+// ReceivedOwnershipTransfersOrErr returns the ReceivedOwnershipTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReceivedOwnershipTransfersOrErr() ([]*OwnershipTransfer, error) {
+	if e.loadedTypes[10] {
+		return e.ReceivedOwnershipTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "received_ownership_transfers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -70,11 +202,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldIsActive, user.FieldIsSuperUser, user.FieldVerified:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldVerificationCode, user.FieldPasswordResetToken:
+		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldVerificationCode, user.FieldPasswordResetToken, user.FieldCalendarToken, user.FieldPlan:
 			values[i] = new(sql.NullString)
-		case user.FieldCreateTime, user.FieldUpdateTime, user.FieldPasswordResetAt:
+		case user.FieldCreateTime, user.FieldUpdateTime, user.FieldDeleteTime, user.FieldPasswordResetAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -109,6 +241,19 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.UpdateTime = value.Time
 			}
+		case user.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				u.DeleteTime = new(time.Time)
+				*u.DeleteTime = value.Time
+			}
+		case user.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				u.Version = int(value.Int64)
+			}
 		case user.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -166,6 +311,19 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.PasswordResetAt = new(time.Time)
 				*u.PasswordResetAt = value.Time
 			}
+		case user.FieldCalendarToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_token", values[i])
+			} else if value.Valid {
+				u.CalendarToken = new(string)
+				*u.CalendarToken = value.String
+			}
+		case user.FieldPlan:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan", values[i])
+			} else if value.Valid {
+				u.Plan = value.String
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -184,6 +342,56 @@ func (u *User) QueryItems() *ItemQuery {
 	return NewUserClient(u.config).QueryItems(u)
 }
 
+// QuerySharedItems queries the "shared_items" edge of the User entity.
+func (u *User) QuerySharedItems() *ItemShareQuery {
+	return NewUserClient(u.config).QuerySharedItems(u)
+}
+
+// QueryItemRevisions queries the "item_revisions" edge of the User entity.
+func (u *User) QueryItemRevisions() *ItemRevisionQuery {
+	return NewUserClient(u.config).QueryItemRevisions(u)
+}
+
+// QueryTags queries the "tags" edge of the User entity.
+func (u *User) QueryTags() *TagQuery {
+	return NewUserClient(u.config).QueryTags(u)
+}
+
+// QueryAttachments queries the "attachments" edge of the User entity.
+func (u *User) QueryAttachments() *AttachmentQuery {
+	return NewUserClient(u.config).QueryAttachments(u)
+}
+
+// QueryItemImports queries the "item_imports" edge of the User entity.
+func (u *User) QueryItemImports() *ItemImportQuery {
+	return NewUserClient(u.config).QueryItemImports(u)
+}
+
+// QueryComments queries the "comments" edge of the User entity.
+func (u *User) QueryComments() *CommentQuery {
+	return NewUserClient(u.config).QueryComments(u)
+}
+
+// QueryItemTransitions queries the "item_transitions" edge of the User entity.
+func (u *User) QueryItemTransitions() *ItemTransitionQuery {
+	return NewUserClient(u.config).QueryItemTransitions(u)
+}
+
+// QueryMetadataSchemas queries the "metadata_schemas" edge of the User entity.
+func (u *User) QueryMetadataSchemas() *MetadataSchemaQuery {
+	return NewUserClient(u.config).QueryMetadataSchemas(u)
+}
+
+// QuerySentOwnershipTransfers queries the "sent_ownership_transfers" edge of the User entity.
+func (u *User) QuerySentOwnershipTransfers() *OwnershipTransferQuery {
+	return NewUserClient(u.config).QuerySentOwnershipTransfers(u)
+}
+
+// QueryReceivedOwnershipTransfers queries the "received_ownership_transfers" edge of the User entity.
+func (u *User) QueryReceivedOwnershipTransfers() *OwnershipTransferQuery {
+	return NewUserClient(u.config).QueryReceivedOwnershipTransfers(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -213,6 +421,14 @@ func (u *User) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(u.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := u.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", u.Version))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(u.Name)
 	builder.WriteString(", ")
@@ -245,9 +461,278 @@ func (u *User) String() string {
 		builder.WriteString("password_reset_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("calendar_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("plan=")
+	builder.WriteString(u.Plan)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedItems returns the Items named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedItems(name string) ([]*Item, error) {
+	if u.Edges.namedItems == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedItems[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedItems(name string, edges ...*Item) {
+	if u.Edges.namedItems == nil {
+		u.Edges.namedItems = make(map[string][]*Item)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedItems[name] = []*Item{}
+	} else {
+		u.Edges.namedItems[name] = append(u.Edges.namedItems[name], edges...)
+	}
+}
+
+// NamedSharedItems returns the SharedItems named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSharedItems(name string) ([]*ItemShare, error) {
+	if u.Edges.namedSharedItems == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSharedItems[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSharedItems(name string, edges ...*ItemShare) {
+	if u.Edges.namedSharedItems == nil {
+		u.Edges.namedSharedItems = make(map[string][]*ItemShare)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSharedItems[name] = []*ItemShare{}
+	} else {
+		u.Edges.namedSharedItems[name] = append(u.Edges.namedSharedItems[name], edges...)
+	}
+}
+
+// NamedItemRevisions returns the ItemRevisions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedItemRevisions(name string) ([]*ItemRevision, error) {
+	if u.Edges.namedItemRevisions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedItemRevisions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedItemRevisions(name string, edges ...*ItemRevision) {
+	if u.Edges.namedItemRevisions == nil {
+		u.Edges.namedItemRevisions = make(map[string][]*ItemRevision)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedItemRevisions[name] = []*ItemRevision{}
+	} else {
+		u.Edges.namedItemRevisions[name] = append(u.Edges.namedItemRevisions[name], edges...)
+	}
+}
+
+// NamedTags returns the Tags named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedTags(name string) ([]*Tag, error) {
+	if u.Edges.namedTags == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedTags[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedTags(name string, edges ...*Tag) {
+	if u.Edges.namedTags == nil {
+		u.Edges.namedTags = make(map[string][]*Tag)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedTags[name] = []*Tag{}
+	} else {
+		u.Edges.namedTags[name] = append(u.Edges.namedTags[name], edges...)
+	}
+}
+
+// NamedAttachments returns the Attachments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAttachments(name string) ([]*Attachment, error) {
+	if u.Edges.namedAttachments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAttachments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAttachments(name string, edges ...*Attachment) {
+	if u.Edges.namedAttachments == nil {
+		u.Edges.namedAttachments = make(map[string][]*Attachment)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAttachments[name] = []*Attachment{}
+	} else {
+		u.Edges.namedAttachments[name] = append(u.Edges.namedAttachments[name], edges...)
+	}
+}
+
+// NamedItemImports returns the ItemImports named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedItemImports(name string) ([]*ItemImport, error) {
+	if u.Edges.namedItemImports == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedItemImports[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedItemImports(name string, edges ...*ItemImport) {
+	if u.Edges.namedItemImports == nil {
+		u.Edges.namedItemImports = make(map[string][]*ItemImport)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedItemImports[name] = []*ItemImport{}
+	} else {
+		u.Edges.namedItemImports[name] = append(u.Edges.namedItemImports[name], edges...)
+	}
+}
+
+// NamedComments returns the Comments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedComments(name string) ([]*Comment, error) {
+	if u.Edges.namedComments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedComments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedComments(name string, edges ...*Comment) {
+	if u.Edges.namedComments == nil {
+		u.Edges.namedComments = make(map[string][]*Comment)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedComments[name] = []*Comment{}
+	} else {
+		u.Edges.namedComments[name] = append(u.Edges.namedComments[name], edges...)
+	}
+}
+
+// NamedItemTransitions returns the ItemTransitions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedItemTransitions(name string) ([]*ItemTransition, error) {
+	if u.Edges.namedItemTransitions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedItemTransitions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedItemTransitions(name string, edges ...*ItemTransition) {
+	if u.Edges.namedItemTransitions == nil {
+		u.Edges.namedItemTransitions = make(map[string][]*ItemTransition)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedItemTransitions[name] = []*ItemTransition{}
+	} else {
+		u.Edges.namedItemTransitions[name] = append(u.Edges.namedItemTransitions[name], edges...)
+	}
+}
+
+// NamedMetadataSchemas returns the MetadataSchemas named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedMetadataSchemas(name string) ([]*MetadataSchema, error) {
+	if u.Edges.namedMetadataSchemas == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedMetadataSchemas[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedMetadataSchemas(name string, edges ...*MetadataSchema) {
+	if u.Edges.namedMetadataSchemas == nil {
+		u.Edges.namedMetadataSchemas = make(map[string][]*MetadataSchema)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedMetadataSchemas[name] = []*MetadataSchema{}
+	} else {
+		u.Edges.namedMetadataSchemas[name] = append(u.Edges.namedMetadataSchemas[name], edges...)
+	}
+}
+
+// NamedSentOwnershipTransfers returns the SentOwnershipTransfers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSentOwnershipTransfers(name string) ([]*OwnershipTransfer, error) {
+	if u.Edges.namedSentOwnershipTransfers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSentOwnershipTransfers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSentOwnershipTransfers(name string, edges ...*OwnershipTransfer) {
+	if u.Edges.namedSentOwnershipTransfers == nil {
+		u.Edges.namedSentOwnershipTransfers = make(map[string][]*OwnershipTransfer)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSentOwnershipTransfers[name] = []*OwnershipTransfer{}
+	} else {
+		u.Edges.namedSentOwnershipTransfers[name] = append(u.Edges.namedSentOwnershipTransfers[name], edges...)
+	}
+}
+
+// NamedReceivedOwnershipTransfers returns the ReceivedOwnershipTransfers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedReceivedOwnershipTransfers(name string) ([]*OwnershipTransfer, error) {
+	if u.Edges.namedReceivedOwnershipTransfers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedReceivedOwnershipTransfers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedReceivedOwnershipTransfers(name string, edges ...*OwnershipTransfer) {
+	if u.Edges.namedReceivedOwnershipTransfers == nil {
+		u.Edges.namedReceivedOwnershipTransfers = make(map[string][]*OwnershipTransfer)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedReceivedOwnershipTransfers[name] = []*OwnershipTransfer{}
+	} else {
+		u.Edges.namedReceivedOwnershipTransfers[name] = append(u.Edges.namedReceivedOwnershipTransfers[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
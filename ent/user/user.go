@@ -5,6 +5,7 @@ package user
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -18,6 +19,10 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldEmail holds the string denoting the email field in the database.
@@ -36,8 +41,32 @@ const (
 	FieldPasswordResetToken = "password_reset_token"
 	// FieldPasswordResetAt holds the string denoting the password_reset_at field in the database.
 	FieldPasswordResetAt = "password_reset_at"
+	// FieldCalendarToken holds the string denoting the calendar_token field in the database.
+	FieldCalendarToken = "calendar_token"
+	// FieldPlan holds the string denoting the plan field in the database.
+	FieldPlan = "plan"
 	// EdgeItems holds the string denoting the items edge name in mutations.
 	EdgeItems = "items"
+	// EdgeSharedItems holds the string denoting the shared_items edge name in mutations.
+	EdgeSharedItems = "shared_items"
+	// EdgeItemRevisions holds the string denoting the item_revisions edge name in mutations.
+	EdgeItemRevisions = "item_revisions"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeItemImports holds the string denoting the item_imports edge name in mutations.
+	EdgeItemImports = "item_imports"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeItemTransitions holds the string denoting the item_transitions edge name in mutations.
+	EdgeItemTransitions = "item_transitions"
+	// EdgeMetadataSchemas holds the string denoting the metadata_schemas edge name in mutations.
+	EdgeMetadataSchemas = "metadata_schemas"
+	// EdgeSentOwnershipTransfers holds the string denoting the sent_ownership_transfers edge name in mutations.
+	EdgeSentOwnershipTransfers = "sent_ownership_transfers"
+	// EdgeReceivedOwnershipTransfers holds the string denoting the received_ownership_transfers edge name in mutations.
+	EdgeReceivedOwnershipTransfers = "received_ownership_transfers"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// ItemsTable is the table that holds the items relation/edge.
@@ -47,6 +76,76 @@ const (
 	ItemsInverseTable = "items"
 	// ItemsColumn is the table column denoting the items relation/edge.
 	ItemsColumn = "owner_id"
+	// SharedItemsTable is the table that holds the shared_items relation/edge.
+	SharedItemsTable = "item_shares"
+	// SharedItemsInverseTable is the table name for the ItemShare entity.
+	// It exists in this package in order to avoid circular dependency with the "itemshare" package.
+	SharedItemsInverseTable = "item_shares"
+	// SharedItemsColumn is the table column denoting the shared_items relation/edge.
+	SharedItemsColumn = "user_id"
+	// ItemRevisionsTable is the table that holds the item_revisions relation/edge.
+	ItemRevisionsTable = "item_revisions"
+	// ItemRevisionsInverseTable is the table name for the ItemRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "itemrevision" package.
+	ItemRevisionsInverseTable = "item_revisions"
+	// ItemRevisionsColumn is the table column denoting the item_revisions relation/edge.
+	ItemRevisionsColumn = "user_id"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "owner_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "uploader_id"
+	// ItemImportsTable is the table that holds the item_imports relation/edge.
+	ItemImportsTable = "item_imports"
+	// ItemImportsInverseTable is the table name for the ItemImport entity.
+	// It exists in this package in order to avoid circular dependency with the "itemimport" package.
+	ItemImportsInverseTable = "item_imports"
+	// ItemImportsColumn is the table column denoting the item_imports relation/edge.
+	ItemImportsColumn = "owner_id"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "author_id"
+	// ItemTransitionsTable is the table that holds the item_transitions relation/edge.
+	ItemTransitionsTable = "item_transitions"
+	// ItemTransitionsInverseTable is the table name for the ItemTransition entity.
+	// It exists in this package in order to avoid circular dependency with the "itemtransition" package.
+	ItemTransitionsInverseTable = "item_transitions"
+	// ItemTransitionsColumn is the table column denoting the item_transitions relation/edge.
+	ItemTransitionsColumn = "user_id"
+	// MetadataSchemasTable is the table that holds the metadata_schemas relation/edge.
+	MetadataSchemasTable = "metadata_schemas"
+	// MetadataSchemasInverseTable is the table name for the MetadataSchema entity.
+	// It exists in this package in order to avoid circular dependency with the "metadataschema" package.
+	MetadataSchemasInverseTable = "metadata_schemas"
+	// MetadataSchemasColumn is the table column denoting the metadata_schemas relation/edge.
+	MetadataSchemasColumn = "owner_id"
+	// SentOwnershipTransfersTable is the table that holds the sent_ownership_transfers relation/edge.
+	SentOwnershipTransfersTable = "ownership_transfers"
+	// SentOwnershipTransfersInverseTable is the table name for the OwnershipTransfer entity.
+	// It exists in this package in order to avoid circular dependency with the "ownershiptransfer" package.
+	SentOwnershipTransfersInverseTable = "ownership_transfers"
+	// SentOwnershipTransfersColumn is the table column denoting the sent_ownership_transfers relation/edge.
+	SentOwnershipTransfersColumn = "from_user_id"
+	// ReceivedOwnershipTransfersTable is the table that holds the received_ownership_transfers relation/edge.
+	ReceivedOwnershipTransfersTable = "ownership_transfers"
+	// ReceivedOwnershipTransfersInverseTable is the table name for the OwnershipTransfer entity.
+	// It exists in this package in order to avoid circular dependency with the "ownershiptransfer" package.
+	ReceivedOwnershipTransfersInverseTable = "ownership_transfers"
+	// ReceivedOwnershipTransfersColumn is the table column denoting the received_ownership_transfers relation/edge.
+	ReceivedOwnershipTransfersColumn = "to_user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -54,6 +153,8 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldVersion,
 	FieldName,
 	FieldEmail,
 	FieldPassword,
@@ -63,6 +164,8 @@ var Columns = []string{
 	FieldVerificationCode,
 	FieldPasswordResetToken,
 	FieldPasswordResetAt,
+	FieldCalendarToken,
+	FieldPlan,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,13 +178,23 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
 var (
+	Hooks        [3]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 	// DefaultIsSuperUser holds the default value on creation for the "is_super_user" field.
@@ -108,6 +221,16 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -153,6 +276,16 @@ func ByPasswordResetAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPasswordResetAt, opts...).ToFunc()
 }
 
+// ByCalendarToken orders the results by the calendar_token field.
+func ByCalendarToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarToken, opts...).ToFunc()
+}
+
+// ByPlan orders the results by the plan field.
+func ByPlan(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlan, opts...).ToFunc()
+}
+
 // ByItemsCount orders the results by items count.
 func ByItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -166,6 +299,146 @@ func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySharedItemsCount orders the results by shared_items count.
+func BySharedItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharedItemsStep(), opts...)
+	}
+}
+
+// BySharedItems orders the results by shared_items terms.
+func BySharedItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharedItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemRevisionsCount orders the results by item_revisions count.
+func ByItemRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemRevisionsStep(), opts...)
+	}
+}
+
+// ByItemRevisions orders the results by item_revisions terms.
+func ByItemRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemImportsCount orders the results by item_imports count.
+func ByItemImportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemImportsStep(), opts...)
+	}
+}
+
+// ByItemImports orders the results by item_imports terms.
+func ByItemImports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemImportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemTransitionsCount orders the results by item_transitions count.
+func ByItemTransitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemTransitionsStep(), opts...)
+	}
+}
+
+// ByItemTransitions orders the results by item_transitions terms.
+func ByItemTransitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemTransitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMetadataSchemasCount orders the results by metadata_schemas count.
+func ByMetadataSchemasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMetadataSchemasStep(), opts...)
+	}
+}
+
+// ByMetadataSchemas orders the results by metadata_schemas terms.
+func ByMetadataSchemas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetadataSchemasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentOwnershipTransfersCount orders the results by sent_ownership_transfers count.
+func BySentOwnershipTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentOwnershipTransfersStep(), opts...)
+	}
+}
+
+// BySentOwnershipTransfers orders the results by sent_ownership_transfers terms.
+func BySentOwnershipTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentOwnershipTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedOwnershipTransfersCount orders the results by received_ownership_transfers count.
+func ByReceivedOwnershipTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedOwnershipTransfersStep(), opts...)
+	}
+}
+
+// ByReceivedOwnershipTransfers orders the results by received_ownership_transfers terms.
+func ByReceivedOwnershipTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedOwnershipTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -173,3 +446,73 @@ func newItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
 	)
 }
+func newSharedItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharedItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SharedItemsTable, SharedItemsColumn),
+	)
+}
+func newItemRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemRevisionsTable, ItemRevisionsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newItemImportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemImportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemImportsTable, ItemImportsColumn),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newItemTransitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemTransitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemTransitionsTable, ItemTransitionsColumn),
+	)
+}
+func newMetadataSchemasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MetadataSchemasInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MetadataSchemasTable, MetadataSchemasColumn),
+	)
+}
+func newSentOwnershipTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentOwnershipTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentOwnershipTransfersTable, SentOwnershipTransfersColumn),
+	)
+}
+func newReceivedOwnershipTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedOwnershipTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedOwnershipTransfersTable, ReceivedOwnershipTransfersColumn),
+	)
+}
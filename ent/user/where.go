@@ -65,6 +65,16 @@ func UpdateTime(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVersion, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -110,6 +120,16 @@ func PasswordResetAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPasswordResetAt, v))
 }
 
+// CalendarToken applies equality check predicate on the "calendar_token" field. It's identical to CalendarTokenEQ.
+func CalendarToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCalendarToken, v))
+}
+
+// Plan applies equality check predicate on the "plan" field. It's identical to PlanEQ.
+func Plan(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPlan, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreateTime, v))
@@ -190,6 +210,96 @@ func UpdateTimeLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldVersion, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -615,6 +725,156 @@ func PasswordResetAtNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldPasswordResetAt))
 }
 
+// CalendarTokenEQ applies the EQ predicate on the "calendar_token" field.
+func CalendarTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCalendarToken, v))
+}
+
+// CalendarTokenNEQ applies the NEQ predicate on the "calendar_token" field.
+func CalendarTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldCalendarToken, v))
+}
+
+// CalendarTokenIn applies the In predicate on the "calendar_token" field.
+func CalendarTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldCalendarToken, vs...))
+}
+
+// CalendarTokenNotIn applies the NotIn predicate on the "calendar_token" field.
+func CalendarTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldCalendarToken, vs...))
+}
+
+// CalendarTokenGT applies the GT predicate on the "calendar_token" field.
+func CalendarTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldCalendarToken, v))
+}
+
+// CalendarTokenGTE applies the GTE predicate on the "calendar_token" field.
+func CalendarTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldCalendarToken, v))
+}
+
+// CalendarTokenLT applies the LT predicate on the "calendar_token" field.
+func CalendarTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldCalendarToken, v))
+}
+
+// CalendarTokenLTE applies the LTE predicate on the "calendar_token" field.
+func CalendarTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldCalendarToken, v))
+}
+
+// CalendarTokenContains applies the Contains predicate on the "calendar_token" field.
+func CalendarTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldCalendarToken, v))
+}
+
+// CalendarTokenHasPrefix applies the HasPrefix predicate on the "calendar_token" field.
+func CalendarTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldCalendarToken, v))
+}
+
+// CalendarTokenHasSuffix applies the HasSuffix predicate on the "calendar_token" field.
+func CalendarTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldCalendarToken, v))
+}
+
+// CalendarTokenIsNil applies the IsNil predicate on the "calendar_token" field.
+func CalendarTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldCalendarToken))
+}
+
+// CalendarTokenNotNil applies the NotNil predicate on the "calendar_token" field.
+func CalendarTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldCalendarToken))
+}
+
+// CalendarTokenEqualFold applies the EqualFold predicate on the "calendar_token" field.
+func CalendarTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldCalendarToken, v))
+}
+
+// CalendarTokenContainsFold applies the ContainsFold predicate on the "calendar_token" field.
+func CalendarTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldCalendarToken, v))
+}
+
+// PlanEQ applies the EQ predicate on the "plan" field.
+func PlanEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPlan, v))
+}
+
+// PlanNEQ applies the NEQ predicate on the "plan" field.
+func PlanNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPlan, v))
+}
+
+// PlanIn applies the In predicate on the "plan" field.
+func PlanIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPlan, vs...))
+}
+
+// PlanNotIn applies the NotIn predicate on the "plan" field.
+func PlanNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPlan, vs...))
+}
+
+// PlanGT applies the GT predicate on the "plan" field.
+func PlanGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPlan, v))
+}
+
+// PlanGTE applies the GTE predicate on the "plan" field.
+func PlanGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPlan, v))
+}
+
+// PlanLT applies the LT predicate on the "plan" field.
+func PlanLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPlan, v))
+}
+
+// PlanLTE applies the LTE predicate on the "plan" field.
+func PlanLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPlan, v))
+}
+
+// PlanContains applies the Contains predicate on the "plan" field.
+func PlanContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPlan, v))
+}
+
+// PlanHasPrefix applies the HasPrefix predicate on the "plan" field.
+func PlanHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPlan, v))
+}
+
+// PlanHasSuffix applies the HasSuffix predicate on the "plan" field.
+func PlanHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPlan, v))
+}
+
+// PlanIsNil applies the IsNil predicate on the "plan" field.
+func PlanIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPlan))
+}
+
+// PlanNotNil applies the NotNil predicate on the "plan" field.
+func PlanNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPlan))
+}
+
+// PlanEqualFold applies the EqualFold predicate on the "plan" field.
+func PlanEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPlan, v))
+}
+
+// PlanContainsFold applies the ContainsFold predicate on the "plan" field.
+func PlanContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPlan, v))
+}
+
 // HasItems applies the HasEdge predicate on the "items" edge.
 func HasItems() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -638,6 +898,236 @@ func HasItemsWith(preds ...predicate.Item) predicate.User {
 	})
 }
 
+// HasSharedItems applies the HasEdge predicate on the "shared_items" edge.
+func HasSharedItems() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SharedItemsTable, SharedItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSharedItemsWith applies the HasEdge predicate on the "shared_items" edge with a given conditions (other predicates).
+func HasSharedItemsWith(preds ...predicate.ItemShare) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSharedItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemRevisions applies the HasEdge predicate on the "item_revisions" edge.
+func HasItemRevisions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemRevisionsTable, ItemRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemRevisionsWith applies the HasEdge predicate on the "item_revisions" edge with a given conditions (other predicates).
+func HasItemRevisionsWith(preds ...predicate.ItemRevision) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newItemRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemImports applies the HasEdge predicate on the "item_imports" edge.
+func HasItemImports() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemImportsTable, ItemImportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemImportsWith applies the HasEdge predicate on the "item_imports" edge with a given conditions (other predicates).
+func HasItemImportsWith(preds ...predicate.ItemImport) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newItemImportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemTransitions applies the HasEdge predicate on the "item_transitions" edge.
+func HasItemTransitions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemTransitionsTable, ItemTransitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemTransitionsWith applies the HasEdge predicate on the "item_transitions" edge with a given conditions (other predicates).
+func HasItemTransitionsWith(preds ...predicate.ItemTransition) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newItemTransitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMetadataSchemas applies the HasEdge predicate on the "metadata_schemas" edge.
+func HasMetadataSchemas() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MetadataSchemasTable, MetadataSchemasColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetadataSchemasWith applies the HasEdge predicate on the "metadata_schemas" edge with a given conditions (other predicates).
+func HasMetadataSchemasWith(preds ...predicate.MetadataSchema) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMetadataSchemasStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSentOwnershipTransfers applies the HasEdge predicate on the "sent_ownership_transfers" edge.
+func HasSentOwnershipTransfers() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SentOwnershipTransfersTable, SentOwnershipTransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSentOwnershipTransfersWith applies the HasEdge predicate on the "sent_ownership_transfers" edge with a given conditions (other predicates).
+func HasSentOwnershipTransfersWith(preds ...predicate.OwnershipTransfer) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSentOwnershipTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceivedOwnershipTransfers applies the HasEdge predicate on the "received_ownership_transfers" edge.
+func HasReceivedOwnershipTransfers() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivedOwnershipTransfersTable, ReceivedOwnershipTransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivedOwnershipTransfersWith applies the HasEdge predicate on the "received_ownership_transfers" edge with a given conditions (other predicates).
+func HasReceivedOwnershipTransfersWith(preds ...predicate.OwnershipTransfer) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReceivedOwnershipTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -10,7 +10,16 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
@@ -49,6 +58,34 @@ func (uc *UserCreate) SetNillableUpdateTime(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetDeleteTime sets the "delete_time" field.
+func (uc *UserCreate) SetDeleteTime(t time.Time) *UserCreate {
+	uc.mutation.SetDeleteTime(t)
+	return uc
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeleteTime(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeleteTime(*t)
+	}
+	return uc
+}
+
+// SetVersion sets the "version" field.
+func (uc *UserCreate) SetVersion(i int) *UserCreate {
+	uc.mutation.SetVersion(i)
+	return uc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetVersion(*i)
+	}
+	return uc
+}
+
 // SetName sets the "name" field.
 func (uc *UserCreate) SetName(s string) *UserCreate {
 	uc.mutation.SetName(s)
@@ -151,6 +188,34 @@ func (uc *UserCreate) SetNillablePasswordResetAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetCalendarToken sets the "calendar_token" field.
+func (uc *UserCreate) SetCalendarToken(s string) *UserCreate {
+	uc.mutation.SetCalendarToken(s)
+	return uc
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (uc *UserCreate) SetNillableCalendarToken(s *string) *UserCreate {
+	if s != nil {
+		uc.SetCalendarToken(*s)
+	}
+	return uc
+}
+
+// SetPlan sets the "plan" field.
+func (uc *UserCreate) SetPlan(s string) *UserCreate {
+	uc.mutation.SetPlan(s)
+	return uc
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePlan(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPlan(*s)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uint) *UserCreate {
 	uc.mutation.SetID(u)
@@ -172,6 +237,156 @@ func (uc *UserCreate) AddItems(i ...*Item) *UserCreate {
 	return uc.AddItemIDs(ids...)
 }
 
+// AddSharedItemIDs adds the "shared_items" edge to the ItemShare entity by IDs.
+func (uc *UserCreate) AddSharedItemIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddSharedItemIDs(ids...)
+	return uc
+}
+
+// AddSharedItems adds the "shared_items" edges to the ItemShare entity.
+func (uc *UserCreate) AddSharedItems(i ...*ItemShare) *UserCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddSharedItemIDs(ids...)
+}
+
+// AddItemRevisionIDs adds the "item_revisions" edge to the ItemRevision entity by IDs.
+func (uc *UserCreate) AddItemRevisionIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddItemRevisionIDs(ids...)
+	return uc
+}
+
+// AddItemRevisions adds the "item_revisions" edges to the ItemRevision entity.
+func (uc *UserCreate) AddItemRevisions(i ...*ItemRevision) *UserCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddItemRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uc *UserCreate) AddTagIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddTagIDs(ids...)
+	return uc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uc *UserCreate) AddTags(t ...*Tag) *UserCreate {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uc *UserCreate) AddAttachmentIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddAttachmentIDs(ids...)
+	return uc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uc *UserCreate) AddAttachments(a ...*Attachment) *UserCreate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAttachmentIDs(ids...)
+}
+
+// AddItemImportIDs adds the "item_imports" edge to the ItemImport entity by IDs.
+func (uc *UserCreate) AddItemImportIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddItemImportIDs(ids...)
+	return uc
+}
+
+// AddItemImports adds the "item_imports" edges to the ItemImport entity.
+func (uc *UserCreate) AddItemImports(i ...*ItemImport) *UserCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddItemImportIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (uc *UserCreate) AddCommentIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddCommentIDs(ids...)
+	return uc
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (uc *UserCreate) AddComments(c ...*Comment) *UserCreate {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCommentIDs(ids...)
+}
+
+// AddItemTransitionIDs adds the "item_transitions" edge to the ItemTransition entity by IDs.
+func (uc *UserCreate) AddItemTransitionIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddItemTransitionIDs(ids...)
+	return uc
+}
+
+// AddItemTransitions adds the "item_transitions" edges to the ItemTransition entity.
+func (uc *UserCreate) AddItemTransitions(i ...*ItemTransition) *UserCreate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddItemTransitionIDs(ids...)
+}
+
+// AddMetadataSchemaIDs adds the "metadata_schemas" edge to the MetadataSchema entity by IDs.
+func (uc *UserCreate) AddMetadataSchemaIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddMetadataSchemaIDs(ids...)
+	return uc
+}
+
+// AddMetadataSchemas adds the "metadata_schemas" edges to the MetadataSchema entity.
+func (uc *UserCreate) AddMetadataSchemas(m ...*MetadataSchema) *UserCreate {
+	ids := make([]uint, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMetadataSchemaIDs(ids...)
+}
+
+// AddSentOwnershipTransferIDs adds the "sent_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (uc *UserCreate) AddSentOwnershipTransferIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddSentOwnershipTransferIDs(ids...)
+	return uc
+}
+
+// AddSentOwnershipTransfers adds the "sent_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uc *UserCreate) AddSentOwnershipTransfers(o ...*OwnershipTransfer) *UserCreate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddSentOwnershipTransferIDs(ids...)
+}
+
+// AddReceivedOwnershipTransferIDs adds the "received_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (uc *UserCreate) AddReceivedOwnershipTransferIDs(ids ...uint) *UserCreate {
+	uc.mutation.AddReceivedOwnershipTransferIDs(ids...)
+	return uc
+}
+
+// AddReceivedOwnershipTransfers adds the "received_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uc *UserCreate) AddReceivedOwnershipTransfers(o ...*OwnershipTransfer) *UserCreate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddReceivedOwnershipTransferIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -179,7 +394,9 @@ func (uc *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
-	uc.defaults()
+	if err := uc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks[*User, UserMutation](ctx, uc.sqlSave, uc.mutation, uc.hooks)
 }
 
@@ -206,15 +423,25 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uc *UserCreate) defaults() {
+func (uc *UserCreate) defaults() error {
 	if _, ok := uc.mutation.CreateTime(); !ok {
+		if user.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultCreateTime()
 		uc.mutation.SetCreateTime(v)
 	}
 	if _, ok := uc.mutation.UpdateTime(); !ok {
+		if user.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultUpdateTime()
 		uc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := uc.mutation.Version(); !ok {
+		v := user.DefaultVersion
+		uc.mutation.SetVersion(v)
+	}
 	if _, ok := uc.mutation.IsActive(); !ok {
 		v := user.DefaultIsActive
 		uc.mutation.SetIsActive(v)
@@ -227,6 +454,7 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultVerified
 		uc.mutation.SetVerified(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -237,6 +465,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "User.update_time"`)}
 	}
+	if _, ok := uc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "User.version"`)}
+	}
 	if _, ok := uc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
 	}
@@ -295,6 +526,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := uc.mutation.DeleteTime(); ok {
+		_spec.SetField(user.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := uc.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := uc.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -331,6 +570,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPasswordResetAt, field.TypeTime, value)
 		_node.PasswordResetAt = &value
 	}
+	if value, ok := uc.mutation.CalendarToken(); ok {
+		_spec.SetField(user.FieldCalendarToken, field.TypeString, value)
+		_node.CalendarToken = &value
+	}
+	if value, ok := uc.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+		_node.Plan = value
+	}
 	if nodes := uc.mutation.ItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -347,6 +594,166 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.SharedItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ItemRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ItemImportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ItemTransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MetadataSchemasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SentOwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ReceivedOwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -5,25 +5,58 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx        *QueryContext
-	order      []user.OrderOption
-	inters     []Interceptor
-	predicates []predicate.User
-	withItems  *ItemQuery
+	ctx                                 *QueryContext
+	order                               []user.OrderOption
+	inters                              []Interceptor
+	predicates                          []predicate.User
+	withItems                           *ItemQuery
+	withSharedItems                     *ItemShareQuery
+	withItemRevisions                   *ItemRevisionQuery
+	withTags                            *TagQuery
+	withAttachments                     *AttachmentQuery
+	withItemImports                     *ItemImportQuery
+	withComments                        *CommentQuery
+	withItemTransitions                 *ItemTransitionQuery
+	withMetadataSchemas                 *MetadataSchemaQuery
+	withSentOwnershipTransfers          *OwnershipTransferQuery
+	withReceivedOwnershipTransfers      *OwnershipTransferQuery
+	loadTotal                           []func(context.Context, []*User) error
+	modifiers                           []func(*sql.Selector)
+	withNamedItems                      map[string]*ItemQuery
+	withNamedSharedItems                map[string]*ItemShareQuery
+	withNamedItemRevisions              map[string]*ItemRevisionQuery
+	withNamedTags                       map[string]*TagQuery
+	withNamedAttachments                map[string]*AttachmentQuery
+	withNamedItemImports                map[string]*ItemImportQuery
+	withNamedComments                   map[string]*CommentQuery
+	withNamedItemTransitions            map[string]*ItemTransitionQuery
+	withNamedMetadataSchemas            map[string]*MetadataSchemaQuery
+	withNamedSentOwnershipTransfers     map[string]*OwnershipTransferQuery
+	withNamedReceivedOwnershipTransfers map[string]*OwnershipTransferQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +115,226 @@ func (uq *UserQuery) QueryItems() *ItemQuery {
 	return query
 }
 
+// QuerySharedItems chains the current query on the "shared_items" edge.
+func (uq *UserQuery) QuerySharedItems() *ItemShareQuery {
+	query := (&ItemShareClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(itemshare.Table, itemshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SharedItemsTable, user.SharedItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemRevisions chains the current query on the "item_revisions" edge.
+func (uq *UserQuery) QueryItemRevisions() *ItemRevisionQuery {
+	query := (&ItemRevisionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(itemrevision.Table, itemrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemRevisionsTable, user.ItemRevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (uq *UserQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (uq *UserQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AttachmentsTable, user.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemImports chains the current query on the "item_imports" edge.
+func (uq *UserQuery) QueryItemImports() *ItemImportQuery {
+	query := (&ItemImportClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(itemimport.Table, itemimport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemImportsTable, user.ItemImportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (uq *UserQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CommentsTable, user.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemTransitions chains the current query on the "item_transitions" edge.
+func (uq *UserQuery) QueryItemTransitions() *ItemTransitionQuery {
+	query := (&ItemTransitionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(itemtransition.Table, itemtransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ItemTransitionsTable, user.ItemTransitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMetadataSchemas chains the current query on the "metadata_schemas" edge.
+func (uq *UserQuery) QueryMetadataSchemas() *MetadataSchemaQuery {
+	query := (&MetadataSchemaClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(metadataschema.Table, metadataschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MetadataSchemasTable, user.MetadataSchemasColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySentOwnershipTransfers chains the current query on the "sent_ownership_transfers" edge.
+func (uq *UserQuery) QuerySentOwnershipTransfers() *OwnershipTransferQuery {
+	query := (&OwnershipTransferClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(ownershiptransfer.Table, ownershiptransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentOwnershipTransfersTable, user.SentOwnershipTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceivedOwnershipTransfers chains the current query on the "received_ownership_transfers" edge.
+func (uq *UserQuery) QueryReceivedOwnershipTransfers() *OwnershipTransferQuery {
+	query := (&OwnershipTransferClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(ownershiptransfer.Table, ownershiptransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReceivedOwnershipTransfersTable, user.ReceivedOwnershipTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -269,12 +522,22 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:     uq.config,
-		ctx:        uq.ctx.Clone(),
-		order:      append([]user.OrderOption{}, uq.order...),
-		inters:     append([]Interceptor{}, uq.inters...),
-		predicates: append([]predicate.User{}, uq.predicates...),
-		withItems:  uq.withItems.Clone(),
+		config:                         uq.config,
+		ctx:                            uq.ctx.Clone(),
+		order:                          append([]user.OrderOption{}, uq.order...),
+		inters:                         append([]Interceptor{}, uq.inters...),
+		predicates:                     append([]predicate.User{}, uq.predicates...),
+		withItems:                      uq.withItems.Clone(),
+		withSharedItems:                uq.withSharedItems.Clone(),
+		withItemRevisions:              uq.withItemRevisions.Clone(),
+		withTags:                       uq.withTags.Clone(),
+		withAttachments:                uq.withAttachments.Clone(),
+		withItemImports:                uq.withItemImports.Clone(),
+		withComments:                   uq.withComments.Clone(),
+		withItemTransitions:            uq.withItemTransitions.Clone(),
+		withMetadataSchemas:            uq.withMetadataSchemas.Clone(),
+		withSentOwnershipTransfers:     uq.withSentOwnershipTransfers.Clone(),
+		withReceivedOwnershipTransfers: uq.withReceivedOwnershipTransfers.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -292,6 +555,116 @@ func (uq *UserQuery) WithItems(opts ...func(*ItemQuery)) *UserQuery {
 	return uq
 }
 
+// WithSharedItems tells the query-builder to eager-load the nodes that are connected to
+// the "shared_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSharedItems(opts ...func(*ItemShareQuery)) *UserQuery {
+	query := (&ItemShareClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSharedItems = query
+	return uq
+}
+
+// WithItemRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "item_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithItemRevisions(opts ...func(*ItemRevisionQuery)) *UserQuery {
+	query := (&ItemRevisionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withItemRevisions = query
+	return uq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTags(opts ...func(*TagQuery)) *UserQuery {
+	query := (&TagClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTags = query
+	return uq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAttachments(opts ...func(*AttachmentQuery)) *UserQuery {
+	query := (&AttachmentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAttachments = query
+	return uq
+}
+
+// WithItemImports tells the query-builder to eager-load the nodes that are connected to
+// the "item_imports" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithItemImports(opts ...func(*ItemImportQuery)) *UserQuery {
+	query := (&ItemImportClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withItemImports = query
+	return uq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithComments(opts ...func(*CommentQuery)) *UserQuery {
+	query := (&CommentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withComments = query
+	return uq
+}
+
+// WithItemTransitions tells the query-builder to eager-load the nodes that are connected to
+// the "item_transitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithItemTransitions(opts ...func(*ItemTransitionQuery)) *UserQuery {
+	query := (&ItemTransitionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withItemTransitions = query
+	return uq
+}
+
+// WithMetadataSchemas tells the query-builder to eager-load the nodes that are connected to
+// the "metadata_schemas" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMetadataSchemas(opts ...func(*MetadataSchemaQuery)) *UserQuery {
+	query := (&MetadataSchemaClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMetadataSchemas = query
+	return uq
+}
+
+// WithSentOwnershipTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "sent_ownership_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSentOwnershipTransfers(opts ...func(*OwnershipTransferQuery)) *UserQuery {
+	query := (&OwnershipTransferClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSentOwnershipTransfers = query
+	return uq
+}
+
+// WithReceivedOwnershipTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "received_ownership_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithReceivedOwnershipTransfers(opts ...func(*OwnershipTransferQuery)) *UserQuery {
+	query := (&OwnershipTransferClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withReceivedOwnershipTransfers = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -363,6 +736,12 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 		}
 		uq.sql = prev
 	}
+	if user.Policy == nil {
+		return errors.New("ent: uninitialized user.Policy (forgotten import ent/runtime?)")
+	}
+	if err := user.Policy.EvalQuery(ctx, uq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -370,8 +749,18 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [11]bool{
 			uq.withItems != nil,
+			uq.withSharedItems != nil,
+			uq.withItemRevisions != nil,
+			uq.withTags != nil,
+			uq.withAttachments != nil,
+			uq.withItemImports != nil,
+			uq.withComments != nil,
+			uq.withItemTransitions != nil,
+			uq.withMetadataSchemas != nil,
+			uq.withSentOwnershipTransfers != nil,
+			uq.withReceivedOwnershipTransfers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -383,6 +772,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,28 +791,340 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
-	return nodes, nil
-}
-
-func (uq *UserQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []*User, init func(*User), assign func(*User, *Item)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[uint]*User)
-	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+	if query := uq.withSharedItems; query != nil {
+		if err := uq.loadSharedItems(ctx, query, nodes,
+			func(n *User) { n.Edges.SharedItems = []*ItemShare{} },
+			func(n *User, e *ItemShare) { n.Edges.SharedItems = append(n.Edges.SharedItems, e) }); err != nil {
+			return nil, err
 		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(item.FieldOwnerID)
+	if query := uq.withItemRevisions; query != nil {
+		if err := uq.loadItemRevisions(ctx, query, nodes,
+			func(n *User) { n.Edges.ItemRevisions = []*ItemRevision{} },
+			func(n *User, e *ItemRevision) { n.Edges.ItemRevisions = append(n.Edges.ItemRevisions, e) }); err != nil {
+			return nil, err
+		}
 	}
-	query.Where(predicate.Item(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.ItemsColumn), fks...))
-	}))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
+	if query := uq.withTags; query != nil {
+		if err := uq.loadTags(ctx, query, nodes,
+			func(n *User) { n.Edges.Tags = []*Tag{} },
+			func(n *User, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAttachments; query != nil {
+		if err := uq.loadAttachments(ctx, query, nodes,
+			func(n *User) { n.Edges.Attachments = []*Attachment{} },
+			func(n *User, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withItemImports; query != nil {
+		if err := uq.loadItemImports(ctx, query, nodes,
+			func(n *User) { n.Edges.ItemImports = []*ItemImport{} },
+			func(n *User, e *ItemImport) { n.Edges.ItemImports = append(n.Edges.ItemImports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withComments; query != nil {
+		if err := uq.loadComments(ctx, query, nodes,
+			func(n *User) { n.Edges.Comments = []*Comment{} },
+			func(n *User, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withItemTransitions; query != nil {
+		if err := uq.loadItemTransitions(ctx, query, nodes,
+			func(n *User) { n.Edges.ItemTransitions = []*ItemTransition{} },
+			func(n *User, e *ItemTransition) { n.Edges.ItemTransitions = append(n.Edges.ItemTransitions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMetadataSchemas; query != nil {
+		if err := uq.loadMetadataSchemas(ctx, query, nodes,
+			func(n *User) { n.Edges.MetadataSchemas = []*MetadataSchema{} },
+			func(n *User, e *MetadataSchema) { n.Edges.MetadataSchemas = append(n.Edges.MetadataSchemas, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSentOwnershipTransfers; query != nil {
+		if err := uq.loadSentOwnershipTransfers(ctx, query, nodes,
+			func(n *User) { n.Edges.SentOwnershipTransfers = []*OwnershipTransfer{} },
+			func(n *User, e *OwnershipTransfer) {
+				n.Edges.SentOwnershipTransfers = append(n.Edges.SentOwnershipTransfers, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withReceivedOwnershipTransfers; query != nil {
+		if err := uq.loadReceivedOwnershipTransfers(ctx, query, nodes,
+			func(n *User) { n.Edges.ReceivedOwnershipTransfers = []*OwnershipTransfer{} },
+			func(n *User, e *OwnershipTransfer) {
+				n.Edges.ReceivedOwnershipTransfers = append(n.Edges.ReceivedOwnershipTransfers, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedItems {
+		if err := uq.loadItems(ctx, query, nodes,
+			func(n *User) { n.appendNamedItems(name) },
+			func(n *User, e *Item) { n.appendNamedItems(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedSharedItems {
+		if err := uq.loadSharedItems(ctx, query, nodes,
+			func(n *User) { n.appendNamedSharedItems(name) },
+			func(n *User, e *ItemShare) { n.appendNamedSharedItems(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedItemRevisions {
+		if err := uq.loadItemRevisions(ctx, query, nodes,
+			func(n *User) { n.appendNamedItemRevisions(name) },
+			func(n *User, e *ItemRevision) { n.appendNamedItemRevisions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedTags {
+		if err := uq.loadTags(ctx, query, nodes,
+			func(n *User) { n.appendNamedTags(name) },
+			func(n *User, e *Tag) { n.appendNamedTags(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedAttachments {
+		if err := uq.loadAttachments(ctx, query, nodes,
+			func(n *User) { n.appendNamedAttachments(name) },
+			func(n *User, e *Attachment) { n.appendNamedAttachments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedItemImports {
+		if err := uq.loadItemImports(ctx, query, nodes,
+			func(n *User) { n.appendNamedItemImports(name) },
+			func(n *User, e *ItemImport) { n.appendNamedItemImports(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedComments {
+		if err := uq.loadComments(ctx, query, nodes,
+			func(n *User) { n.appendNamedComments(name) },
+			func(n *User, e *Comment) { n.appendNamedComments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedItemTransitions {
+		if err := uq.loadItemTransitions(ctx, query, nodes,
+			func(n *User) { n.appendNamedItemTransitions(name) },
+			func(n *User, e *ItemTransition) { n.appendNamedItemTransitions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedMetadataSchemas {
+		if err := uq.loadMetadataSchemas(ctx, query, nodes,
+			func(n *User) { n.appendNamedMetadataSchemas(name) },
+			func(n *User, e *MetadataSchema) { n.appendNamedMetadataSchemas(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedSentOwnershipTransfers {
+		if err := uq.loadSentOwnershipTransfers(ctx, query, nodes,
+			func(n *User) { n.appendNamedSentOwnershipTransfers(name) },
+			func(n *User, e *OwnershipTransfer) { n.appendNamedSentOwnershipTransfers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedReceivedOwnershipTransfers {
+		if err := uq.loadReceivedOwnershipTransfers(ctx, query, nodes,
+			func(n *User) { n.appendNamedReceivedOwnershipTransfers(name) },
+			func(n *User, e *OwnershipTransfer) { n.appendNamedReceivedOwnershipTransfers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range uq.loadTotal {
+		if err := uq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uq *UserQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []*User, init func(*User), assign func(*User, *Item)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(item.FieldOwnerID)
+	}
+	query.Where(predicate.Item(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadSharedItems(ctx context.Context, query *ItemShareQuery, nodes []*User, init func(*User), assign func(*User, *ItemShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemshare.FieldUserID)
+	}
+	query.Where(predicate.ItemShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SharedItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadItemRevisions(ctx context.Context, query *ItemRevisionQuery, nodes []*User, init func(*User), assign func(*User, *ItemRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemrevision.FieldUserID)
+	}
+	query.Where(predicate.ItemRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ItemRevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*User, init func(*User), assign func(*User, *Tag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tag.FieldOwnerID)
+	}
+	query.Where(predicate.Tag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*User, init func(*User), assign func(*User, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldUploaderID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UploaderID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "uploader_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "uploader_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadItemImports(ctx context.Context, query *ItemImportQuery, nodes []*User, init func(*User), assign func(*User, *ItemImport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemimport.FieldOwnerID)
+	}
+	query.Where(predicate.ItemImport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ItemImportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
 	for _, n := range neighbors {
 		fk := n.OwnerID
@@ -432,9 +1136,171 @@ func (uq *UserQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []*U
 	}
 	return nil
 }
+func (uq *UserQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*User, init func(*User), assign func(*User, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldAuthorID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AuthorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "author_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "author_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadItemTransitions(ctx context.Context, query *ItemTransitionQuery, nodes []*User, init func(*User), assign func(*User, *ItemTransition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemtransition.FieldUserID)
+	}
+	query.Where(predicate.ItemTransition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ItemTransitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadMetadataSchemas(ctx context.Context, query *MetadataSchemaQuery, nodes []*User, init func(*User), assign func(*User, *MetadataSchema)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(metadataschema.FieldOwnerID)
+	}
+	query.Where(predicate.MetadataSchema(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MetadataSchemasColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "owner_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadSentOwnershipTransfers(ctx context.Context, query *OwnershipTransferQuery, nodes []*User, init func(*User), assign func(*User, *OwnershipTransfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ownershiptransfer.FieldFromUserID)
+	}
+	query.Where(predicate.OwnershipTransfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SentOwnershipTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FromUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "from_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadReceivedOwnershipTransfers(ctx context.Context, query *OwnershipTransferQuery, nodes []*User, init func(*User), assign func(*User, *OwnershipTransfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ownershiptransfer.FieldToUserID)
+	}
+	query.Where(predicate.OwnershipTransfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReceivedOwnershipTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ToUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "to_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -497,6 +1363,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -514,6 +1383,166 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uq *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	uq.modifiers = append(uq.modifiers, modifiers...)
+	return uq.Select()
+}
+
+// WithNamedItems tells the query-builder to eager-load the nodes that are connected to the "items"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedItems(name string, opts ...func(*ItemQuery)) *UserQuery {
+	query := (&ItemClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedItems == nil {
+		uq.withNamedItems = make(map[string]*ItemQuery)
+	}
+	uq.withNamedItems[name] = query
+	return uq
+}
+
+// WithNamedSharedItems tells the query-builder to eager-load the nodes that are connected to the "shared_items"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedSharedItems(name string, opts ...func(*ItemShareQuery)) *UserQuery {
+	query := (&ItemShareClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedSharedItems == nil {
+		uq.withNamedSharedItems = make(map[string]*ItemShareQuery)
+	}
+	uq.withNamedSharedItems[name] = query
+	return uq
+}
+
+// WithNamedItemRevisions tells the query-builder to eager-load the nodes that are connected to the "item_revisions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedItemRevisions(name string, opts ...func(*ItemRevisionQuery)) *UserQuery {
+	query := (&ItemRevisionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedItemRevisions == nil {
+		uq.withNamedItemRevisions = make(map[string]*ItemRevisionQuery)
+	}
+	uq.withNamedItemRevisions[name] = query
+	return uq
+}
+
+// WithNamedTags tells the query-builder to eager-load the nodes that are connected to the "tags"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedTags(name string, opts ...func(*TagQuery)) *UserQuery {
+	query := (&TagClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedTags == nil {
+		uq.withNamedTags = make(map[string]*TagQuery)
+	}
+	uq.withNamedTags[name] = query
+	return uq
+}
+
+// WithNamedAttachments tells the query-builder to eager-load the nodes that are connected to the "attachments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedAttachments(name string, opts ...func(*AttachmentQuery)) *UserQuery {
+	query := (&AttachmentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedAttachments == nil {
+		uq.withNamedAttachments = make(map[string]*AttachmentQuery)
+	}
+	uq.withNamedAttachments[name] = query
+	return uq
+}
+
+// WithNamedItemImports tells the query-builder to eager-load the nodes that are connected to the "item_imports"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedItemImports(name string, opts ...func(*ItemImportQuery)) *UserQuery {
+	query := (&ItemImportClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedItemImports == nil {
+		uq.withNamedItemImports = make(map[string]*ItemImportQuery)
+	}
+	uq.withNamedItemImports[name] = query
+	return uq
+}
+
+// WithNamedComments tells the query-builder to eager-load the nodes that are connected to the "comments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedComments(name string, opts ...func(*CommentQuery)) *UserQuery {
+	query := (&CommentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedComments == nil {
+		uq.withNamedComments = make(map[string]*CommentQuery)
+	}
+	uq.withNamedComments[name] = query
+	return uq
+}
+
+// WithNamedItemTransitions tells the query-builder to eager-load the nodes that are connected to the "item_transitions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedItemTransitions(name string, opts ...func(*ItemTransitionQuery)) *UserQuery {
+	query := (&ItemTransitionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedItemTransitions == nil {
+		uq.withNamedItemTransitions = make(map[string]*ItemTransitionQuery)
+	}
+	uq.withNamedItemTransitions[name] = query
+	return uq
+}
+
+// WithNamedMetadataSchemas tells the query-builder to eager-load the nodes that are connected to the "metadata_schemas"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedMetadataSchemas(name string, opts ...func(*MetadataSchemaQuery)) *UserQuery {
+	query := (&MetadataSchemaClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedMetadataSchemas == nil {
+		uq.withNamedMetadataSchemas = make(map[string]*MetadataSchemaQuery)
+	}
+	uq.withNamedMetadataSchemas[name] = query
+	return uq
+}
+
+// WithNamedSentOwnershipTransfers tells the query-builder to eager-load the nodes that are connected to the "sent_ownership_transfers"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedSentOwnershipTransfers(name string, opts ...func(*OwnershipTransferQuery)) *UserQuery {
+	query := (&OwnershipTransferClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedSentOwnershipTransfers == nil {
+		uq.withNamedSentOwnershipTransfers = make(map[string]*OwnershipTransferQuery)
+	}
+	uq.withNamedSentOwnershipTransfers[name] = query
+	return uq
+}
+
+// WithNamedReceivedOwnershipTransfers tells the query-builder to eager-load the nodes that are connected to the "received_ownership_transfers"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedReceivedOwnershipTransfers(name string, opts ...func(*OwnershipTransferQuery)) *UserQuery {
+	query := (&OwnershipTransferClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedReceivedOwnershipTransfers == nil {
+		uq.withNamedReceivedOwnershipTransfers = make(map[string]*OwnershipTransferQuery)
+	}
+	uq.withNamedReceivedOwnershipTransfers[name] = query
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -603,3 +1632,9 @@ func (us *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (us *UserSelect) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	us.modifiers = append(us.modifiers, modifiers...)
+	return us
+}
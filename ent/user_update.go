@@ -11,16 +11,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 )
 
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -35,6 +45,47 @@ func (uu *UserUpdate) SetUpdateTime(t time.Time) *UserUpdate {
 	return uu
 }
 
+// SetDeleteTime sets the "delete_time" field.
+func (uu *UserUpdate) SetDeleteTime(t time.Time) *UserUpdate {
+	uu.mutation.SetDeleteTime(t)
+	return uu
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeleteTime(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeleteTime(*t)
+	}
+	return uu
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (uu *UserUpdate) ClearDeleteTime() *UserUpdate {
+	uu.mutation.ClearDeleteTime()
+	return uu
+}
+
+// SetVersion sets the "version" field.
+func (uu *UserUpdate) SetVersion(i int) *UserUpdate {
+	uu.mutation.ResetVersion()
+	uu.mutation.SetVersion(i)
+	return uu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetVersion(*i)
+	}
+	return uu
+}
+
+// AddVersion adds i to the "version" field.
+func (uu *UserUpdate) AddVersion(i int) *UserUpdate {
+	uu.mutation.AddVersion(i)
+	return uu
+}
+
 // SetName sets the "name" field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
@@ -155,6 +206,46 @@ func (uu *UserUpdate) ClearPasswordResetAt() *UserUpdate {
 	return uu
 }
 
+// SetCalendarToken sets the "calendar_token" field.
+func (uu *UserUpdate) SetCalendarToken(s string) *UserUpdate {
+	uu.mutation.SetCalendarToken(s)
+	return uu
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableCalendarToken(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetCalendarToken(*s)
+	}
+	return uu
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (uu *UserUpdate) ClearCalendarToken() *UserUpdate {
+	uu.mutation.ClearCalendarToken()
+	return uu
+}
+
+// SetPlan sets the "plan" field.
+func (uu *UserUpdate) SetPlan(s string) *UserUpdate {
+	uu.mutation.SetPlan(s)
+	return uu
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePlan(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPlan(*s)
+	}
+	return uu
+}
+
+// ClearPlan clears the value of the "plan" field.
+func (uu *UserUpdate) ClearPlan() *UserUpdate {
+	uu.mutation.ClearPlan()
+	return uu
+}
+
 // AddItemIDs adds the "items" edge to the Item entity by IDs.
 func (uu *UserUpdate) AddItemIDs(ids ...uint) *UserUpdate {
 	uu.mutation.AddItemIDs(ids...)
@@ -170,6 +261,156 @@ func (uu *UserUpdate) AddItems(i ...*Item) *UserUpdate {
 	return uu.AddItemIDs(ids...)
 }
 
+// AddSharedItemIDs adds the "shared_items" edge to the ItemShare entity by IDs.
+func (uu *UserUpdate) AddSharedItemIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddSharedItemIDs(ids...)
+	return uu
+}
+
+// AddSharedItems adds the "shared_items" edges to the ItemShare entity.
+func (uu *UserUpdate) AddSharedItems(i ...*ItemShare) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddSharedItemIDs(ids...)
+}
+
+// AddItemRevisionIDs adds the "item_revisions" edge to the ItemRevision entity by IDs.
+func (uu *UserUpdate) AddItemRevisionIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddItemRevisionIDs(ids...)
+	return uu
+}
+
+// AddItemRevisions adds the "item_revisions" edges to the ItemRevision entity.
+func (uu *UserUpdate) AddItemRevisions(i ...*ItemRevision) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddItemRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uu *UserUpdate) AddTagIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddTagIDs(ids...)
+	return uu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uu *UserUpdate) AddTags(t ...*Tag) *UserUpdate {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uu *UserUpdate) AddAttachmentIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddAttachmentIDs(ids...)
+	return uu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uu *UserUpdate) AddAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAttachmentIDs(ids...)
+}
+
+// AddItemImportIDs adds the "item_imports" edge to the ItemImport entity by IDs.
+func (uu *UserUpdate) AddItemImportIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddItemImportIDs(ids...)
+	return uu
+}
+
+// AddItemImports adds the "item_imports" edges to the ItemImport entity.
+func (uu *UserUpdate) AddItemImports(i ...*ItemImport) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddItemImportIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (uu *UserUpdate) AddCommentIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddCommentIDs(ids...)
+	return uu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (uu *UserUpdate) AddComments(c ...*Comment) *UserUpdate {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCommentIDs(ids...)
+}
+
+// AddItemTransitionIDs adds the "item_transitions" edge to the ItemTransition entity by IDs.
+func (uu *UserUpdate) AddItemTransitionIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddItemTransitionIDs(ids...)
+	return uu
+}
+
+// AddItemTransitions adds the "item_transitions" edges to the ItemTransition entity.
+func (uu *UserUpdate) AddItemTransitions(i ...*ItemTransition) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddItemTransitionIDs(ids...)
+}
+
+// AddMetadataSchemaIDs adds the "metadata_schemas" edge to the MetadataSchema entity by IDs.
+func (uu *UserUpdate) AddMetadataSchemaIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddMetadataSchemaIDs(ids...)
+	return uu
+}
+
+// AddMetadataSchemas adds the "metadata_schemas" edges to the MetadataSchema entity.
+func (uu *UserUpdate) AddMetadataSchemas(m ...*MetadataSchema) *UserUpdate {
+	ids := make([]uint, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMetadataSchemaIDs(ids...)
+}
+
+// AddSentOwnershipTransferIDs adds the "sent_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (uu *UserUpdate) AddSentOwnershipTransferIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddSentOwnershipTransferIDs(ids...)
+	return uu
+}
+
+// AddSentOwnershipTransfers adds the "sent_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uu *UserUpdate) AddSentOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddSentOwnershipTransferIDs(ids...)
+}
+
+// AddReceivedOwnershipTransferIDs adds the "received_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (uu *UserUpdate) AddReceivedOwnershipTransferIDs(ids ...uint) *UserUpdate {
+	uu.mutation.AddReceivedOwnershipTransferIDs(ids...)
+	return uu
+}
+
+// AddReceivedOwnershipTransfers adds the "received_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uu *UserUpdate) AddReceivedOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddReceivedOwnershipTransferIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -196,9 +437,221 @@ func (uu *UserUpdate) RemoveItems(i ...*Item) *UserUpdate {
 	return uu.RemoveItemIDs(ids...)
 }
 
+// ClearSharedItems clears all "shared_items" edges to the ItemShare entity.
+func (uu *UserUpdate) ClearSharedItems() *UserUpdate {
+	uu.mutation.ClearSharedItems()
+	return uu
+}
+
+// RemoveSharedItemIDs removes the "shared_items" edge to ItemShare entities by IDs.
+func (uu *UserUpdate) RemoveSharedItemIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveSharedItemIDs(ids...)
+	return uu
+}
+
+// RemoveSharedItems removes "shared_items" edges to ItemShare entities.
+func (uu *UserUpdate) RemoveSharedItems(i ...*ItemShare) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveSharedItemIDs(ids...)
+}
+
+// ClearItemRevisions clears all "item_revisions" edges to the ItemRevision entity.
+func (uu *UserUpdate) ClearItemRevisions() *UserUpdate {
+	uu.mutation.ClearItemRevisions()
+	return uu
+}
+
+// RemoveItemRevisionIDs removes the "item_revisions" edge to ItemRevision entities by IDs.
+func (uu *UserUpdate) RemoveItemRevisionIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveItemRevisionIDs(ids...)
+	return uu
+}
+
+// RemoveItemRevisions removes "item_revisions" edges to ItemRevision entities.
+func (uu *UserUpdate) RemoveItemRevisions(i ...*ItemRevision) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveItemRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (uu *UserUpdate) ClearTags() *UserUpdate {
+	uu.mutation.ClearTags()
+	return uu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (uu *UserUpdate) RemoveTagIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveTagIDs(ids...)
+	return uu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (uu *UserUpdate) RemoveTags(t ...*Tag) *UserUpdate {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveTagIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (uu *UserUpdate) ClearAttachments() *UserUpdate {
+	uu.mutation.ClearAttachments()
+	return uu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (uu *UserUpdate) RemoveAttachmentIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveAttachmentIDs(ids...)
+	return uu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (uu *UserUpdate) RemoveAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearItemImports clears all "item_imports" edges to the ItemImport entity.
+func (uu *UserUpdate) ClearItemImports() *UserUpdate {
+	uu.mutation.ClearItemImports()
+	return uu
+}
+
+// RemoveItemImportIDs removes the "item_imports" edge to ItemImport entities by IDs.
+func (uu *UserUpdate) RemoveItemImportIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveItemImportIDs(ids...)
+	return uu
+}
+
+// RemoveItemImports removes "item_imports" edges to ItemImport entities.
+func (uu *UserUpdate) RemoveItemImports(i ...*ItemImport) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveItemImportIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (uu *UserUpdate) ClearComments() *UserUpdate {
+	uu.mutation.ClearComments()
+	return uu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (uu *UserUpdate) RemoveCommentIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveCommentIDs(ids...)
+	return uu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (uu *UserUpdate) RemoveComments(c ...*Comment) *UserUpdate {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCommentIDs(ids...)
+}
+
+// ClearItemTransitions clears all "item_transitions" edges to the ItemTransition entity.
+func (uu *UserUpdate) ClearItemTransitions() *UserUpdate {
+	uu.mutation.ClearItemTransitions()
+	return uu
+}
+
+// RemoveItemTransitionIDs removes the "item_transitions" edge to ItemTransition entities by IDs.
+func (uu *UserUpdate) RemoveItemTransitionIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveItemTransitionIDs(ids...)
+	return uu
+}
+
+// RemoveItemTransitions removes "item_transitions" edges to ItemTransition entities.
+func (uu *UserUpdate) RemoveItemTransitions(i ...*ItemTransition) *UserUpdate {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveItemTransitionIDs(ids...)
+}
+
+// ClearMetadataSchemas clears all "metadata_schemas" edges to the MetadataSchema entity.
+func (uu *UserUpdate) ClearMetadataSchemas() *UserUpdate {
+	uu.mutation.ClearMetadataSchemas()
+	return uu
+}
+
+// RemoveMetadataSchemaIDs removes the "metadata_schemas" edge to MetadataSchema entities by IDs.
+func (uu *UserUpdate) RemoveMetadataSchemaIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveMetadataSchemaIDs(ids...)
+	return uu
+}
+
+// RemoveMetadataSchemas removes "metadata_schemas" edges to MetadataSchema entities.
+func (uu *UserUpdate) RemoveMetadataSchemas(m ...*MetadataSchema) *UserUpdate {
+	ids := make([]uint, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMetadataSchemaIDs(ids...)
+}
+
+// ClearSentOwnershipTransfers clears all "sent_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uu *UserUpdate) ClearSentOwnershipTransfers() *UserUpdate {
+	uu.mutation.ClearSentOwnershipTransfers()
+	return uu
+}
+
+// RemoveSentOwnershipTransferIDs removes the "sent_ownership_transfers" edge to OwnershipTransfer entities by IDs.
+func (uu *UserUpdate) RemoveSentOwnershipTransferIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveSentOwnershipTransferIDs(ids...)
+	return uu
+}
+
+// RemoveSentOwnershipTransfers removes "sent_ownership_transfers" edges to OwnershipTransfer entities.
+func (uu *UserUpdate) RemoveSentOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveSentOwnershipTransferIDs(ids...)
+}
+
+// ClearReceivedOwnershipTransfers clears all "received_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uu *UserUpdate) ClearReceivedOwnershipTransfers() *UserUpdate {
+	uu.mutation.ClearReceivedOwnershipTransfers()
+	return uu
+}
+
+// RemoveReceivedOwnershipTransferIDs removes the "received_ownership_transfers" edge to OwnershipTransfer entities by IDs.
+func (uu *UserUpdate) RemoveReceivedOwnershipTransferIDs(ids ...uint) *UserUpdate {
+	uu.mutation.RemoveReceivedOwnershipTransferIDs(ids...)
+	return uu
+}
+
+// RemoveReceivedOwnershipTransfers removes "received_ownership_transfers" edges to OwnershipTransfer entities.
+func (uu *UserUpdate) RemoveReceivedOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdate {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveReceivedOwnershipTransferIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	uu.defaults()
+	if err := uu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks[int, UserMutation](ctx, uu.sqlSave, uu.mutation, uu.hooks)
 }
 
@@ -225,11 +678,21 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uu *UserUpdate) defaults() {
+func (uu *UserUpdate) defaults() error {
 	if _, ok := uu.mutation.UpdateTime(); !ok {
+		if user.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := user.UpdateDefaultUpdateTime()
 		uu.mutation.SetUpdateTime(v)
 	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uu *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	uu.modifiers = append(uu.modifiers, modifiers...)
+	return uu
 }
 
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -244,6 +707,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.UpdateTime(); ok {
 		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := uu.mutation.DeleteTime(); ok {
+		_spec.SetField(user.FieldDeleteTime, field.TypeTime, value)
+	}
+	if uu.mutation.DeleteTimeCleared() {
+		_spec.ClearField(user.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedVersion(); ok {
+		_spec.AddField(user.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 	}
@@ -280,6 +755,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.PasswordResetAtCleared() {
 		_spec.ClearField(user.FieldPasswordResetAt, field.TypeTime)
 	}
+	if value, ok := uu.mutation.CalendarToken(); ok {
+		_spec.SetField(user.FieldCalendarToken, field.TypeString, value)
+	}
+	if uu.mutation.CalendarTokenCleared() {
+		_spec.ClearField(user.FieldCalendarToken, field.TypeString)
+	}
+	if value, ok := uu.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+	}
+	if uu.mutation.PlanCleared() {
+		_spec.ClearField(user.FieldPlan, field.TypeString)
+	}
 	if uu.mutation.ItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -325,29 +812,522 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.SharedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (uuo *UserUpdateOne) SetUpdateTime(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdateTime(t)
+	if nodes := uu.mutation.RemovedSharedItemsIDs(); len(nodes) > 0 && !uu.mutation.SharedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SharedItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ItemRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedItemRevisionsIDs(); len(nodes) > 0 && !uu.mutation.ItemRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ItemRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !uu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !uu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ItemImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedItemImportsIDs(); len(nodes) > 0 && !uu.mutation.ItemImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ItemImportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !uu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ItemTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedItemTransitionsIDs(); len(nodes) > 0 && !uu.mutation.ItemTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ItemTransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MetadataSchemasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMetadataSchemasIDs(); len(nodes) > 0 && !uu.mutation.MetadataSchemasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MetadataSchemasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SentOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSentOwnershipTransfersIDs(); len(nodes) > 0 && !uu.mutation.SentOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SentOwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ReceivedOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedReceivedOwnershipTransfersIDs(); len(nodes) > 0 && !uu.mutation.ReceivedOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ReceivedOwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (uuo *UserUpdateOne) SetUpdateTime(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdateTime(t)
+	return uuo
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (uuo *UserUpdateOne) SetDeleteTime(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeleteTime(t)
+	return uuo
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeleteTime(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeleteTime(*t)
+	}
+	return uuo
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (uuo *UserUpdateOne) ClearDeleteTime() *UserUpdateOne {
+	uuo.mutation.ClearDeleteTime()
+	return uuo
+}
+
+// SetVersion sets the "version" field.
+func (uuo *UserUpdateOne) SetVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetVersion()
+	uuo.mutation.SetVersion(i)
+	return uuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetVersion(*i)
+	}
+	return uuo
+}
+
+// AddVersion adds i to the "version" field.
+func (uuo *UserUpdateOne) AddVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddVersion(i)
 	return uuo
 }
 
@@ -457,59 +1437,459 @@ func (uuo *UserUpdateOne) SetPasswordResetAt(t time.Time) *UserUpdateOne {
 	return uuo
 }
 
-// SetNillablePasswordResetAt sets the "password_reset_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePasswordResetAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetPasswordResetAt(*t)
+// SetNillablePasswordResetAt sets the "password_reset_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePasswordResetAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPasswordResetAt(*t)
+	}
+	return uuo
+}
+
+// ClearPasswordResetAt clears the value of the "password_reset_at" field.
+func (uuo *UserUpdateOne) ClearPasswordResetAt() *UserUpdateOne {
+	uuo.mutation.ClearPasswordResetAt()
+	return uuo
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (uuo *UserUpdateOne) SetCalendarToken(s string) *UserUpdateOne {
+	uuo.mutation.SetCalendarToken(s)
+	return uuo
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCalendarToken(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetCalendarToken(*s)
+	}
+	return uuo
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (uuo *UserUpdateOne) ClearCalendarToken() *UserUpdateOne {
+	uuo.mutation.ClearCalendarToken()
+	return uuo
+}
+
+// SetPlan sets the "plan" field.
+func (uuo *UserUpdateOne) SetPlan(s string) *UserUpdateOne {
+	uuo.mutation.SetPlan(s)
+	return uuo
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePlan(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPlan(*s)
+	}
+	return uuo
+}
+
+// ClearPlan clears the value of the "plan" field.
+func (uuo *UserUpdateOne) ClearPlan() *UserUpdateOne {
+	uuo.mutation.ClearPlan()
+	return uuo
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (uuo *UserUpdateOne) AddItemIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddItemIDs(ids...)
+	return uuo
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (uuo *UserUpdateOne) AddItems(i ...*Item) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddItemIDs(ids...)
+}
+
+// AddSharedItemIDs adds the "shared_items" edge to the ItemShare entity by IDs.
+func (uuo *UserUpdateOne) AddSharedItemIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddSharedItemIDs(ids...)
+	return uuo
+}
+
+// AddSharedItems adds the "shared_items" edges to the ItemShare entity.
+func (uuo *UserUpdateOne) AddSharedItems(i ...*ItemShare) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddSharedItemIDs(ids...)
+}
+
+// AddItemRevisionIDs adds the "item_revisions" edge to the ItemRevision entity by IDs.
+func (uuo *UserUpdateOne) AddItemRevisionIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddItemRevisionIDs(ids...)
+	return uuo
+}
+
+// AddItemRevisions adds the "item_revisions" edges to the ItemRevision entity.
+func (uuo *UserUpdateOne) AddItemRevisions(i ...*ItemRevision) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddItemRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uuo *UserUpdateOne) AddTagIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddTagIDs(ids...)
+	return uuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uuo *UserUpdateOne) AddTags(t ...*Tag) *UserUpdateOne {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uuo *UserUpdateOne) AddAttachmentIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddAttachmentIDs(ids...)
+	return uuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uuo *UserUpdateOne) AddAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAttachmentIDs(ids...)
+}
+
+// AddItemImportIDs adds the "item_imports" edge to the ItemImport entity by IDs.
+func (uuo *UserUpdateOne) AddItemImportIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddItemImportIDs(ids...)
+	return uuo
+}
+
+// AddItemImports adds the "item_imports" edges to the ItemImport entity.
+func (uuo *UserUpdateOne) AddItemImports(i ...*ItemImport) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddItemImportIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (uuo *UserUpdateOne) AddCommentIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddCommentIDs(ids...)
+	return uuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (uuo *UserUpdateOne) AddComments(c ...*Comment) *UserUpdateOne {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCommentIDs(ids...)
+}
+
+// AddItemTransitionIDs adds the "item_transitions" edge to the ItemTransition entity by IDs.
+func (uuo *UserUpdateOne) AddItemTransitionIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddItemTransitionIDs(ids...)
+	return uuo
+}
+
+// AddItemTransitions adds the "item_transitions" edges to the ItemTransition entity.
+func (uuo *UserUpdateOne) AddItemTransitions(i ...*ItemTransition) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddItemTransitionIDs(ids...)
+}
+
+// AddMetadataSchemaIDs adds the "metadata_schemas" edge to the MetadataSchema entity by IDs.
+func (uuo *UserUpdateOne) AddMetadataSchemaIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddMetadataSchemaIDs(ids...)
+	return uuo
+}
+
+// AddMetadataSchemas adds the "metadata_schemas" edges to the MetadataSchema entity.
+func (uuo *UserUpdateOne) AddMetadataSchemas(m ...*MetadataSchema) *UserUpdateOne {
+	ids := make([]uint, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMetadataSchemaIDs(ids...)
+}
+
+// AddSentOwnershipTransferIDs adds the "sent_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (uuo *UserUpdateOne) AddSentOwnershipTransferIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddSentOwnershipTransferIDs(ids...)
+	return uuo
+}
+
+// AddSentOwnershipTransfers adds the "sent_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uuo *UserUpdateOne) AddSentOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdateOne {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddSentOwnershipTransferIDs(ids...)
+}
+
+// AddReceivedOwnershipTransferIDs adds the "received_ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (uuo *UserUpdateOne) AddReceivedOwnershipTransferIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.AddReceivedOwnershipTransferIDs(ids...)
+	return uuo
+}
+
+// AddReceivedOwnershipTransfers adds the "received_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uuo *UserUpdateOne) AddReceivedOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdateOne {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddReceivedOwnershipTransferIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearItems clears all "items" edges to the Item entity.
+func (uuo *UserUpdateOne) ClearItems() *UserUpdateOne {
+	uuo.mutation.ClearItems()
+	return uuo
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveItemIDs(ids...)
+	return uuo
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (uuo *UserUpdateOne) RemoveItems(i ...*Item) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveItemIDs(ids...)
+}
+
+// ClearSharedItems clears all "shared_items" edges to the ItemShare entity.
+func (uuo *UserUpdateOne) ClearSharedItems() *UserUpdateOne {
+	uuo.mutation.ClearSharedItems()
+	return uuo
+}
+
+// RemoveSharedItemIDs removes the "shared_items" edge to ItemShare entities by IDs.
+func (uuo *UserUpdateOne) RemoveSharedItemIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveSharedItemIDs(ids...)
+	return uuo
+}
+
+// RemoveSharedItems removes "shared_items" edges to ItemShare entities.
+func (uuo *UserUpdateOne) RemoveSharedItems(i ...*ItemShare) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveSharedItemIDs(ids...)
+}
+
+// ClearItemRevisions clears all "item_revisions" edges to the ItemRevision entity.
+func (uuo *UserUpdateOne) ClearItemRevisions() *UserUpdateOne {
+	uuo.mutation.ClearItemRevisions()
+	return uuo
+}
+
+// RemoveItemRevisionIDs removes the "item_revisions" edge to ItemRevision entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemRevisionIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveItemRevisionIDs(ids...)
+	return uuo
+}
+
+// RemoveItemRevisions removes "item_revisions" edges to ItemRevision entities.
+func (uuo *UserUpdateOne) RemoveItemRevisions(i ...*ItemRevision) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveItemRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (uuo *UserUpdateOne) ClearTags() *UserUpdateOne {
+	uuo.mutation.ClearTags()
+	return uuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (uuo *UserUpdateOne) RemoveTagIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveTagIDs(ids...)
+	return uuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (uuo *UserUpdateOne) RemoveTags(t ...*Tag) *UserUpdateOne {
+	ids := make([]uint, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTagIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (uuo *UserUpdateOne) ClearAttachments() *UserUpdateOne {
+	uuo.mutation.ClearAttachments()
+	return uuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (uuo *UserUpdateOne) RemoveAttachmentIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveAttachmentIDs(ids...)
+	return uuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (uuo *UserUpdateOne) RemoveAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearItemImports clears all "item_imports" edges to the ItemImport entity.
+func (uuo *UserUpdateOne) ClearItemImports() *UserUpdateOne {
+	uuo.mutation.ClearItemImports()
+	return uuo
+}
+
+// RemoveItemImportIDs removes the "item_imports" edge to ItemImport entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemImportIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveItemImportIDs(ids...)
+	return uuo
+}
+
+// RemoveItemImports removes "item_imports" edges to ItemImport entities.
+func (uuo *UserUpdateOne) RemoveItemImports(i ...*ItemImport) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveItemImportIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (uuo *UserUpdateOne) ClearComments() *UserUpdateOne {
+	uuo.mutation.ClearComments()
+	return uuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (uuo *UserUpdateOne) RemoveCommentIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveCommentIDs(ids...)
+	return uuo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (uuo *UserUpdateOne) RemoveComments(c ...*Comment) *UserUpdateOne {
+	ids := make([]uint, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCommentIDs(ids...)
+}
+
+// ClearItemTransitions clears all "item_transitions" edges to the ItemTransition entity.
+func (uuo *UserUpdateOne) ClearItemTransitions() *UserUpdateOne {
+	uuo.mutation.ClearItemTransitions()
+	return uuo
+}
+
+// RemoveItemTransitionIDs removes the "item_transitions" edge to ItemTransition entities by IDs.
+func (uuo *UserUpdateOne) RemoveItemTransitionIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveItemTransitionIDs(ids...)
+	return uuo
+}
+
+// RemoveItemTransitions removes "item_transitions" edges to ItemTransition entities.
+func (uuo *UserUpdateOne) RemoveItemTransitions(i ...*ItemTransition) *UserUpdateOne {
+	ids := make([]uint, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveItemTransitionIDs(ids...)
+}
+
+// ClearMetadataSchemas clears all "metadata_schemas" edges to the MetadataSchema entity.
+func (uuo *UserUpdateOne) ClearMetadataSchemas() *UserUpdateOne {
+	uuo.mutation.ClearMetadataSchemas()
+	return uuo
+}
+
+// RemoveMetadataSchemaIDs removes the "metadata_schemas" edge to MetadataSchema entities by IDs.
+func (uuo *UserUpdateOne) RemoveMetadataSchemaIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveMetadataSchemaIDs(ids...)
+	return uuo
+}
+
+// RemoveMetadataSchemas removes "metadata_schemas" edges to MetadataSchema entities.
+func (uuo *UserUpdateOne) RemoveMetadataSchemas(m ...*MetadataSchema) *UserUpdateOne {
+	ids := make([]uint, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uuo
+	return uuo.RemoveMetadataSchemaIDs(ids...)
 }
 
-// ClearPasswordResetAt clears the value of the "password_reset_at" field.
-func (uuo *UserUpdateOne) ClearPasswordResetAt() *UserUpdateOne {
-	uuo.mutation.ClearPasswordResetAt()
+// ClearSentOwnershipTransfers clears all "sent_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uuo *UserUpdateOne) ClearSentOwnershipTransfers() *UserUpdateOne {
+	uuo.mutation.ClearSentOwnershipTransfers()
 	return uuo
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by IDs.
-func (uuo *UserUpdateOne) AddItemIDs(ids ...uint) *UserUpdateOne {
-	uuo.mutation.AddItemIDs(ids...)
+// RemoveSentOwnershipTransferIDs removes the "sent_ownership_transfers" edge to OwnershipTransfer entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentOwnershipTransferIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveSentOwnershipTransferIDs(ids...)
 	return uuo
 }
 
-// AddItems adds the "items" edges to the Item entity.
-func (uuo *UserUpdateOne) AddItems(i ...*Item) *UserUpdateOne {
-	ids := make([]uint, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+// RemoveSentOwnershipTransfers removes "sent_ownership_transfers" edges to OwnershipTransfer entities.
+func (uuo *UserUpdateOne) RemoveSentOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdateOne {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return uuo.AddItemIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
+	return uuo.RemoveSentOwnershipTransferIDs(ids...)
 }
 
-// ClearItems clears all "items" edges to the Item entity.
-func (uuo *UserUpdateOne) ClearItems() *UserUpdateOne {
-	uuo.mutation.ClearItems()
+// ClearReceivedOwnershipTransfers clears all "received_ownership_transfers" edges to the OwnershipTransfer entity.
+func (uuo *UserUpdateOne) ClearReceivedOwnershipTransfers() *UserUpdateOne {
+	uuo.mutation.ClearReceivedOwnershipTransfers()
 	return uuo
 }
 
-// RemoveItemIDs removes the "items" edge to Item entities by IDs.
-func (uuo *UserUpdateOne) RemoveItemIDs(ids ...uint) *UserUpdateOne {
-	uuo.mutation.RemoveItemIDs(ids...)
+// RemoveReceivedOwnershipTransferIDs removes the "received_ownership_transfers" edge to OwnershipTransfer entities by IDs.
+func (uuo *UserUpdateOne) RemoveReceivedOwnershipTransferIDs(ids ...uint) *UserUpdateOne {
+	uuo.mutation.RemoveReceivedOwnershipTransferIDs(ids...)
 	return uuo
 }
 
-// RemoveItems removes "items" edges to Item entities.
-func (uuo *UserUpdateOne) RemoveItems(i ...*Item) *UserUpdateOne {
-	ids := make([]uint, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+// RemoveReceivedOwnershipTransfers removes "received_ownership_transfers" edges to OwnershipTransfer entities.
+func (uuo *UserUpdateOne) RemoveReceivedOwnershipTransfers(o ...*OwnershipTransfer) *UserUpdateOne {
+	ids := make([]uint, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return uuo.RemoveItemIDs(ids...)
+	return uuo.RemoveReceivedOwnershipTransferIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -527,7 +1907,9 @@ func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne
 
 // Save executes the query and returns the updated User entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
+	if err := uuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks[*User, UserMutation](ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
 }
 
@@ -551,103 +1933,587 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	if err := uuo.Exec(ctx); err != nil {
 		panic(err)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
-	if _, ok := uuo.mutation.UpdateTime(); !ok {
-		v := user.UpdateDefaultUpdateTime()
-		uuo.mutation.SetUpdateTime(v)
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() error {
+	if _, ok := uuo.mutation.UpdateTime(); !ok {
+		if user.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := user.UpdateDefaultUpdateTime()
+		uuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uuo *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	uuo.modifiers = append(uuo.modifiers, modifiers...)
+	return uuo
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.UpdateTime(); ok {
+		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.DeleteTime(); ok {
+		_spec.SetField(user.FieldDeleteTime, field.TypeTime, value)
+	}
+	if uuo.mutation.DeleteTimeCleared() {
+		_spec.ClearField(user.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Version(); ok {
+		_spec.SetField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedVersion(); ok {
+		_spec.AddField(user.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.IsActive(); ok {
+		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.IsSuperUser(); ok {
+		_spec.SetField(user.FieldIsSuperUser, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.Verified(); ok {
+		_spec.SetField(user.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.VerificationCode(); ok {
+		_spec.SetField(user.FieldVerificationCode, field.TypeString, value)
+	}
+	if uuo.mutation.VerificationCodeCleared() {
+		_spec.ClearField(user.FieldVerificationCode, field.TypeString)
+	}
+	if value, ok := uuo.mutation.PasswordResetToken(); ok {
+		_spec.SetField(user.FieldPasswordResetToken, field.TypeString, value)
+	}
+	if uuo.mutation.PasswordResetTokenCleared() {
+		_spec.ClearField(user.FieldPasswordResetToken, field.TypeString)
+	}
+	if value, ok := uuo.mutation.PasswordResetAt(); ok {
+		_spec.SetField(user.FieldPasswordResetAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PasswordResetAtCleared() {
+		_spec.ClearField(user.FieldPasswordResetAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.CalendarToken(); ok {
+		_spec.SetField(user.FieldCalendarToken, field.TypeString, value)
+	}
+	if uuo.mutation.CalendarTokenCleared() {
+		_spec.ClearField(user.FieldCalendarToken, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+	}
+	if uuo.mutation.PlanCleared() {
+		_spec.ClearField(user.FieldPlan, field.TypeString)
+	}
+	if uuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemsTable,
+			Columns: []string{user.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !uuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemsTable,
+			Columns: []string{user.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemsTable,
+			Columns: []string{user.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SharedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSharedItemsIDs(); len(nodes) > 0 && !uuo.mutation.SharedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SharedItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharedItemsTable,
+			Columns: []string{user.SharedItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemshare.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ItemRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedItemRevisionsIDs(); len(nodes) > 0 && !uuo.mutation.ItemRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ItemRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemRevisionsTable,
+			Columns: []string{user.ItemRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !uuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if nodes := uuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !uuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if uuo.mutation.ItemImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.UpdateTime(); ok {
-		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
+	if nodes := uuo.mutation.RemovedItemImportsIDs(); len(nodes) > 0 && !uuo.mutation.ItemImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+	if nodes := uuo.mutation.ItemImportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemImportsTable,
+			Columns: []string{user.ItemImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimport.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if uuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !uuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.IsActive(); ok {
-		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
+	if nodes := uuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.IsSuperUser(); ok {
-		_spec.SetField(user.FieldIsSuperUser, field.TypeBool, value)
+	if uuo.mutation.ItemTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Verified(); ok {
-		_spec.SetField(user.FieldVerified, field.TypeBool, value)
+	if nodes := uuo.mutation.RemovedItemTransitionsIDs(); len(nodes) > 0 && !uuo.mutation.ItemTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.VerificationCode(); ok {
-		_spec.SetField(user.FieldVerificationCode, field.TypeString, value)
+	if nodes := uuo.mutation.ItemTransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ItemTransitionsTable,
+			Columns: []string{user.ItemTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtransition.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.VerificationCodeCleared() {
-		_spec.ClearField(user.FieldVerificationCode, field.TypeString)
+	if uuo.mutation.MetadataSchemasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.PasswordResetToken(); ok {
-		_spec.SetField(user.FieldPasswordResetToken, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedMetadataSchemasIDs(); len(nodes) > 0 && !uuo.mutation.MetadataSchemasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.PasswordResetTokenCleared() {
-		_spec.ClearField(user.FieldPasswordResetToken, field.TypeString)
+	if nodes := uuo.mutation.MetadataSchemasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MetadataSchemasTable,
+			Columns: []string{user.MetadataSchemasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metadataschema.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.PasswordResetAt(); ok {
-		_spec.SetField(user.FieldPasswordResetAt, field.TypeTime, value)
+	if uuo.mutation.SentOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.PasswordResetAtCleared() {
-		_spec.ClearField(user.FieldPasswordResetAt, field.TypeTime)
+	if nodes := uuo.mutation.RemovedSentOwnershipTransfersIDs(); len(nodes) > 0 && !uuo.mutation.SentOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.ItemsCleared() {
+	if nodes := uuo.mutation.SentOwnershipTransfersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ItemsTable,
-			Columns: []string{user.ItemsColumn},
+			Table:   user.SentOwnershipTransfersTable,
+			Columns: []string{user.SentOwnershipTransfersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ReceivedOwnershipTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !uuo.mutation.ItemsCleared() {
+	if nodes := uuo.mutation.RemovedReceivedOwnershipTransfersIDs(); len(nodes) > 0 && !uuo.mutation.ReceivedOwnershipTransfersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ItemsTable,
-			Columns: []string{user.ItemsColumn},
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
 			},
 		}
 		for _, k := range nodes {
@@ -655,15 +2521,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ReceivedOwnershipTransfersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ItemsTable,
-			Columns: []string{user.ItemsColumn},
+			Table:   user.ReceivedOwnershipTransfersTable,
+			Columns: []string{user.ReceivedOwnershipTransfersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUint),
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUint),
 			},
 		}
 		for _, k := range nodes {
@@ -671,6 +2537,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/jwt"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type authServer struct {
+	pb.UnimplementedAuthServiceServer
+	cfg     *config.Config
+	usersUC users.UserUseCase
+	logger  logger.Logger
+}
+
+func CreateAuthServer(uc users.UserUseCase, cfg *config.Config, logger logger.Logger) pb.AuthServiceServer {
+	return &authServer{cfg: cfg, usersUC: uc, logger: logger}
+}
+
+func (s *authServer) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.Token, error) {
+	user := &presenter.UserSignIn{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}
+
+	err := utils.ValidateStruct(ctx, user)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	accessToken, refreshToken, err := s.usersUC.SignIn(ctx, user.Email, user.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "bearer",
+	}, nil
+}
+
+func (s *authServer) RefreshToken(ctx context.Context, req *emptypb.Empty) (*pb.Token, error) {
+	accessToken, refreshToken, err := s.usersUC.Refresh(ctx, middleware.TokenFromMetadata(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "bearer",
+	}, nil
+}
+
+func (s *authServer) Logout(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	err := s.usersUC.Logout(ctx, middleware.TokenFromMetadata(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *authServer) LogoutAll(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	err := s.usersUC.LogoutAllWithToken(ctx, middleware.TokenFromMetadata(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *authServer) GetPublicKey(ctx context.Context, req *emptypb.Empty) (*pb.PublicKey, error) {
+	publicKeyAccessToken, err := jwt.DecodeBase64(s.cfg.Jwt.AccessTokenPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyRefreshToken, err := jwt.DecodeBase64(s.cfg.Jwt.RefreshTokenPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PublicKey{
+		PublicKeyAccessToken:  string(publicKeyAccessToken),
+		PublicKeyRefreshToken: string(publicKeyRefreshToken),
+	}, nil
+}
+
+func (s *authServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*emptypb.Empty, error) {
+	err := s.usersUC.Verify(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *authServer) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*emptypb.Empty, error) {
+	forgotPassword := &presenter.ForgotPassword{Email: req.GetEmail()}
+
+	err := utils.ValidateStruct(ctx, forgotPassword)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	err = s.usersUC.ForgotPassword(ctx, forgotPassword.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *authServer) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	resetPassword := &presenter.ResetPassword{
+		NewPassword:     req.GetNewPassword(),
+		ConfirmPassword: req.GetConfirmPassword(),
+	}
+
+	err := utils.ValidateStruct(ctx, resetPassword)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	err = s.usersUC.ResetPassword(ctx, req.GetCode(), resetPassword.NewPassword, resetPassword.ConfirmPassword)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
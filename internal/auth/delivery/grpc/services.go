@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"google.golang.org/grpc"
+)
+
+func MapAuthService(server *grpc.Server, s pb.AuthServiceServer, access map[string]middleware.GrpcAccess) {
+	pb.RegisterAuthServiceServer(server, s)
+
+	// Public methods
+	access[pb.AuthService_SignIn_FullMethodName] = middleware.GrpcPublic
+	access[pb.AuthService_GetPublicKey_FullMethodName] = middleware.GrpcPublic
+	access[pb.AuthService_VerifyEmail_FullMethodName] = middleware.GrpcPublic
+	access[pb.AuthService_ForgotPassword_FullMethodName] = middleware.GrpcPublic
+	access[pb.AuthService_ResetPassword_FullMethodName] = middleware.GrpcPublic
+	// Refresh token methods
+	access[pb.AuthService_RefreshToken_FullMethodName] = middleware.GrpcRefreshToken
+	access[pb.AuthService_Logout_FullMethodName] = middleware.GrpcRefreshToken
+	access[pb.AuthService_LogoutAll_FullMethodName] = middleware.GrpcRefreshToken
+}
@@ -21,6 +21,17 @@ func NewRedisClient(cfg *config.Config) *asynq.Client {
 	return asynq.NewClient(redisOpt)
 }
 
+// NewRedisInspector returns an inspector of the task queues, it is used to
+// delete scheduled tasks.
+func NewRedisInspector(cfg *config.Config) *asynq.Inspector {
+	redisOpt := asynq.RedisClientOpt{
+		Addr: cfg.TaskRedis.Addr,
+		DB:   cfg.TaskRedis.Db,
+	}
+
+	return asynq.NewInspector(redisOpt)
+}
+
 func NewRedisTaskDistributor(redisClient *asynq.Client, cfg *config.Config, loggger logger.Logger) RedisTaskDistributor {
 	return RedisTaskDistributor{
 		RedisClient: redisClient,
@@ -0,0 +1,101 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.36
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql/generated"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+// ID is the resolver for the id field.
+func (r *itemResolver) ID(ctx context.Context, obj *ent.Item) (string, error) {
+	return marshalId(itemKind, obj.ID), nil
+}
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (ent.Noder, error) {
+	kind, value, err := parseId(id)
+	if err != nil {
+		return nil, err
+	}
+	table, ok := nodeTables[kind]
+	if !ok {
+		return nil, nil
+	}
+
+	node, err := r.client.Noder(ctx, value, ent.WithFixedNodeType(table))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return node, err
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, error) {
+	nodes := make([]ent.Noder, len(ids))
+	for i, id := range ids {
+		node, err := r.Node(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// Items is the resolver for the items field.
+func (r *queryResolver) Items(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.ItemOrder) (*ent.ItemConnection, error) {
+	return r.client.Item.Query().
+		Paginate(ctx, after, first, before, last, ent.WithItemOrder(orderBy))
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.UserOrder) (*ent.UserConnection, error) {
+	// Like GET /user, listing the users is for super users only.
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsSuperUser {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only super users can list the users"))
+	}
+
+	return r.client.User.Query().
+		Paginate(ctx, after, first, before, last, ent.WithUserOrder(orderBy))
+}
+
+// ID is the resolver for the id field.
+func (r *userResolver) ID(ctx context.Context, obj *ent.User) (string, error) {
+	return marshalId(userKind, obj.ID), nil
+}
+
+// Item returns generated.ItemResolver implementation.
+func (r *Resolver) Item() generated.ItemResolver { return &itemResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
+type itemResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
+
+// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have
+// one last chance to move it out of harms way if you want. There are two reasons this happens:
+//   - When renaming or deleting a resolver the old code will be put in here. You can safely delete
+//     it when you're done.
+//   - You have helper methods in this file. Move them out to keep these resolver files clean.
+func (r *userResolver) PlanX(ctx context.Context, obj *ent.User) (*string, error) {
+	panic(fmt.Errorf("not implemented: PlanX - planX"))
+}
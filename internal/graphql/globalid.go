@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+// The ids of the nodes are global: the base64 of the type name and the
+// database id, like "User:1". The database ids are only unique per table.
+const (
+	userKind = "User"
+	itemKind = "Item"
+)
+
+// nodeTables are the tables of the types of the nodes.
+var nodeTables = map[string]string{
+	userKind: user.Table,
+	itemKind: item.Table,
+}
+
+func marshalId(kind string, id uint) string {
+	return base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", kind, id)))
+}
+
+// parseId returns the type name and the database id of a global id.
+func parseId(id string) (string, uint, error) {
+	invalid := httpErrors.ErrValidation(fmt.Errorf("invalid id %q", id))
+
+	decoded, err := base64.URLEncoding.DecodeString(id)
+	if err != nil {
+		return "", 0, invalid
+	}
+	kind, value, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", 0, invalid
+	}
+	parsed, err := strconv.ParseUint(value, 10, 0)
+	if err != nil {
+		return "", 0, invalid
+	}
+	return kind, uint(parsed), nil
+}
+
+// unmarshalId returns the database id of a global id of the given type.
+func unmarshalId(id string, kind string) (uint, error) {
+	idKind, value, err := parseId(id)
+	if err != nil {
+		return 0, err
+	}
+	if idKind != kind {
+		return 0, httpErrors.ErrValidation(fmt.Errorf("invalid %s id %q", kind, id))
+	}
+	return value, nil
+}
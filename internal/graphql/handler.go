@@ -0,0 +1,120 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql/generated"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxRequestSize is the largest body of a GraphQL request.
+const maxRequestSize = 1 << 20
+
+type Handlers interface {
+	Query() func(w http.ResponseWriter, r *http.Request)
+}
+
+type graphqlHandler struct {
+	server *handler.Server
+}
+
+func CreateGraphqlHandler(
+	client *ent.Client,
+	usersUC users.UserUseCase,
+	itemsUC items.ItemUseCase,
+	cfg *config.Config,
+	logger logger.Logger,
+) (Handlers, error) {
+	if cfg.Graphql.MaxDepth < 1 || cfg.Graphql.MaxComplexity < 1 {
+		return nil, fmt.Errorf("graphql max depth and max complexity must be at least 1, got %d and %d", cfg.Graphql.MaxDepth, cfg.Graphql.MaxComplexity)
+	}
+
+	schemaConfig := generated.Config{Resolvers: CreateResolver(client, usersUC, itemsUC, cfg, logger)}
+	schemaConfig.Complexity.Query.Users = func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.UserOrder) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	schemaConfig.Complexity.Query.Items = func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.ItemOrder) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	schemaConfig.Complexity.User.Items = func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.ItemOrder) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	server := handler.New(generated.NewExecutableSchema(schemaConfig))
+	server.AddTransport(transport.POST{})
+	server.SetQueryCache(lru.New(1000))
+	if cfg.Graphql.Introspection {
+		server.Use(extension.Introspection{})
+	}
+	server.Use(limits{maxDepth: cfg.Graphql.MaxDepth})
+	server.Use(extension.FixedComplexityLimit(cfg.Graphql.MaxComplexity))
+	server.SetErrorPresenter(presentError)
+	server.SetRecoverFunc(func(ctx context.Context, value interface{}) error {
+		// The panics are answered with an error of the field like
+		// middleware.Recoverer answers with a 500, their value is hidden.
+		logger.Errorf("panic in graphql resolver: %v\n%s", value, debug.Stack())
+		return gqlerror.Errorf("internal server error")
+	})
+
+	return &graphqlHandler{server: server}, nil
+}
+
+// request is the body of a GraphQL request.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query godoc
+// @Summary Run a GraphQL query
+// @Description Run a query or a mutation of the GraphQL schema, see internal/graphql/ent.graphql and internal/graphql/schema.graphql. Queries deeper than MaxDepth or costing more than MaxComplexity of the graphql config are rejected before they run, so are the connections without first or last.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body request true "GraphQL request"
+// @Success 200 {object} graphql.Response
+// @Failure 422 {object} graphql.Response
+// @Security OAuth2Password
+// @Router /graphql [post]
+func (h *graphqlHandler) Query() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+		h.server.ServeHTTP(w, r)
+	}
+}
+
+// presentError converts the errors of the resolvers like the REST responses
+// do, the status is in the extensions. The errors of the GraphQL server, e.g.
+// of the validation of the query, are left as they are.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if gqlErr.Unwrap() == nil {
+		return gqlErr
+	}
+
+	restErr := httpErrors.ParseErrors(gqlErr.Unwrap())
+	gqlErr.Message = restErr.GetMsg()
+	gqlErr.Extensions = map[string]interface{}{
+		"status":     restErr.GetStatus(),
+		"statusText": restErr.GetStatusText(),
+	}
+	if usage := restErr.GetUsage(); usage != nil {
+		gqlErr.Extensions["usage"] = usage
+	}
+	return gqlErr
+}
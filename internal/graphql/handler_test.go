@@ -0,0 +1,350 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	_ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// The use cases below write with the ent client, the methods the mutations
+// do not call are left to the embedded nil interfaces.
+
+type userUseCase struct {
+	users.UserUseCase
+	client *ent.Client
+}
+
+func (u *userUseCase) Update(ctx context.Context, id uint, obj_update *models.UserUpdate) (*models.User, error) {
+	user := u.client.User.GetX(ctx, id)
+	if obj_update.Version != nil && *obj_update.Version != user.Version {
+		return nil, httpErrors.ErrPreconditionFailed(errors.New("version mismatch"))
+	}
+	user = user.Update().SetName(*obj_update.Name).SaveX(ctx)
+	return &models.User{Id: user.ID, Name: user.Name, Version: user.Version}, nil
+}
+
+type itemUseCase struct {
+	items.ItemUseCase
+	client *ent.Client
+}
+
+func (u *itemUseCase) CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.ItemCreate) (*models.Item, error) {
+	item := u.client.Item.Create().
+		SetTitle(obj_create.Title).
+		SetDescription(obj_create.Description).
+		SetMetadata(obj_create.Metadata).
+		SetOwnerID(ownerId).
+		SaveX(ctx)
+	return &models.Item{Id: item.ID}, nil
+}
+
+type fixture struct {
+	handler http.HandlerFunc
+	user    *models.User
+	admin   *models.User
+}
+
+// setup creates a user owning 5 items and a super user owning 1 item.
+func setup(t *testing.T) *fixture {
+	t.Helper()
+
+	client := enttest.Open(t, dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	ctx := viewer.NewSystemContext(context.Background())
+	f := &fixture{}
+	for _, isSuperUser := range []bool{false, true} {
+		name := "user"
+		if isSuperUser {
+			name = "admin"
+		}
+		user := client.User.Create().
+			SetName(name).
+			SetEmail(name + "@example.com").
+			SetPassword("password").
+			SetIsSuperUser(isSuperUser).
+			SaveX(ctx)
+
+		model := &models.User{Id: user.ID, Name: user.Name, IsActive: true, IsSuperUser: isSuperUser, Version: user.Version}
+		if isSuperUser {
+			f.admin = model
+		} else {
+			f.user = model
+		}
+	}
+	for i := 1; i <= 5; i++ {
+		client.Item.Create().SetTitle(fmt.Sprintf("item %d", i)).SetDescription("").SetOwnerID(f.user.Id).SaveX(ctx)
+	}
+	client.Item.Create().SetTitle("admin item").SetDescription("").SetOwnerID(f.admin.Id).SaveX(ctx)
+
+	cfg := &config.Config{Graphql: config.GraphqlConfig{MaxDepth: 6, MaxComplexity: 100, Introspection: true}}
+	cfg.Logger.Level = "fatal"
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	h, err := graphql.CreateGraphqlHandler(client, &userUseCase{client: client}, &itemUseCase{client: client}, cfg, apiLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.handler = h.Query()
+	return f
+}
+
+func globalId(kind string, id uint) string {
+	return base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", kind, id)))
+}
+
+type response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (f *fixture) do(t *testing.T, user *models.User, query string, variables map[string]interface{}) *response {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	r.Header.Set("Content-Type", "application/json")
+	ctx := context.WithValue(r.Context(), middleware.UserCtxKey, user)
+	r = r.WithContext(viewer.NewUserContext(ctx, user))
+	w := httptest.NewRecorder()
+	f.handler(w, r)
+
+	res := &response{}
+	if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+		t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+	}
+	return res
+}
+
+func (res *response) errorMessage() string {
+	if len(res.Errors) == 0 {
+		return ""
+	}
+	return res.Errors[0].Message
+}
+
+func TestMe(t *testing.T) {
+	f := setup(t)
+
+	res := f.do(t, f.user, `{ me { id name } }`, nil)
+	if len(res.Errors) > 0 {
+		t.Fatal(res.errorMessage())
+	}
+	me := res.Data["me"].(map[string]interface{})
+	if me["name"] != "user" || me["id"] != globalId("User", f.user.Id) {
+		t.Fatalf("got %v", me)
+	}
+}
+
+// TestItemsConnection walks the items the user can see, the item of the
+// super user is filtered out by the privacy policy of the items.
+func TestItemsConnection(t *testing.T) {
+	f := setup(t)
+
+	query := `query($after: Cursor) {
+		items(first: 2, after: $after) {
+			edges { cursor node { title owner { name } } }
+			pageInfo { hasNextPage hasPreviousPage endCursor }
+		}
+	}`
+
+	var titles []string
+	var after interface{}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+
+		res := f.do(t, f.user, query, map[string]interface{}{"after": after})
+		if len(res.Errors) > 0 {
+			t.Fatal(res.errorMessage())
+		}
+
+		connection := res.Data["items"].(map[string]interface{})
+		for _, edge := range connection["edges"].([]interface{}) {
+			node := edge.(map[string]interface{})["node"].(map[string]interface{})
+			if owner := node["owner"].(map[string]interface{}); owner["name"] != "user" {
+				t.Fatalf("got owner %v", owner)
+			}
+			titles = append(titles, node["title"].(string))
+		}
+
+		pageInfo := connection["pageInfo"].(map[string]interface{})
+		if pageInfo["hasNextPage"] != true {
+			break
+		}
+		after = pageInfo["endCursor"]
+	}
+
+	if got := strings.Join(titles, ","); got != "item 1,item 2,item 3,item 4,item 5" {
+		t.Fatalf("got %s", got)
+	}
+}
+
+func TestUserItems(t *testing.T) {
+	f := setup(t)
+
+	res := f.do(t, f.admin, `{ users(first: 2, orderBy: {field: NAME}) {
+		edges { node { name items(first: 2) { totalCount } } }
+	} }`, nil)
+	if len(res.Errors) > 0 {
+		t.Fatal(res.errorMessage())
+	}
+
+	var got []string
+	for _, edge := range res.Data["users"].(map[string]interface{})["edges"].([]interface{}) {
+		node := edge.(map[string]interface{})["node"].(map[string]interface{})
+		got = append(got, fmt.Sprintf("%s:%v", node["name"], node["items"].(map[string]interface{})["totalCount"]))
+	}
+	if strings.Join(got, ",") != "admin:1,user:5" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestUsersForSuperUsersOnly(t *testing.T) {
+	f := setup(t)
+
+	res := f.do(t, f.user, `{ users(first: 10) { edges { cursor } } }`, nil)
+	if len(res.Errors) != 1 || res.Errors[0].Extensions["status"] != float64(http.StatusForbidden) {
+		t.Fatalf("got %+v, want a forbidden error", res.Errors)
+	}
+}
+
+func TestNode(t *testing.T) {
+	f := setup(t)
+
+	res := f.do(t, f.user, `query($id: ID!) { node(id: $id) { id ... on Item { title } } }`, map[string]interface{}{
+		"id": globalId("Item", 3),
+	})
+	if len(res.Errors) > 0 {
+		t.Fatal(res.errorMessage())
+	}
+	if node := res.Data["node"].(map[string]interface{}); node["title"] != "item 3" {
+		t.Fatalf("got %v", node)
+	}
+
+	// The item of the super user is not visible to the user.
+	for _, id := range []uint{6, 42} {
+		res = f.do(t, f.user, `query($id: ID!) { item(id: $id) { id } }`, map[string]interface{}{
+			"id": globalId("Item", id),
+		})
+		if len(res.Errors) > 0 || res.Data["item"] != nil {
+			t.Fatalf("got %+v, want a null item", res)
+		}
+	}
+
+	res = f.do(t, f.user, `query($id: ID!) { item(id: $id) { id } }`, map[string]interface{}{
+		"id": globalId("User", f.user.Id),
+	})
+	if len(res.Errors) != 1 || res.Errors[0].Extensions["status"] != float64(http.StatusUnprocessableEntity) {
+		t.Fatalf("got %+v, want a validation error", res.Errors)
+	}
+}
+
+func TestMutations(t *testing.T) {
+	f := setup(t)
+
+	res := f.do(t, f.user, `mutation { createItem(input: {title: "new", metadata: {a: 1}}) { title status metadata owner { name } } }`, nil)
+	if len(res.Errors) > 0 {
+		t.Fatal(res.errorMessage())
+	}
+	item := res.Data["createItem"].(map[string]interface{})
+	if item["title"] != "new" || item["status"] != "draft" || item["metadata"].(map[string]interface{})["a"] != float64(1) {
+		t.Fatalf("got %v", item)
+	}
+
+	res = f.do(t, f.user, `mutation { createItem(input: {title: ""}) { id } }`, nil)
+	if len(res.Errors) != 1 || res.Errors[0].Extensions["status"] != float64(http.StatusBadRequest) {
+		t.Fatalf("got %+v, want the validation of the presenter", res.Errors)
+	}
+
+	res = f.do(t, f.user, `mutation { updateMe(input: {name: "renamed", version: 1}) { name version } }`, nil)
+	if len(res.Errors) > 0 {
+		t.Fatal(res.errorMessage())
+	}
+	if me := res.Data["updateMe"].(map[string]interface{}); me["name"] != "renamed" || me["version"] != float64(2) {
+		t.Fatalf("got %v", me)
+	}
+
+	res = f.do(t, f.user, `mutation { updateMe(input: {name: "again", version: 1}) { name } }`, nil)
+	if len(res.Errors) != 1 || res.Errors[0].Extensions["status"] != float64(http.StatusPreconditionFailed) {
+		t.Fatalf("got %+v, want a precondition failed error", res.Errors)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	f := setup(t)
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "complexity",
+			query: `{ items(first: 50) { edges { node { id } } } }`,
+			want:  "complexity",
+		},
+		{
+			name:  "depth",
+			query: `{ me { items(first: 1) { edges { node { owner { items(first: 1) { edges { node { id } } } } } } } } }`,
+			want:  "depth",
+		},
+		{
+			name:  "missing page size",
+			query: `{ items { totalCount } }`,
+			want:  "first or last is required",
+		},
+		{
+			name:  "nested missing page size",
+			query: `query { me { ...items } } fragment items on User { items { totalCount } }`,
+			want:  "first or last is required",
+		},
+		{
+			name:  "page size too large",
+			query: `{ items(last: 1000) { totalCount } }`,
+			want:  "can not exceed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.do(t, f.user, tt.query, nil)
+			if !strings.Contains(res.errorMessage(), tt.want) {
+				t.Fatalf("got %+v, want %q", res.Errors, tt.want)
+			}
+			if res.Data != nil {
+				t.Fatalf("got data %v, the query ran", res.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxCost bounds the computed cost so that large pages of nested connections
+// can not overflow.
+const maxCost = math.MaxInt32
+
+// limits rejects the operations deeper than maxDepth and the connections
+// without a page size of 1 to listQuery.MaxLimit, before they run. ent loads
+// the whole connection when its page size is missing.
+type limits struct {
+	maxDepth int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = limits{}
+
+func (limits) ExtensionName() string {
+	return "Limits"
+}
+
+func (limits) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (l limits) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	depth, err := l.selectionSet(rc.Operation.SelectionSet, rc.Variables, map[string]bool{})
+	if err != nil {
+		return err
+	}
+	if depth > l.maxDepth {
+		return gqlerror.Errorf("query depth %d exceeds the limit of %d", depth, l.maxDepth)
+	}
+	return nil
+}
+
+// selectionSet checks the fields of a selection set and returns its depth.
+// The schema queries are left to the introspection config.
+func (l limits) selectionSet(selections ast.SelectionSet, variables map[string]interface{}, visiting map[string]bool) (int, *gqlerror.Error) {
+	depth := 0
+	for _, selection := range selections {
+		var children int
+		var err *gqlerror.Error
+
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+			if isConnection(sel.Definition) {
+				if err := checkPageSize(sel, variables); err != nil {
+					return 0, err
+				}
+			}
+			children, err = l.selectionSet(sel.SelectionSet, variables, visiting)
+			children++
+		case *ast.InlineFragment:
+			children, err = l.selectionSet(sel.SelectionSet, variables, visiting)
+		case *ast.FragmentSpread:
+			// Validation rejects the cycles of fragments, the guard only
+			// keeps a bad query from looping.
+			if visiting[sel.Name] {
+				continue
+			}
+			visiting[sel.Name] = true
+			children, err = l.selectionSet(sel.Definition.SelectionSet, variables, visiting)
+			delete(visiting, sel.Name)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if children > depth {
+			depth = children
+		}
+	}
+	return depth, nil
+}
+
+// isConnection reports whether a field returns a Relay connection.
+func isConnection(definition *ast.FieldDefinition) bool {
+	return definition != nil && strings.HasSuffix(definition.Type.Name(), "Connection")
+}
+
+func checkPageSize(field *ast.Field, variables map[string]interface{}) *gqlerror.Error {
+	args := field.ArgumentMap(variables)
+	first, hasFirst := intArgument(args["first"])
+	last, hasLast := intArgument(args["last"])
+	if !hasFirst && !hasLast {
+		return gqlerror.ErrorPosf(field.Position, "%s: first or last is required", field.Alias)
+	}
+	if first > listQuery.MaxLimit || last > listQuery.MaxLimit {
+		return gqlerror.ErrorPosf(field.Position, "%s: first and last can not exceed %d", field.Alias, listQuery.MaxLimit)
+	}
+	return nil
+}
+
+// intArgument reads an Int argument, the variables are decoded as numbers.
+func intArgument(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// connectionComplexity is the cost of a connection: its children cost once
+// per node of its page.
+func connectionComplexity(childComplexity int, first *int, last *int) int {
+	size := 0
+	switch {
+	case first != nil:
+		size = *first
+	case last != nil:
+		size = *last
+	}
+	return addCost(1, mulCost(childComplexity, size))
+}
+
+func addCost(a, b int) int {
+	if a > maxCost-b {
+		return maxCost
+	}
+	return a + b
+}
+
+func mulCost(a, b int) int {
+	if b > 0 && a > maxCost/b {
+		return maxCost
+	}
+	return a * b
+}
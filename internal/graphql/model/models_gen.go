@@ -0,0 +1,39 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+type CreateItemInput struct {
+	Title       string                 `json:"title"`
+	Description *string                `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	DueAt       *time.Time             `json:"dueAt,omitempty"`
+	ParentID    *string                `json:"parentId,omitempty"`
+}
+
+type CreateUserInput struct {
+	Name            string `json:"name"`
+	Email           string `json:"email"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirmPassword"`
+}
+
+type UpdateItemInput struct {
+	ID          string                 `json:"id"`
+	Title       *string                `json:"title,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	DueAt       *time.Time             `json:"dueAt,omitempty"`
+	ClearDueAt  *bool                  `json:"clearDueAt,omitempty"`
+	// The version the item is expected to have.
+	Version *int `json:"version,omitempty"`
+}
+
+type UpdateMeInput struct {
+	Name string `json:"name"`
+	// The version the user is expected to have.
+	Version *int `json:"version,omitempty"`
+}
@@ -0,0 +1,27 @@
+package graphql
+
+import (
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+//go:generate go run -mod=mod github.com/99designs/gqlgen
+
+// Resolver is the root resolver of the schema. The queries read the users and
+// the items with the ent client, under its privacy policies, and the
+// mutations call the use cases like the REST handlers do, so validation and
+// side effects are the same.
+type Resolver struct {
+	client  *ent.Client
+	usersUC users.UserUseCase
+	itemsUC items.ItemUseCase
+	cfg     *config.Config
+	logger  logger.Logger
+}
+
+func CreateResolver(client *ent.Client, usersUC users.UserUseCase, itemsUC items.ItemUseCase, cfg *config.Config, logger logger.Logger) *Resolver {
+	return &Resolver{client: client, usersUC: usersUC, itemsUC: itemsUC, cfg: cfg, logger: logger}
+}
@@ -0,0 +1,19 @@
+package graphql
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+)
+
+func MapGraphqlRoute(router *chi.Mux, h Handlers, mw *middleware.MiddlewareManager) {
+	// GraphQL routes
+	router.Route("/graphql", func(r chi.Router) {
+		// Protected routes, the same token as the REST api
+		r.Use(mw.Verifier(true))
+		r.Use(mw.Authenticator())
+		r.Use(mw.CurrentUser())
+		r.Use(mw.ActiveUser())
+		r.Use(mw.CountApiCall())
+		r.Post("/", h.Query())
+	})
+}
@@ -0,0 +1,193 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.36
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/schema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql/generated"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql/model"
+	itemPresenter "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	userPresenter "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+)
+
+// CreateUser is the resolver for the createUser field.
+func (r *mutationResolver) CreateUser(ctx context.Context, input model.CreateUserInput) (*ent.User, error) {
+	// Like POST /user, creating users is for super users only.
+	current, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !current.IsSuperUser {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only super users can create users"))
+	}
+
+	user := &userPresenter.UserCreate{
+		Name:            input.Name,
+		Email:           input.Email,
+		Password:        input.Password,
+		ConfirmPassword: input.ConfirmPassword,
+	}
+	if err := utils.ValidateStruct(ctx, user); err != nil {
+		return nil, err
+	}
+
+	newUser, err := r.usersUC.Create(
+		ctx,
+		&models.UserCreate{
+			Name:     user.Name,
+			Email:    user.Email,
+			Password: user.Password,
+		},
+		user.ConfirmPassword,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.User.Get(ctx, newUser.Id)
+}
+
+// UpdateMe is the resolver for the updateMe field.
+func (r *mutationResolver) UpdateMe(ctx context.Context, input model.UpdateMeInput) (*ent.User, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := r.usersUC.Update(ctx, user.Id, &models.UserUpdate{
+		Name:    &input.Name,
+		Version: input.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.client.User.Get(ctx, updatedUser.Id)
+}
+
+// CreateItem is the resolver for the createItem field.
+func (r *mutationResolver) CreateItem(ctx context.Context, input model.CreateItemInput) (*ent.Item, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &itemPresenter.ItemCreate{
+		Title:    input.Title,
+		Metadata: input.Metadata,
+		DueAt:    input.DueAt,
+	}
+	if input.Description != nil {
+		item.Description = *input.Description
+	}
+	if input.ParentID != nil {
+		parentId, err := unmarshalId(*input.ParentID, itemKind)
+		if err != nil {
+			return nil, err
+		}
+		item.ParentId = &parentId
+	}
+	if err := utils.ValidateStruct(ctx, item); err != nil {
+		return nil, err
+	}
+
+	newItem, err := r.itemsUC.CreateWithOwner(ctx, user.Id, &models.ItemCreate{
+		Title:       item.Title,
+		Description: item.Description,
+		Metadata:    item.Metadata,
+		DueAt:       item.DueAt,
+		ParentId:    item.ParentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Item.Get(ctx, newItem.Id)
+}
+
+// UpdateItem is the resolver for the updateItem field.
+func (r *mutationResolver) UpdateItem(ctx context.Context, input model.UpdateItemInput) (*ent.Item, error) {
+	id, err := unmarshalId(input.ID, itemKind)
+	if err != nil {
+		return nil, err
+	}
+	if input.Title != nil && *input.Title == "" {
+		return nil, httpErrors.ErrValidation(errors.New("title can not be empty"))
+	}
+
+	updatedItem, err := r.itemsUC.Update(ctx, id, &models.ItemUpdate{
+		Title:       input.Title,
+		Description: input.Description,
+		Metadata:    input.Metadata,
+		DueAt:       input.DueAt,
+		ClearDueAt:  input.ClearDueAt != nil && *input.ClearDueAt,
+		Version:     input.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Item.Get(ctx, updatedItem.Id)
+}
+
+// DeleteItem is the resolver for the deleteItem field.
+func (r *mutationResolver) DeleteItem(ctx context.Context, id string) (*ent.Item, error) {
+	itemId, err := unmarshalId(id, itemKind)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.itemsUC.Delete(ctx, itemId); err != nil {
+		return nil, err
+	}
+	// The item is in the trash now.
+	return r.client.Item.Get(schema.SkipSoftDelete(ctx), itemId)
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.User.Get(ctx, user.Id)
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*ent.User, error) {
+	userId, err := unmarshalId(id, userKind)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.client.User.Get(ctx, userId)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+// Item is the resolver for the item field.
+func (r *queryResolver) Item(ctx context.Context, id string) (*ent.Item, error) {
+	itemId, err := unmarshalId(id, itemKind)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.client.Item.Get(ctx, itemId)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return item, err
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
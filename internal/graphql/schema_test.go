@@ -0,0 +1,69 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql/generated"
+)
+
+// TestSchemaFields fails when a field of the ent schema is missing from the
+// GraphQL types, e.g. when ent.graphql was not generated again. The hidden
+// fields are skipped with entgql annotations in the ent schema.
+func TestSchemaFields(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+
+	for _, tt := range []struct {
+		typ     string
+		columns []string
+		hidden  []string
+	}{
+		{
+			typ:     "User",
+			columns: user.Columns,
+			hidden: []string{
+				user.FieldDeleteTime,
+				user.FieldPassword,
+				user.FieldVerificationCode,
+				user.FieldPasswordResetToken,
+				user.FieldPasswordResetAt,
+				user.FieldCalendarToken,
+			},
+		},
+		{
+			typ:     "Item",
+			columns: item.Columns,
+			hidden: []string{
+				item.FieldDeleteTime,
+				// The owner and the parent are edges of the type.
+				item.FieldOwnerID,
+				item.FieldParentID,
+			},
+		},
+	} {
+		definition := schema.Types[tt.typ]
+		if definition == nil {
+			t.Fatalf("type %s is missing", tt.typ)
+		}
+
+		hidden := map[string]bool{}
+		for _, column := range tt.hidden {
+			hidden[column] = true
+		}
+		for _, column := range tt.columns {
+			if !hidden[column] && definition.Fields.ForName(lowerCamel(column)) == nil {
+				t.Errorf("field %s of %s is missing from the GraphQL schema", column, tt.typ)
+			}
+		}
+	}
+}
+
+func lowerCamel(column string) string {
+	words := strings.Split(column, "_")
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+	return strings.Join(words, "")
+}
@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+type IdempotencyRedisRepository interface {
+	// Lock saves obj_in unless key already has a record, it returns whether
+	// obj_in was saved.
+	Lock(ctx context.Context, key string, obj_in *models.IdempotencyRecord, expiration time.Duration) (bool, error)
+	Get(ctx context.Context, key string) (*models.IdempotencyRecord, error)
+	Update(ctx context.Context, key string, obj_in *models.IdempotencyRecord, expiration time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
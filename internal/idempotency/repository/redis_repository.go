@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/redis/go-redis/v9"
+)
+
+type IdempotencyRedisRepo struct {
+	redisClient *redis.Client
+}
+
+func CreateIdempotencyRedisRepository(redisClient *redis.Client) idempotency.IdempotencyRedisRepository {
+	return &IdempotencyRedisRepo{redisClient: redisClient}
+}
+
+func (r *IdempotencyRedisRepo) Lock(ctx context.Context, key string, obj_in *models.IdempotencyRecord, expiration time.Duration) (bool, error) {
+	objBytes, err := json.Marshal(obj_in)
+	if err != nil {
+		return false, httpErrors.ErrJson(err)
+	}
+
+	return r.redisClient.SetNX(ctx, key, objBytes, expiration).Result()
+}
+
+func (r *IdempotencyRedisRepo) Get(ctx context.Context, key string) (*models.IdempotencyRecord, error) {
+	objBytes, err := r.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var obj models.IdempotencyRecord
+
+	if err = json.Unmarshal(objBytes, &obj); err != nil {
+		return nil, httpErrors.ErrJson(err)
+	}
+
+	return &obj, nil
+}
+
+func (r *IdempotencyRedisRepo) Update(ctx context.Context, key string, obj_in *models.IdempotencyRecord, expiration time.Duration) error {
+	objBytes, err := json.Marshal(obj_in)
+	if err != nil {
+		return httpErrors.ErrJson(err)
+	}
+
+	return r.redisClient.Set(ctx, key, objBytes, expiration).Err()
+}
+
+func (r *IdempotencyRedisRepo) Delete(ctx context.Context, key string) error {
+	if err := r.redisClient.Del(ctx, key).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// The keys are per user, the same key of two users are two requests.
+type IdempotencyUseCase interface {
+	// Start starts the request of a user with key. It returns the response of
+	// the request when it is completed, an aborted error while it is running
+	// and an idempotency_key_reused error when the key was used for a request
+	// with another fingerprint.
+	Start(ctx context.Context, userId uint, key string, fingerprint string) (*models.IdempotentResponse, error)
+	// Complete saves the response of the started request with key.
+	Complete(ctx context.Context, userId uint, key string, fingerprint string, response *models.IdempotentResponse) error
+	// Cancel forgets the started request with key, so it runs again on retry.
+	Cancel(ctx context.Context, userId uint, key string) error
+}
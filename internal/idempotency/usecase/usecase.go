@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// A started request holds its key for LockTtl seconds, a completed request
+// for Ttl seconds.
+type idempotencyUseCase struct {
+	redisRepo idempotency.IdempotencyRedisRepository
+	cfg       *config.Config
+	logger    logger.Logger
+}
+
+func CreateIdempotencyUseCase(
+	redisRepo idempotency.IdempotencyRedisRepository,
+	cfg *config.Config,
+	logger logger.Logger,
+) idempotency.IdempotencyUseCase {
+	return &idempotencyUseCase{
+		redisRepo: redisRepo,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+func (u *idempotencyUseCase) generateRedisIdempotencyKey(userId uint, key string) string {
+	return fmt.Sprintf("Idempotency:%v:%v", userId, key)
+}
+
+func (u *idempotencyUseCase) Start(ctx context.Context, userId uint, key string, fingerprint string) (*models.IdempotentResponse, error) {
+	redisKey := u.generateRedisIdempotencyKey(userId, key)
+
+	// The record can expire between Lock and Get, the lock is taken again then.
+	for i := 0; i < 2; i++ {
+		locked, err := u.redisRepo.Lock(
+			ctx,
+			redisKey,
+			&models.IdempotencyRecord{Fingerprint: fingerprint},
+			time.Second*time.Duration(u.cfg.Idempotency.LockTtl),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return nil, nil
+		}
+
+		record, err := u.redisRepo.Get(ctx, redisKey)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			continue
+		}
+
+		if record.Fingerprint != fingerprint {
+			return nil, httpErrors.ErrIdempotencyKeyReused(errors.New("idempotency key was used for another request"))
+		}
+		if record.Response == nil {
+			return nil, httpErrors.ErrAborted(errors.New("request with the idempotency key is still running"))
+		}
+		return record.Response, nil
+	}
+
+	return nil, httpErrors.ErrAborted(errors.New("request with the idempotency key is still running"))
+}
+
+func (u *idempotencyUseCase) Complete(ctx context.Context, userId uint, key string, fingerprint string, response *models.IdempotentResponse) error {
+	return u.redisRepo.Update(
+		ctx,
+		u.generateRedisIdempotencyKey(userId, key),
+		&models.IdempotencyRecord{Fingerprint: fingerprint, Response: response},
+		time.Second*time.Duration(u.cfg.Idempotency.Ttl),
+	)
+}
+
+func (u *idempotencyUseCase) Cancel(ctx context.Context, userId uint, key string) error {
+	return u.redisRepo.Delete(ctx, u.generateRedisIdempotencyKey(userId, key))
+}
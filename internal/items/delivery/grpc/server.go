@@ -0,0 +1,385 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type itemServer struct {
+	pb.UnimplementedItemServiceServer
+	cfg     *config.Config
+	itemsUC items.ItemUseCase
+	logger  logger.Logger
+}
+
+func CreateItemServer(uc items.ItemUseCase, cfg *config.Config, logger logger.Logger) pb.ItemServiceServer {
+	return &itemServer{cfg: cfg, itemsUC: uc, logger: logger}
+}
+
+func (s *itemServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.Item, error) {
+	item, err := s.itemsUC.Get(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(item)
+}
+
+func (s *itemServer) ListItems(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	query, err := parseListQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var page *listQuery.Page[*models.Item]
+	if user.IsSuperUser {
+		page, err = s.itemsUC.GetMulti(ctx, query)
+	} else {
+		page, err = s.itemsUC.GetMultiByOwnerId(ctx, user.Id, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPageResponse(page)
+}
+
+func (s *itemServer) CreateItem(ctx context.Context, req *pb.CreateItemRequest) (*pb.Item, error) {
+	item := &presenter.ItemCreate{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		DueAt:       mapTimeRequest(req.DueAt),
+	}
+	if req.Metadata != nil {
+		item.Metadata = req.Metadata.AsMap()
+	}
+	if req.ParentId != nil {
+		parentId := uint(req.GetParentId())
+		item.ParentId = &parentId
+	}
+
+	err := utils.ValidateStruct(ctx, item)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newItem, err := s.itemsUC.CreateWithOwner(
+		ctx,
+		user.Id,
+		&models.ItemCreate{
+			Title:       item.Title,
+			Description: item.Description,
+			Metadata:    item.Metadata,
+			DueAt:       item.DueAt,
+			ParentId:    item.ParentId,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapModelResponse(newItem)
+}
+
+func (s *itemServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.Item, error) {
+	if req.DueAt != nil && req.GetClearDueAt() {
+		return nil, httpErrors.ErrValidation(errors.New("due_at and clear_due_at can not be used together"))
+	}
+
+	item_update := models.ItemUpdate{
+		Title:       req.Title,
+		Description: req.Description,
+		DueAt:       mapTimeRequest(req.DueAt),
+		ClearDueAt:  req.GetClearDueAt(),
+	}
+	if req.Metadata != nil {
+		item_update.Metadata = req.Metadata.AsMap()
+	}
+	if req.Version != nil {
+		version := int(req.GetVersion())
+		item_update.Version = &version
+	}
+
+	// Items can not have an empty title, like new items.
+	if item_update.Title != nil && *item_update.Title == "" {
+		return nil, httpErrors.ErrValidation(errors.New("title can not be empty"))
+	}
+
+	updatedItem, err := s.itemsUC.Update(ctx, uint(req.GetId()), &item_update)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapModelResponse(updatedItem)
+}
+
+func (s *itemServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest) (*pb.Item, error) {
+	item, err := s.itemsUC.Delete(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(item)
+}
+
+func (s *itemServer) RestoreItem(ctx context.Context, req *pb.RestoreItemRequest) (*pb.Item, error) {
+	item, err := s.itemsUC.Restore(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(item)
+}
+
+func (s *itemServer) ListItemsTrash(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	query, err := parseListQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.itemsUC.GetMultiTrash(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPageResponse(page)
+}
+
+func (s *itemServer) ListSharedItems(ctx context.Context, req *pb.ListSharedItemsRequest) (*pb.ListSharedItemsResponse, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.itemsUC.GetMultiSharedWith(ctx, user.Id, int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := mapModelsResponse(items)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListSharedItemsResponse{Items: out}, nil
+}
+
+func (s *itemServer) SearchItems(ctx context.Context, req *pb.SearchItemsRequest) (*pb.SearchItemsResponse, error) {
+	results, err := s.itemsUC.Search(ctx, req.GetQ(), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.SearchItemsResponse{Results: make([]*pb.SearchItemsResult, len(results))}
+	for i, result := range results {
+		item, err := mapModelResponse(&result.Item)
+		if err != nil {
+			return nil, err
+		}
+		out.Results[i] = &pb.SearchItemsResult{
+			Item:                 item,
+			Rank:                 result.Rank,
+			TitleHighlight:       result.TitleHighlight,
+			DescriptionHighlight: result.DescriptionHighlight,
+		}
+	}
+	return out, nil
+}
+
+func (s *itemServer) ListShares(ctx context.Context, req *pb.ListSharesRequest) (*pb.ListSharesResponse, error) {
+	shares, err := s.itemsUC.GetShares(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.ListSharesResponse{Shares: make([]*pb.ItemShare, len(shares))}
+	for i, share := range shares {
+		out.Shares[i] = mapShareResponse(share)
+	}
+	return out, nil
+}
+
+func (s *itemServer) CreateShare(ctx context.Context, req *pb.CreateShareRequest) (*pb.ItemShare, error) {
+	share := &presenter.ItemShareCreate{
+		UserId:     uint(req.GetUserId()),
+		Permission: req.GetPermission(),
+	}
+
+	err := utils.ValidateStruct(ctx, share)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	newShare, err := s.itemsUC.CreateShare(ctx, uint(req.GetId()), &models.ItemShareCreate{
+		UserId:     share.UserId,
+		Permission: share.Permission,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapShareResponse(newShare), nil
+}
+
+func (s *itemServer) DeleteShare(ctx context.Context, req *pb.DeleteShareRequest) (*pb.ItemShare, error) {
+	share, err := s.itemsUC.DeleteShare(ctx, uint(req.GetId()), uint(req.GetUserId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapShareResponse(share), nil
+}
+
+func (s *itemServer) ListChildren(ctx context.Context, req *pb.ListChildrenRequest) (*pb.ListChildrenResponse, error) {
+	children, err := s.itemsUC.GetChildren(ctx, uint(req.GetId()), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := mapModelsResponse(children)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListChildrenResponse{Items: out}, nil
+}
+
+func (s *itemServer) MoveItem(ctx context.Context, req *pb.MoveItemRequest) (*pb.Item, error) {
+	obj_move := &models.ItemMove{}
+	if req.ParentId != nil {
+		parentId := uint(req.GetParentId())
+		obj_move.ParentId = &parentId
+	}
+
+	item, err := s.itemsUC.Move(ctx, uint(req.GetId()), obj_move)
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(item)
+}
+
+// parseListQuery parses the options of a list like the query parameters of
+// the REST item list.
+func parseListQuery(req *pb.ListItemsRequest) (*listQuery.Query, error) {
+	values := req.GetOptions().Values()
+	for _, tag := range req.GetTag() {
+		values.Add("tag", tag)
+	}
+	if req.GetTagMatch() != "" {
+		values.Set("tag_match", req.GetTagMatch())
+	}
+	return listQuery.Parse(values, append([]string{"tag", "tag_match"}, metadataParams(values)...)...)
+}
+
+// metadataParams returns the metadata filters of values, they are checked
+// against the metadata schemas by the use case.
+func metadataParams(values url.Values) []string {
+	var params []string
+	for key := range values {
+		if strings.HasPrefix(key, items.MetadataParamPrefix) {
+			params = append(params, key)
+		}
+	}
+	return params
+}
+
+func mapTimeRequest(exp *timestamppb.Timestamp) *time.Time {
+	if exp == nil {
+		return nil
+	}
+	out := exp.AsTime()
+	return &out
+}
+
+func mapTime(exp *time.Time) *timestamppb.Timestamp {
+	if exp == nil {
+		return nil
+	}
+	return timestamppb.New(*exp)
+}
+
+func mapModelResponse(exp *models.Item) (*pb.Item, error) {
+	out := &pb.Item{
+		Id:          uint64(exp.Id),
+		Title:       exp.Title,
+		Description: exp.Description,
+		OwnerId:     uint64(exp.OwnerId),
+		Status:      exp.Status,
+		DueAt:       mapTime(exp.DueAt),
+		DeleteTime:  mapTime(exp.DeleteTime),
+		Version:     int32(exp.Version),
+		Tags:        make([]*pb.Tag, len(exp.Tags)),
+	}
+	if exp.Metadata != nil {
+		metadata, err := structpb.NewStruct(exp.Metadata)
+		if err != nil {
+			return nil, httpErrors.ErrInternalServer(err)
+		}
+		out.Metadata = metadata
+	}
+	if exp.ParentId != nil {
+		parentId := uint64(*exp.ParentId)
+		out.ParentId = &parentId
+	}
+	for i, tag := range exp.Tags {
+		out.Tags[i] = &pb.Tag{
+			Id:    uint64(tag.Id),
+			Name:  tag.Name,
+			Color: tag.Color,
+		}
+	}
+	return out, nil
+}
+
+func mapModelsResponse(exp []*models.Item) ([]*pb.Item, error) {
+	out := make([]*pb.Item, len(exp))
+	for i, item := range exp {
+		var err error
+		out[i], err = mapModelResponse(item)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func mapPageResponse(exp *listQuery.Page[*models.Item]) (*pb.ListItemsResponse, error) {
+	out, err := mapModelsResponse(exp.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListItemsResponse{
+		Items: out,
+		Page:  pb.CreatePageInfo(exp.NextCursor, exp.PrevCursor, exp.Total),
+	}, nil
+}
+
+func mapShareResponse(exp *models.ItemShare) *pb.ItemShare {
+	return &pb.ItemShare{
+		ItemId:     uint64(exp.ItemId),
+		UserId:     uint64(exp.UserId),
+		Permission: exp.Permission,
+		CreateTime: timestamppb.New(exp.CreateTime),
+		UpdateTime: timestamppb.New(exp.UpdateTime),
+	}
+}
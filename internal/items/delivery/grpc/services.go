@@ -0,0 +1,14 @@
+package grpc
+
+import (
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"google.golang.org/grpc"
+)
+
+// MapItemService registers the item service, its methods have the default
+// access: an access token of an active user, counted against the API calls
+// quota.
+func MapItemService(server *grpc.Server, s pb.ItemServiceServer, access map[string]middleware.GrpcAccess) {
+	pb.RegisterItemServiceServer(server, s)
+}
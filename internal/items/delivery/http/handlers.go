@@ -1,20 +1,35 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/transfer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/etag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/ical"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/patch"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
 )
 
@@ -31,14 +46,18 @@ func CreateItemHandler(uc items.ItemUseCase, cfg *config.Config, logger logger.L
 // Create godoc
 // @Summary Create Item
 // @Description Create new item.
+// @Description With parent_id the item is created under an item the current user can edit.
 // @Tags items
 // @Accept json
 // @Produce json
 // @Param item body presenter.ItemCreate true "Add item"
+// @Param Idempotency-Key header string false "Key of the request, a retry with the same key gets the response of the first request"
 // @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
+// @Failure 429	{object} responses.ErrorResponse
 // @Security OAuth2Password
 // @Router /item [post]
 func (h *itemHandler) Create() func(w http.ResponseWriter, r *http.Request) {
@@ -65,19 +84,13 @@ func (h *itemHandler) Create() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		newItem, err := h.itemsUC.CreateWithOwner(
-			ctx,
-			user.Id,
-			&models.ItemCreate{
-				Title:       item.Title,
-				Description: item.Description,
-			},
-		)
+		newItem, err := h.itemsUC.CreateWithOwner(ctx, user.Id, mapModelCreate(item))
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
 
+		etag.Set(w, newItem.Version)
 		itemResponse := *mapModelResponse(newItem)
 		render.Respond(w, r, responses.CreateSuccessResponse(itemResponse))
 	}
@@ -91,6 +104,7 @@ func (h *itemHandler) Create() func(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path string true "Item Id"
 // @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Header 200 {string} ETag "version of the item"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 403	{object} responses.ErrorResponse
@@ -108,23 +122,13 @@ func (h *itemHandler) Get() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		user, err := middleware.GetUserFromCtx(ctx)
-		if err != nil {
-			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
-			return
-		}
-
 		item, err := h.itemsUC.Get(ctx, uint(id))
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
 
-		if !user.IsSuperUser && item.OwnerId != user.Id {
-			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrNotEnoughPrivileges(err))) //nolint:errcheck
-			return
-		}
-
+		etag.Set(w, item.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
 	}
 }
@@ -132,12 +136,25 @@ func (h *itemHandler) Get() func(w http.ResponseWriter, r *http.Request) {
 // GetMulti godoc
 // @Summary Read Items
 // @Description Retrieve items.
+// @Description Filterable fields: id, create_time, update_time, title, description, owner_id, status, due_at.
+// @Description Sortable fields: id, create_time, update_time, title, owner_id, status.
+// @Description Indexed metadata keys of the metadata schemas filter with metadata.<key>=value, e.g. metadata.color=red.
 // @Tags items
 // @Accept json
 // @Produce json
 // @Param limit query int false "limit" Format(limit)
 // @Param offset query int false "offset" Format(offset)
-// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemResponse]
+// @Param after query string false "cursor of the next page"
+// @Param before query string false "cursor of the previous page"
+// @Param total query bool false "include the total count"
+// @Param filter query []string false "filter as field<op>value, op is one of = != ~ > >= < <=" collectionFormat(multi)
+// @Param sort query string false "comma separated fields, prefix a field with - to sort descending" example(-update_time,id)
+// @Param title query string false "filter by exact title"
+// @Param owner_id query int false "filter by owner id"
+// @Param tag query []string false "filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "items with any (default) or all of the tags" Enums(any, all)
+// @Success 200 {object} responses.SuccessResponse[responses.PageResponse[presenter.ItemResponse]]
+// @Header 200 {string} Link "links to the next and previous pages"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
@@ -145,10 +162,12 @@ func (h *itemHandler) Get() func(w http.ResponseWriter, r *http.Request) {
 // @Router /item [get]
 func (h *itemHandler) GetMulti() func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		q := r.URL.Query()
-
-		limit, _ := strconv.Atoi(q.Get("limit"))
-		offset, _ := strconv.Atoi(q.Get("offset"))
+		values := r.URL.Query()
+		query, err := listQuery.Parse(values, append([]string{"tag", "tag_match"}, metadataParams(values)...)...)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
 
 		ctx := r.Context()
 
@@ -158,23 +177,32 @@ func (h *itemHandler) GetMulti() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		var items []*models.Item
+		var page *listQuery.Page[*models.Item]
 		if user.IsSuperUser {
-			items, err = h.itemsUC.GetMulti(ctx, limit, offset)
+			page, err = h.itemsUC.GetMulti(ctx, query)
 		} else {
-			items, err = h.itemsUC.GetMultiByOwnerId(ctx, user.Id, limit, offset)
+			page, err = h.itemsUC.GetMultiByOwnerId(ctx, user.Id, query)
 		}
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
-		render.Respond(w, r, responses.CreateSuccessResponse(mapModelsResponse(items)))
+
+		if link := listQuery.LinkHeader(r.URL, page.NextCursor, page.PrevCursor); link != "" {
+			w.Header().Add("Link", link)
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(
+			responses.CreatePageResponse(mapModelsResponse(page.Items), page.NextCursor, page.PrevCursor, page.Total),
+		))
 	}
 }
 
 // Delete godoc
 // @Summary Delete item
 // @Description Delete an item by ID.
+// @Description Its descendants are deleted as well, or its children are moved to its parent, depending on the
+// @Description configured hierarchy delete behavior.
 // @Tags items
 // @Accept json
 // @Produce json
@@ -197,24 +225,7 @@ func (h *itemHandler) Delete() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		user, err := middleware.GetUserFromCtx(ctx)
-		if err != nil {
-			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
-			return
-		}
-
-		item, err := h.itemsUC.Get(ctx, uint(id))
-		if err != nil {
-			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
-			return
-		}
-
-		if !user.IsSuperUser && item.OwnerId != user.Id {
-			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrNotEnoughPrivileges(err))) //nolint:errcheck
-			return
-		}
-
-		err = h.itemsUC.DeleteWithoutGet(ctx, uint(id))
+		item, err := h.itemsUC.Delete(ctx, uint(id))
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
@@ -227,16 +238,20 @@ func (h *itemHandler) Delete() func(w http.ResponseWriter, r *http.Request) {
 // Update godoc
 // @Summary Update item
 // @Description Update an item by ID.
+// @Description With If-Match the update only applies if the item still has that ETag, otherwise 412 is returned with the current item.
 // @Tags items
 // @Accept json
 // @Produce json
 // @Param id path string true "Item Id"
+// @Param If-Match header string false "ETag of the item the update is based on"
 // @Param item body presenter.ItemUpdate true "Update item"
 // @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Header 200 {string} ETag "version of the item"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 403	{object} responses.ErrorResponse
 // @Failure 404	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
 // @Security OAuth2Password
 // @Router /item/{id} [put]
@@ -250,6 +265,12 @@ func (h *itemHandler) Update() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		version, err := etag.ParseIfMatch(r)
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
 		item := new(presenter.ItemUpdate)
 
 		err = json.NewDecoder(r.Body).Decode(&item)
@@ -264,61 +285,2418 @@ func (h *itemHandler) Update() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		user, err := middleware.GetUserFromCtx(ctx)
+		// values := make(map[string]interface{})
+		// if item.Title != "" {
+		// 	values["title"] = item.Title
+		// }
+		// if item.Description != "" {
+		// 	values["description"] = item.Description
+		// }
+		item_update := models.ItemUpdate{Version: version}
+		if item.Title != "" {
+			item_update.Title = &item.Title
+		}
+		if item.Description != "" {
+			item_update.Description = &item.Description
+		}
+		item_update.Metadata = item.Metadata
+		item_update.DueAt = item.DueAt
+
+		updatedItem, err := h.itemsUC.Update(ctx, uint(id), &item_update)
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
+		etag.Set(w, updatedItem.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedItem)))
+	}
+}
+
+// Patch godoc
+// @Summary Patch item
+// @Description Partially update an item by ID with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902).
+// @Description Null members of a merge patch and removed members clear the field. The patched item is validated like a new item.
+// @Description With If-Match the update only applies if the item still has that ETag, otherwise 412 is returned with the current item.
+// @Tags items
+// @Accept application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param If-Match header string false "ETag of the item the patch is based on"
+// @Param item body presenter.ItemPatch true "Patch of the item"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Header 200 {string} ETag "version of the item"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
+// @Failure 415	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id} [patch]
+func (h *itemHandler) Patch() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		version, err := etag.ParseIfMatch(r)
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
+		current, err := h.itemsUC.Get(ctx, uint(id))
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
 
-		dbItem, err := h.itemsUC.Get(ctx, uint(id))
+		original := presenter.ItemPatch{
+			Title:       current.Title,
+			Description: current.Description,
+			Metadata:    current.Metadata,
+			DueAt:       current.DueAt,
+		}
+
+		item := new(presenter.ItemPatch)
+
+		err = patch.Apply(r, original, item)
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
 
-		if !user.IsSuperUser && dbItem.OwnerId != user.Id {
-			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrNotEnoughPrivileges(err))) //nolint:errcheck
+		err = utils.ValidateStruct(ctx, item)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
 			return
 		}
 
-		// values := make(map[string]interface{})
-		// if item.Title != "" {
-		// 	values["title"] = item.Title
-		// }
-		// if item.Description != "" {
-		// 	values["description"] = item.Description
-		// }
-		item_update := models.ItemUpdate{}
-		if item.Title != "" {
+		// Only the changed fields are written, so concurrent updates of other
+		// fields are kept.
+		item_update := models.ItemUpdate{Version: version}
+		if item.Title != original.Title {
 			item_update.Title = &item.Title
 		}
-		if item.Description != "" {
+		if item.Description != original.Description {
 			item_update.Description = &item.Description
 		}
+		if !reflect.DeepEqual(item.Metadata, original.Metadata) {
+			item_update.Metadata = item.Metadata
+			if item_update.Metadata == nil {
+				item_update.Metadata = map[string]interface{}{}
+			}
+		}
+		switch {
+		case item.DueAt == nil && original.DueAt != nil:
+			item_update.ClearDueAt = true
+		case item.DueAt != nil && (original.DueAt == nil || !item.DueAt.Equal(*original.DueAt)):
+			item_update.DueAt = item.DueAt
+		}
 
-		updatedItem, err := h.itemsUC.Update(r.Context(), uint(id), &item_update)
+		updatedItem, err := h.itemsUC.Update(ctx, uint(id), &item_update)
 		if err != nil {
-			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			h.renderUpdateError(w, r, uint(id), err)
 			return
 		}
 
+		etag.Set(w, updatedItem.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedItem)))
 	}
 }
 
-func mapModelResponse(exp *models.Item) *presenter.ItemResponse {
-	return &presenter.ItemResponse{
-		Id:          exp.Id,
-		Title:       exp.Title,
-		Description: exp.Description,
-		OwnerId:     exp.OwnerId,
+// Bulk godoc
+// @Summary Bulk create, update and delete items
+// @Description Run a list of create, update and delete operations and return the result of each operation. A create has the fields of POST /item.
+// @Description By default the operations run in one transaction: when one fails, the others are rolled back (status 424) and the
+// @Description response has the status of the failed operation. With atomic=false every operation is applied on its own, the creates past the items quota fail with quota_exceeded.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param atomic query bool false "run the operations in one transaction, true by default"
+// @Param operations body presenter.ItemBulk true "Operations"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemBulkResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Failure 429	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/bulk [post]
+func (h *itemHandler) Bulk() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		atomic := true
+		if raw := r.URL.Query().Get("atomic"); raw != "" {
+			parsed, err := strconv.ParseBool(raw)
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+				return
+			}
+			atomic = parsed
+		}
+
+		bulk := new(presenter.ItemBulk)
+
+		err := json.NewDecoder(r.Body).Decode(&bulk)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, bulk)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		operations := make([]*models.ItemBulkOperation, len(bulk.Operations))
+		for i, operation := range bulk.Operations {
+			operations[i] = &models.ItemBulkOperation{
+				Op:          operation.Op,
+				Id:          operation.Id,
+				Title:       operation.Title,
+				Description: operation.Description,
+				Version:     operation.Version,
+			}
+			if operation.Op == models.ItemBulkOpCreate {
+				// A create is validated by the use case, its failure is the
+				// result of the operation.
+				item := &presenter.ItemCreate{
+					Metadata: operation.Metadata,
+					DueAt:    operation.DueAt,
+					ParentId: operation.ParentId,
+				}
+				if operation.Title != nil {
+					item.Title = *operation.Title
+				}
+				if operation.Description != nil {
+					item.Description = *operation.Description
+				}
+				operations[i].Create = mapModelCreate(item)
+			}
+		}
+
+		results, err := h.itemsUC.Bulk(ctx, user.Id, operations, atomic)
+		if err != nil && results == nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		bulkResponse := presenter.ItemBulkResponse{
+			Atomic:  atomic,
+			Results: mapBulkResultsResponse(results),
+		}
+
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponseWithData(err, bulkResponse)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(bulkResponse))
 	}
 }
 
-func mapModelsResponse(exp []*models.Item) []*presenter.ItemResponse {
-	out := make([]*presenter.ItemResponse, len(exp))
-	for i, user := range exp {
-		out[i] = mapModelResponse(user)
+// GetMultiShared godoc
+// @Summary Read shared items
+// @Description Retrieve items shared with current user.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/shared [get]
+func (h *itemHandler) GetMultiShared() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		items, err := h.itemsUC.GetMultiSharedWith(ctx, user.Id, offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelsResponse(items)))
+	}
+}
+
+// GetShares godoc
+// @Summary Read item shares
+// @Description Retrieve users an item is shared with.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemShareResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/shares [get]
+func (h *itemHandler) GetShares() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		shares, err := h.itemsUC.GetShares(ctx, uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapSharesResponse(shares)))
+	}
+}
+
+// CreateShare godoc
+// @Summary Share item
+// @Description Share an item with another user as viewer or editor. Sharing again with the same user updates the permission.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param share body presenter.ItemShareCreate true "Share item"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemShareResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/shares [post]
+func (h *itemHandler) CreateShare() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		share := new(presenter.ItemShareCreate)
+
+		err = json.NewDecoder(r.Body).Decode(&share)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, share)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		newShare, err := h.itemsUC.CreateShare(ctx, uint(id), &models.ItemShareCreate{
+			UserId:     share.UserId,
+			Permission: share.Permission,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapShareResponse(newShare)))
+	}
+}
+
+// DeleteShare godoc
+// @Summary Unshare item
+// @Description Remove access of an user to an item.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param userId path string true "User Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemShareResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/shares/{userId} [delete]
+func (h *itemHandler) DeleteShare() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		userId, err := strconv.ParseUint(chi.URLParam(r, "userId"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		share, err := h.itemsUC.DeleteShare(ctx, uint(id), uint(userId))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapShareResponse(share)))
+	}
+}
+
+// Search godoc
+// @Summary Search items
+// @Description Full-text search in the title and description of the items visible to current user.
+// @Description Every word is matched as a prefix, results are ordered by rank and matches are wrapped in <mark> tags.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param q query string true "search text"
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemSearchResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/search [get]
+func (h *itemHandler) Search() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		results, err := h.itemsUC.Search(r.Context(), q.Get("q"), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapSearchResultsResponse(results)))
+	}
+}
+
+// GetMultiTrash godoc
+// @Summary Read deleted items
+// @Description Retrieve deleted items, accepts the same query parameters as the item list.
+// @Description Deleted items are purged permanently after the configured retention period.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Param after query string false "cursor of the next page"
+// @Param before query string false "cursor of the previous page"
+// @Param total query bool false "include the total count"
+// @Param filter query []string false "filter as field<op>value, op is one of = != ~ > >= < <=" collectionFormat(multi)
+// @Param sort query string false "comma separated fields, prefix a field with - to sort descending" example(-delete_time)
+// @Param tag query []string false "filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "items with any (default) or all of the tags" Enums(any, all)
+// @Success 200 {object} responses.SuccessResponse[responses.PageResponse[presenter.ItemResponse]]
+// @Header 200 {string} Link "links to the next and previous pages"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/trash [get]
+func (h *itemHandler) GetMultiTrash() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := listQuery.Parse(r.URL.Query(), "tag", "tag_match")
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		page, err := h.itemsUC.GetMultiTrash(r.Context(), query)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		if link := listQuery.LinkHeader(r.URL, page.NextCursor, page.PrevCursor); link != "" {
+			w.Header().Add("Link", link)
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(
+			responses.CreatePageResponse(mapModelsResponse(page.Items), page.NextCursor, page.PrevCursor, page.Total),
+		))
+	}
+}
+
+// Restore godoc
+// @Summary Restore item
+// @Description Restore a deleted item by ID, with the descendants deleted with it.
+// @Description The item is moved to the root if its parent is still deleted.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Failure 429	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/restore [post]
+func (h *itemHandler) Restore() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		item, err := h.itemsUC.Restore(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, item.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
+	}
+}
+
+// GetRevisions godoc
+// @Summary Read item revisions
+// @Description Retrieve the revision history of an item, newest first. Every create, update, delete, restore
+// @Description and revert of the item is saved with the user who made it and the changed fields.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemRevisionResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/revisions [get]
+func (h *itemHandler) GetRevisions() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		revisions, err := h.itemsUC.GetRevisions(r.Context(), uint(id), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapRevisionsResponse(revisions)))
+	}
+}
+
+// DiffRevisions godoc
+// @Summary Diff item revisions
+// @Description Compare two revisions of an item. When to is omitted the latest revision is used.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param from query int true "Revision Id to compare from"
+// @Param to query int false "Revision Id to compare to"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemRevisionDiffResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/revisions/diff [get]
+func (h *itemHandler) DiffRevisions() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		q := r.URL.Query()
+
+		fromId, err := strconv.ParseUint(q.Get("from"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		var toId *uint
+		if raw := q.Get("to"); raw != "" {
+			parsed, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+				return
+			}
+			to := uint(parsed)
+			toId = &to
+		}
+
+		diff, err := h.itemsUC.DiffRevisions(r.Context(), uint(id), uint(fromId), toId)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(presenter.ItemRevisionDiffResponse{
+			From:    *mapRevisionResponse(diff.From),
+			To:      *mapRevisionResponse(diff.To),
+			Changes: mapFieldChangesResponse(diff.Changes),
+		}))
+	}
+}
+
+// RevertRevision godoc
+// @Summary Revert item
+// @Description Restore the title, description, metadata and due date of an item from a revision, the status only changes through the transitions. The metadata is validated and the reminder follows the due date. The revert is saved as a new revision.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param revisionId path string true "Revision Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/revisions/{revisionId}/revert [post]
+func (h *itemHandler) RevertRevision() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		revisionId, err := strconv.ParseUint(chi.URLParam(r, "revisionId"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		item, err := h.itemsUC.RevertRevision(r.Context(), uint(id), uint(revisionId))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, item.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
+	}
+}
+
+// AddTags godoc
+// @Summary Tag item
+// @Description Attach tags to an item. Only the tags of the item owner can be attached, tags already attached are ignored.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param tags body presenter.ItemTagsAdd true "Tags to attach"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/tags [post]
+func (h *itemHandler) AddTags() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		tags := new(presenter.ItemTagsAdd)
+
+		err = json.NewDecoder(r.Body).Decode(&tags)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, tags)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		item, err := h.itemsUC.AddTags(ctx, uint(id), tags.TagIds)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, item.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
+	}
+}
+
+// RemoveTag godoc
+// @Summary Untag item
+// @Description Detach a tag from an item.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param tagId path string true "Tag Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/tags/{tagId} [delete]
+func (h *itemHandler) RemoveTag() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		tagId, err := strconv.ParseUint(chi.URLParam(r, "tagId"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		item, err := h.itemsUC.RemoveTag(r.Context(), uint(id), uint(tagId))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, item.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
+	}
+}
+
+// GetAttachments godoc
+// @Summary Read item attachments
+// @Description Retrieve the files attached to an item.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemAttachmentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/attachments [get]
+func (h *itemHandler) GetAttachments() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		attachments, err := h.itemsUC.GetAttachments(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapAttachmentsResponse(attachments)))
+	}
+}
+
+// CreateAttachment godoc
+// @Summary Upload item attachment
+// @Description Upload a file to an item as multipart/form-data. The file is streamed to the storage, its content type is sniffed from its content.
+// @Description An optional "checksum" field, sent before the "file" field, holds the hex encoded SHA-256 of the file, the upload is rejected if it does not match.
+// @Tags items
+// @Accept mpfd
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param checksum formData string false "Hex encoded SHA-256 of the file"
+// @Param file formData file true "File"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemAttachmentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 413	{object} responses.ErrorResponse
+// @Failure 415	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Failure 429	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/attachments [post]
+func (h *itemHandler) CreateAttachment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		// The server read timeout is meant for small JSON bodies, uploads get the whole processing time.
+		http.NewResponseController(w).SetReadDeadline(time.Now().Add(time.Duration(h.cfg.Server.ProcessTimeout) * time.Second)) //nolint:errcheck
+
+		// Leave some room for the multipart headers and the checksum field.
+		r.Body = http.MaxBytesReader(w, r.Body, h.cfg.Storage.MaxUploadSize+1<<20)
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrUnsupportedMediaType(err))) //nolint:errcheck
+			return
+		}
+
+		obj_create := new(models.ItemAttachmentCreate)
+
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(errors.New("file is required")))) //nolint:errcheck
+				return
+			}
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+
+			switch part.FormName() {
+			case "checksum":
+				value, err := io.ReadAll(io.LimitReader(part, 128))
+				if err != nil {
+					render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+					return
+				}
+				obj_create.Checksum = strings.TrimSpace(string(value))
+			case "file":
+				obj_create.Filename = part.FileName()
+
+				attachment, err := h.itemsUC.CreateAttachment(ctx, uint(id), user.Id, obj_create, part)
+				if err != nil {
+					render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+					return
+				}
+
+				render.Respond(w, r, responses.CreateSuccessResponse(mapAttachmentResponse(attachment)))
+				return
+			}
+		}
+	}
+}
+
+// GetAttachment godoc
+// @Summary Read item attachment
+// @Description Get the metadata of an attachment.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param attachmentId path string true "Attachment Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemAttachmentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/attachments/{attachmentId} [get]
+func (h *itemHandler) GetAttachment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, attachmentId, err := parseAttachmentParams(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		attachment, err := h.itemsUC.GetAttachment(r.Context(), id, attachmentId)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapAttachmentResponse(attachment)))
+	}
+}
+
+// DownloadAttachment godoc
+// @Summary Download item attachment
+// @Description Stream the content of an attachment. The ETag is the SHA-256 of the content.
+// @Tags items
+// @Produce octet-stream
+// @Param id path string true "Item Id"
+// @Param attachmentId path string true "Attachment Id"
+// @Success 200 {file} file
+// @Header 200 {string} ETag "SHA-256 of the content"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/attachments/{attachmentId}/download [get]
+func (h *itemHandler) DownloadAttachment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, attachmentId, err := parseAttachmentParams(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		attachment, content, err := h.itemsUC.OpenAttachment(r.Context(), id, attachmentId)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+		defer content.Close()
+
+		// The server write timeout is meant for small JSON bodies, downloads get the whole processing time.
+		http.NewResponseController(w).SetWriteDeadline(time.Now().Add(time.Duration(h.cfg.Server.ProcessTimeout) * time.Second)) //nolint:errcheck
+
+		w.Header().Set("Content-Type", attachment.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+		w.Header().Set("Content-Disposition", storage.ContentDisposition(attachment.Filename))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("ETag", strconv.Quote(attachment.Checksum))
+		w.WriteHeader(http.StatusOK)
+
+		if _, err := io.Copy(w, content); err != nil {
+			h.logger.Warnf("failed to send attachment %d: %v", attachment.Id, err)
+		}
+	}
+}
+
+// GetAttachmentURL godoc
+// @Summary Presign item attachment
+// @Description Get a presigned URL to download an attachment without authentication, the URL expires after a few minutes.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param attachmentId path string true "Attachment Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemAttachmentURLResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/attachments/{attachmentId}/url [get]
+func (h *itemHandler) GetAttachmentURL() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, attachmentId, err := parseAttachmentParams(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		url, err := h.itemsUC.GetAttachmentURL(r.Context(), id, attachmentId)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(presenter.ItemAttachmentURLResponse{
+			Url:       url.Url,
+			ExpiresAt: url.ExpiresAt,
+		}))
+	}
+}
+
+// DeleteAttachment godoc
+// @Summary Delete item attachment
+// @Description Delete an attachment and its content.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param attachmentId path string true "Attachment Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemAttachmentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/attachments/{attachmentId} [delete]
+func (h *itemHandler) DeleteAttachment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, attachmentId, err := parseAttachmentParams(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		attachment, err := h.itemsUC.DeleteAttachment(r.Context(), id, attachmentId)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapAttachmentResponse(attachment)))
+	}
+}
+
+// Export godoc
+// @Summary Export items
+// @Description Stream the items of current user, or all items for a super user, as CSV, JSON or NDJSON. The list filters
+// @Description of the item list apply, including metadata.<key>=value, the items are exported in id order.
+// @Tags items
+// @Produce json
+// @Produce plain
+// @Param format query string false "export format" Enums(csv, json, ndjson) default(json)
+// @Param filter query []string false "filter, field<op>value with op in =, !=, >, >=, <, <=, ~" collectionFormat(multi)
+// @Param tag query []uint false "tag ids" collectionFormat(multi)
+// @Param tag_match query string false "items must have any or all of the tags" Enums(any, all) default(any)
+// @Success 200 {file} file
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/export [get]
+func (h *itemHandler) Export() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values := r.URL.Query()
+		query, err := listQuery.Parse(values, append([]string{"tag", "tag_match", "format"}, metadataParams(values)...)...)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		format := query.Params.Get("format")
+		if format == "" {
+			format = models.ItemFormatJson
+		}
+		if !transfer.ValidFormat(format) {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(fmt.Errorf("unknown format %q", format)))) //nolint:errcheck
+			return
+		}
+
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		var ownerId *uint
+		if !user.IsSuperUser {
+			ownerId = &user.Id
+		}
+
+		// The server write timeout is meant for small JSON bodies, exports get the whole processing time.
+		http.NewResponseController(w).SetWriteDeadline(time.Now().Add(time.Duration(h.cfg.Server.ProcessTimeout) * time.Second)) //nolint:errcheck
+
+		// The headers are sent with the first batch, so an invalid filter is still
+		// answered with an error response.
+		var writer transfer.Writer
+		start := func() (err error) {
+			w.Header().Set("Content-Type", transfer.ContentType(format))
+			w.Header().Set("Content-Disposition", storage.ContentDisposition("items."+format))
+			w.WriteHeader(http.StatusOK)
+
+			writer, err = transfer.NewWriter(w, format)
+			return err
+		}
+
+		err = h.itemsUC.Export(ctx, ownerId, query, func(objs []*models.Item) error {
+			if writer == nil {
+				if err := start(); err != nil {
+					return err
+				}
+			}
+
+			for _, obj := range objs {
+				if err := writer.Write(obj); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil && writer == nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+		if err != nil {
+			h.logger.Warnf("failed to export items: %v", err)
+			return
+		}
+
+		if writer == nil {
+			if err := start(); err != nil {
+				h.logger.Warnf("failed to export items: %v", err)
+				return
+			}
+		}
+
+		if err := writer.Close(); err != nil {
+			h.logger.Warnf("failed to export items: %v", err)
+		}
+	}
+}
+
+// syncImportMaxSize is the largest body imported during the request, larger
+// bodies and bodies of unknown size are imported by the worker.
+const syncImportMaxSize = 1 << 20
+
+// Import godoc
+// @Summary Import items
+// @Description Create items from a CSV, JSON or NDJSON body. CSV needs a header with a title column and an optional description
+// @Description column, JSON is an array of items and NDJSON one item per line. Every row is validated like a created item, the
+// @Description invalid rows are skipped and reported with their line.
+// @Description Bodies up to 1 MiB are imported during the request. Larger bodies, bodies without Content-Length and async=true
+// @Description imports run in the background: the response is 202 with the import, which can be polled at its Location.
+// @Tags items
+// @Accept plain
+// @Accept json
+// @Produce json
+// @Param format query string false "import format, read from the Content-Type by default" Enums(csv, json, ndjson)
+// @Param async query bool false "run the import in the background"
+// @Param content body string true "Items"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemImportResultResponse]
+// @Success 202 {object} responses.SuccessResponse[presenter.ItemImportResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 413	{object} responses.ErrorResponse
+// @Failure 415	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Failure 429	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/import [post]
+func (h *itemHandler) Import() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format, err := importFormat(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		async := r.ContentLength < 0 || r.ContentLength > syncImportMaxSize
+		if raw := r.URL.Query().Get("async"); raw != "" {
+			parsed, err := strconv.ParseBool(raw)
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+				return
+			}
+			async = async || parsed
+		}
+
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		if !async {
+			r.Body = http.MaxBytesReader(w, r.Body, syncImportMaxSize)
+
+			result, err := h.itemsUC.Import(ctx, user.Id, format, r.Body)
+			if err != nil && result == nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+
+			// A malformed document stops the import, the rows before it are kept.
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponseWithData(err, mapImportResultResponse(result))) //nolint:errcheck
+				return
+			}
+
+			render.Respond(w, r, responses.CreateSuccessResponse(mapImportResultResponse(result)))
+			return
+		}
+
+		// The server read timeout is meant for small JSON bodies, uploads get the whole processing time.
+		http.NewResponseController(w).SetReadDeadline(time.Now().Add(time.Duration(h.cfg.Server.ProcessTimeout) * time.Second)) //nolint:errcheck
+
+		r.Body = http.MaxBytesReader(w, r.Body, h.cfg.Storage.MaxUploadSize)
+
+		itemImport, err := h.itemsUC.StartImport(ctx, user.Id, format, r.Body)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		w.Header().Set("Location", strings.TrimSuffix(r.URL.Path, "/")+"/"+strconv.FormatUint(uint64(itemImport.Id), 10))
+		render.Status(r, http.StatusAccepted)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapImportResponse(itemImport)))
+	}
+}
+
+// GetImport godoc
+// @Summary Read item import
+// @Description Get the progress of a background import.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param importId path string true "Import Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemImportResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/import/{importId} [get]
+func (h *itemHandler) GetImport() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		importId, err := strconv.ParseUint(chi.URLParam(r, "importId"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		itemImport, err := h.itemsUC.GetImport(r.Context(), uint(importId))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapImportResponse(itemImport)))
+	}
+}
+
+// GetComments godoc
+// @Summary Read item comments
+// @Description Retrieve the comments of an item, oldest first.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemCommentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/comments [get]
+func (h *itemHandler) GetComments() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		comments, err := h.itemsUC.GetComments(r.Context(), uint(id), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapCommentsResponse(comments)))
+	}
+}
+
+// CreateComment godoc
+// @Summary Comment item
+// @Description Add a comment to an item visible to current user. The users mentioned with @email who can see the item
+// @Description are notified by email.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param comment body presenter.ItemCommentCreate true "Add comment"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemCommentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/comments [post]
+func (h *itemHandler) CreateComment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		comment := new(presenter.ItemCommentCreate)
+
+		err = json.NewDecoder(r.Body).Decode(&comment)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, comment)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		newComment, err := h.itemsUC.CreateComment(ctx, uint(id), user, &models.ItemCommentCreate{
+			Body: comment.Body,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapCommentResponse(newComment)))
+	}
+}
+
+// UpdateComment godoc
+// @Summary Edit item comment
+// @Description Edit a comment of current user. The users newly mentioned by the edit are notified by email.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param commentId path string true "Comment Id"
+// @Param comment body presenter.ItemCommentUpdate true "Edit comment"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemCommentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/comments/{commentId} [put]
+func (h *itemHandler) UpdateComment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, commentId, err := parseCommentParams(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		comment := new(presenter.ItemCommentUpdate)
+
+		err = json.NewDecoder(r.Body).Decode(&comment)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, comment)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		updatedComment, err := h.itemsUC.UpdateComment(ctx, id, commentId, user, &models.ItemCommentUpdate{
+			Body: comment.Body,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapCommentResponse(updatedComment)))
+	}
+}
+
+// DeleteComment godoc
+// @Summary Delete item comment
+// @Description Delete a comment, by its author or by the item owner.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param commentId path string true "Comment Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemCommentResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/comments/{commentId} [delete]
+func (h *itemHandler) DeleteComment() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, commentId, err := parseCommentParams(r)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		comment, err := h.itemsUC.DeleteComment(r.Context(), id, commentId)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapCommentResponse(comment)))
+	}
+}
+
+// GetActivity godoc
+// @Summary Read item activity
+// @Description Retrieve the activity feed of an item, newest first: its comments and its revisions with the changed fields.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemActivityResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/activity [get]
+func (h *itemHandler) GetActivity() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		activity, err := h.itemsUC.GetActivity(r.Context(), uint(id), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapActivityResponse(activity)))
+	}
+}
+
+func parseCommentParams(r *http.Request) (uint, uint, error) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, 0, httpErrors.ErrValidation(err)
+	}
+
+	commentId, err := strconv.ParseUint(chi.URLParam(r, "commentId"), 10, 64)
+	if err != nil {
+		return 0, 0, httpErrors.ErrValidation(err)
+	}
+
+	return uint(id), uint(commentId), nil
+}
+
+// importFormat returns the format of an import body, from the format query
+// parameter or from the Content-Type.
+func importFormat(r *http.Request) (string, error) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		if !transfer.ValidFormat(format) {
+			return "", httpErrors.ErrValidation(fmt.Errorf("unknown format %q", format))
+		}
+		return format, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", httpErrors.ErrUnsupportedMediaType(errors.New("content type or format is required"))
+	}
+
+	switch mediaType {
+	case "text/csv":
+		return models.ItemFormatCsv, nil
+	case "application/json":
+		return models.ItemFormatJson, nil
+	case "application/x-ndjson", "application/ndjson", "application/jsonl":
+		return models.ItemFormatNdjson, nil
+	default:
+		return "", httpErrors.ErrUnsupportedMediaType(fmt.Errorf("unsupported content type %q", mediaType))
+	}
+}
+
+func parseAttachmentParams(r *http.Request) (uint, uint, error) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, 0, httpErrors.ErrValidation(err)
+	}
+
+	attachmentId, err := strconv.ParseUint(chi.URLParam(r, "attachmentId"), 10, 64)
+	if err != nil {
+		return 0, 0, httpErrors.ErrValidation(err)
+	}
+
+	return uint(id), uint(attachmentId), nil
+}
+
+// renderUpdateError renders the error of an update. A failed If-Match is
+// answered with the current item and its ETag, so the client can merge.
+func (h *itemHandler) renderUpdateError(w http.ResponseWriter, r *http.Request, id uint, err error) {
+	if httpErrors.ParseErrors(err).GetStatus() == http.StatusPreconditionFailed {
+		if current, getErr := h.itemsUC.Get(r.Context(), id); getErr == nil {
+			etag.Set(w, current.Version)
+			render.Render(w, r, responses.CreateErrorResponseWithData(err, mapModelResponse(current))) //nolint:errcheck
+			return
+		}
+	}
+	render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+}
+
+// GetTransitions godoc
+// @Summary Read item transitions
+// @Description Retrieve the status changes of an item, newest first.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemTransitionResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/transitions [get]
+func (h *itemHandler) GetTransitions() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		transitions, err := h.itemsUC.GetTransitions(r.Context(), uint(id), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapTransitionsResponse(transitions)))
+	}
+}
+
+// CreateTransition godoc
+// @Summary Change item status
+// @Description Change the status of an item with a transition of the workflow. The transition must start from the
+// @Description status of the item (409 otherwise) and current user must have one of the roles allowed to make it
+// @Description (403 otherwise). The users with a role notified by the transition are emailed.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param transition body presenter.ItemTransitionCreate true "Make transition"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Header 200 {string} ETag "version of the item"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/transitions [post]
+func (h *itemHandler) CreateTransition() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		transition := new(presenter.ItemTransitionCreate)
+
+		err = json.NewDecoder(r.Body).Decode(&transition)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, transition)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		item, err := h.itemsUC.Transition(ctx, uint(id), user, &models.ItemTransitionCreate{
+			Transition: transition.Transition,
+			Comment:    transition.Comment,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, item.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
+	}
+}
+
+// TransferOwnership godoc
+// @Summary Transfer item ownership
+// @Description Transfer an item to another user. The transfer of a super user is applied right away, the transfer of
+// @Description the owner is pending until the recipient accepts it. The item leaves the tags of its previous owner and
+// @Description the change of owner is saved in the item revisions.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param transfer body presenter.OwnershipTransferCreate true "Transfer item"
+// @Success 201 {object} responses.SuccessResponse[presenter.OwnershipTransferResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/transfer [post]
+func (h *itemHandler) TransferOwnership() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		transfer := new(presenter.OwnershipTransferCreate)
+
+		err = json.NewDecoder(r.Body).Decode(&transfer)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, transfer)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		newTransfer, err := h.itemsUC.TransferOwnership(ctx, uint(id), user, &models.OwnershipTransferCreate{
+			ToUserId: transfer.ToUserId,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapOwnershipTransferResponse(newTransfer)))
+	}
+}
+
+// TransferAllOwnership godoc
+// @Summary Transfer all items of an user
+// @Description Transfer every item of an user, trashed ones included, to another user in one transaction. Pending
+// @Description transfers sent by the previous owner are cancelled. Super user only.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param transfer body presenter.OwnershipTransferAll true "Transfer items"
+// @Success 200 {object} responses.SuccessResponse[presenter.OwnershipTransferAllResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/transfers/all [post]
+func (h *itemHandler) TransferAllOwnership() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		transfer := new(presenter.OwnershipTransferAll)
+
+		err := json.NewDecoder(r.Body).Decode(&transfer)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, transfer)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		count, err := h.itemsUC.TransferAllOwnership(ctx, &models.OwnershipTransferAll{
+			FromUserId: transfer.FromUserId,
+			ToUserId:   transfer.ToUserId,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(&presenter.OwnershipTransferAllResponse{Count: count}))
+	}
+}
+
+// GetOwnershipTransfers godoc
+// @Summary Read ownership transfers
+// @Description Retrieve the ownership transfers sent or received by current user, newest first.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param status query string false "filter by status" Enums(pending, accepted, declined, cancelled)
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.OwnershipTransferResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/transfers [get]
+func (h *itemHandler) GetOwnershipTransfers() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		transfers, err := h.itemsUC.GetOwnershipTransfers(ctx, user, q.Get("status"), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapOwnershipTransfersResponse(transfers)))
+	}
+}
+
+// AcceptOwnershipTransfer godoc
+// @Summary Accept ownership transfer
+// @Description Accept a pending transfer sent to current user, the item is transferred right away.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param transferId path string true "Transfer Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.OwnershipTransferResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Failure 429	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/transfers/{transferId}/accept [post]
+func (h *itemHandler) AcceptOwnershipTransfer() func(w http.ResponseWriter, r *http.Request) {
+	return h.updateOwnershipTransfer(h.itemsUC.AcceptOwnershipTransfer)
+}
+
+// DeclineOwnershipTransfer godoc
+// @Summary Decline ownership transfer
+// @Description Decline a pending transfer sent to current user.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param transferId path string true "Transfer Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.OwnershipTransferResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/transfers/{transferId}/decline [post]
+func (h *itemHandler) DeclineOwnershipTransfer() func(w http.ResponseWriter, r *http.Request) {
+	return h.updateOwnershipTransfer(h.itemsUC.DeclineOwnershipTransfer)
+}
+
+// CancelOwnershipTransfer godoc
+// @Summary Cancel ownership transfer
+// @Description Cancel a pending transfer sent by current user.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param transferId path string true "Transfer Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.OwnershipTransferResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/transfers/{transferId}/cancel [post]
+func (h *itemHandler) CancelOwnershipTransfer() func(w http.ResponseWriter, r *http.Request) {
+	return h.updateOwnershipTransfer(h.itemsUC.CancelOwnershipTransfer)
+}
+
+// updateOwnershipTransfer returns a handler changing the status of the
+// transfer of the url with fn.
+func (h *itemHandler) updateOwnershipTransfer(
+	fn func(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error),
+) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		transferId, err := strconv.ParseUint(chi.URLParam(r, "transferId"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		transfer, err := fn(ctx, uint(transferId), user)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapOwnershipTransferResponse(transfer)))
+	}
+}
+
+// Calendar godoc
+// @Summary Calendar feed
+// @Description iCalendar feed of the items with a due date owned by or shared with the user of the token, for calendar
+// @Description apps. The url, with a .ics extension, is given by POST /user/me/calendar-token.
+// @Tags items
+// @Produce plain
+// @Param token path string true "Calendar token"
+// @Success 200 {file} file
+// @Failure 404	{object} responses.ErrorResponse
+// @Router /item/calendar/{token} [get]
+func (h *itemHandler) Calendar() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// The .ics extension of the url is removed by the URLFormat middleware.
+		dueItems, err := h.itemsUC.CalendarFeed(r.Context(), chi.URLParam(r, "token"))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		calendar := &ical.Calendar{
+			ProdId: fmt.Sprintf("-//%s//Items//EN", h.cfg.Email.Name),
+			Name:   h.cfg.Email.Name,
+			Events: make([]ical.Event, len(dueItems)),
+		}
+		for i, item := range dueItems {
+			description := item.Description
+			if item.Status != "" {
+				description = strings.TrimSpace(fmt.Sprintf("Status: %s\n\n%s", item.Status, item.Description))
+			}
+			calendar.Events[i] = ical.Event{
+				Uid:         fmt.Sprintf("item-%d@%s", item.Id, calendarHost(h.cfg.Calendar.FeedUrl)),
+				Stamp:       time.Now(),
+				Start:       *item.DueAt,
+				Summary:     item.Title,
+				Description: description,
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		if err := calendar.Write(w); err != nil {
+			h.logger.Warnf("failed to write calendar: %v", err)
+		}
+	}
+}
+
+// calendarHost returns the host of the feed url, the domain of the uid of the
+// calendar events.
+func calendarHost(feedUrl string) string {
+	if parsed, err := url.Parse(feedUrl); err == nil && parsed.Hostname() != "" {
+		return parsed.Hostname()
+	}
+	return "localhost"
+}
+
+func mapModelCreate(exp *presenter.ItemCreate) *models.ItemCreate {
+	return &models.ItemCreate{
+		Title:       exp.Title,
+		Description: exp.Description,
+		Metadata:    exp.Metadata,
+		DueAt:       exp.DueAt,
+		ParentId:    exp.ParentId,
+	}
+}
+
+func mapModelResponse(exp *models.Item) *presenter.ItemResponse {
+	return &presenter.ItemResponse{
+		Id:          exp.Id,
+		Title:       exp.Title,
+		Description: exp.Description,
+		OwnerId:     exp.OwnerId,
+		Status:      exp.Status,
+		Metadata:    exp.Metadata,
+		DueAt:       exp.DueAt,
+		ParentId:    exp.ParentId,
+		DeleteTime:  exp.DeleteTime,
+		Version:     exp.Version,
+		Tags:        mapTagsResponse(exp.Tags),
+	}
+}
+
+func mapTagsResponse(exp []*models.Tag) []presenter.ItemTagResponse {
+	out := make([]presenter.ItemTagResponse, len(exp))
+	for i, tag := range exp {
+		out[i] = presenter.ItemTagResponse{
+			Id:    tag.Id,
+			Name:  tag.Name,
+			Color: tag.Color,
+		}
+	}
+	return out
+}
+
+func mapModelsResponse(exp []*models.Item) []*presenter.ItemResponse {
+	out := make([]*presenter.ItemResponse, len(exp))
+	for i, user := range exp {
+		out[i] = mapModelResponse(user)
+	}
+	return out
+}
+
+func mapShareResponse(exp *models.ItemShare) *presenter.ItemShareResponse {
+	return &presenter.ItemShareResponse{
+		ItemId:     exp.ItemId,
+		UserId:     exp.UserId,
+		Permission: exp.Permission,
+		CreateTime: exp.CreateTime,
+		UpdateTime: exp.UpdateTime,
+	}
+}
+
+func mapSharesResponse(exp []*models.ItemShare) []*presenter.ItemShareResponse {
+	out := make([]*presenter.ItemShareResponse, len(exp))
+	for i, share := range exp {
+		out[i] = mapShareResponse(share)
+	}
+	return out
+}
+
+func mapAttachmentResponse(exp *models.ItemAttachment) *presenter.ItemAttachmentResponse {
+	return &presenter.ItemAttachmentResponse{
+		Id:          exp.Id,
+		ItemId:      exp.ItemId,
+		UploaderId:  exp.UploaderId,
+		Filename:    exp.Filename,
+		ContentType: exp.ContentType,
+		Size:        exp.Size,
+		Checksum:    exp.Checksum,
+		CreateTime:  exp.CreateTime,
+
+		ProcessingStatus: exp.ProcessingStatus,
+		Width:            exp.Width,
+		Height:           exp.Height,
+		Thumbnails:       mapThumbnailsResponse(exp.Thumbnails),
+	}
+}
+
+func mapThumbnailsResponse(exp []models.ItemAttachmentThumbnail) []presenter.ItemAttachmentThumbnailResponse {
+	out := make([]presenter.ItemAttachmentThumbnailResponse, len(exp))
+	for i, thumbnail := range exp {
+		out[i] = presenter.ItemAttachmentThumbnailResponse{
+			Name:   thumbnail.Name,
+			Width:  thumbnail.Width,
+			Height: thumbnail.Height,
+			Url:    thumbnail.Url,
+		}
+	}
+	return out
+}
+
+func mapAttachmentsResponse(exp []*models.ItemAttachment) []*presenter.ItemAttachmentResponse {
+	out := make([]*presenter.ItemAttachmentResponse, len(exp))
+	for i, attachment := range exp {
+		out[i] = mapAttachmentResponse(attachment)
+	}
+	return out
+}
+
+func mapSearchResultsResponse(exp []*models.ItemSearchResult) []*presenter.ItemSearchResponse {
+	out := make([]*presenter.ItemSearchResponse, len(exp))
+	for i, result := range exp {
+		out[i] = &presenter.ItemSearchResponse{
+			ItemResponse:         *mapModelResponse(&result.Item),
+			Rank:                 result.Rank,
+			TitleHighlight:       result.TitleHighlight,
+			DescriptionHighlight: result.DescriptionHighlight,
+		}
+	}
+	return out
+}
+
+func mapFieldChangesResponse(exp []models.FieldChange) []presenter.FieldChangeResponse {
+	out := make([]presenter.FieldChangeResponse, len(exp))
+	for i, change := range exp {
+		out[i] = presenter.FieldChangeResponse{
+			Field: change.Field,
+			Old:   change.Old,
+			New:   change.New,
+		}
+	}
+	return out
+}
+
+func mapRevisionResponse(exp *models.ItemRevision) *presenter.ItemRevisionResponse {
+	return &presenter.ItemRevisionResponse{
+		Id:         exp.Id,
+		CreateTime: exp.CreateTime,
+		ItemId:     exp.ItemId,
+		UserId:     exp.UserId,
+		Action:     exp.Action,
+		Snapshot: presenter.ItemSnapshotResponse{
+			Title:       exp.Snapshot.Title,
+			Description: exp.Snapshot.Description,
+			OwnerId:     exp.Snapshot.OwnerId,
+			Status:      exp.Snapshot.Status,
+			Metadata:    exp.Snapshot.Metadata,
+			DueAt:       exp.Snapshot.DueAt,
+			ParentId:    exp.Snapshot.ParentId,
+			DeleteTime:  exp.Snapshot.DeleteTime,
+		},
+		Changes: mapFieldChangesResponse(exp.Changes),
+	}
+}
+
+func mapRevisionsResponse(exp []*models.ItemRevision) []*presenter.ItemRevisionResponse {
+	out := make([]*presenter.ItemRevisionResponse, len(exp))
+	for i, revision := range exp {
+		out[i] = mapRevisionResponse(revision)
+	}
+	return out
+}
+
+func mapBulkResultsResponse(exp []*models.ItemBulkResult) []presenter.ItemBulkResultResponse {
+	out := make([]presenter.ItemBulkResultResponse, len(exp))
+	for i, result := range exp {
+		out[i] = presenter.ItemBulkResultResponse{Op: result.Op, Status: http.StatusOK}
+		if result.Err != nil {
+			parsedErr := httpErrors.ParseErrors(result.Err)
+			out[i].Status = parsedErr.GetStatus()
+			out[i].Error = &httpErrors.ErrResponse{
+				Status:     parsedErr.GetStatus(),
+				StatusText: parsedErr.GetStatusText(),
+				Msg:        parsedErr.GetMsg(),
+				Usage:      parsedErr.GetUsage(),
+			}
+		}
+		if result.Item != nil {
+			out[i].Item = mapModelResponse(result.Item)
+		}
+	}
+	return out
+}
+
+func mapImportRowErrorsResponse(exp []models.ItemImportRowError) []presenter.ItemImportRowErrorResponse {
+	out := make([]presenter.ItemImportRowErrorResponse, len(exp))
+	for i, rowError := range exp {
+		out[i] = presenter.ItemImportRowErrorResponse{
+			Line:  rowError.Line,
+			Error: rowError.Error,
+		}
+	}
+	return out
+}
+
+func mapImportResultResponse(exp *models.ItemImportResult) *presenter.ItemImportResultResponse {
+	return &presenter.ItemImportResultResponse{
+		CreatedRows: exp.CreatedRows,
+		FailedRows:  exp.FailedRows,
+		Errors:      mapImportRowErrorsResponse(exp.Errors),
+	}
+}
+
+func mapImportResponse(exp *models.ItemImport) *presenter.ItemImportResponse {
+	return &presenter.ItemImportResponse{
+		Id:          exp.Id,
+		CreateTime:  exp.CreateTime,
+		UpdateTime:  exp.UpdateTime,
+		Format:      exp.Format,
+		Status:      exp.Status,
+		Size:        exp.Size,
+		ReadBytes:   exp.ReadBytes,
+		CreatedRows: exp.CreatedRows,
+		FailedRows:  exp.FailedRows,
+		Errors:      mapImportRowErrorsResponse(exp.Errors),
+		Error:       exp.Error,
+		FinishTime:  exp.FinishTime,
+	}
+}
+
+func mapCommentResponse(exp *models.ItemComment) *presenter.ItemCommentResponse {
+	return &presenter.ItemCommentResponse{
+		Id:         exp.Id,
+		CreateTime: exp.CreateTime,
+		UpdateTime: exp.UpdateTime,
+		ItemId:     exp.ItemId,
+		AuthorId:   exp.AuthorId,
+		Body:       exp.Body,
+		EditedAt:   exp.EditedAt,
+	}
+}
+
+func mapCommentsResponse(exp []*models.ItemComment) []*presenter.ItemCommentResponse {
+	out := make([]*presenter.ItemCommentResponse, len(exp))
+	for i, comment := range exp {
+		out[i] = mapCommentResponse(comment)
+	}
+	return out
+}
+
+func mapActivityResponse(exp []*models.ItemActivity) []*presenter.ItemActivityResponse {
+	out := make([]*presenter.ItemActivityResponse, len(exp))
+	for i, activity := range exp {
+		out[i] = &presenter.ItemActivityResponse{
+			Type:   activity.Type,
+			Time:   activity.Time,
+			UserId: activity.UserId,
+		}
+		if activity.Comment != nil {
+			out[i].Comment = mapCommentResponse(activity.Comment)
+		}
+		if activity.Revision != nil {
+			out[i].Revision = mapRevisionResponse(activity.Revision)
+		}
+	}
+	return out
+}
+
+// metadataParams returns the query parameters filtering by a metadata key.
+func metadataParams(values url.Values) []string {
+	var params []string
+	for key := range values {
+		if strings.HasPrefix(key, items.MetadataParamPrefix) {
+			params = append(params, key)
+		}
+	}
+	return params
+}
+
+func mapTransitionResponse(exp *models.ItemTransition) *presenter.ItemTransitionResponse {
+	return &presenter.ItemTransitionResponse{
+		Id:         exp.Id,
+		CreateTime: exp.CreateTime,
+		ItemId:     exp.ItemId,
+		UserId:     exp.UserId,
+		Name:       exp.Name,
+		FromStatus: exp.FromStatus,
+		ToStatus:   exp.ToStatus,
+		Comment:    exp.Comment,
+	}
+}
+
+func mapTransitionsResponse(exp []*models.ItemTransition) []*presenter.ItemTransitionResponse {
+	out := make([]*presenter.ItemTransitionResponse, len(exp))
+	for i, transition := range exp {
+		out[i] = mapTransitionResponse(transition)
+	}
+	return out
+}
+
+func mapOwnershipTransferResponse(exp *models.OwnershipTransfer) *presenter.OwnershipTransferResponse {
+	return &presenter.OwnershipTransferResponse{
+		Id:         exp.Id,
+		CreateTime: exp.CreateTime,
+		UpdateTime: exp.UpdateTime,
+		ItemId:     exp.ItemId,
+		FromUserId: exp.FromUserId,
+		ToUserId:   exp.ToUserId,
+		Status:     exp.Status,
+	}
+}
+
+func mapOwnershipTransfersResponse(exp []*models.OwnershipTransfer) []*presenter.OwnershipTransferResponse {
+	out := make([]*presenter.OwnershipTransferResponse, len(exp))
+	for i, transfer := range exp {
+		out[i] = mapOwnershipTransferResponse(transfer)
+	}
+	return out
+}
+
+// GetChildren godoc
+// @Summary Read item children
+// @Description Retrieve the children of an item.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/children [get]
+func (h *itemHandler) GetChildren() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		children, err := h.itemsUC.GetChildren(r.Context(), uint(id), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelsResponse(children)))
+	}
+}
+
+// GetAncestors godoc
+// @Summary Read item ancestors
+// @Description Retrieve the ancestors of an item, parent first. The ancestors current user can not see are left out.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemNodeResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/ancestors [get]
+func (h *itemHandler) GetAncestors() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		ancestors, err := h.itemsUC.GetAncestors(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapNodesResponse(ancestors)))
+	}
+}
+
+// GetSubtree godoc
+// @Summary Read item subtree
+// @Description Retrieve the descendants of an item up to depth levels, by depth. The whole subtree is returned
+// @Description without depth, within the configured maximum depth and at most 1000 items. The descendants current
+// @Description user can not see are left out.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param depth query int false "depth" minimum(1)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.ItemNodeResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/subtree [get]
+func (h *itemHandler) GetSubtree() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		var depth int
+		if value := r.URL.Query().Get("depth"); value != "" {
+			depth, err = strconv.Atoi(value)
+			if err != nil || depth < 1 {
+				render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(errors.New("depth must be a positive integer")))) //nolint:errcheck
+				return
+			}
+		}
+
+		subtree, err := h.itemsUC.GetSubtree(r.Context(), uint(id), depth)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapNodesResponse(subtree)))
+	}
+}
+
+// Move godoc
+// @Summary Move item
+// @Description Move an item under another item, or to the root when parent_id is null. Current user must be able to
+// @Description edit both items. An item can not be moved under one of its descendants, and the tree can not be
+// @Description deeper than the configured maximum depth (422 otherwise).
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item Id"
+// @Param move body presenter.ItemMove true "Move item"
+// @Success 200 {object} responses.SuccessResponse[presenter.ItemResponse]
+// @Header 200 {string} ETag "version of the item"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /item/{id}/move [post]
+func (h *itemHandler) Move() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		move := new(presenter.ItemMove)
+
+		err = json.NewDecoder(r.Body).Decode(&move)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		item, err := h.itemsUC.Move(ctx, uint(id), &models.ItemMove{ParentId: move.ParentId})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, item.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(item)))
+	}
+}
+
+func mapNodesResponse(exp []*models.ItemNode) []*presenter.ItemNodeResponse {
+	out := make([]*presenter.ItemNodeResponse, len(exp))
+	for i, node := range exp {
+		out[i] = &presenter.ItemNodeResponse{
+			ItemResponse: *mapModelResponse(&node.Item),
+			Depth:        node.Depth,
+		}
 	}
 	return out
 }
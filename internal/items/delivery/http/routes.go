@@ -9,20 +9,73 @@ import (
 func MapItemRoute(router *chi.Mux, h items.Handlers, mw *middleware.MiddlewareManager) {
 	// Item routes
 	router.Route("/item", func(r chi.Router) {
+		// The calendar feed is authenticated by the secret token of its url,
+		// calendar apps can not send a bearer token.
+		r.Get("/calendar/{token}", h.Calendar())
 		// Protected routes
 		r.Group(func(r chi.Router) {
 			r.Use(mw.Verifier(true))
 			r.Use(mw.Authenticator())
 			r.Use(mw.CurrentUser())
 			r.Use(mw.ActiveUser())
+			r.Use(mw.CountApiCall())
 			r.Get("/", h.GetMulti())
-			r.Post("/", h.Create())
+			r.With(mw.Idempotency()).Post("/", h.Create())
+			r.Get("/shared", h.GetMultiShared())
+			r.Get("/search", h.Search())
+			r.Post("/bulk", h.Bulk())
+			r.Get("/trash", h.GetMultiTrash())
+			r.Get("/export", h.Export())
+			r.Post("/import", h.Import())
+			r.Get("/import/{importId}", h.GetImport())
+			// Ownership transfer routes
+			r.Get("/transfers", h.GetOwnershipTransfers())
+			r.Post("/transfers/{transferId}/accept", h.AcceptOwnershipTransfer())
+			r.Post("/transfers/{transferId}/decline", h.DeclineOwnershipTransfer())
+			r.Post("/transfers/{transferId}/cancel", h.CancelOwnershipTransfer())
+			r.With(mw.SuperUser()).Post("/transfers/all", h.TransferAllOwnership())
 			// Per id routes
 			r.Route("/{id}", func(r chi.Router) {
 				r.Get("/", h.Get())
 				// Admin routes
 				r.Delete("/", h.Delete())
 				r.Put("/", h.Update())
+				r.Patch("/", h.Patch())
+				r.Post("/restore", h.Restore())
+				// Share routes
+				r.Get("/shares", h.GetShares())
+				r.Post("/shares", h.CreateShare())
+				r.Delete("/shares/{userId}", h.DeleteShare())
+				// Revision routes
+				r.Get("/revisions", h.GetRevisions())
+				r.Get("/revisions/diff", h.DiffRevisions())
+				r.Post("/revisions/{revisionId}/revert", h.RevertRevision())
+				// Tag routes
+				r.Post("/tags", h.AddTags())
+				r.Delete("/tags/{tagId}", h.RemoveTag())
+				// Attachment routes
+				r.Get("/attachments", h.GetAttachments())
+				r.Post("/attachments", h.CreateAttachment())
+				r.Get("/attachments/{attachmentId}", h.GetAttachment())
+				r.Delete("/attachments/{attachmentId}", h.DeleteAttachment())
+				r.Get("/attachments/{attachmentId}/download", h.DownloadAttachment())
+				r.Get("/attachments/{attachmentId}/url", h.GetAttachmentURL())
+				// Comment routes
+				r.Get("/comments", h.GetComments())
+				r.Post("/comments", h.CreateComment())
+				r.Put("/comments/{commentId}", h.UpdateComment())
+				r.Delete("/comments/{commentId}", h.DeleteComment())
+				r.Get("/activity", h.GetActivity())
+
+				r.Get("/transitions", h.GetTransitions())
+				r.Post("/transitions", h.CreateTransition())
+
+				r.Post("/transfer", h.TransferOwnership())
+				// Tree routes
+				r.Get("/children", h.GetChildren())
+				r.Get("/ancestors", h.GetAncestors())
+				r.Get("/subtree", h.GetSubtree())
+				r.Post("/move", h.Move())
 			})
 		})
 	})
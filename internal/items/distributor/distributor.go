@@ -0,0 +1,134 @@
+package distributor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/distributor"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+type itemRedisTaskDistributor struct {
+	distributor.RedisTaskDistributor
+	inspector *asynq.Inspector
+}
+
+func NewItemRedisTaskDistributor(redisClient *asynq.Client, cfg *config.Config, loggger logger.Logger) items.ItemRedisTaskDistributor {
+	return &itemRedisTaskDistributor{
+		RedisTaskDistributor: distributor.NewRedisTaskDistributor(redisClient, cfg, loggger),
+		inspector:            distributor.NewRedisInspector(cfg),
+	}
+}
+
+func (distributor *itemRedisTaskDistributor) DistributeTaskProcessAttachment(ctx context.Context, payload *items.PayloadProcessAttachment, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload %w", err)
+	}
+
+	task := asynq.NewTask(items.TaskProcessAttachment, jsonPayload, opts...)
+
+	info, err := distributor.RedisClient.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	distributor.Logger.Infof("Type: %v, Queue: %v, Max-Retry: %v, Msg: queued task", task.Type(), info.Queue, info.MaxRetry)
+
+	return nil
+}
+
+func (distributor *itemRedisTaskDistributor) DistributeTaskImportItems(ctx context.Context, payload *items.PayloadImportItems, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload %w", err)
+	}
+
+	task := asynq.NewTask(items.TaskImportItems, jsonPayload, opts...)
+
+	info, err := distributor.RedisClient.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	distributor.Logger.Infof("Type: %v, Queue: %v, Max-Retry: %v, Msg: queued task", task.Type(), info.Queue, info.MaxRetry)
+
+	return nil
+}
+
+func (distributor *itemRedisTaskDistributor) DistributeTaskNotifyMentions(ctx context.Context, payload *items.PayloadNotifyMentions, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload %w", err)
+	}
+
+	task := asynq.NewTask(items.TaskNotifyMentions, jsonPayload, opts...)
+
+	info, err := distributor.RedisClient.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	distributor.Logger.Infof("Type: %v, Queue: %v, Max-Retry: %v, Msg: queued task", task.Type(), info.Queue, info.MaxRetry)
+
+	return nil
+}
+
+func (distributor *itemRedisTaskDistributor) DistributeTaskItemTransitioned(ctx context.Context, payload *items.PayloadItemTransitioned, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload %w", err)
+	}
+
+	task := asynq.NewTask(items.TaskItemTransitioned, jsonPayload, opts...)
+
+	info, err := distributor.RedisClient.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	distributor.Logger.Infof("Type: %v, Queue: %v, Max-Retry: %v, Msg: queued task", task.Type(), info.Queue, info.MaxRetry)
+
+	return nil
+}
+
+func (distributor *itemRedisTaskDistributor) DistributeTaskItemReminder(ctx context.Context, payload *items.PayloadItemReminder, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload %w", err)
+	}
+
+	task := asynq.NewTask(items.TaskItemReminder, jsonPayload, append(opts, asynq.TaskID(payload.TaskId()))...)
+
+	info, err := distributor.RedisClient.EnqueueContext(ctx, task)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		// The reminder of this due date is already scheduled.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	distributor.Logger.Infof("Type: %v, Queue: %v, Max-Retry: %v, Process-At: %v, Msg: scheduled task", task.Type(), info.Queue, info.MaxRetry, info.NextProcessAt)
+
+	return nil
+}
+
+func (distributor *itemRedisTaskDistributor) CancelTaskItemReminder(ctx context.Context, payload *items.PayloadItemReminder, queue string) error {
+	err := distributor.inspector.DeleteTask(queue, payload.TaskId())
+	if errors.Is(err, asynq.ErrTaskNotFound) || errors.Is(err, asynq.ErrQueueNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	distributor.Logger.Infof("Type: %v, Queue: %v, Id: %v, Msg: deleted task", items.TaskItemReminder, queue, payload.TaskId())
+
+	return nil
+}
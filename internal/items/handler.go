@@ -8,4 +8,45 @@ type Handlers interface {
 	GetMulti() func(w http.ResponseWriter, r *http.Request)
 	Delete() func(w http.ResponseWriter, r *http.Request)
 	Update() func(w http.ResponseWriter, r *http.Request)
+	Patch() func(w http.ResponseWriter, r *http.Request)
+	Bulk() func(w http.ResponseWriter, r *http.Request)
+	GetMultiShared() func(w http.ResponseWriter, r *http.Request)
+	GetShares() func(w http.ResponseWriter, r *http.Request)
+	CreateShare() func(w http.ResponseWriter, r *http.Request)
+	DeleteShare() func(w http.ResponseWriter, r *http.Request)
+	Search() func(w http.ResponseWriter, r *http.Request)
+	GetMultiTrash() func(w http.ResponseWriter, r *http.Request)
+	Restore() func(w http.ResponseWriter, r *http.Request)
+	GetRevisions() func(w http.ResponseWriter, r *http.Request)
+	DiffRevisions() func(w http.ResponseWriter, r *http.Request)
+	RevertRevision() func(w http.ResponseWriter, r *http.Request)
+	AddTags() func(w http.ResponseWriter, r *http.Request)
+	RemoveTag() func(w http.ResponseWriter, r *http.Request)
+	GetAttachments() func(w http.ResponseWriter, r *http.Request)
+	CreateAttachment() func(w http.ResponseWriter, r *http.Request)
+	GetAttachment() func(w http.ResponseWriter, r *http.Request)
+	DownloadAttachment() func(w http.ResponseWriter, r *http.Request)
+	GetAttachmentURL() func(w http.ResponseWriter, r *http.Request)
+	DeleteAttachment() func(w http.ResponseWriter, r *http.Request)
+	Export() func(w http.ResponseWriter, r *http.Request)
+	Import() func(w http.ResponseWriter, r *http.Request)
+	GetImport() func(w http.ResponseWriter, r *http.Request)
+	GetComments() func(w http.ResponseWriter, r *http.Request)
+	CreateComment() func(w http.ResponseWriter, r *http.Request)
+	UpdateComment() func(w http.ResponseWriter, r *http.Request)
+	DeleteComment() func(w http.ResponseWriter, r *http.Request)
+	GetActivity() func(w http.ResponseWriter, r *http.Request)
+	GetTransitions() func(w http.ResponseWriter, r *http.Request)
+	CreateTransition() func(w http.ResponseWriter, r *http.Request)
+	TransferOwnership() func(w http.ResponseWriter, r *http.Request)
+	TransferAllOwnership() func(w http.ResponseWriter, r *http.Request)
+	GetOwnershipTransfers() func(w http.ResponseWriter, r *http.Request)
+	AcceptOwnershipTransfer() func(w http.ResponseWriter, r *http.Request)
+	DeclineOwnershipTransfer() func(w http.ResponseWriter, r *http.Request)
+	CancelOwnershipTransfer() func(w http.ResponseWriter, r *http.Request)
+	Calendar() func(w http.ResponseWriter, r *http.Request)
+	GetChildren() func(w http.ResponseWriter, r *http.Request)
+	GetAncestors() func(w http.ResponseWriter, r *http.Request)
+	GetSubtree() func(w http.ResponseWriter, r *http.Request)
+	Move() func(w http.ResponseWriter, r *http.Request)
 }
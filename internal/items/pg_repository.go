@@ -2,16 +2,136 @@ package items
 
 import (
 	"context"
+	"time"
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+)
+
+// MetadataParamPrefix prefixes the list query parameters filtering by a
+// metadata key, e.g. `metadata.color=red`. The use case turns them into the
+// MetadataParam parameter, the JSON document the metadata must contain.
+const (
+	MetadataParamPrefix = "metadata."
+	MetadataParam       = "metadata"
 )
 
 type ItemPgRepository interface {
+	// WithTx runs fn with a repository bound to a new transaction, which is
+	// committed if fn returns nil and rolled back otherwise. The context of fn
+	// carries the transaction, the other repositories read it with
+	// ent.TxFromContext.
+	WithTx(ctx context.Context, fn func(ctx context.Context, repo ItemPgRepository) error) error
 	Get(ctx context.Context, id uint) (*models.Item, error)
-	GetMulti(ctx context.Context, offset, limit int) ([]*models.Item, error)
+	GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error)
 	Delete(ctx context.Context, id uint) (*models.Item, error)
 	Update(ctx context.Context, id uint, obj_update *models.ItemUpdate) (*models.Item, error)
-	GetMultiByOwnerId(ctx context.Context, ownerId uint, offset, limit int) ([]*models.Item, error)
+	GetMultiByOwnerId(ctx context.Context, ownerId uint, query *listQuery.Query) (*listQuery.Page[*models.Item], error)
 	CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.ItemCreate) (*models.Item, error)
 	DeleteWithoutGet(ctx context.Context, id uint) error
+	GetMultiSharedWith(ctx context.Context, userId uint, offset, limit int) ([]*models.Item, error)
+	GetShare(ctx context.Context, itemId uint, userId uint) (*models.ItemShare, error)
+	GetShares(ctx context.Context, itemId uint) ([]*models.ItemShare, error)
+	UpsertShare(ctx context.Context, itemId uint, obj_create *models.ItemShareCreate) (*models.ItemShare, error)
+	DeleteShare(ctx context.Context, itemId uint, userId uint) (*models.ItemShare, error)
+	Search(ctx context.Context, text string, offset, limit int) ([]*models.ItemSearchResult, error)
+	GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error)
+	Restore(ctx context.Context, id uint) (*models.Item, error)
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error)
+	GetRevisions(ctx context.Context, itemId uint, offset, limit int) ([]*models.ItemRevision, error)
+	GetRevision(ctx context.Context, itemId uint, revisionId uint) (*models.ItemRevision, error)
+	GetLatestRevision(ctx context.Context, itemId uint) (*models.ItemRevision, error)
+	CountTagsOfOwner(ctx context.Context, ownerId uint, tagIds []uint) (int, error)
+	AddTags(ctx context.Context, id uint, tagIds []uint) (*models.Item, error)
+	RemoveTags(ctx context.Context, id uint, tagIds []uint) (*models.Item, error)
+	GetAttachments(ctx context.Context, itemId uint) ([]*models.ItemAttachment, error)
+	GetAttachment(ctx context.Context, itemId uint, attachmentId uint) (*models.ItemAttachment, error)
+	CreateAttachment(ctx context.Context, obj *models.ItemAttachment) (*models.ItemAttachment, error)
+	UpdateAttachmentProcessed(ctx context.Context, attachmentId uint, obj_update *models.ItemAttachmentProcessed) (*models.ItemAttachment, error)
+	DeleteAttachment(ctx context.Context, itemId uint, attachmentId uint) (*models.ItemAttachment, error)
+	// GetTrashAttachmentKeys returns the storage keys of the attachments, and of
+	// their thumbnails, of the items PurgeTrash deletes with the same deletedBefore.
+	GetTrashAttachmentKeys(ctx context.Context, deletedBefore time.Time) ([]string, error)
+	// Iterate calls fn with the items matching the filters of query, by batches
+	// of batchSize items in id order. The items are limited to the items owned by
+	// ownerId when it is not nil.
+	Iterate(ctx context.Context, ownerId *uint, query *listQuery.Query, batchSize int, fn func(objs []*models.Item) error) error
+	CreateImport(ctx context.Context, ownerId uint, obj_create *models.ItemImportCreate) (*models.ItemImport, error)
+	GetImport(ctx context.Context, id uint) (*models.ItemImport, error)
+	UpdateImportProgress(ctx context.Context, id uint, obj_update *models.ItemImportProgress) (*models.ItemImport, error)
+	GetComments(ctx context.Context, itemId uint, offset, limit int) ([]*models.ItemComment, error)
+	// GetLatestComments returns the last comments of an item, newest first.
+	GetLatestComments(ctx context.Context, itemId uint, limit int) ([]*models.ItemComment, error)
+	GetComment(ctx context.Context, itemId uint, commentId uint) (*models.ItemComment, error)
+	CreateComment(ctx context.Context, itemId uint, authorId uint, obj_create *models.ItemCommentCreate) (*models.ItemComment, error)
+	UpdateComment(ctx context.Context, itemId uint, commentId uint, obj_update *models.ItemCommentUpdate) (*models.ItemComment, error)
+	DeleteComment(ctx context.Context, itemId uint, commentId uint) (*models.ItemComment, error)
+	// GetMembersByEmail returns the active users among the owner of an item and
+	// the users it is shared with whose email is in emails.
+	GetMembersByEmail(ctx context.Context, itemId uint, emails []string) ([]*models.User, error)
+	// GetMembers returns the active users among the owner of an item and the
+	// users it is shared with whose permission is in permissions.
+	GetMembers(ctx context.Context, itemId uint, permissions []string) ([]*models.ItemMember, error)
+	// UpdateStatus changes the status of an item from the from status, it
+	// fails with a precondition error if the item is no longer in the from status.
+	UpdateStatus(ctx context.Context, id uint, from string, to string) (*models.Item, error)
+	CreateTransition(ctx context.Context, obj *models.ItemTransition) (*models.ItemTransition, error)
+	// GetTransitions returns the transitions of an item, newest first.
+	GetTransitions(ctx context.Context, itemId uint, offset, limit int) ([]*models.ItemTransition, error)
+	// IsActiveUser reports whether a user exists and is active. Only admins
+	// and the system can see the other users.
+	IsActiveUser(ctx context.Context, userId uint) (bool, error)
+	// TransferOwner moves an item from fromUserId to toUserId and cancels the
+	// pending transfers of the item.
+	TransferOwner(ctx context.Context, id uint, fromUserId uint, toUserId uint) (*models.Item, error)
+	// TransferAllOwner moves every item of fromUserId, trashed ones included,
+	// to toUserId, cancels the pending transfers of fromUserId and returns the
+	// ids of the moved items.
+	TransferAllOwner(ctx context.Context, fromUserId uint, toUserId uint) ([]uint, error)
+	GetOwnershipTransfer(ctx context.Context, id uint) (*models.OwnershipTransfer, error)
+	// GetOwnershipTransfers returns the transfers sent or received by a user,
+	// newest first, status filters them when it is not empty.
+	GetOwnershipTransfers(ctx context.Context, userId uint, status string, offset, limit int) ([]*models.OwnershipTransfer, error)
+	// CreateOwnershipTransfers fails with a conflict when an item would have
+	// two pending transfers, which a partial unique index prevents.
+	CreateOwnershipTransfers(ctx context.Context, objs []*models.OwnershipTransfer) ([]*models.OwnershipTransfer, error)
+	// UpdateOwnershipTransferStatus changes the status of a transfer from the
+	// from status, it fails with a conflict if the status was changed.
+	UpdateOwnershipTransferStatus(ctx context.Context, id uint, from string, to string) (*models.OwnershipTransfer, error)
+	// GetUserIdByCalendarToken returns the active user with the hash of a
+	// calendar token, it is called with the system as viewer.
+	GetUserIdByCalendarToken(ctx context.Context, tokenHash string) (uint, error)
+	// GetDue returns the items visible to the viewer which are due after from,
+	// by due date.
+	GetDue(ctx context.Context, from time.Time, limit int) ([]*models.Item, error)
+	GetChildren(ctx context.Context, id uint, offset, limit int) ([]*models.Item, error)
+	// GetAncestors returns the ancestors of an item up to maxDepth levels,
+	// parent first.
+	GetAncestors(ctx context.Context, id uint, maxDepth int) ([]*models.ItemNode, error)
+	// GetSubtree returns the descendants of an item up to maxDepth levels, by
+	// depth.
+	GetSubtree(ctx context.Context, id uint, maxDepth int, limit int) ([]*models.ItemNode, error)
+	// GetAncestorIds returns the ids of the ancestors of an item up to
+	// maxDepth levels, parent first, trashed ones included.
+	GetAncestorIds(ctx context.Context, id uint, maxDepth int) ([]uint, error)
+	// GetSubtreeHeight returns the number of levels below an item, counting
+	// up to maxDepth levels.
+	GetSubtreeHeight(ctx context.Context, id uint, maxDepth int) (int, error)
+	// LockItems locks the rows of items until the end of the transaction. They
+	// are locked in id order, so concurrent locks do not deadlock.
+	LockItems(ctx context.Context, ids []uint) error
+	// UpdateParent moves an item under parentId, or to the root when it is nil.
+	UpdateParent(ctx context.Context, id uint, parentId *uint) (*models.Item, error)
+	// ReparentChildren moves the children of an item under parentId.
+	ReparentChildren(ctx context.Context, id uint, parentId *uint) (int, error)
+	// DeleteDescendants deletes the descendants of an item up to maxDepth
+	// levels and returns them.
+	DeleteDescendants(ctx context.Context, id uint, maxDepth int) ([]*models.Item, error)
+	// RestoreDescendants restores the descendants of a deleted item which were
+	// deleted with it or after it and returns them. It must be called before
+	// the item is restored.
+	RestoreDescendants(ctx context.Context, id uint, maxDepth int) ([]*models.Item, error)
+	// DetachFromDeletedParent moves an item to the root if its parent is
+	// deleted and reports whether it was moved.
+	DetachFromDeletedParent(ctx context.Context, id uint) (bool, error)
 }
@@ -1,18 +1,266 @@
 package presenter
 
+import (
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
 type ItemCreate struct {
-	Title       string `json:"title" validate:"required" example:"item title"`
-	Description string `json:"description" validate:"required" example:"item description"`
+	Title       string                 `json:"title" validate:"required" example:"item title"`
+	Description string                 `json:"description" example:"item description"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" swaggertype:"object"`
+	DueAt       *time.Time             `json:"due_at,omitempty" example:"2023-09-01T09:00:00Z"`
+	ParentId    *uint                  `json:"parent_id,omitempty" example:"1"`
 }
 
 type ItemResponse struct {
-	Id          uint   `json:"id,omitempty"`
-	Title       string `json:"title,omitempty"`
-	Description string `json:"description,omitempty"`
-	OwnerId     uint   `json:"owner_id,omitempty"`
+	Id          uint                   `json:"id,omitempty"`
+	Title       string                 `json:"title,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	OwnerId     uint                   `json:"owner_id,omitempty"`
+	Status      string                 `json:"status,omitempty" example:"draft"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" swaggertype:"object"`
+	DueAt       *time.Time             `json:"due_at,omitempty"`
+	ParentId    *uint                  `json:"parent_id,omitempty"`
+	DeleteTime  *time.Time             `json:"delete_time,omitempty"`
+	Version     int                    `json:"version" example:"1"`
+	Tags        []ItemTagResponse      `json:"tags"`
+}
+
+type ItemTagResponse struct {
+	Id    uint   `json:"id"`
+	Name  string `json:"name" example:"urgent"`
+	Color string `json:"color" example:"#ff0000"`
+}
+
+type ItemTagsAdd struct {
+	TagIds []uint `json:"tag_ids" validate:"required,min=1" example:"1,2"`
 }
 
 type ItemUpdate struct {
-	Title       string `json:"title" example:"item title"`
-	Description string `json:"description" example:"item description"`
+	Title       string                 `json:"title" example:"item title"`
+	Description string                 `json:"description" example:"item description"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" swaggertype:"object"`
+	DueAt       *time.Time             `json:"due_at,omitempty" example:"2023-09-01T09:00:00Z"`
+}
+
+// ItemPatch is the document PATCH requests are applied to, it is validated
+// with the same rules as ItemCreate.
+type ItemPatch struct {
+	Title       string                 `json:"title" validate:"required" example:"item title"`
+	Description string                 `json:"description" example:"item description"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" swaggertype:"object"`
+	DueAt       *time.Time             `json:"due_at" example:"2023-09-01T09:00:00Z"`
+}
+
+type ItemShareCreate struct {
+	UserId     uint   `json:"user_id" validate:"required" example:"2"`
+	Permission string `json:"permission" validate:"required,oneof=viewer editor" example:"viewer"`
+}
+
+type ItemShareResponse struct {
+	ItemId     uint      `json:"item_id,omitempty"`
+	UserId     uint      `json:"user_id,omitempty"`
+	Permission string    `json:"permission,omitempty"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
+}
+
+type ItemAttachmentResponse struct {
+	Id          uint      `json:"id"`
+	ItemId      uint      `json:"item_id"`
+	UploaderId  *uint     `json:"uploader_id"`
+	Filename    string    `json:"filename" example:"report.pdf"`
+	ContentType string    `json:"content_type" example:"application/pdf"`
+	Size        int64     `json:"size" example:"1024"`
+	Checksum    string    `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreateTime  time.Time `json:"create_time"`
+	// Images only, the thumbnails are listed once the processing status is processed.
+	ProcessingStatus *string                           `json:"processing_status,omitempty" enums:"pending,processed,failed"`
+	Width            *int                              `json:"width,omitempty" example:"1920"`
+	Height           *int                              `json:"height,omitempty" example:"1080"`
+	Thumbnails       []ItemAttachmentThumbnailResponse `json:"thumbnails,omitempty"`
+}
+
+type ItemAttachmentThumbnailResponse struct {
+	Name   string `json:"name" example:"small"`
+	Width  int    `json:"width" example:"128"`
+	Height int    `json:"height" example:"72"`
+	Url    string `json:"url"`
+}
+
+type ItemAttachmentURLResponse struct {
+	Url       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ItemSearchResponse struct {
+	ItemResponse
+	Rank                 float64 `json:"rank"`
+	TitleHighlight       string  `json:"title_highlight,omitempty" example:"<mark>item</mark> title"`
+	DescriptionHighlight string  `json:"description_highlight,omitempty" example:"<mark>item</mark> description"`
+}
+
+// ItemNodeResponse is an item of a subtree or of the ancestors of an item,
+// depth is its distance to that item.
+type ItemNodeResponse struct {
+	ItemResponse
+	Depth int `json:"depth" example:"1"`
+}
+
+// ItemMove moves an item under parent_id, or to the root when it is null.
+type ItemMove struct {
+	ParentId *uint `json:"parent_id" example:"1"`
+}
+
+type ItemSnapshotResponse struct {
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	OwnerId     uint                   `json:"owner_id"`
+	Status      string                 `json:"status,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" swaggertype:"object"`
+	DueAt       *time.Time             `json:"due_at,omitempty"`
+	ParentId    *uint                  `json:"parent_id,omitempty"`
+	DeleteTime  *time.Time             `json:"delete_time,omitempty"`
+}
+
+type FieldChangeResponse struct {
+	Field string      `json:"field" example:"title"`
+	Old   interface{} `json:"old" swaggertype:"string" example:"old title"`
+	New   interface{} `json:"new" swaggertype:"string" example:"new title"`
+}
+
+type ItemRevisionResponse struct {
+	Id         uint                  `json:"id"`
+	CreateTime time.Time             `json:"create_time"`
+	ItemId     uint                  `json:"item_id"`
+	UserId     *uint                 `json:"user_id"`
+	Action     string                `json:"action" enums:"create,update,delete,restore,transfer" example:"update"`
+	Snapshot   ItemSnapshotResponse  `json:"snapshot"`
+	Changes    []FieldChangeResponse `json:"changes"`
+}
+
+type ItemRevisionDiffResponse struct {
+	From    ItemRevisionResponse  `json:"from"`
+	To      ItemRevisionResponse  `json:"to"`
+	Changes []FieldChangeResponse `json:"changes"`
+}
+
+type ItemBulkOperation struct {
+	Op          string                 `json:"op" validate:"required,oneof=create update delete" example:"update"`
+	Id          uint                   `json:"id,omitempty" validate:"required_unless=Op create" example:"1"`
+	Title       *string                `json:"title,omitempty" example:"item title"`
+	Description *string                `json:"description,omitempty" example:"item description"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" swaggertype:"object"`
+	DueAt       *time.Time             `json:"due_at,omitempty" example:"2023-09-01T09:00:00Z"`
+	ParentId    *uint                  `json:"parent_id,omitempty" example:"1"`
+	Version     *int                   `json:"version,omitempty" example:"1"`
+}
+
+type ItemBulk struct {
+	Operations []ItemBulkOperation `json:"operations" validate:"required,min=1,max=500,dive"`
+}
+
+type ItemBulkResultResponse struct {
+	Op     string                  `json:"op" example:"update"`
+	Status int                     `json:"status" example:"200"`
+	Item   *ItemResponse           `json:"item,omitempty"`
+	Error  *httpErrors.ErrResponse `json:"error,omitempty"`
+}
+
+type ItemBulkResponse struct {
+	Atomic  bool                     `json:"atomic"`
+	Results []ItemBulkResultResponse `json:"results"`
+}
+
+type ItemImportRowErrorResponse struct {
+	Line  int    `json:"line" example:"3"`
+	Error string `json:"error" example:"Key: 'ItemCreate.Title' Error:Field validation for 'Title' failed on the 'required' tag"`
+}
+
+type ItemImportResultResponse struct {
+	CreatedRows int                          `json:"created_rows" example:"10"`
+	FailedRows  int                          `json:"failed_rows" example:"1"`
+	Errors      []ItemImportRowErrorResponse `json:"errors"`
+}
+
+type ItemImportResponse struct {
+	Id          uint                         `json:"id"`
+	CreateTime  time.Time                    `json:"create_time"`
+	UpdateTime  time.Time                    `json:"update_time"`
+	Format      string                       `json:"format" enums:"csv,json,ndjson" example:"csv"`
+	Status      string                       `json:"status" enums:"pending,running,succeeded,failed" example:"running"`
+	Size        int64                        `json:"size" example:"1048576"`
+	ReadBytes   int64                        `json:"read_bytes" example:"524288"`
+	CreatedRows int                          `json:"created_rows" example:"10"`
+	FailedRows  int                          `json:"failed_rows" example:"1"`
+	Errors      []ItemImportRowErrorResponse `json:"errors"`
+	Error       *string                      `json:"error,omitempty"`
+	FinishTime  *time.Time                   `json:"finish_time,omitempty"`
+}
+
+type ItemCommentCreate struct {
+	Body string `json:"body" validate:"required,max=10000" example:"what do you think @alice@example.com?"`
+}
+
+type ItemCommentUpdate struct {
+	Body string `json:"body" validate:"required,max=10000" example:"what do you think @alice@example.com?"`
+}
+
+type ItemCommentResponse struct {
+	Id         uint       `json:"id"`
+	CreateTime time.Time  `json:"create_time"`
+	UpdateTime time.Time  `json:"update_time"`
+	ItemId     uint       `json:"item_id"`
+	AuthorId   *uint      `json:"author_id"`
+	Body       string     `json:"body" example:"what do you think @alice@example.com?"`
+	EditedAt   *time.Time `json:"edited_at"`
+}
+
+type ItemActivityResponse struct {
+	Type     string                `json:"type" enums:"comment,revision" example:"comment"`
+	Time     time.Time             `json:"time"`
+	UserId   *uint                 `json:"user_id"`
+	Comment  *ItemCommentResponse  `json:"comment,omitempty"`
+	Revision *ItemRevisionResponse `json:"revision,omitempty"`
+}
+
+type ItemTransitionCreate struct {
+	Transition string  `json:"transition" validate:"required" example:"submit"`
+	Comment    *string `json:"comment" validate:"omitempty,max=10000" example:"ready for review"`
+}
+
+type ItemTransitionResponse struct {
+	Id         uint      `json:"id"`
+	CreateTime time.Time `json:"create_time"`
+	ItemId     uint      `json:"item_id"`
+	UserId     *uint     `json:"user_id"`
+	Name       string    `json:"name" example:"submit"`
+	FromStatus string    `json:"from_status" example:"draft"`
+	ToStatus   string    `json:"to_status" example:"review"`
+	Comment    *string   `json:"comment" example:"ready for review"`
+}
+
+type OwnershipTransferCreate struct {
+	ToUserId uint `json:"to_user_id" validate:"required" example:"2"`
+}
+
+type OwnershipTransferAll struct {
+	FromUserId uint `json:"from_user_id" validate:"required" example:"2"`
+	ToUserId   uint `json:"to_user_id" validate:"required" example:"3"`
+}
+
+type OwnershipTransferAllResponse struct {
+	Count int `json:"count"`
+}
+
+type OwnershipTransferResponse struct {
+	Id         uint      `json:"id"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
+	ItemId     uint      `json:"item_id"`
+	FromUserId uint      `json:"from_user_id"`
+	ToUserId   uint      `json:"to_user_id"`
+	Status     string    `json:"status" enums:"pending,accepted,declined,cancelled" example:"pending"`
 }
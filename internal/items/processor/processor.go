@@ -0,0 +1,506 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/transfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/processor"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/emailTemplates"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/imaging"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/sendEmail"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
+)
+
+type itemRedisTaskProcessor struct {
+	processor.RedisTaskProcessor
+	pgRepo                 items.ItemPgRepository
+	quotasUC               quotas.QuotaUseCase
+	storage                storage.Storage
+	emailSender            sendEmail.EmailSender
+	emailTemplateGenerator emailTemplates.EmailTemplatesGenerator
+}
+
+func NewItemRedisTaskProcessor(
+	server *asynq.Server,
+	cfg *config.Config,
+	logger logger.Logger,
+	pgRepo items.ItemPgRepository,
+	quotasUC quotas.QuotaUseCase,
+	storage storage.Storage,
+	emailSender sendEmail.EmailSender,
+) items.ItemRedisTaskProcessor {
+	return &itemRedisTaskProcessor{
+		RedisTaskProcessor:     processor.NewRedisTaskProcessor(server, cfg, logger),
+		pgRepo:                 pgRepo,
+		quotasUC:               quotasUC,
+		storage:                storage,
+		emailSender:            emailSender,
+		emailTemplateGenerator: emailTemplates.NewEmailTemplatesGenerator(cfg),
+	}
+}
+
+func (processor *itemRedisTaskProcessor) ProcessTaskPurgeTrash(ctx context.Context, task *asynq.Task) error {
+	deletedBefore := time.Now().AddDate(0, 0, -processor.Cfg.Trash.RetentionDays)
+
+	ctx = viewer.NewSystemContext(ctx)
+
+	// The attachment rows are deleted with their items, collect their content first.
+	attachmentKeys, err := processor.pgRepo.GetTrashAttachmentKeys(ctx, deletedBefore)
+	if err != nil {
+		return fmt.Errorf("failed to get attachments of deleted items: %w", err)
+	}
+
+	count, err := processor.pgRepo.PurgeTrash(ctx, deletedBefore)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted items: %w", err)
+	}
+
+	for _, key := range attachmentKeys {
+		if err := processor.storage.Delete(ctx, key); err != nil {
+			processor.Logger.Warnf("Type: %v, Key: %v, Msg: failed to delete attachment content: %v", task.Type(), key, err)
+		}
+	}
+
+	processor.Logger.Infof("Type: %v, Count: %v, Msg: deleted items purged", task.Type(), count)
+
+	return nil
+}
+
+// thumbnailSizes are the thumbnails generated for image attachments.
+var thumbnailSizes = []imaging.Size{
+	{Name: "small", Max: 128},
+	{Name: "medium", Max: 512},
+	{Name: "large", Max: 1024},
+}
+
+func (processor *itemRedisTaskProcessor) ProcessTaskProcessAttachment(ctx context.Context, task *asynq.Task) error {
+	var payload items.PayloadProcessAttachment
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	ctx = viewer.NewSystemContext(ctx)
+
+	attachment, err := processor.pgRepo.GetAttachment(ctx, payload.ItemId, payload.AttachmentId)
+	if ent.IsNotFound(err) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: attachment was deleted", task.Type(), payload.AttachmentId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	content, err := processor.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to get attachment content: %w", err)
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return fmt.Errorf("failed to read attachment content: %w", err)
+	}
+
+	result, err := imaging.Process(data, thumbnailSizes)
+	if errors.Is(err, imaging.ErrUnsupported) {
+		if _, err := processor.pgRepo.UpdateAttachmentProcessed(ctx, attachment.Id, &models.ItemAttachmentProcessed{
+			Status: models.ItemAttachmentStatusFailed,
+		}); err != nil {
+			return fmt.Errorf("failed to update attachment: %w", err)
+		}
+		processor.Logger.Warnf("Type: %v, Id: %v, Msg: failed to process image: %v", task.Type(), attachment.Id, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to process image: %w", err)
+	}
+
+	processed := &models.ItemAttachmentProcessed{
+		Status:     models.ItemAttachmentStatusProcessed,
+		Width:      &result.Width,
+		Height:     &result.Height,
+		Thumbnails: make([]models.ItemAttachmentThumbnail, len(result.Thumbnails)),
+	}
+
+	for i, thumbnail := range result.Thumbnails {
+		key := fmt.Sprintf("%s-%s", attachment.StorageKey, thumbnail.Name)
+
+		err := processor.storage.Put(ctx, key, bytes.NewReader(thumbnail.Data), int64(len(thumbnail.Data)), thumbnail.ContentType)
+		if err != nil {
+			return fmt.Errorf("failed to store thumbnail: %w", err)
+		}
+
+		processed.Thumbnails[i] = models.ItemAttachmentThumbnail{
+			Name:        thumbnail.Name,
+			Width:       thumbnail.Width,
+			Height:      thumbnail.Height,
+			ContentType: thumbnail.ContentType,
+			StorageKey:  key,
+		}
+	}
+
+	// The content without its metadata replaces the upload, under the same key.
+	if result.Stripped != nil {
+		err := processor.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(result.Stripped), int64(len(result.Stripped)), attachment.ContentType)
+		if err != nil {
+			return fmt.Errorf("failed to store stripped image: %w", err)
+		}
+
+		size := int64(len(result.Stripped))
+		sum := sha256.Sum256(result.Stripped)
+		checksum := hex.EncodeToString(sum[:])
+		processed.Size = &size
+		processed.Checksum = &checksum
+	}
+
+	_, err = processor.pgRepo.UpdateAttachmentProcessed(ctx, attachment.Id, processed)
+	if ent.IsNotFound(err) {
+		// The attachment was deleted meanwhile, its thumbnails are not referenced.
+		for _, thumbnail := range processed.Thumbnails {
+			processor.storage.Delete(ctx, thumbnail.StorageKey) //nolint:errcheck
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update attachment: %w", err)
+	}
+
+	processor.Logger.Infof("Type: %v, Id: %v, Thumbnails: %v, Msg: attachment processed", task.Type(), attachment.Id, len(processed.Thumbnails))
+
+	return nil
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r    io.Reader
+	read int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.read += int64(n)
+	return n, err
+}
+
+// ProcessTaskImportItems imports the file of an item import. The rows already
+// created are not rolled back, so an import interrupted while running is marked
+// as failed instead of being started again.
+func (processor *itemRedisTaskProcessor) ProcessTaskImportItems(ctx context.Context, task *asynq.Task) error {
+	var payload items.PayloadImportItems
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	systemCtx := viewer.NewSystemContext(ctx)
+
+	obj, err := processor.pgRepo.GetImport(systemCtx, payload.ImportId)
+	if ent.IsNotFound(err) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: import was deleted", task.Type(), payload.ImportId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get import: %w", err)
+	}
+
+	switch obj.Status {
+	case models.ItemImportStatusSucceeded, models.ItemImportStatusFailed:
+		return nil
+	case models.ItemImportStatusRunning:
+		return processor.finishImport(systemCtx, task, obj, &models.ItemImportResult{
+			CreatedRows: obj.CreatedRows,
+			FailedRows:  obj.FailedRows,
+			Errors:      obj.Errors,
+		}, obj.ReadBytes, errors.New("import was interrupted"))
+	}
+
+	content, err := processor.storage.Get(ctx, obj.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to get import content: %w", err)
+	}
+	defer content.Close()
+
+	if _, err := processor.pgRepo.UpdateImportProgress(systemCtx, obj.Id, &models.ItemImportProgress{
+		Status: models.ItemImportStatusRunning,
+	}); err != nil {
+		return fmt.Errorf("failed to update import: %w", err)
+	}
+
+	counter := &countingReader{r: content}
+
+	// The items are created as the owner, with the same checks as the API.
+	ownerCtx := viewer.NewContext(ctx, &viewer.Viewer{Id: obj.OwnerId, Role: viewer.RoleUser})
+
+	quota := transfer.ItemsQuota(processor.quotasUC, obj.OwnerId)
+
+	result, err := transfer.Import(ownerCtx, processor.pgRepo, obj.OwnerId, processor.Cfg.Workflow.Initial, obj.Format, counter, quota, func(result *models.ItemImportResult) error {
+		_, err := processor.pgRepo.UpdateImportProgress(systemCtx, obj.Id, &models.ItemImportProgress{
+			Status:      models.ItemImportStatusRunning,
+			ReadBytes:   counter.read,
+			CreatedRows: result.CreatedRows,
+			FailedRows:  result.FailedRows,
+			Errors:      result.Errors,
+		})
+		return err
+	})
+
+	return processor.finishImport(systemCtx, task, obj, result, counter.read, err)
+}
+
+// finishImport saves the outcome of an import and deletes its file.
+func (processor *itemRedisTaskProcessor) finishImport(
+	ctx context.Context,
+	task *asynq.Task,
+	obj *models.ItemImport,
+	result *models.ItemImportResult,
+	readBytes int64,
+	importErr error,
+) error {
+	finishTime := time.Now()
+	progress := &models.ItemImportProgress{
+		Status:      models.ItemImportStatusSucceeded,
+		ReadBytes:   readBytes,
+		CreatedRows: result.CreatedRows,
+		FailedRows:  result.FailedRows,
+		Errors:      result.Errors,
+		FinishTime:  &finishTime,
+	}
+	if importErr != nil {
+		message := importErr.Error()
+		var restErr httpErrors.ErrRest
+		if errors.As(importErr, &restErr) {
+			message = restErr.GetMsg()
+		}
+		progress.Status = models.ItemImportStatusFailed
+		progress.Error = &message
+	}
+
+	if _, err := processor.pgRepo.UpdateImportProgress(ctx, obj.Id, progress); err != nil {
+		return fmt.Errorf("failed to update import: %w", err)
+	}
+
+	if err := processor.storage.Delete(ctx, obj.StorageKey); err != nil {
+		processor.Logger.Warnf("Type: %v, Key: %v, Msg: failed to delete import content: %v", task.Type(), obj.StorageKey, err)
+	}
+
+	processor.Logger.Infof(
+		"Type: %v, Id: %v, Status: %v, Created: %v, Failed: %v, Msg: import finished",
+		task.Type(), obj.Id, progress.Status, progress.CreatedRows, progress.FailedRows,
+	)
+
+	return nil
+}
+
+// ProcessTaskNotifyMentions emails the users mentioned in a comment. Only the
+// users who can see the item are notified, the author is never notified. The
+// emails already sent are not sent again, so the task is not retried once the
+// first email is sent.
+func (processor *itemRedisTaskProcessor) ProcessTaskNotifyMentions(ctx context.Context, task *asynq.Task) error {
+	var payload items.PayloadNotifyMentions
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	ctx = viewer.NewSystemContext(ctx)
+
+	comment, err := processor.pgRepo.GetComment(ctx, payload.ItemId, payload.CommentId)
+	if ent.IsNotFound(err) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: comment was deleted", task.Type(), payload.CommentId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	item, err := processor.pgRepo.Get(ctx, payload.ItemId)
+	if ent.IsNotFound(err) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: item was deleted", task.Type(), payload.ItemId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get item: %w", err)
+	}
+
+	members, err := processor.pgRepo.GetMembersByEmail(ctx, payload.ItemId, payload.Emails)
+	if err != nil {
+		return fmt.Errorf("failed to get mentioned users: %w", err)
+	}
+
+	sent := 0
+	for _, member := range members {
+		if comment.AuthorId != nil && member.Id == *comment.AuthorId {
+			continue
+		}
+
+		bodyHtml, bodyPlain, err := processor.emailTemplateGenerator.GenerateMentionTemplate(ctx, member.Name, payload.AuthorName, item.Title, comment.Body)
+		if err != nil {
+			return fmt.Errorf("failed to generate email: %w", err)
+		}
+
+		if err := processor.emailSender.SendEmail(
+			ctx,
+			processor.Cfg.Email.From,
+			member.Email,
+			processor.Cfg.Email.MentionSubject,
+			bodyHtml,
+			bodyPlain,
+		); err != nil {
+			if sent == 0 {
+				return fmt.Errorf("failed to send mention email: %w", err)
+			}
+			processor.Logger.Warnf("Type: %v, Id: %v, Msg: failed to send mention email: %v", task.Type(), comment.Id, err)
+			continue
+		}
+		sent++
+	}
+
+	processor.Logger.Infof("Type: %v, Id: %v, Count: %v, Msg: mention emails sended", task.Type(), comment.Id, sent)
+
+	return nil
+}
+
+// ProcessTaskItemTransitioned emails the users with a role to notify of the
+// transition, except the user who made it. Like the mention emails, the task is
+// not retried once the first email is sent.
+func (processor *itemRedisTaskProcessor) ProcessTaskItemTransitioned(ctx context.Context, task *asynq.Task) error {
+	var payload items.PayloadItemTransitioned
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	ctx = viewer.NewSystemContext(ctx)
+
+	item, err := processor.pgRepo.Get(ctx, payload.ItemId)
+	if ent.IsNotFound(err) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: item was deleted", task.Type(), payload.ItemId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get item: %w", err)
+	}
+
+	members, err := processor.pgRepo.GetMembers(ctx, payload.ItemId, payload.Notify)
+	if err != nil {
+		return fmt.Errorf("failed to get item members: %w", err)
+	}
+
+	sent := 0
+	for _, member := range members {
+		if member.Id == payload.UserId {
+			continue
+		}
+
+		bodyHtml, bodyPlain, err := processor.emailTemplateGenerator.GenerateTransitionTemplate(
+			ctx,
+			member.Name,
+			payload.UserName,
+			item.Title,
+			payload.From,
+			payload.To,
+			payload.Comment,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate email: %w", err)
+		}
+
+		if err := processor.emailSender.SendEmail(
+			ctx,
+			processor.Cfg.Email.From,
+			member.Email,
+			processor.Cfg.Email.TransitionSubject,
+			bodyHtml,
+			bodyPlain,
+		); err != nil {
+			if sent == 0 {
+				return fmt.Errorf("failed to send transition email: %w", err)
+			}
+			processor.Logger.Warnf("Type: %v, Id: %v, Msg: failed to send transition email: %v", task.Type(), payload.TransitionId, err)
+			continue
+		}
+		sent++
+	}
+
+	processor.Logger.Infof("Type: %v, Id: %v, Count: %v, Msg: transition emails sended", task.Type(), payload.TransitionId, sent)
+
+	return nil
+}
+
+// ProcessTaskItemReminder emails the users with a role to notify of the
+// reminder. Reminders of a due date the item does not have anymore are
+// skipped, as well as the reminders of deleted items.
+func (processor *itemRedisTaskProcessor) ProcessTaskItemReminder(ctx context.Context, task *asynq.Task) error {
+	var payload items.PayloadItemReminder
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	ctx = viewer.NewSystemContext(ctx)
+
+	item, err := processor.pgRepo.Get(ctx, payload.ItemId)
+	if ent.IsNotFound(err) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: item was deleted", task.Type(), payload.ItemId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get item: %w", err)
+	}
+
+	if item.DueAt == nil || !item.DueAt.Equal(payload.DueAt) {
+		processor.Logger.Infof("Type: %v, Id: %v, Msg: due date was changed", task.Type(), payload.ItemId)
+		return nil
+	}
+
+	members, err := processor.pgRepo.GetMembers(ctx, payload.ItemId, processor.Cfg.Reminder.Notify)
+	if err != nil {
+		return fmt.Errorf("failed to get item members: %w", err)
+	}
+
+	sent := 0
+	for _, member := range members {
+		bodyHtml, bodyPlain, err := processor.emailTemplateGenerator.GenerateReminderTemplate(
+			ctx,
+			member.Name,
+			item.Title,
+			*item.DueAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate email: %w", err)
+		}
+
+		if err := processor.emailSender.SendEmail(
+			ctx,
+			processor.Cfg.Email.From,
+			member.Email,
+			processor.Cfg.Email.ReminderSubject,
+			bodyHtml,
+			bodyPlain,
+		); err != nil {
+			if sent == 0 {
+				return fmt.Errorf("failed to send reminder email: %w", err)
+			}
+			processor.Logger.Warnf("Type: %v, Id: %v, Msg: failed to send reminder email: %v", task.Type(), payload.ItemId, err)
+			continue
+		}
+		sent++
+	}
+
+	processor.Logger.Infof("Type: %v, Id: %v, Count: %v, Msg: reminder emails sended", task.Type(), payload.ItemId, sent)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/repository"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestGetMultiCursor walks the items one page at a time after the cursor of
+// the previous page, for every sortable field, and checks that every item is
+// listed once in the order of the field.
+func TestGetMultiCursor(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:cursor?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := viewer.NewSystemContext(context.Background())
+	repo := repository.CreateItemPgRepository(client)
+
+	var ownerIds []uint
+	for _, name := range []string{"first", "second"} {
+		ownerIds = append(ownerIds, client.User.Create().
+			SetName(name).
+			SetEmail(name+"@example.com").
+			SetPassword("password").
+			SaveX(ctx).ID)
+	}
+
+	// Duplicated values check that the pages break the ties by id.
+	statuses := []string{"draft", "done", "draft", "in_progress", "done", "draft"}
+	titles := []string{"b", "a", "c", "a", "b", "d"}
+	for i := range statuses {
+		client.Item.Create().
+			SetTitle(titles[i]).
+			SetDescription("").
+			SetStatus(statuses[i]).
+			SetOwnerID(ownerIds[i%2]).
+			SaveX(ctx)
+		// The times are compared with their text in the cursors.
+		time.Sleep(time.Millisecond)
+	}
+
+	var fields []string
+	for field, spec := range repository.ItemListFields {
+		if spec.Sortable {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		for _, desc := range []bool{false, true} {
+			s := listQuery.Sort{Field: field, Desc: desc}
+			t.Run(s.String(), func(t *testing.T) {
+				all, err := repo.GetMulti(ctx, &listQuery.Query{
+					Sorts: []listQuery.Sort{s},
+					Limit: len(statuses),
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var want, got []uint
+				for _, obj := range all.Items {
+					want = append(want, obj.Id)
+				}
+
+				var after *listQuery.Cursor
+				for i := 0; i <= len(statuses); i++ {
+					page, err := repo.GetMulti(ctx, &listQuery.Query{
+						Sorts: []listQuery.Sort{s},
+						Limit: 1,
+						After: after,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, obj := range page.Items {
+						got = append(got, obj.Id)
+					}
+					if page.NextCursor == "" {
+						break
+					}
+					if after, err = listQuery.DecodeCursor(page.NextCursor); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				if fmt.Sprint(got) != fmt.Sprint(want) {
+					t.Fatalf("got %v walking the pages, want %v", got, want)
+				}
+			})
+		}
+	}
+}
@@ -2,14 +2,53 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/schema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 )
 
+// ItemListFields are the item columns that list queries may filter and sort by.
+var ItemListFields = listQuery.Fields{
+	item.FieldID:          {Kind: listQuery.KindUint, Sortable: true},
+	item.FieldCreateTime:  {Kind: listQuery.KindTime, Sortable: true},
+	item.FieldUpdateTime:  {Kind: listQuery.KindTime, Sortable: true},
+	item.FieldTitle:       {Kind: listQuery.KindString, Sortable: true},
+	item.FieldDescription: {Kind: listQuery.KindString},
+	item.FieldOwnerID:     {Kind: listQuery.KindUint, Sortable: true},
+	item.FieldStatus:      {Kind: listQuery.KindString, Sortable: true},
+	item.FieldDueAt:       {Kind: listQuery.KindTime},
+}
+
+// ItemTrashListFields are the columns of the trash list, which is the only
+// list whose items have a delete time.
+var ItemTrashListFields = ItemListFields.With(listQuery.Fields{
+	item.FieldDeleteTime: {Kind: listQuery.KindTime, Sortable: true},
+})
+
 type ItemPgRepo struct {
 	client *ent.Client
 }
@@ -20,9 +59,71 @@ func CreateItemPgRepository(client *ent.Client) items.ItemPgRepository {
 
 func (r *ItemPgRepo) mapModel(db_obj *ent.Item) *models.Item {
 	return &models.Item{
-		Id:      db_obj.ID,
-		Title:   db_obj.Title,
-		OwnerId: db_obj.OwnerID,
+		Id:          db_obj.ID,
+		Title:       db_obj.Title,
+		Description: db_obj.Description,
+		OwnerId:     db_obj.OwnerID,
+		Status:      db_obj.Status,
+		Metadata:    db_obj.Metadata,
+		DueAt:       db_obj.DueAt,
+		ParentId:    db_obj.ParentID,
+		DeleteTime:  db_obj.DeleteTime,
+		Version:     db_obj.Version,
+		Tags:        r.mapTagModels(db_obj.Edges.Tags),
+	}
+}
+
+func (r *ItemPgRepo) mapTagModels(db_objs []*ent.Tag) []*models.Tag {
+	objs := make([]*models.Tag, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = &models.Tag{
+			Id:         db_obj.ID,
+			CreateTime: db_obj.CreateTime,
+			UpdateTime: db_obj.UpdateTime,
+			Name:       db_obj.Name,
+			Color:      db_obj.Color,
+			OwnerId:    db_obj.OwnerID,
+		}
+	}
+	return objs
+}
+
+// withTags loads the tags of the items, ordered by name.
+func withTags(q *ent.TagQuery) {
+	q.Order(tag.ByName(sql.OrderAsc()), tag.ByID(sql.OrderAsc()))
+}
+
+// loadTags loads the tags of an item returned by a mutation.
+func (r *ItemPgRepo) loadTags(ctx context.Context, db_obj *ent.Item) error {
+	q := db_obj.QueryTags()
+	withTags(q)
+	tags, err := q.All(ctx)
+	if err != nil {
+		return err
+	}
+	db_obj.Edges.Tags = tags
+	return nil
+}
+
+// tagPredicate returns the predicate of the `tag` and `tag_match` list
+// parameters: items with any (the default) or all of the named tags.
+func tagPredicate(params url.Values) (predicate.Item, error) {
+	names := params["tag"]
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	switch match := params.Get("tag_match"); match {
+	case "", "any":
+		return item.HasTagsWith(tag.NameIn(names...)), nil
+	case "all":
+		predicates := make([]predicate.Item, len(names))
+		for i, name := range names {
+			predicates[i] = item.HasTagsWith(tag.Name(name))
+		}
+		return item.And(predicates...), nil
+	default:
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid tag_match %q, must be any or all", match))
 	}
 }
 
@@ -34,9 +135,289 @@ func (r *ItemPgRepo) mapModels(db_objs []*ent.Item) []*models.Item {
 	return objs
 }
 
+// searchVectorColumn is the generated tsvector column of the items table, it is
+// created by the migrations and not part of the ent schema.
+const searchVectorColumn = "search_vector"
+
+// applyFilters adds the filters of the list query on fields to q.
+func applyFilters(q *ent.ItemQuery, query *listQuery.Query, fields listQuery.Fields) (*ent.ItemQuery, error) {
+	predicates, err := query.Predicates(fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range predicates {
+		q = q.Where(predicate.Item(p))
+	}
+
+	if query != nil {
+		p, err := tagPredicate(query.Params)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			q = q.Where(p)
+		}
+
+		if contains := query.Params.Get(items.MetadataParam); contains != "" {
+			q = q.Where(metadataPredicate(contains))
+		}
+	}
+
+	return q, nil
+}
+
+// metadataPredicate matches the items whose metadata contains the JSON
+// document, which uses the GIN index of the metadata column.
+func metadataPredicate(contains string) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(item.FieldMetadata)).WriteString(" @> ").Arg(contains).WriteString("::jsonb")
+		}))
+	})
+}
+
+func (r *ItemPgRepo) getPage(
+	ctx context.Context,
+	q *ent.ItemQuery,
+	query *listQuery.Query,
+	fields listQuery.Fields,
+) (*listQuery.Page[*models.Item], error) {
+	q, err := applyFilters(q, query, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var total *int
+	if query.WithTotal {
+		count, err := q.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		total = &count
+	}
+
+	cursor, err := query.CursorPredicate(fields)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		q = q.Where(predicate.Item(cursor))
+	}
+
+	orders, err := query.Orders(fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		q = q.Order(item.OrderOption(o))
+	}
+
+	db_objs, err := q.
+		WithTags(withTags).
+		Offset(query.Offset).
+		Limit(query.Limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := listQuery.MapPage(listQuery.NewPage(query, db_objs, itemCursorKey), r.mapModels)
+	page.Total = total
+	return page, nil
+}
+
+func itemCursorKey(db_obj *ent.Item, field string) (string, uint) {
+	switch field {
+	case item.FieldCreateTime:
+		return db_obj.CreateTime.Format(time.RFC3339Nano), db_obj.ID
+	case item.FieldUpdateTime:
+		return db_obj.UpdateTime.Format(time.RFC3339Nano), db_obj.ID
+	case item.FieldTitle:
+		return db_obj.Title, db_obj.ID
+	case item.FieldOwnerID:
+		return strconv.FormatUint(uint64(db_obj.OwnerID), 10), db_obj.ID
+	case item.FieldStatus:
+		return db_obj.Status, db_obj.ID
+	case item.FieldDeleteTime:
+		// Only the trash is sorted by delete time, its items have one.
+		return db_obj.DeleteTime.Format(time.RFC3339Nano), db_obj.ID
+	default:
+		return "", db_obj.ID
+	}
+}
+
+func (r *ItemPgRepo) mapShareModel(db_obj *ent.ItemShare) *models.ItemShare {
+	return &models.ItemShare{
+		Id:         db_obj.ID,
+		CreateTime: db_obj.CreateTime,
+		UpdateTime: db_obj.UpdateTime,
+		ItemId:     db_obj.ItemID,
+		UserId:     db_obj.UserID,
+		Permission: db_obj.Permission.String(),
+	}
+}
+
+func (r *ItemPgRepo) mapShareModels(db_objs []*ent.ItemShare) []*models.ItemShare {
+	objs := make([]*models.ItemShare, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapShareModel(db_obj)
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) mapAttachmentModel(db_obj *ent.Attachment) *models.ItemAttachment {
+	return &models.ItemAttachment{
+		Id:          db_obj.ID,
+		CreateTime:  db_obj.CreateTime,
+		UpdateTime:  db_obj.UpdateTime,
+		ItemId:      db_obj.ItemID,
+		UploaderId:  db_obj.UploaderID,
+		Filename:    db_obj.Filename,
+		ContentType: db_obj.ContentType,
+		Size:        db_obj.Size,
+		Checksum:    db_obj.Checksum,
+		StorageKey:  db_obj.StorageKey,
+
+		ProcessingStatus: (*string)(db_obj.ProcessingStatus),
+		Width:            db_obj.Width,
+		Height:           db_obj.Height,
+		Thumbnails:       db_obj.Thumbnails,
+	}
+}
+
+func (r *ItemPgRepo) mapAttachmentModels(db_objs []*ent.Attachment) []*models.ItemAttachment {
+	objs := make([]*models.ItemAttachment, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapAttachmentModel(db_obj)
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) mapImportModel(db_obj *ent.ItemImport) *models.ItemImport {
+	return &models.ItemImport{
+		Id:          db_obj.ID,
+		CreateTime:  db_obj.CreateTime,
+		UpdateTime:  db_obj.UpdateTime,
+		OwnerId:     db_obj.OwnerID,
+		Format:      string(db_obj.Format),
+		Status:      string(db_obj.Status),
+		StorageKey:  db_obj.StorageKey,
+		Size:        db_obj.Size,
+		ReadBytes:   db_obj.ReadBytes,
+		CreatedRows: db_obj.CreatedRows,
+		FailedRows:  db_obj.FailedRows,
+		Errors:      db_obj.Errors,
+		Error:       db_obj.Error,
+		FinishTime:  db_obj.FinishTime,
+	}
+}
+
+func (r *ItemPgRepo) mapCommentModel(db_obj *ent.Comment) *models.ItemComment {
+	return &models.ItemComment{
+		Id:         db_obj.ID,
+		CreateTime: db_obj.CreateTime,
+		UpdateTime: db_obj.UpdateTime,
+		ItemId:     db_obj.ItemID,
+		AuthorId:   db_obj.AuthorID,
+		Body:       db_obj.Body,
+		EditedAt:   db_obj.EditedAt,
+	}
+}
+
+func (r *ItemPgRepo) mapCommentModels(db_objs []*ent.Comment) []*models.ItemComment {
+	objs := make([]*models.ItemComment, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapCommentModel(db_obj)
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) mapTransitionModel(db_obj *ent.ItemTransition) *models.ItemTransition {
+	return &models.ItemTransition{
+		Id:         db_obj.ID,
+		CreateTime: db_obj.CreateTime,
+		ItemId:     db_obj.ItemID,
+		UserId:     db_obj.UserID,
+		Name:       db_obj.Name,
+		FromStatus: db_obj.FromStatus,
+		ToStatus:   db_obj.ToStatus,
+		Comment:    db_obj.Comment,
+	}
+}
+
+func (r *ItemPgRepo) mapOwnershipTransferModel(db_obj *ent.OwnershipTransfer) *models.OwnershipTransfer {
+	return &models.OwnershipTransfer{
+		Id:         db_obj.ID,
+		CreateTime: db_obj.CreateTime,
+		UpdateTime: db_obj.UpdateTime,
+		ItemId:     db_obj.ItemID,
+		FromUserId: db_obj.FromUserID,
+		ToUserId:   db_obj.ToUserID,
+		Status:     db_obj.Status.String(),
+	}
+}
+
+func (r *ItemPgRepo) mapOwnershipTransferModels(db_objs []*ent.OwnershipTransfer) []*models.OwnershipTransfer {
+	objs := make([]*models.OwnershipTransfer, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapOwnershipTransferModel(db_obj)
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) mapTransitionModels(db_objs []*ent.ItemTransition) []*models.ItemTransition {
+	objs := make([]*models.ItemTransition, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapTransitionModel(db_obj)
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) mapRevisionModel(db_obj *ent.ItemRevision) *models.ItemRevision {
+	return &models.ItemRevision{
+		Id:         db_obj.ID,
+		CreateTime: db_obj.CreateTime,
+		ItemId:     db_obj.ItemID,
+		UserId:     db_obj.UserID,
+		Action:     db_obj.Action.String(),
+		Snapshot:   db_obj.Snapshot,
+		Changes:    db_obj.Changes,
+	}
+}
+
+func (r *ItemPgRepo) mapRevisionModels(db_objs []*ent.ItemRevision) []*models.ItemRevision {
+	objs := make([]*models.ItemRevision, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapRevisionModel(db_obj)
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) WithTx(ctx context.Context, fn func(ctx context.Context, repo items.ItemPgRepository) error) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback() //nolint:errcheck
+			panic(v)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx), &ItemPgRepo{client: tx.Client()}); err != nil {
+		tx.Rollback() //nolint:errcheck
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *ItemPgRepo) Get(ctx context.Context, id uint) (*models.Item, error) {
 	db_obj, err := r.client.Item.Query().
 		Where(item.ID(id)).
+		WithTags(withTags).
 		Only(ctx)
 
 	if err != nil {
@@ -46,21 +427,14 @@ func (r *ItemPgRepo) Get(ctx context.Context, id uint) (*models.Item, error) {
 	return r.mapModel(db_obj), nil
 }
 
-func (r *ItemPgRepo) GetMulti(ctx context.Context, offset, limit int) ([]*models.Item, error) {
-	db_objs, err := r.client.Item.Query().
-		Order(item.ByID(sql.OrderAsc())).
-		Offset(offset).
-		Limit(limit).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return r.mapModels(db_objs), nil
+func (r *ItemPgRepo) GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error) {
+	return r.getPage(ctx, r.client.Item.Query(), query, ItemListFields)
 }
 
 func (r *ItemPgRepo) Delete(ctx context.Context, id uint) (*models.Item, error) {
 	db_obj, err := r.client.Item.Query().
 		Where(item.ID(id)).
+		WithTags(withTags).
 		Only(ctx)
 
 	if err != nil {
@@ -77,12 +451,62 @@ func (r *ItemPgRepo) Delete(ctx context.Context, id uint) (*models.Item, error)
 
 func (r *ItemPgRepo) Update(ctx context.Context, id uint, obj_update *models.ItemUpdate) (*models.Item, error) {
 	query := r.client.Item.UpdateOneID(id)
+	if obj_update.Version != nil {
+		// The version is checked by the update statement itself, so a concurrent
+		// write between a read and this update can not be overwritten.
+		query = query.Where(item.Version(*obj_update.Version))
+	}
 	if obj_update.Title != nil {
 		query = query.SetTitle(*obj_update.Title)
 	}
 	if obj_update.Description != nil {
 		query = query.SetDescription(*obj_update.Description)
 	}
+	if obj_update.Metadata != nil {
+		query = query.SetMetadata(obj_update.Metadata)
+	}
+	if obj_update.DueAt != nil {
+		query = query.SetDueAt(*obj_update.DueAt)
+	} else if obj_update.ClearDueAt {
+		query = query.ClearDueAt()
+	}
+	db_obj, err := query.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) && obj_update.Version != nil {
+			exist, existErr := r.client.Item.Query().Where(item.ID(id)).Exist(ctx)
+			if existErr != nil {
+				return nil, existErr
+			}
+			if exist {
+				return nil, httpErrors.ErrPreconditionFailed(errors.New("item was modified"))
+			}
+		}
+		return nil, err
+	}
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetMultiByOwnerId(ctx context.Context, ownerId uint, query *listQuery.Query) (*listQuery.Page[*models.Item], error) {
+	return r.getPage(ctx, r.client.Item.Query().Where(item.OwnerID(ownerId)), query, ItemListFields)
+}
+
+func (r *ItemPgRepo) CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.ItemCreate) (*models.Item, error) {
+	query := r.client.Item.
+		Create().
+		SetTitle(obj_create.Title).
+		SetDescription(obj_create.Description).
+		SetOwnerID(ownerId)
+	if obj_create.Status != "" {
+		query = query.SetStatus(obj_create.Status)
+	}
+	if obj_create.Metadata != nil {
+		query = query.SetMetadata(obj_create.Metadata)
+	}
+	query = query.SetNillableDueAt(obj_create.DueAt)
+	query = query.SetNillableParentID(obj_create.ParentId)
 	db_obj, err := query.Save(ctx)
 	if err != nil {
 		return nil, err
@@ -90,9 +514,14 @@ func (r *ItemPgRepo) Update(ctx context.Context, id uint, obj_update *models.Ite
 	return r.mapModel(db_obj), nil
 }
 
-func (r *ItemPgRepo) GetMultiByOwnerId(ctx context.Context, ownerId uint, offset, limit int) ([]*models.Item, error) {
+func (r *ItemPgRepo) DeleteWithoutGet(ctx context.Context, id uint) error {
+	return r.client.Item.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *ItemPgRepo) GetMultiSharedWith(ctx context.Context, userId uint, offset, limit int) ([]*models.Item, error) {
 	db_objs, err := r.client.Item.Query().
-		Where(item.OwnerID(ownerId)).
+		Where(item.HasSharesWith(itemshare.UserID(userId))).
+		WithTags(withTags).
 		Order(item.ByID(sql.OrderAsc())).
 		Offset(offset).
 		Limit(limit).
@@ -101,22 +530,1061 @@ func (r *ItemPgRepo) GetMultiByOwnerId(ctx context.Context, ownerId uint, offset
 		return nil, err
 	}
 	return r.mapModels(db_objs), nil
+}
 
+func (r *ItemPgRepo) GetShare(ctx context.Context, itemId uint, userId uint) (*models.ItemShare, error) {
+	db_obj, err := r.client.ItemShare.Query().
+		Where(itemshare.ItemID(itemId), itemshare.UserID(userId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapShareModel(db_obj), nil
 }
 
-func (r *ItemPgRepo) CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.ItemCreate) (*models.Item, error) {
-	db_obj, err := r.client.Item.
-		Create().
-		SetTitle(obj_create.Title).
-		SetDescription(obj_create.Description).
-		SetOwnerID(ownerId).
+func (r *ItemPgRepo) GetShares(ctx context.Context, itemId uint) ([]*models.ItemShare, error) {
+	db_objs, err := r.client.ItemShare.Query().
+		Where(itemshare.ItemID(itemId)).
+		Order(itemshare.ByID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapShareModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) UpsertShare(ctx context.Context, itemId uint, obj_create *models.ItemShareCreate) (*models.ItemShare, error) {
+	db_obj, err := r.client.ItemShare.Query().
+		Where(itemshare.ItemID(itemId), itemshare.UserID(obj_create.UserId)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if db_obj != nil {
+		db_obj, err = r.client.ItemShare.UpdateOne(db_obj).
+			SetPermission(itemshare.Permission(obj_create.Permission)).
+			Save(ctx)
+	} else {
+		db_obj, err = r.client.ItemShare.Create().
+			SetItemID(itemId).
+			SetUserID(obj_create.UserId).
+			SetPermission(itemshare.Permission(obj_create.Permission)).
+			Save(ctx)
+	}
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, httpErrors.ErrNotFound(errors.New("not found user to share with"))
+		}
+		return nil, err
+	}
+	return r.mapShareModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) DeleteShare(ctx context.Context, itemId uint, userId uint) (*models.ItemShare, error) {
+	db_obj, err := r.client.ItemShare.Query().
+		Where(itemshare.ItemID(itemId), itemshare.UserID(userId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.client.ItemShare.DeleteOne(db_obj).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapShareModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) Search(ctx context.Context, text string, offset, limit int) ([]*models.ItemSearchResult, error) {
+	// Every word is matched as a prefix, so "tod li" finds "todo list".
+	terms := strings.FieldsFunc(text, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+	if len(terms) == 0 {
+		return nil, httpErrors.ErrValidation(errors.New("search query has no words"))
+	}
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+
+	tsQuery := sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("to_tsquery('english', ").Arg(strings.Join(terms, " & ")).WriteString(")")
+	})
+
+	headline := func(column string) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline('english', ").WriteString(column).Comma().Join(tsQuery).
+				WriteString(", 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5')")
+		})
+	}
+
+	q := r.client.Item.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString(s.C(searchVectorColumn)).WriteString(" @@ ").Join(tsQuery)
+			}))
+		}).
+		WithTags(withTags).
+		Offset(offset).
+		Limit(limit)
+
+	q.Modify(func(s *sql.Selector) {
+		s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(").WriteString(s.C(searchVectorColumn)).Comma().Join(tsQuery).WriteString(")::float8")
+		}), "rank")
+		s.AppendSelectExprAs(headline(s.C(item.FieldTitle)), "title_highlight")
+		s.AppendSelectExprAs(headline(s.C(item.FieldDescription)), "description_highlight")
+		s.OrderBy(sql.Desc("rank"), sql.Asc(s.C(item.FieldID)))
+	})
+
+	db_objs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]*models.ItemSearchResult, len(db_objs))
+	for i, db_obj := range db_objs {
+		rank, _ := db_obj.Value("rank")
+		titleHighlight, _ := db_obj.Value("title_highlight")
+		descriptionHighlight, _ := db_obj.Value("description_highlight")
+
+		objs[i] = &models.ItemSearchResult{Item: *r.mapModel(db_obj)}
+		if v, ok := rank.(float64); ok {
+			objs[i].Rank = v
+		}
+		if v, ok := titleHighlight.(string); ok {
+			objs[i].TitleHighlight = v
+		}
+		if v, ok := descriptionHighlight.(string); ok {
+			objs[i].DescriptionHighlight = v
+		}
+	}
+	return objs, nil
+}
+
+func (r *ItemPgRepo) GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error) {
+	return r.getPage(schema.SkipSoftDelete(ctx), r.client.Item.Query().Where(item.DeleteTimeNotNil()), query, ItemTrashListFields)
+}
+
+func (r *ItemPgRepo) Restore(ctx context.Context, id uint) (*models.Item, error) {
+	db_obj, err := r.client.Item.UpdateOneID(id).
+		Where(item.DeleteTimeNotNil()).
+		ClearDeleteTime().
+		Save(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	return r.client.Item.Delete().
+		Where(item.DeleteTimeLT(deletedBefore)).
+		Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (r *ItemPgRepo) GetRevisions(ctx context.Context, itemId uint, offset, limit int) ([]*models.ItemRevision, error) {
+	db_objs, err := r.client.ItemRevision.Query().
+		Where(itemrevision.ItemID(itemId)).
+		Order(itemrevision.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapRevisionModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetRevision(ctx context.Context, itemId uint, revisionId uint) (*models.ItemRevision, error) {
+	db_obj, err := r.client.ItemRevision.Query().
+		Where(itemrevision.ID(revisionId), itemrevision.ItemID(itemId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapRevisionModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetLatestRevision(ctx context.Context, itemId uint) (*models.ItemRevision, error) {
+	db_obj, err := r.client.ItemRevision.Query().
+		Where(itemrevision.ItemID(itemId)).
+		Order(itemrevision.ByID(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapRevisionModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) CountTagsOfOwner(ctx context.Context, ownerId uint, tagIds []uint) (int, error) {
+	return r.client.Tag.Query().
+		Where(tag.IDIn(tagIds...), tag.OwnerID(ownerId)).
+		Count(ctx)
+}
+
+func (r *ItemPgRepo) AddTags(ctx context.Context, id uint, tagIds []uint) (*models.Item, error) {
+	db_obj, err := r.client.Item.UpdateOneID(id).
+		AddTagIDs(tagIds...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) RemoveTags(ctx context.Context, id uint, tagIds []uint) (*models.Item, error) {
+	db_obj, err := r.client.Item.UpdateOneID(id).
+		RemoveTagIDs(tagIds...).
 		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
 	return r.mapModel(db_obj), nil
 }
 
-func (r *ItemPgRepo) DeleteWithoutGet(ctx context.Context, id uint) error {
-	return r.client.Item.DeleteOneID(id).Exec(ctx)
+func (r *ItemPgRepo) GetAttachments(ctx context.Context, itemId uint) ([]*models.ItemAttachment, error) {
+	db_objs, err := r.client.Attachment.Query().
+		Where(attachment.ItemID(itemId)).
+		Order(attachment.ByID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapAttachmentModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetAttachment(ctx context.Context, itemId uint, attachmentId uint) (*models.ItemAttachment, error) {
+	db_obj, err := r.client.Attachment.Query().
+		Where(attachment.ID(attachmentId), attachment.ItemID(itemId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapAttachmentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) CreateAttachment(ctx context.Context, obj *models.ItemAttachment) (*models.ItemAttachment, error) {
+	db_obj, err := r.client.Attachment.Create().
+		SetItemID(obj.ItemId).
+		SetNillableUploaderID(obj.UploaderId).
+		SetFilename(obj.Filename).
+		SetContentType(obj.ContentType).
+		SetSize(obj.Size).
+		SetChecksum(obj.Checksum).
+		SetStorageKey(obj.StorageKey).
+		SetNillableProcessingStatus((*attachment.ProcessingStatus)(obj.ProcessingStatus)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapAttachmentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) UpdateAttachmentProcessed(ctx context.Context, attachmentId uint, obj_update *models.ItemAttachmentProcessed) (*models.ItemAttachment, error) {
+	query := r.client.Attachment.UpdateOneID(attachmentId).
+		SetProcessingStatus(attachment.ProcessingStatus(obj_update.Status)).
+		SetNillableWidth(obj_update.Width).
+		SetNillableHeight(obj_update.Height)
+
+	if obj_update.Size != nil {
+		query = query.SetSize(*obj_update.Size)
+	}
+
+	if obj_update.Checksum != nil {
+		query = query.SetChecksum(*obj_update.Checksum)
+	}
+
+	if obj_update.Thumbnails != nil {
+		query = query.SetThumbnails(obj_update.Thumbnails)
+	}
+
+	db_obj, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapAttachmentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) DeleteAttachment(ctx context.Context, itemId uint, attachmentId uint) (*models.ItemAttachment, error) {
+	db_obj, err := r.client.Attachment.Query().
+		Where(attachment.ID(attachmentId), attachment.ItemID(itemId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.client.Attachment.DeleteOne(db_obj).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapAttachmentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetTrashAttachmentKeys(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	db_objs, err := r.client.Attachment.Query().
+		Where(attachment.HasItemWith(item.DeleteTimeLT(deletedBefore))).
+		Select(attachment.FieldStorageKey, attachment.FieldThumbnails).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, db_obj := range db_objs {
+		keys = append(keys, db_obj.StorageKey)
+		for _, thumbnail := range db_obj.Thumbnails {
+			keys = append(keys, thumbnail.StorageKey)
+		}
+	}
+	return keys, nil
+}
+
+func (r *ItemPgRepo) Iterate(ctx context.Context, ownerId *uint, query *listQuery.Query, batchSize int, fn func(objs []*models.Item) error) error {
+	q := r.client.Item.Query()
+	if ownerId != nil {
+		q = q.Where(item.OwnerID(*ownerId))
+	}
+
+	q, err := applyFilters(q, query, ItemListFields)
+	if err != nil {
+		return err
+	}
+
+	var lastId uint
+	for {
+		db_objs, err := q.Clone().
+			Where(item.IDGT(lastId)).
+			Order(item.ByID(sql.OrderAsc())).
+			WithTags(withTags).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(db_objs) == 0 {
+			return nil
+		}
+
+		if err := fn(r.mapModels(db_objs)); err != nil {
+			return err
+		}
+
+		if len(db_objs) < batchSize {
+			return nil
+		}
+		lastId = db_objs[len(db_objs)-1].ID
+	}
+}
+
+func (r *ItemPgRepo) CreateImport(ctx context.Context, ownerId uint, obj_create *models.ItemImportCreate) (*models.ItemImport, error) {
+	db_obj, err := r.client.ItemImport.Create().
+		SetOwnerID(ownerId).
+		SetFormat(itemimport.Format(obj_create.Format)).
+		SetStorageKey(obj_create.StorageKey).
+		SetSize(obj_create.Size).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapImportModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetImport(ctx context.Context, id uint) (*models.ItemImport, error) {
+	db_obj, err := r.client.ItemImport.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapImportModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) UpdateImportProgress(ctx context.Context, id uint, obj_update *models.ItemImportProgress) (*models.ItemImport, error) {
+	query := r.client.ItemImport.UpdateOneID(id).
+		SetStatus(itemimport.Status(obj_update.Status)).
+		SetReadBytes(obj_update.ReadBytes).
+		SetCreatedRows(obj_update.CreatedRows).
+		SetFailedRows(obj_update.FailedRows).
+		SetNillableError(obj_update.Error).
+		SetNillableFinishTime(obj_update.FinishTime)
+
+	if obj_update.Errors != nil {
+		query = query.SetErrors(obj_update.Errors)
+	}
+
+	db_obj, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapImportModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetComments(ctx context.Context, itemId uint, offset, limit int) ([]*models.ItemComment, error) {
+	db_objs, err := r.client.Comment.Query().
+		Where(comment.ItemID(itemId)).
+		Order(comment.ByID(sql.OrderAsc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapCommentModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetLatestComments(ctx context.Context, itemId uint, limit int) ([]*models.ItemComment, error) {
+	db_objs, err := r.client.Comment.Query().
+		Where(comment.ItemID(itemId)).
+		Order(comment.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapCommentModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetComment(ctx context.Context, itemId uint, commentId uint) (*models.ItemComment, error) {
+	db_obj, err := r.client.Comment.Query().
+		Where(comment.ID(commentId), comment.ItemID(itemId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapCommentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) CreateComment(ctx context.Context, itemId uint, authorId uint, obj_create *models.ItemCommentCreate) (*models.ItemComment, error) {
+	db_obj, err := r.client.Comment.Create().
+		SetItemID(itemId).
+		SetAuthorID(authorId).
+		SetBody(obj_create.Body).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapCommentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) UpdateComment(ctx context.Context, itemId uint, commentId uint, obj_update *models.ItemCommentUpdate) (*models.ItemComment, error) {
+	db_obj, err := r.client.Comment.UpdateOneID(commentId).
+		Where(comment.ItemID(itemId)).
+		SetBody(obj_update.Body).
+		SetEditedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapCommentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) DeleteComment(ctx context.Context, itemId uint, commentId uint) (*models.ItemComment, error) {
+	db_obj, err := r.client.Comment.Query().
+		Where(comment.ID(commentId), comment.ItemID(itemId)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.client.Comment.DeleteOne(db_obj).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapCommentModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetMembersByEmail(ctx context.Context, itemId uint, emails []string) ([]*models.User, error) {
+	db_objs, err := r.client.User.Query().
+		Where(
+			user.EmailIn(emails...),
+			user.IsActive(true),
+			user.Or(
+				user.HasItemsWith(item.ID(itemId)),
+				user.HasSharedItemsWith(itemshare.ItemID(itemId)),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]*models.User, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = &models.User{
+			Id:       db_obj.ID,
+			Name:     db_obj.Name,
+			Email:    db_obj.Email,
+			IsActive: db_obj.IsActive,
+		}
+	}
+	return objs, nil
+}
+
+func (r *ItemPgRepo) GetMembers(ctx context.Context, itemId uint, permissions []string) ([]*models.ItemMember, error) {
+	objs := make([]*models.ItemMember, 0)
+
+	sharePermissions := make([]itemshare.Permission, 0, len(permissions))
+	withOwner := false
+	for _, permission := range permissions {
+		if permission == models.ItemPermissionOwner {
+			withOwner = true
+			continue
+		}
+		sharePermissions = append(sharePermissions, itemshare.Permission(permission))
+	}
+
+	if withOwner {
+		db_obj, err := r.client.User.Query().
+			Where(user.HasItemsWith(item.ID(itemId)), user.IsActive(true)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			objs = append(objs, &models.ItemMember{
+				Id:         db_obj.ID,
+				Name:       db_obj.Name,
+				Email:      db_obj.Email,
+				Permission: models.ItemPermissionOwner,
+			})
+		}
+	}
+
+	if len(sharePermissions) == 0 {
+		return objs, nil
+	}
+
+	db_shares, err := r.client.ItemShare.Query().
+		Where(
+			itemshare.ItemID(itemId),
+			itemshare.PermissionIn(sharePermissions...),
+			itemshare.HasUserWith(user.IsActive(true)),
+		).
+		WithUser().
+		Order(itemshare.ByID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, db_share := range db_shares {
+		objs = append(objs, &models.ItemMember{
+			Id:         db_share.Edges.User.ID,
+			Name:       db_share.Edges.User.Name,
+			Email:      db_share.Edges.User.Email,
+			Permission: db_share.Permission.String(),
+		})
+	}
+
+	return objs, nil
+}
+
+func (r *ItemPgRepo) UpdateStatus(ctx context.Context, id uint, from string, to string) (*models.Item, error) {
+	db_obj, err := r.client.Item.UpdateOneID(id).
+		Where(item.Status(from)).
+		SetStatus(to).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			exist, existErr := r.client.Item.Query().Where(item.ID(id)).Exist(ctx)
+			if existErr != nil {
+				return nil, existErr
+			}
+			if exist {
+				return nil, httpErrors.ErrPreconditionFailed(errors.New("item status was changed"))
+			}
+		}
+		return nil, err
+	}
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) CreateTransition(ctx context.Context, obj *models.ItemTransition) (*models.ItemTransition, error) {
+	db_obj, err := r.client.ItemTransition.Create().
+		SetItemID(obj.ItemId).
+		SetNillableUserID(obj.UserId).
+		SetName(obj.Name).
+		SetFromStatus(obj.FromStatus).
+		SetToStatus(obj.ToStatus).
+		SetNillableComment(obj.Comment).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapTransitionModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetTransitions(ctx context.Context, itemId uint, offset, limit int) ([]*models.ItemTransition, error) {
+	db_objs, err := r.client.ItemTransition.Query().
+		Where(itemtransition.ItemID(itemId)).
+		Order(itemtransition.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapTransitionModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) IsActiveUser(ctx context.Context, userId uint) (bool, error) {
+	return r.client.User.Query().
+		Where(user.ID(userId), user.IsActive(true)).
+		Exist(ctx)
+}
+
+func (r *ItemPgRepo) TransferOwner(ctx context.Context, id uint, fromUserId uint, toUserId uint) (*models.Item, error) {
+	// Tags are per owner, the item leaves the tags of the previous owner.
+	db_obj, err := r.client.Item.UpdateOneID(id).
+		Where(item.OwnerID(fromUserId)).
+		SetOwnerID(toUserId).
+		ClearTags().
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			exist, existErr := r.client.Item.Query().Where(item.ID(id)).Exist(ctx)
+			if existErr != nil {
+				return nil, existErr
+			}
+			if exist {
+				return nil, httpErrors.ErrAborted(errors.New("item owner was changed"))
+			}
+		}
+		return nil, err
+	}
+
+	// The new owner does not need its share anymore.
+	if _, err := r.client.ItemShare.Delete().
+		Where(itemshare.ItemID(id), itemshare.UserID(toUserId)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.client.OwnershipTransfer.Update().
+		Where(
+			ownershiptransfer.ItemID(id),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+		).
+		SetStatus(ownershiptransfer.StatusCancelled).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) TransferAllOwner(ctx context.Context, fromUserId uint, toUserId uint) ([]uint, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	ids, err := r.client.Item.Query().
+		Where(item.OwnerID(fromUserId)).
+		Order(item.ByID()).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	if _, err := r.client.Item.Update().
+		Where(item.OwnerID(fromUserId)).
+		SetOwnerID(toUserId).
+		ClearTags().
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.client.ItemShare.Delete().
+		Where(itemshare.UserID(toUserId), itemshare.HasItemWith(item.OwnerID(toUserId))).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.client.OwnershipTransfer.Update().
+		Where(
+			ownershiptransfer.FromUserID(fromUserId),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+		).
+		SetStatus(ownershiptransfer.StatusCancelled).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *ItemPgRepo) GetOwnershipTransfer(ctx context.Context, id uint) (*models.OwnershipTransfer, error) {
+	db_obj, err := r.client.OwnershipTransfer.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapOwnershipTransferModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetOwnershipTransfers(ctx context.Context, userId uint, status string, offset, limit int) ([]*models.OwnershipTransfer, error) {
+	query := r.client.OwnershipTransfer.Query().
+		Where(ownershiptransfer.Or(
+			ownershiptransfer.FromUserID(userId),
+			ownershiptransfer.ToUserID(userId),
+		))
+	if status != "" {
+		query = query.Where(ownershiptransfer.StatusEQ(ownershiptransfer.Status(status)))
+	}
+
+	db_objs, err := query.
+		Order(ownershiptransfer.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapOwnershipTransferModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) CreateOwnershipTransfers(ctx context.Context, objs []*models.OwnershipTransfer) ([]*models.OwnershipTransfer, error) {
+	builders := make([]*ent.OwnershipTransferCreate, len(objs))
+	for i, obj := range objs {
+		builders[i] = r.client.OwnershipTransfer.Create().
+			SetItemID(obj.ItemId).
+			SetFromUserID(obj.FromUserId).
+			SetToUserID(obj.ToUserId).
+			SetStatus(ownershiptransfer.Status(obj.Status))
+	}
+
+	db_objs, err := r.client.OwnershipTransfer.CreateBulk(builders...).
+		Save(ctx)
+	if err != nil {
+		// The items and the users are checked before, the only constraint
+		// left is the unique index of the pending transfers.
+		if ent.IsConstraintError(err) {
+			return nil, httpErrors.ErrConflict(errors.New("item already has a pending ownership transfer"))
+		}
+		return nil, err
+	}
+	return r.mapOwnershipTransferModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) UpdateOwnershipTransferStatus(ctx context.Context, id uint, from string, to string) (*models.OwnershipTransfer, error) {
+	db_obj, err := r.client.OwnershipTransfer.UpdateOneID(id).
+		Where(ownershiptransfer.StatusEQ(ownershiptransfer.Status(from))).
+		SetStatus(ownershiptransfer.Status(to)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			exist, existErr := r.client.OwnershipTransfer.Query().Where(ownershiptransfer.ID(id)).Exist(ctx)
+			if existErr != nil {
+				return nil, existErr
+			}
+			if exist {
+				return nil, httpErrors.ErrInvalidTransition(fmt.Errorf("ownership transfer is not %s anymore", from))
+			}
+		}
+		return nil, err
+	}
+	return r.mapOwnershipTransferModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) GetUserIdByCalendarToken(ctx context.Context, tokenHash string) (uint, error) {
+	return r.client.User.Query().
+		Where(user.CalendarToken(tokenHash), user.IsActive(true)).
+		OnlyID(ctx)
+}
+
+func (r *ItemPgRepo) GetDue(ctx context.Context, from time.Time, limit int) ([]*models.Item, error) {
+	db_objs, err := r.client.Item.Query().
+		Where(item.DueAtGTE(from)).
+		Order(item.ByDueAt(sql.OrderAsc()), item.ByID(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+// treeTable is the recursive CTE of the tree queries, with the id and the
+// depth of the items of the tree.
+const (
+	treeTable       = "item_tree"
+	treeDepthColumn = "depth"
+)
+
+// descendantsCTE walks down the tree from an item up to maxDepth levels, the
+// children have depth 1. Deleted items and their descendants are left out.
+func descendantsCTE(id uint, maxDepth int) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("WITH RECURSIVE item_tree(id, depth) AS (" +
+			"SELECT id, 1 FROM items WHERE parent_id = ").Arg(id).
+			WriteString(" AND delete_time IS NULL" +
+				" UNION ALL" +
+				" SELECT i.id, t.depth + 1 FROM items i JOIN item_tree t ON i.parent_id = t.id" +
+				" WHERE i.delete_time IS NULL AND t.depth < ").Arg(maxDepth).
+			WriteString(")")
+	})
+}
+
+// deletedDescendantsCTE walks down the tree from a deleted item up to maxDepth
+// levels, through the items deleted at or after deletedFrom.
+func deletedDescendantsCTE(id uint, deletedFrom time.Time, maxDepth int) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("WITH RECURSIVE item_tree(id, depth) AS (" +
+			"SELECT id, 1 FROM items WHERE parent_id = ").Arg(id).
+			WriteString(" AND delete_time >= ").Arg(deletedFrom).
+			WriteString(" UNION ALL" +
+				" SELECT i.id, t.depth + 1 FROM items i JOIN item_tree t ON i.parent_id = t.id" +
+				" WHERE i.delete_time >= ").Arg(deletedFrom).
+			WriteString(" AND t.depth < ").Arg(maxDepth).
+			WriteString(")")
+	})
+}
+
+// ancestorsCTE walks up the tree from an item up to maxDepth levels, the
+// parent has depth 1. The depth limit also ends the walk if the tree has a
+// cycle.
+func ancestorsCTE(id uint, maxDepth int) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("WITH RECURSIVE item_tree(id, parent_id, depth) AS (" +
+			"SELECT id, parent_id, 0 FROM items WHERE id = ").Arg(id).
+			WriteString(" UNION ALL" +
+				" SELECT i.id, i.parent_id, t.depth + 1 FROM items i JOIN item_tree t ON i.id = t.parent_id" +
+				" WHERE t.depth < ").Arg(maxDepth).
+			WriteString(")")
+	})
+}
+
+// withTree limits the items of q to the items of the tree of cte, the item
+// the tree starts from excluded, and selects their depth. They are sorted
+// by depth with depthOrder, then by id.
+func withTree(q *ent.ItemQuery, cte sql.Querier, depthOrder func(string) string) {
+	q.Modify(func(s *sql.Selector) {
+		tree := sql.Table(treeTable).As(treeTable)
+		s.Prefix(cte).
+			Join(tree).
+			On(s.C(item.FieldID), tree.C(item.FieldID)).
+			Where(sql.GT(tree.C(treeDepthColumn), 0)).
+			AppendSelectAs(tree.C(treeDepthColumn), treeDepthColumn).
+			OrderBy(depthOrder(tree.C(treeDepthColumn)), sql.Asc(s.C(item.FieldID)))
+	})
+}
+
+func (r *ItemPgRepo) mapNodeModels(db_objs []*ent.Item) []*models.ItemNode {
+	objs := make([]*models.ItemNode, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = &models.ItemNode{Item: *r.mapModel(db_obj)}
+		if depth, _ := db_obj.Value(treeDepthColumn); depth != nil {
+			if v, ok := depth.(int64); ok {
+				objs[i].Depth = int(v)
+			}
+		}
+	}
+	return objs
+}
+
+func (r *ItemPgRepo) GetChildren(ctx context.Context, id uint, offset, limit int) ([]*models.Item, error) {
+	db_objs, err := r.client.Item.Query().
+		Where(item.ParentID(id)).
+		WithTags(withTags).
+		Order(item.ByID(sql.OrderAsc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetAncestors(ctx context.Context, id uint, maxDepth int) ([]*models.ItemNode, error) {
+	q := r.client.Item.Query().WithTags(withTags)
+	withTree(q, ancestorsCTE(id, maxDepth), sql.Asc)
+
+	db_objs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapNodeModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetSubtree(ctx context.Context, id uint, maxDepth int, limit int) ([]*models.ItemNode, error) {
+	q := r.client.Item.Query().WithTags(withTags).Limit(limit)
+	withTree(q, descendantsCTE(id, maxDepth), sql.Asc)
+
+	db_objs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapNodeModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) GetAncestorIds(ctx context.Context, id uint, maxDepth int) ([]uint, error) {
+	q := r.client.Item.Query()
+	withTree(q, ancestorsCTE(id, maxDepth), sql.Asc)
+
+	db_objs, err := q.All(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(db_objs))
+	for i, db_obj := range db_objs {
+		ids[i] = db_obj.ID
+	}
+	return ids, nil
+}
+
+func (r *ItemPgRepo) GetSubtreeHeight(ctx context.Context, id uint, maxDepth int) (int, error) {
+	q := r.client.Item.Query().Limit(1)
+	withTree(q, descendantsCTE(id, maxDepth), sql.Desc)
+
+	db_objs, err := q.All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(db_objs) == 0 {
+		return 0, nil
+	}
+	return r.mapNodeModels(db_objs)[0].Depth, nil
+}
+
+func (r *ItemPgRepo) LockItems(ctx context.Context, ids []uint) error {
+	q := r.client.Item.Query().
+		Where(item.IDIn(ids...)).
+		Order(item.ByID(sql.OrderAsc()))
+	q.Modify(func(s *sql.Selector) {
+		// SQLite has no row locks, a transaction writing locks the whole
+		// database.
+		if s.Dialect() != dialect.SQLite {
+			s.ForUpdate()
+		}
+	})
+
+	_, err := q.IDs(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (r *ItemPgRepo) UpdateParent(ctx context.Context, id uint, parentId *uint) (*models.Item, error) {
+	query := r.client.Item.UpdateOneID(id)
+	if parentId != nil {
+		query = query.SetParentID(*parentId)
+	} else {
+		query = query.ClearParentID()
+	}
+
+	db_obj, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadTags(ctx, db_obj); err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *ItemPgRepo) ReparentChildren(ctx context.Context, id uint, parentId *uint) (int, error) {
+	query := r.client.Item.Update().Where(item.ParentID(id))
+	if parentId != nil {
+		query = query.SetParentID(*parentId)
+	} else {
+		query = query.ClearParentID()
+	}
+	return query.Save(ctx)
+}
+
+func (r *ItemPgRepo) DeleteDescendants(ctx context.Context, id uint, maxDepth int) ([]*models.Item, error) {
+	q := r.client.Item.Query()
+	withTree(q, descendantsCTE(id, maxDepth), sql.Asc)
+
+	db_objs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(db_objs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint, len(db_objs))
+	for i, db_obj := range db_objs {
+		ids[i] = db_obj.ID
+	}
+	if _, err := r.client.Item.Delete().Where(item.IDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) RestoreDescendants(ctx context.Context, id uint, maxDepth int) ([]*models.Item, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	db_obj, err := r.client.Item.Query().Where(item.ID(id)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if db_obj.DeleteTime == nil {
+		return nil, nil
+	}
+
+	q := r.client.Item.Query()
+	withTree(q, deletedDescendantsCTE(id, *db_obj.DeleteTime, maxDepth), sql.Asc)
+
+	db_objs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(db_objs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint, len(db_objs))
+	for i, db_obj := range db_objs {
+		ids[i] = db_obj.ID
+	}
+
+	if _, err := r.client.Item.Update().
+		Where(item.IDIn(ids...)).
+		ClearDeleteTime().
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	db_objs, err = r.client.Item.Query().
+		Where(item.IDIn(ids...)).
+		Order(item.ByID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+func (r *ItemPgRepo) DetachFromDeletedParent(ctx context.Context, id uint) (bool, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	count, err := r.client.Item.Update().
+		Where(item.ID(id), item.HasParentWith(item.DeleteTimeNotNil())).
+		ClearParentID().
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
@@ -0,0 +1,106 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/repository"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+func TestCreateOwnershipTransfersPendingUnique(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:transfer?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := viewer.NewSystemContext(context.Background())
+	repo := repository.CreateItemPgRepository(client)
+
+	var userIds []uint
+	for _, name := range []string{"owner", "first", "second"} {
+		userIds = append(userIds, client.User.Create().
+			SetName(name).
+			SetEmail(name+"@example.com").
+			SetPassword("password").
+			SaveX(ctx).ID)
+	}
+	itemId := client.Item.Create().
+		SetTitle("item").
+		SetDescription("").
+		SetOwnerID(userIds[0]).
+		SaveX(ctx).ID
+
+	transfer := func(toUserId uint, status string) (*models.OwnershipTransfer, error) {
+		objs, err := repo.CreateOwnershipTransfers(ctx, []*models.OwnershipTransfer{{
+			ItemId:     itemId,
+			FromUserId: userIds[0],
+			ToUserId:   toUserId,
+			Status:     status,
+		}})
+		if err != nil {
+			return nil, err
+		}
+		return objs[0], nil
+	}
+
+	isConflict := func(err error) bool {
+		var restErr httpErrors.ErrRest
+		return errors.As(err, &restErr) && restErr.GetStatus() == http.StatusConflict
+	}
+
+	// Concurrent requests: only one transfer stays pending.
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = transfer(userIds[1+i%2], models.OwnershipTransferStatusPending)
+		}(i)
+	}
+	wg.Wait()
+
+	var created int
+	var pending *models.OwnershipTransfer
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case !isConflict(err):
+			t.Fatalf("got %v, want a conflict", err)
+		}
+	}
+	if created != 1 {
+		t.Fatalf("got %d pending transfers, want 1", created)
+	}
+
+	// The other statuses are not limited.
+	for i := 0; i < 2; i++ {
+		if _, err := transfer(userIds[1], models.OwnershipTransferStatusAccepted); err != nil {
+			t.Fatalf("got %v creating an accepted transfer", err)
+		}
+	}
+
+	objs, err := repo.GetOwnershipTransfers(ctx, userIds[0], models.OwnershipTransferStatusPending, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("got %d pending transfers, want 1", len(objs))
+	}
+	pending = objs[0]
+
+	// Once the pending transfer is cancelled, another one can be sent.
+	if _, err := repo.UpdateOwnershipTransferStatus(ctx, pending.Id, models.OwnershipTransferStatusPending, models.OwnershipTransferStatusCancelled); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transfer(userIds[2], models.OwnershipTransferStatusPending); err != nil {
+		t.Fatalf("got %v after cancelling the pending transfer", err)
+	}
+}
@@ -0,0 +1,149 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// ContentType returns the media type of a format.
+func ContentType(format string) string {
+	switch format {
+	case models.ItemFormatCsv:
+		return "text/csv; charset=utf-8"
+	case models.ItemFormatNdjson:
+		return "application/x-ndjson"
+	default:
+		return "application/json"
+	}
+}
+
+// ValidFormat reports whether format is a supported import and export format.
+func ValidFormat(format string) bool {
+	switch format {
+	case models.ItemFormatCsv, models.ItemFormatJson, models.ItemFormatNdjson:
+		return true
+	}
+	return false
+}
+
+// Row is an exported item. Only title and description are read back by the
+// import, the other fields are assigned by the target.
+type Row struct {
+	Id          uint   `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	OwnerId     uint   `json:"owner_id"`
+}
+
+var csvHeader = []string{"id", "title", "description", "owner_id"}
+
+func newRow(obj *models.Item) *Row {
+	return &Row{
+		Id:          obj.Id,
+		Title:       obj.Title,
+		Description: obj.Description,
+		OwnerId:     obj.OwnerId,
+	}
+}
+
+// Writer encodes items one at a time, Close writes the end of the document.
+type Writer interface {
+	Write(obj *models.Item) error
+	Close() error
+}
+
+func NewWriter(w io.Writer, format string) (Writer, error) {
+	switch format {
+	case models.ItemFormatCsv:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case models.ItemFormatJson:
+		return &jsonWriter{w: w, enc: json.NewEncoder(w)}, nil
+	case models.ItemFormatNdjson:
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (cw *csvWriter) Write(obj *models.Item) error {
+	if !cw.wroteHeader {
+		cw.wroteHeader = true
+		if err := cw.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+
+	row := newRow(obj)
+	if err := cw.w.Write([]string{
+		strconv.FormatUint(uint64(row.Id), 10),
+		row.Title,
+		row.Description,
+		strconv.FormatUint(uint64(row.OwnerId), 10),
+	}); err != nil {
+		return err
+	}
+
+	// Flush every row so that the response is streamed.
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvWriter) Close() error {
+	if !cw.wroteHeader {
+		cw.wroteHeader = true
+		if err := cw.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type jsonWriter struct {
+	w       io.Writer
+	enc     *json.Encoder
+	started bool
+}
+
+func (jw *jsonWriter) Write(obj *models.Item) error {
+	separator := ","
+	if !jw.started {
+		jw.started = true
+		separator = "["
+	}
+	if _, err := io.WriteString(jw.w, separator); err != nil {
+		return err
+	}
+	return jw.enc.Encode(newRow(obj))
+}
+
+func (jw *jsonWriter) Close() error {
+	end := "]\n"
+	if !jw.started {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(jw.w, end)
+	return err
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (nw *ndjsonWriter) Write(obj *models.Item) error {
+	return nw.enc.Encode(newRow(obj))
+}
+
+func (nw *ndjsonWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,287 @@
+package transfer
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+)
+
+// MaxRowErrors is the number of row errors reported, the next failed rows are
+// only counted.
+const MaxRowErrors = 1000
+
+// progressRows is the number of rows between two calls of the progress function.
+const progressRows = 100
+
+// maxNdjsonLine is the longest line of a NDJSON import.
+const maxNdjsonLine = 1 << 20
+
+// Import creates an item owned by ownerId in status for every valid row read
+// from r. Rows are validated like the items created with the API, invalid rows are
+// reported and skipped. A malformed document stops the import with a
+// validation error, the result then holds the rows imported so far. Every item
+// is created in its own transaction, quota, if not nil, is called in it before
+// the item is created and stops the import with its error, see ItemsQuota.
+// progress, if not nil, is called every few rows.
+func Import(
+	ctx context.Context,
+	repo items.ItemPgRepository,
+	ownerId uint,
+	status string,
+	format string,
+	r io.Reader,
+	quota func(ctx context.Context) error,
+	progress func(result *models.ItemImportResult) error,
+) (*models.ItemImportResult, error) {
+	rows, err := newRowReader(r, format)
+	if err != nil {
+		return &models.ItemImportResult{}, err
+	}
+
+	result := &models.ItemImportResult{}
+
+	for {
+		line, row, err := rows.Next()
+		if err == io.EOF {
+			break
+		}
+
+		var rowErr *rowError
+		if errors.As(err, &rowErr) {
+			addRowError(result, line, rowErr.err)
+			continue
+		}
+		if err != nil {
+			return result, httpErrors.ErrValidation(fmt.Errorf("line %d: %w", line, err))
+		}
+
+		if err := utils.ValidateStruct(ctx, row); err != nil {
+			addRowError(result, line, err)
+			continue
+		}
+
+		err = repo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) error {
+			if quota != nil {
+				if err := quota(ctx); err != nil {
+					return err
+				}
+			}
+
+			_, err := repo.CreateWithOwner(ctx, ownerId, &models.ItemCreate{
+				Title:       row.Title,
+				Description: row.Description,
+				Status:      status,
+			})
+			return err
+		})
+		if err != nil {
+			return result, err
+		}
+		result.CreatedRows++
+
+		if progress != nil && (result.CreatedRows+result.FailedRows)%progressRows == 0 {
+			if err := progress(result); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ItemsQuota returns the quota of an import of ownerId, the items of the owner
+// are counted in the transaction of every row so the concurrent creates can
+// not exceed the plan.
+func ItemsQuota(quotasUC quotas.QuotaUseCase, ownerId uint) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return quotasUC.CheckItems(ctx, ownerId, 1)
+	}
+}
+
+func addRowError(result *models.ItemImportResult, line int, err error) {
+	result.FailedRows++
+	if len(result.Errors) < MaxRowErrors {
+		result.Errors = append(result.Errors, models.ItemImportRowError{Line: line, Error: err.Error()})
+	}
+}
+
+// rowError is the error of a single row, the next rows can still be read.
+type rowError struct {
+	err error
+}
+
+func (e *rowError) Error() string {
+	return e.err.Error()
+}
+
+type rowReader interface {
+	// Next returns the next row and its line, a *rowError for an invalid
+	// row and io.EOF at the end of the document.
+	Next() (int, *presenter.ItemCreate, error)
+}
+
+func newRowReader(r io.Reader, format string) (rowReader, error) {
+	switch format {
+	case models.ItemFormatCsv:
+		return newCsvRowReader(r)
+	case models.ItemFormatJson:
+		return newJsonRowReader(r)
+	case models.ItemFormatNdjson:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxNdjsonLine)
+		return &ndjsonRowReader{scanner: scanner}, nil
+	default:
+		return nil, httpErrors.ErrValidation(fmt.Errorf("unknown format %q", format))
+	}
+}
+
+type csvRowReader struct {
+	r           *csv.Reader
+	title       int
+	description int
+}
+
+// newCsvRowReader reads the header of a CSV document, which must have a title
+// column. The other columns than title and description are ignored.
+func newCsvRowReader(r io.Reader) (*csvRowReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, httpErrors.ErrValidation(errors.New("csv header is missing"))
+	}
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	rows := &csvRowReader{r: reader, title: -1, description: -1}
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "title":
+			rows.title = i
+		case "description":
+			rows.description = i
+		}
+	}
+	if rows.title < 0 {
+		return nil, httpErrors.ErrValidation(errors.New("csv header has no title column"))
+	}
+
+	return rows, nil
+}
+
+func (rows *csvRowReader) Next() (int, *presenter.ItemCreate, error) {
+	record, err := rows.r.Read()
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.StartLine, nil, &rowError{err: parseErr.Err}
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	line, _ := rows.r.FieldPos(0)
+
+	row := &presenter.ItemCreate{}
+	if rows.title < len(record) {
+		row.Title = record[rows.title]
+	}
+	if rows.description >= 0 && rows.description < len(record) {
+		row.Description = record[rows.description]
+	}
+	return line, row, nil
+}
+
+type jsonRowReader struct {
+	dec   *json.Decoder
+	index int
+	done  bool
+}
+
+// newJsonRowReader reads a JSON array of items, the line of a row is its
+// position in the array.
+func newJsonRowReader(r io.Reader) (*jsonRowReader, error) {
+	dec := json.NewDecoder(r)
+
+	token, err := dec.Token()
+	if err != nil {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid json: %w", err))
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, httpErrors.ErrValidation(errors.New("json document must be an array"))
+	}
+
+	return &jsonRowReader{dec: dec}, nil
+}
+
+func (rows *jsonRowReader) Next() (int, *presenter.ItemCreate, error) {
+	if rows.done {
+		return rows.index, nil, io.EOF
+	}
+
+	if !rows.dec.More() {
+		rows.done = true
+		if _, err := rows.dec.Token(); err != nil {
+			return rows.index, nil, err
+		}
+		return rows.index, nil, io.EOF
+	}
+
+	rows.index++
+
+	row := &presenter.ItemCreate{}
+	err := rows.dec.Decode(row)
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return rows.index, nil, &rowError{err: err}
+	}
+	if err != nil {
+		return rows.index, nil, err
+	}
+	return rows.index, row, nil
+}
+
+type ndjsonRowReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (rows *ndjsonRowReader) Next() (int, *presenter.ItemCreate, error) {
+	for rows.scanner.Scan() {
+		rows.line++
+
+		text := strings.TrimSpace(rows.scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		row := &presenter.ItemCreate{}
+		if err := json.Unmarshal([]byte(text), row); err != nil {
+			return rows.line, nil, &rowError{err: err}
+		}
+		return rows.line, row, nil
+	}
+
+	if err := rows.scanner.Err(); err != nil {
+		return rows.line + 1, nil, err
+	}
+	return rows.line, nil, io.EOF
+}
@@ -2,16 +2,73 @@ package items
 
 import (
 	"context"
+	"io"
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 )
 
 type ItemUseCase interface {
 	CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.ItemCreate) (*models.Item, error)
 	Get(ctx context.Context, id uint) (*models.Item, error)
-	GetMulti(ctx context.Context, offset, limit int) ([]*models.Item, error)
+	GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error)
 	Delete(ctx context.Context, id uint) (*models.Item, error)
 	Update(ctx context.Context, id uint, obj_update *models.ItemUpdate) (*models.Item, error)
-	GetMultiByOwnerId(ctx context.Context, ownerId uint, limit, offset int) ([]*models.Item, error)
+	Bulk(ctx context.Context, ownerId uint, operations []*models.ItemBulkOperation, atomic bool) ([]*models.ItemBulkResult, error)
+	GetMultiByOwnerId(ctx context.Context, ownerId uint, query *listQuery.Query) (*listQuery.Page[*models.Item], error)
 	DeleteWithoutGet(ctx context.Context, id uint) error
+	GetMultiSharedWith(ctx context.Context, userId uint, offset, limit int) ([]*models.Item, error)
+	GetShares(ctx context.Context, id uint) ([]*models.ItemShare, error)
+	CreateShare(ctx context.Context, id uint, obj_create *models.ItemShareCreate) (*models.ItemShare, error)
+	DeleteShare(ctx context.Context, id uint, userId uint) (*models.ItemShare, error)
+	Search(ctx context.Context, text string, offset, limit int) ([]*models.ItemSearchResult, error)
+	GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error)
+	Restore(ctx context.Context, id uint) (*models.Item, error)
+	GetRevisions(ctx context.Context, id uint, offset, limit int) ([]*models.ItemRevision, error)
+	DiffRevisions(ctx context.Context, id uint, fromId uint, toId *uint) (*models.ItemRevisionDiff, error)
+	RevertRevision(ctx context.Context, id uint, revisionId uint) (*models.Item, error)
+	AddTags(ctx context.Context, id uint, tagIds []uint) (*models.Item, error)
+	RemoveTag(ctx context.Context, id uint, tagId uint) (*models.Item, error)
+	GetAttachments(ctx context.Context, id uint) ([]*models.ItemAttachment, error)
+	GetAttachment(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachment, error)
+	CreateAttachment(ctx context.Context, id uint, uploaderId uint, obj_create *models.ItemAttachmentCreate, content io.Reader) (*models.ItemAttachment, error)
+	OpenAttachment(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachment, io.ReadCloser, error)
+	GetAttachmentURL(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachmentURL, error)
+	DeleteAttachment(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachment, error)
+	// Export calls fn with the items matching query by batches, ownerId limits
+	// the items to the items of an owner when it is not nil.
+	Export(ctx context.Context, ownerId *uint, query *listQuery.Query, fn func(objs []*models.Item) error) error
+	Import(ctx context.Context, ownerId uint, format string, content io.Reader) (*models.ItemImportResult, error)
+	// StartImport stores content and imports it in the worker.
+	StartImport(ctx context.Context, ownerId uint, format string, content io.Reader) (*models.ItemImport, error)
+	GetImport(ctx context.Context, importId uint) (*models.ItemImport, error)
+	GetComments(ctx context.Context, id uint, offset, limit int) ([]*models.ItemComment, error)
+	CreateComment(ctx context.Context, id uint, author *models.User, obj_create *models.ItemCommentCreate) (*models.ItemComment, error)
+	UpdateComment(ctx context.Context, id uint, commentId uint, author *models.User, obj_update *models.ItemCommentUpdate) (*models.ItemComment, error)
+	DeleteComment(ctx context.Context, id uint, commentId uint) (*models.ItemComment, error)
+	// GetActivity returns the comments and revisions of an item, newest first.
+	GetActivity(ctx context.Context, id uint, offset, limit int) ([]*models.ItemActivity, error)
+	// Transition changes the status of an item with a transition of the
+	// workflow made by user.
+	Transition(ctx context.Context, id uint, user *models.User, obj_create *models.ItemTransitionCreate) (*models.Item, error)
+	GetTransitions(ctx context.Context, id uint, offset, limit int) ([]*models.ItemTransition, error)
+	// TransferOwnership transfers an item to another user. Transfers made by
+	// super users are applied right away, the ones made by the owner wait for
+	// the recipient to accept them.
+	TransferOwnership(ctx context.Context, id uint, user *models.User, obj_create *models.OwnershipTransferCreate) (*models.OwnershipTransfer, error)
+	// TransferAllOwnership transfers every item of a user to another one.
+	TransferAllOwnership(ctx context.Context, obj *models.OwnershipTransferAll) (int, error)
+	GetOwnershipTransfers(ctx context.Context, user *models.User, status string, offset, limit int) ([]*models.OwnershipTransfer, error)
+	AcceptOwnershipTransfer(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error)
+	DeclineOwnershipTransfer(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error)
+	CancelOwnershipTransfer(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error)
+	// CalendarFeed returns the items due for the user of a calendar token.
+	CalendarFeed(ctx context.Context, token string) ([]*models.Item, error)
+	GetChildren(ctx context.Context, id uint, offset, limit int) ([]*models.Item, error)
+	// GetAncestors returns the ancestors of an item, parent first.
+	GetAncestors(ctx context.Context, id uint) ([]*models.ItemNode, error)
+	// GetSubtree returns the descendants of an item up to depth levels, by
+	// depth. A depth of 0 returns the whole subtree.
+	GetSubtree(ctx context.Context, id uint, depth int) ([]*models.ItemNode, error)
+	Move(ctx context.Context, id uint, obj_move *models.ItemMove) (*models.Item, error)
 }
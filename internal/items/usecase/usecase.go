@@ -1,56 +1,1633 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hibiken/asynq"
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/transfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/workflow"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/worker"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/imaging"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/secureRandom"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
 )
 
+// Access to items is enforced by the ent privacy policies (see internal/rule),
+// using the viewer stored in the context by the CurrentUser middleware. The
+// items and the attachments are counted against the quotas of the plan of
+// their owner and uploader.
 type itemUseCase struct {
-	pgRepo items.ItemPgRepository
-	cfg    *config.Config
-	logger logger.Logger
+	pgRepo               items.ItemPgRepository
+	redisTaskDistributor items.ItemRedisTaskDistributor
+	storage              storage.Storage
+	workflow             *workflow.Workflow
+	metadataSchemasUC    metadataSchemas.MetadataSchemaUseCase
+	quotasUC             quotas.QuotaUseCase
+	cfg                  *config.Config
+	logger               logger.Logger
 }
 
 func CreateItemUseCase(
 	pgRepo items.ItemPgRepository,
+	redisTaskDistributor items.ItemRedisTaskDistributor,
+	storage storage.Storage,
+	workflow *workflow.Workflow,
+	metadataSchemasUC metadataSchemas.MetadataSchemaUseCase,
+	quotasUC quotas.QuotaUseCase,
 	cfg *config.Config,
 	logger logger.Logger,
 ) items.ItemUseCase {
 	return &itemUseCase{
-		pgRepo: pgRepo,
-		cfg:    cfg,
-		logger: logger,
+		pgRepo:               pgRepo,
+		redisTaskDistributor: redisTaskDistributor,
+		storage:              storage,
+		workflow:             workflow,
+		metadataSchemasUC:    metadataSchemasUC,
+		quotasUC:             quotasUC,
+		cfg:                  cfg,
+		logger:               logger,
 	}
 }
 
 func (u *itemUseCase) CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.ItemCreate) (*models.Item, error) {
-	return u.pgRepo.CreateWithOwner(ctx, ownerId, obj_create)
+	var item *models.Item
+	err := u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) (err error) {
+		item, err = u.createItem(ctx, repo, ownerId, obj_create)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.rescheduleReminder(ctx, nil, item)
+	return item, nil
+}
+
+// createItem checks the quota of the owner, validates and creates an item in
+// the transaction of repo. The callers schedule the reminder once committed.
+func (u *itemUseCase) createItem(
+	ctx context.Context,
+	repo items.ItemPgRepository,
+	ownerId uint,
+	obj_create *models.ItemCreate,
+) (*models.Item, error) {
+	// The quota is checked in the transaction, which holds the lock of the
+	// owner until the item is committed.
+	if err := u.quotasUC.CheckItems(ctx, ownerId, 1); err != nil {
+		return nil, err
+	}
+
+	if err := u.metadataSchemasUC.Validate(ctx, ownerId, obj_create.Metadata); err != nil {
+		return nil, err
+	}
+
+	obj_create.Status = u.workflow.Initial
+	obj_create.DueAt = truncateDueAt(obj_create.DueAt)
+
+	if obj_create.ParentId != nil {
+		if err := u.checkParent(ctx, *obj_create.ParentId); err != nil {
+			return nil, err
+		}
+		if err := u.checkTree(ctx, repo, *obj_create.ParentId, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return repo.CreateWithOwner(ctx, ownerId, obj_create)
 }
 
 func (u *itemUseCase) Get(ctx context.Context, id uint) (*models.Item, error) {
 	return u.pgRepo.Get(ctx, id)
 }
 
-func (u *itemUseCase) GetMulti(ctx context.Context, offset, limit int) ([]*models.Item, error) {
-	return u.pgRepo.GetMulti(ctx, offset, limit)
+func (u *itemUseCase) GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error) {
+	if err := u.metadataFilter(ctx, nil, query); err != nil {
+		return nil, err
+	}
+	return u.pgRepo.GetMulti(ctx, query)
 }
 
 func (u *itemUseCase) Delete(ctx context.Context, id uint) (*models.Item, error) {
-	return u.pgRepo.Delete(ctx, id)
+	var deleted []*models.Item
+	err := u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) (err error) {
+		deleted, err = u.deleteTree(ctx, repo, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.cancelReminders(ctx, deleted)
+	return deleted[0], nil
+}
+
+// deleteTree deletes an item and, with the configured OnDelete, deletes its
+// descendants or moves its children to its parent. It returns the deleted
+// items, the item first.
+func (u *itemUseCase) deleteTree(ctx context.Context, repo items.ItemPgRepository, id uint) ([]*models.Item, error) {
+	item, err := repo.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The children may be owned by other users, they follow their parent.
+	ctx = viewer.NewElevatedContext(ctx)
+
+	if u.cfg.Hierarchy.OnDelete == models.ItemOnDeleteReparent {
+		if _, err := repo.ReparentChildren(ctx, id, item.ParentId); err != nil {
+			return nil, err
+		}
+		return []*models.Item{item}, nil
+	}
+
+	descendants, err := repo.DeleteDescendants(ctx, id, u.cfg.Hierarchy.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	return append([]*models.Item{item}, descendants...), nil
 }
 
 func (u *itemUseCase) Update(ctx context.Context, id uint, obj_update *models.ItemUpdate) (*models.Item, error) {
-	return u.pgRepo.Update(ctx, id, obj_update)
+	item, updatedItem, err := u.updateItem(ctx, u.pgRepo, id, obj_update)
+	if err != nil {
+		return nil, err
+	}
+
+	u.rescheduleReminder(ctx, item.DueAt, updatedItem)
+	return updatedItem, nil
+}
+
+// updateItem validates and applies an update with repo. It returns the item
+// before and after the update, the callers reschedule the reminder once
+// committed.
+func (u *itemUseCase) updateItem(
+	ctx context.Context,
+	repo items.ItemPgRepository,
+	id uint,
+	obj_update *models.ItemUpdate,
+) (*models.Item, *models.Item, error) {
+	item, err := repo.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if obj_update.Metadata != nil {
+		if err := u.metadataSchemasUC.Validate(ctx, item.OwnerId, obj_update.Metadata); err != nil {
+			return nil, nil, err
+		}
+	}
+	obj_update.DueAt = truncateDueAt(obj_update.DueAt)
+
+	updatedItem, err := repo.Update(ctx, id, obj_update)
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, updatedItem, nil
+}
+
+// truncateDueAt drops the fractions of seconds of a due date, which the
+// reminder tasks are identified with.
+func truncateDueAt(dueAt *time.Time) *time.Time {
+	if dueAt == nil {
+		return nil
+	}
+	truncated := dueAt.Truncate(time.Second)
+	return &truncated
+}
+
+// rescheduleReminder cancels the reminder of the previous due date of an item,
+// nil for a new item, and schedules the reminder of its current due date. The
+// item is saved already, so failures are only logged: the worker skips the
+// reminders of a due date the item does not have anymore.
+func (u *itemUseCase) rescheduleReminder(ctx context.Context, oldDueAt *time.Time, item *models.Item) {
+	if oldDueAt != nil && item.DueAt != nil && oldDueAt.Equal(*item.DueAt) {
+		return
+	}
+
+	if oldDueAt != nil {
+		u.cancelReminder(ctx, item.Id, *oldDueAt)
+	}
+	u.scheduleReminder(ctx, item)
+}
+
+func (u *itemUseCase) scheduleReminder(ctx context.Context, item *models.Item) {
+	if item.DueAt == nil || item.DeleteTime != nil || !item.DueAt.After(time.Now()) {
+		return
+	}
+
+	// A reminder time in the past, for an item due soon, runs right away.
+	processAt := item.DueAt.Add(-time.Duration(u.cfg.Reminder.Before) * time.Minute)
+
+	err := u.redisTaskDistributor.DistributeTaskItemReminder(ctx, &items.PayloadItemReminder{
+		ItemId: item.Id,
+		DueAt:  *item.DueAt,
+	}, []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.ProcessAt(processAt),
+		asynq.Queue(worker.QueueDefault),
+	}...)
+	if err != nil {
+		u.logger.Warnf("failed to schedule reminder of item %d: %v", item.Id, err)
+	}
+}
+
+// cancelReminders cancels the reminders of deleted items.
+func (u *itemUseCase) cancelReminders(ctx context.Context, deleted []*models.Item) {
+	for _, item := range deleted {
+		if item.DueAt != nil {
+			u.cancelReminder(ctx, item.Id, *item.DueAt)
+		}
+	}
+}
+
+func (u *itemUseCase) cancelReminder(ctx context.Context, id uint, dueAt time.Time) {
+	err := u.redisTaskDistributor.CancelTaskItemReminder(ctx, &items.PayloadItemReminder{
+		ItemId: id,
+		DueAt:  dueAt,
+	}, worker.QueueDefault)
+	if err != nil {
+		u.logger.Warnf("failed to cancel reminder of item %d: %v", id, err)
+	}
+}
+
+func (u *itemUseCase) GetMultiByOwnerId(ctx context.Context, ownerId uint, query *listQuery.Query) (*listQuery.Page[*models.Item], error) {
+	if err := u.metadataFilter(ctx, &ownerId, query); err != nil {
+		return nil, err
+	}
+	return u.pgRepo.GetMultiByOwnerId(ctx, ownerId, query)
 }
 
-func (u *itemUseCase) GetMultiByOwnerId(ctx context.Context, ownerId uint, limit, offset int) ([]*models.Item, error) {
-	return u.pgRepo.GetMultiByOwnerId(ctx, ownerId, limit, offset)
+// metadataFilter replaces the `metadata.<key>` parameters of query by the
+// `metadata` parameter, the JSON document the metadata of the listed items
+// must contain. Only the indexed keys of the schemas of ownerId, or of every
+// schema when ownerId is nil, can be filtered by.
+func (u *itemUseCase) metadataFilter(ctx context.Context, ownerId *uint, query *listQuery.Query) error {
+	if query == nil {
+		return nil
+	}
+
+	var keys map[string]string
+	contains := make(map[string]interface{})
+	for param, values := range query.Params {
+		key, ok := strings.CutPrefix(param, items.MetadataParamPrefix)
+		if !ok {
+			continue
+		}
+
+		if keys == nil {
+			var err error
+			keys, err = u.metadataSchemasUC.IndexedKeys(ctx, ownerId)
+			if err != nil {
+				return err
+			}
+		}
+
+		keyType, ok := keys[key]
+		if !ok {
+			return httpErrors.ErrValidation(fmt.Errorf("can not filter by metadata key %q, it is not indexed", key))
+		}
+		if len(values) != 1 {
+			return httpErrors.ErrValidation(fmt.Errorf("metadata key %q can only be filtered by one value", key))
+		}
+
+		value, err := parseMetadataValue(keyType, values[0])
+		if err != nil {
+			return httpErrors.ErrValidation(fmt.Errorf("invalid value for metadata key %q: %w", key, err))
+		}
+		contains[key] = value
+
+		delete(query.Params, param)
+	}
+
+	if len(contains) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(contains)
+	if err != nil {
+		return err
+	}
+	query.Params.Set(items.MetadataParam, string(data))
+
+	return nil
+}
+
+func parseMetadataValue(keyType string, raw string) (interface{}, error) {
+	switch keyType {
+	case "integer":
+		return strconv.ParseInt(raw, 10, 64)
+	case "number":
+		return strconv.ParseFloat(raw, 64)
+	case "boolean":
+		return strconv.ParseBool(raw)
+	default:
+		return raw, nil
+	}
 }
 
 func (u *itemUseCase) DeleteWithoutGet(ctx context.Context, id uint) error {
 	return u.pgRepo.DeleteWithoutGet(ctx, id)
 }
+
+func (u *itemUseCase) GetMultiSharedWith(ctx context.Context, userId uint, offset, limit int) ([]*models.Item, error) {
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetMultiSharedWith(ctx, userId, offset, limit)
+}
+
+func (u *itemUseCase) GetShares(ctx context.Context, id uint) ([]*models.ItemShare, error) {
+	item, err := u.pgRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The users an item is shared with can read their own share only.
+	if v := viewer.FromContext(ctx); v == nil || (!v.Admin() && v.Id != item.OwnerId) {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only the owner can list the shares of an item"))
+	}
+
+	return u.pgRepo.GetShares(ctx, id)
+}
+
+func (u *itemUseCase) CreateShare(ctx context.Context, id uint, obj_create *models.ItemShareCreate) (*models.ItemShare, error) {
+	item, err := u.pgRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj_create.UserId == item.OwnerId {
+		return nil, httpErrors.ErrValidation(errors.New("can not share item with its owner"))
+	}
+
+	return u.pgRepo.UpsertShare(ctx, id, obj_create)
+}
+
+func (u *itemUseCase) DeleteShare(ctx context.Context, id uint, userId uint) (*models.ItemShare, error) {
+	return u.pgRepo.DeleteShare(ctx, id, userId)
+}
+
+func (u *itemUseCase) Search(ctx context.Context, text string, offset, limit int) ([]*models.ItemSearchResult, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, httpErrors.ErrValidation(errors.New("search query is required"))
+	}
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.Search(ctx, text, offset, limit)
+}
+
+func (u *itemUseCase) GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.Item], error) {
+	return u.pgRepo.GetMultiTrash(ctx, query)
+}
+
+// Restore restores an item with the descendants deleted with it. The item is
+// moved to the root if its parent is still deleted.
+func (u *itemUseCase) Restore(ctx context.Context, id uint) (*models.Item, error) {
+	var item *models.Item
+	var descendants []*models.Item
+	err := u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) (err error) {
+		// The descendants may be owned by other users, they follow their
+		// ancestor. The transaction is rolled back when the viewer can not
+		// restore the item.
+		descendants, err = repo.RestoreDescendants(viewer.NewElevatedContext(ctx), id, u.cfg.Hierarchy.MaxDepth)
+		if err != nil {
+			return err
+		}
+
+		item, err = repo.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// The quotas are counted in the transaction, the restored items are
+		// included already.
+		owners := map[uint]bool{item.OwnerId: true}
+		for _, descendant := range descendants {
+			owners[descendant.OwnerId] = true
+		}
+		for ownerId := range owners {
+			if err := u.quotasUC.CheckItems(ctx, ownerId, 0); err != nil {
+				return err
+			}
+		}
+
+		if item.ParentId == nil {
+			return nil
+		}
+
+		detached, err := repo.DetachFromDeletedParent(ctx, id)
+		if err != nil || !detached {
+			return err
+		}
+		item, err = repo.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.scheduleReminder(ctx, item)
+	for _, descendant := range descendants {
+		u.scheduleReminder(ctx, descendant)
+	}
+	return item, nil
+}
+
+func (u *itemUseCase) GetRevisions(ctx context.Context, id uint, offset, limit int) ([]*models.ItemRevision, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetRevisions(ctx, id, offset, limit)
+}
+
+func (u *itemUseCase) DiffRevisions(ctx context.Context, id uint, fromId uint, toId *uint) (*models.ItemRevisionDiff, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	from, err := u.pgRepo.GetRevision(ctx, id, fromId)
+	if err != nil {
+		return nil, err
+	}
+
+	var to *models.ItemRevision
+	if toId != nil {
+		to, err = u.pgRepo.GetRevision(ctx, id, *toId)
+	} else {
+		to, err = u.pgRepo.GetLatestRevision(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ItemRevisionDiff{
+		From:    from,
+		To:      to,
+		Changes: models.DiffItemSnapshots(&from.Snapshot, &to.Snapshot),
+	}, nil
+}
+
+// RevertRevision restores the title, the description, the metadata and the due
+// date of an item from a revision. The status, the owner, the parent and the
+// deletion have their own operations and are not reverted, the status only
+// changes through the transitions of the workflow.
+func (u *itemUseCase) RevertRevision(ctx context.Context, id uint, revisionId uint) (*models.Item, error) {
+	item, err := u.pgRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := u.pgRepo.GetRevision(ctx, id, revisionId)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := revision.Snapshot
+
+	obj_update := &models.ItemUpdate{
+		Title:       &snapshot.Title,
+		Description: &snapshot.Description,
+		Metadata:    snapshot.Metadata,
+		DueAt:       snapshot.DueAt,
+		ClearDueAt:  snapshot.DueAt == nil,
+	}
+	// The metadata is replaced by an empty document when the revision has
+	// none, it is validated like the others.
+	if obj_update.Metadata == nil && item.Metadata != nil {
+		obj_update.Metadata = map[string]interface{}{}
+	}
+
+	// Reverting is an update like any other: the metadata is validated, the
+	// reminder follows the due date and a new revision is recorded.
+	return u.Update(ctx, id, obj_update)
+}
+
+// MaxBulkOperations is the maximum number of operations of a bulk request.
+const MaxBulkOperations = 500
+
+var (
+	errBulkRolledBack = errors.New("operation rolled back because another operation failed")
+	errBulkSkipped    = errors.New("operation skipped because another operation failed")
+)
+
+// Bulk runs the operations in order. When atomic, they run in one transaction
+// that is rolled back on the first failure, otherwise every operation runs in
+// its own transaction and the others are not affected by its failure.
+// Ownership is checked per operation by the privacy policies.
+func (u *itemUseCase) Bulk(
+	ctx context.Context,
+	ownerId uint,
+	operations []*models.ItemBulkOperation,
+	atomic bool,
+) ([]*models.ItemBulkResult, error) {
+	if len(operations) == 0 {
+		return nil, httpErrors.ErrValidation(errors.New("no operations"))
+	}
+	if len(operations) > MaxBulkOperations {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("at most %d operations are allowed", MaxBulkOperations))
+	}
+
+	results := make([]*models.ItemBulkResult, len(operations))
+
+	if !atomic {
+		for i, operation := range operations {
+			var item *models.Item
+			var committed func()
+			err := u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) (err error) {
+				item, committed, err = u.runBulkOperation(ctx, repo, ownerId, operation)
+				return err
+			})
+			if err == nil {
+				committed()
+			}
+			results[i] = &models.ItemBulkResult{Op: operation.Op, Item: item, Err: err}
+		}
+		return results, nil
+	}
+
+	failed := -1
+	committed := make([]func(), 0, len(operations))
+	err := u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) error {
+		for i, operation := range operations {
+			item, done, err := u.runBulkOperation(ctx, repo, ownerId, operation)
+			results[i] = &models.ItemBulkResult{Op: operation.Op, Item: item, Err: err}
+			if err != nil {
+				failed = i
+				return err
+			}
+			committed = append(committed, done)
+		}
+		return nil
+	})
+	if err == nil {
+		for _, done := range committed {
+			done()
+		}
+		return results, nil
+	}
+	if failed < 0 {
+		// The commit failed, no operation was applied.
+		return nil, err
+	}
+
+	for i, operation := range operations {
+		switch {
+		case i < failed:
+			results[i] = &models.ItemBulkResult{Op: operation.Op, Err: httpErrors.ErrFailedDependency(errBulkRolledBack)}
+		case i > failed:
+			results[i] = &models.ItemBulkResult{Op: operation.Op, Err: httpErrors.ErrFailedDependency(errBulkSkipped)}
+		}
+	}
+	return results, err
+}
+
+// runBulkOperation runs an operation in the transaction of repo with the
+// logic of CreateWithOwner, Update and Delete. It returns the reminder
+// changes of the operation, to run once the transaction is committed.
+func (u *itemUseCase) runBulkOperation(
+	ctx context.Context,
+	repo items.ItemPgRepository,
+	ownerId uint,
+	operation *models.ItemBulkOperation,
+) (*models.Item, func(), error) {
+	switch operation.Op {
+	case models.ItemBulkOpCreate:
+		if operation.Create == nil || operation.Create.Title == "" {
+			return nil, nil, httpErrors.ErrValidation(errors.New("title is required"))
+		}
+		item, err := u.createItem(ctx, repo, ownerId, operation.Create)
+		if err != nil {
+			return nil, nil, err
+		}
+		return item, func() { u.rescheduleReminder(ctx, nil, item) }, nil
+	case models.ItemBulkOpUpdate:
+		if operation.Title != nil && *operation.Title == "" {
+			return nil, nil, httpErrors.ErrValidation(errors.New("title can not be empty"))
+		}
+		item, updatedItem, err := u.updateItem(ctx, repo, operation.Id, &models.ItemUpdate{
+			Title:       operation.Title,
+			Description: operation.Description,
+			Version:     operation.Version,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return updatedItem, func() { u.rescheduleReminder(ctx, item.DueAt, updatedItem) }, nil
+	case models.ItemBulkOpDelete:
+		deleted, err := u.deleteTree(ctx, repo, operation.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return deleted[0], func() { u.cancelReminders(ctx, deleted) }, nil
+	default:
+		return nil, nil, httpErrors.ErrValidation(fmt.Errorf("unknown operation %q", operation.Op))
+	}
+}
+
+func (u *itemUseCase) AddTags(ctx context.Context, id uint, tagIds []uint) (*models.Item, error) {
+	item, err := u.pgRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	unique := make([]uint, 0, len(tagIds))
+	seen := make(map[uint]bool, len(tagIds))
+	for _, tagId := range tagIds {
+		if !seen[tagId] {
+			seen[tagId] = true
+			unique = append(unique, tagId)
+		}
+	}
+
+	// Tags are scoped per owner, only the tags of the item owner can be attached.
+	count, err := u.pgRepo.CountTagsOfOwner(ctx, item.OwnerId, unique)
+	if err != nil {
+		return nil, err
+	}
+	if count != len(unique) {
+		return nil, httpErrors.ErrNotFound(errors.New("not found tag of the item owner"))
+	}
+
+	return u.pgRepo.AddTags(ctx, id, unique)
+}
+
+func (u *itemUseCase) RemoveTag(ctx context.Context, id uint, tagId uint) (*models.Item, error) {
+	return u.pgRepo.RemoveTags(ctx, id, []uint{tagId})
+}
+
+func (u *itemUseCase) GetAttachments(ctx context.Context, id uint) ([]*models.ItemAttachment, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	objs, err := u.pgRepo.GetAttachments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objs {
+		if err := u.presignThumbnails(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return objs, nil
+}
+
+func (u *itemUseCase) GetAttachment(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachment, error) {
+	obj, err := u.pgRepo.GetAttachment(ctx, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.presignThumbnails(ctx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// presignThumbnails sets the download URLs of the thumbnails of an attachment.
+func (u *itemUseCase) presignThumbnails(ctx context.Context, obj *models.ItemAttachment) error {
+	expires := time.Duration(u.cfg.Storage.PresignExpireMinutes) * time.Minute
+
+	for i, thumbnail := range obj.Thumbnails {
+		filename := strings.TrimSuffix(obj.Filename, filepath.Ext(obj.Filename)) + "-" + thumbnail.Name + thumbnailExt(thumbnail.ContentType)
+
+		url, err := u.storage.PresignGet(ctx, thumbnail.StorageKey, expires, filename, thumbnail.ContentType)
+		if err != nil {
+			return err
+		}
+		obj.Thumbnails[i].Url = url
+	}
+	return nil
+}
+
+func thumbnailExt(contentType string) string {
+	if contentType == "image/jpeg" {
+		return ".jpg"
+	}
+	return ".png"
+}
+
+var errUploadTooLarge = errors.New("file is too large")
+
+// sizeLimitReader fails with errUploadTooLarge once more than limit bytes are read.
+type sizeLimitReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n, errUploadTooLarge
+	}
+	return n, err
+}
+
+// CreateAttachment streams content to the storage, the content type is sniffed
+// from the first bytes of the content and the SHA-256 of the content is checked
+// against the checksum sent by the client, if any.
+func (u *itemUseCase) CreateAttachment(
+	ctx context.Context,
+	id uint,
+	uploaderId uint,
+	obj_create *models.ItemAttachmentCreate,
+	content io.Reader,
+) (*models.ItemAttachment, error) {
+	filename := strings.TrimSpace(filepath.Base(filepath.Clean("/" + obj_create.Filename)))
+	if filename == "" || filename == "/" || filename == "." {
+		return nil, httpErrors.ErrValidation(errors.New("filename is required"))
+	}
+	if len(filename) > 255 {
+		return nil, httpErrors.ErrValidation(errors.New("filename is too long"))
+	}
+
+	checksum := strings.ToLower(obj_create.Checksum)
+	if checksum != "" {
+		if decoded, err := hex.DecodeString(checksum); err != nil || len(decoded) != sha256.Size {
+			return nil, httpErrors.ErrValidation(errors.New("checksum must be a hex encoded sha256"))
+		}
+	}
+
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// The size is only known once the content is read, the upload is limited
+	// to the storage left by the plan of the uploader.
+	limit := u.cfg.Storage.MaxUploadSize
+	left, err := u.quotasUC.StorageLeft(ctx, uploaderId)
+	if err != nil {
+		return nil, err
+	}
+	if left == 0 {
+		return nil, u.quotasUC.CheckStorage(ctx, uploaderId, 1)
+	}
+	quotaLimited := left > 0 && left < limit
+	if quotaLimited {
+		limit = left
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, httpErrors.ErrValidation(errors.New("file is empty"))
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" {
+		if byExtension := mime.TypeByExtension(filepath.Ext(filename)); byExtension != "" {
+			contentType = byExtension
+		}
+	}
+
+	random, err := secureRandom.RandomHex(16)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("items/%d/%s", id, random)
+
+	hash := sha256.New()
+	limited := &sizeLimitReader{
+		r:     io.TeeReader(io.MultiReader(bytes.NewReader(head), content), hash),
+		limit: limit,
+	}
+
+	err = u.storage.Put(ctx, key, limited, -1, contentType)
+	if errors.Is(err, errUploadTooLarge) {
+		if quotaLimited {
+			if err := u.quotasUC.CheckStorage(ctx, uploaderId, limited.read); err != nil {
+				return nil, err
+			}
+		}
+		return nil, httpErrors.ErrRequestEntityTooLarge(fmt.Errorf("file is larger than %d bytes", u.cfg.Storage.MaxUploadSize))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if checksum != "" && checksum != sum {
+		u.deleteBlob(key)
+		return nil, httpErrors.ErrValidation(errors.New("checksum does not match the uploaded content"))
+	}
+
+	obj_new := &models.ItemAttachment{
+		ItemId:      id,
+		UploaderId:  &uploaderId,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        limited.read,
+		Checksum:    sum,
+		StorageKey:  key,
+	}
+
+	isImage := imaging.Supported(contentType)
+	if isImage {
+		status := models.ItemAttachmentStatusPending
+		obj_new.ProcessingStatus = &status
+	}
+
+	obj, err := u.pgRepo.CreateAttachment(ctx, obj_new)
+	if err != nil {
+		u.deleteBlob(key)
+		return nil, err
+	}
+
+	if isImage {
+		err = u.redisTaskDistributor.DistributeTaskProcessAttachment(ctx, &items.PayloadProcessAttachment{
+			ItemId:       id,
+			AttachmentId: obj.Id,
+		}, []asynq.Option{
+			asynq.MaxRetry(5),
+			asynq.Queue(worker.QueueDefault),
+		}...)
+		if err != nil {
+			// The upload itself succeeded, the image is kept without thumbnails.
+			u.logger.Warnf("failed to enqueue processing of attachment %d: %v", obj.Id, err)
+
+			return u.pgRepo.UpdateAttachmentProcessed(viewer.NewSystemContext(ctx), obj.Id, &models.ItemAttachmentProcessed{
+				Status: models.ItemAttachmentStatusFailed,
+			})
+		}
+	}
+
+	return obj, nil
+}
+
+func (u *itemUseCase) OpenAttachment(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachment, io.ReadCloser, error) {
+	obj, err := u.pgRepo.GetAttachment(ctx, id, attachmentId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := u.storage.Get(ctx, obj.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, httpErrors.ErrNotFound(errors.New("not found attachment content"))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return obj, content, nil
+}
+
+func (u *itemUseCase) GetAttachmentURL(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachmentURL, error) {
+	obj, err := u.pgRepo.GetAttachment(ctx, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	expires := time.Duration(u.cfg.Storage.PresignExpireMinutes) * time.Minute
+
+	url, err := u.storage.PresignGet(ctx, obj.StorageKey, expires, obj.Filename, obj.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ItemAttachmentURL{
+		Url:       url,
+		ExpiresAt: time.Now().Add(expires),
+	}, nil
+}
+
+func (u *itemUseCase) DeleteAttachment(ctx context.Context, id uint, attachmentId uint) (*models.ItemAttachment, error) {
+	obj, err := u.pgRepo.DeleteAttachment(ctx, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	u.deleteBlob(obj.StorageKey)
+	for _, thumbnail := range obj.Thumbnails {
+		u.deleteBlob(thumbnail.StorageKey)
+	}
+
+	return obj, nil
+}
+
+// deleteBlob removes an object whose row is gone or was never created. A
+// failure only leaves an orphan object behind, so it is logged and ignored. It
+// does not use the request context, which may be canceled already.
+func (u *itemUseCase) deleteBlob(key string) {
+	if err := u.storage.Delete(context.Background(), key); err != nil {
+		u.logger.Warnf("failed to delete attachment content %s: %v", key, err)
+	}
+}
+
+// exportBatchSize is the number of items read at once by an export.
+const exportBatchSize = 500
+
+func (u *itemUseCase) Export(ctx context.Context, ownerId *uint, query *listQuery.Query, fn func(objs []*models.Item) error) error {
+	if err := u.metadataFilter(ctx, ownerId, query); err != nil {
+		return err
+	}
+	return u.pgRepo.Iterate(ctx, ownerId, query, exportBatchSize, fn)
+}
+
+func (u *itemUseCase) Import(ctx context.Context, ownerId uint, format string, content io.Reader) (*models.ItemImportResult, error) {
+	return transfer.Import(ctx, u.pgRepo, ownerId, u.workflow.Initial, format, content, transfer.ItemsQuota(u.quotasUC, ownerId), nil)
+}
+
+// StartImport streams content to the storage and enqueues the import, the
+// rows are validated by the worker.
+func (u *itemUseCase) StartImport(ctx context.Context, ownerId uint, format string, content io.Reader) (*models.ItemImport, error) {
+	if !transfer.ValidFormat(format) {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("unknown format %q", format))
+	}
+
+	// The worker checks the quota in the transaction of every row, an owner
+	// without items left fails now.
+	if err := u.quotasUC.CheckItems(ctx, ownerId, 1); err != nil {
+		return nil, err
+	}
+
+	random, err := secureRandom.RandomHex(16)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("imports/%d/%s", ownerId, random)
+
+	limited := &sizeLimitReader{r: content, limit: u.cfg.Storage.MaxUploadSize}
+
+	err = u.storage.Put(ctx, key, limited, -1, transfer.ContentType(format))
+	if errors.Is(err, errUploadTooLarge) {
+		return nil, httpErrors.ErrRequestEntityTooLarge(fmt.Errorf("file is larger than %d bytes", u.cfg.Storage.MaxUploadSize))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := u.pgRepo.CreateImport(ctx, ownerId, &models.ItemImportCreate{
+		Format:     format,
+		StorageKey: key,
+		Size:       limited.read,
+	})
+	if err != nil {
+		u.deleteBlob(key)
+		return nil, err
+	}
+
+	err = u.redisTaskDistributor.DistributeTaskImportItems(ctx, &items.PayloadImportItems{
+		ImportId: obj.Id,
+	}, []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Queue(worker.QueueDefault),
+	}...)
+	if err != nil {
+		message := "failed to enqueue the import"
+		finishTime := time.Now()
+		if _, updateErr := u.pgRepo.UpdateImportProgress(viewer.NewSystemContext(ctx), obj.Id, &models.ItemImportProgress{
+			Status:     models.ItemImportStatusFailed,
+			Error:      &message,
+			FinishTime: &finishTime,
+		}); updateErr != nil {
+			u.logger.Warnf("failed to update import %d: %v", obj.Id, updateErr)
+		}
+		u.deleteBlob(key)
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (u *itemUseCase) GetImport(ctx context.Context, importId uint) (*models.ItemImport, error) {
+	return u.pgRepo.GetImport(ctx, importId)
+}
+
+func (u *itemUseCase) GetComments(ctx context.Context, id uint, offset, limit int) ([]*models.ItemComment, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetComments(ctx, id, offset, limit)
+}
+
+func (u *itemUseCase) CreateComment(ctx context.Context, id uint, author *models.User, obj_create *models.ItemCommentCreate) (*models.ItemComment, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	obj, err := u.pgRepo.CreateComment(ctx, id, author.Id, obj_create)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyMentions(ctx, obj, author, models.ParseMentions(obj.Body))
+
+	return obj, nil
+}
+
+// UpdateComment updates the body of a comment, only the users mentioned by
+// the edit and not by the previous body are notified.
+func (u *itemUseCase) UpdateComment(
+	ctx context.Context,
+	id uint,
+	commentId uint,
+	author *models.User,
+	obj_update *models.ItemCommentUpdate,
+) (*models.ItemComment, error) {
+	current, err := u.pgRepo.GetComment(ctx, id, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := u.pgRepo.UpdateComment(ctx, id, commentId, obj_update)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make(map[string]bool)
+	for _, email := range models.ParseMentions(current.Body) {
+		previous[email] = true
+	}
+
+	var emails []string
+	for _, email := range models.ParseMentions(obj.Body) {
+		if !previous[email] {
+			emails = append(emails, email)
+		}
+	}
+
+	u.notifyMentions(ctx, obj, author, emails)
+
+	return obj, nil
+}
+
+func (u *itemUseCase) DeleteComment(ctx context.Context, id uint, commentId uint) (*models.ItemComment, error) {
+	return u.pgRepo.DeleteComment(ctx, id, commentId)
+}
+
+// notifyMentions enqueues the emails to the mentioned users, the worker checks
+// they can see the item. The comment is saved already, so a failure is only
+// logged.
+func (u *itemUseCase) notifyMentions(ctx context.Context, obj *models.ItemComment, author *models.User, emails []string) {
+	recipients := make([]string, 0, len(emails))
+	for _, email := range emails {
+		if email != author.Email {
+			recipients = append(recipients, email)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	err := u.redisTaskDistributor.DistributeTaskNotifyMentions(ctx, &items.PayloadNotifyMentions{
+		ItemId:     obj.ItemId,
+		CommentId:  obj.Id,
+		AuthorName: author.Name,
+		Emails:     recipients,
+	}, []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Queue(worker.QueueDefault),
+	}...)
+	if err != nil {
+		u.logger.Warnf("failed to enqueue mentions of comment %d: %v", obj.Id, err)
+	}
+}
+
+func (u *itemUseCase) GetActivity(ctx context.Context, id uint, offset, limit int) ([]*models.ItemActivity, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	offset, limit = listQuery.Paginate(offset, limit)
+
+	// Both lists are newest first, the page is in the first offset+limit events
+	// of one or the other.
+	revisions, err := u.pgRepo.GetRevisions(ctx, id, 0, offset+limit)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := u.pgRepo.GetLatestComments(ctx, id, offset+limit)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := make([]*models.ItemActivity, 0, len(revisions)+len(comments))
+	for len(revisions) > 0 || len(comments) > 0 {
+		if len(comments) == 0 || (len(revisions) > 0 && !revisions[0].CreateTime.Before(comments[0].CreateTime)) {
+			activity = append(activity, &models.ItemActivity{
+				Type:     models.ItemActivityRevision,
+				Time:     revisions[0].CreateTime,
+				UserId:   revisions[0].UserId,
+				Revision: revisions[0],
+			})
+			revisions = revisions[1:]
+		} else {
+			activity = append(activity, &models.ItemActivity{
+				Type:    models.ItemActivityComment,
+				Time:    comments[0].CreateTime,
+				UserId:  comments[0].AuthorId,
+				Comment: comments[0],
+			})
+			comments = comments[1:]
+		}
+	}
+
+	if offset >= len(activity) {
+		return []*models.ItemActivity{}, nil
+	}
+	end := offset + limit
+	if end > len(activity) {
+		end = len(activity)
+	}
+	return activity[offset:end], nil
+}
+
+// Transition changes the status of an item if the transition can start from
+// its status and the user has one of the roles allowed to make it. The users
+// to notify are emailed by the worker.
+func (u *itemUseCase) Transition(
+	ctx context.Context,
+	id uint,
+	user *models.User,
+	obj_create *models.ItemTransitionCreate,
+) (*models.Item, error) {
+	transition, ok := u.workflow.Transition(obj_create.Transition)
+	if !ok {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("unknown transition %q", obj_create.Transition))
+	}
+
+	item, err := u.pgRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !transition.CanStartFrom(item.Status) {
+		return nil, httpErrors.ErrInvalidTransition(fmt.Errorf("transition %q is not allowed from status %q", transition.Name, item.Status))
+	}
+
+	roles, err := u.itemRoles(ctx, item, user)
+	if err != nil {
+		return nil, err
+	}
+	if !transition.AllowedFor(roles) {
+		return nil, httpErrors.ErrNotEnoughPrivileges(fmt.Errorf("not allowed to make transition %q", transition.Name))
+	}
+
+	from := item.Status
+
+	var obj *models.ItemTransition
+	err = u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) error {
+		item, err = repo.UpdateStatus(ctx, id, from, transition.To)
+		if err != nil {
+			return err
+		}
+
+		obj, err = repo.CreateTransition(ctx, &models.ItemTransition{
+			ItemId:     id,
+			UserId:     &user.Id,
+			Name:       transition.Name,
+			FromStatus: from,
+			ToStatus:   transition.To,
+			Comment:    obj_create.Comment,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transition.Notify) > 0 {
+		var comment string
+		if obj.Comment != nil {
+			comment = *obj.Comment
+		}
+
+		// The status is changed already, so a failure is only logged.
+		err = u.redisTaskDistributor.DistributeTaskItemTransitioned(ctx, &items.PayloadItemTransitioned{
+			ItemId:       id,
+			TransitionId: obj.Id,
+			Transition:   obj.Name,
+			From:         obj.FromStatus,
+			To:           obj.ToStatus,
+			UserId:       user.Id,
+			UserName:     user.Name,
+			Comment:      comment,
+			Notify:       transition.Notify,
+		}, []asynq.Option{
+			asynq.MaxRetry(5),
+			asynq.Queue(worker.QueueDefault),
+		}...)
+		if err != nil {
+			u.logger.Warnf("failed to enqueue transition %d of item %d: %v", obj.Id, id, err)
+		}
+	}
+
+	return item, nil
+}
+
+// itemRoles returns the workflow roles of user on item.
+func (u *itemUseCase) itemRoles(ctx context.Context, item *models.Item, user *models.User) ([]string, error) {
+	roles := make([]string, 0, 2)
+
+	if item.OwnerId == user.Id {
+		roles = append(roles, models.ItemPermissionOwner)
+	} else {
+		share, err := u.pgRepo.GetShare(ctx, item.Id, user.Id)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if share != nil {
+			roles = append(roles, share.Permission)
+		}
+	}
+
+	if user.IsSuperUser {
+		roles = append(roles, workflow.RoleSuperUser)
+	}
+
+	return roles, nil
+}
+
+func (u *itemUseCase) GetTransitions(ctx context.Context, id uint, offset, limit int) ([]*models.ItemTransition, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetTransitions(ctx, id, offset, limit)
+}
+
+// ownershipTransferBatchSize is the number of transfers saved by statement
+// when all the items of a user are transferred.
+const ownershipTransferBatchSize = 1000
+
+func (u *itemUseCase) TransferOwnership(
+	ctx context.Context,
+	id uint,
+	user *models.User,
+	obj_create *models.OwnershipTransferCreate,
+) (*models.OwnershipTransfer, error) {
+	item, err := u.pgRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.OwnerId != user.Id && !user.IsSuperUser {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only the owner can transfer an item"))
+	}
+	if obj_create.ToUserId == item.OwnerId {
+		return nil, httpErrors.ErrValidation(errors.New("item is already owned by this user"))
+	}
+
+	active, err := u.pgRepo.IsActiveUser(viewer.NewSystemContext(ctx), obj_create.ToUserId)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, httpErrors.ErrNotFound(errors.New("not found user to transfer to"))
+	}
+
+	if !user.IsSuperUser {
+		// A second pending transfer of the item, sent concurrently or not,
+		// is rejected by the database with a conflict.
+		objs, err := u.pgRepo.CreateOwnershipTransfers(ctx, []*models.OwnershipTransfer{{
+			ItemId:     id,
+			FromUserId: item.OwnerId,
+			ToUserId:   obj_create.ToUserId,
+			Status:     models.OwnershipTransferStatusPending,
+		}})
+		if err != nil {
+			return nil, err
+		}
+		return objs[0], nil
+	}
+
+	var obj *models.OwnershipTransfer
+	err = u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) error {
+		if _, err := repo.TransferOwner(ctx, id, item.OwnerId, obj_create.ToUserId); err != nil {
+			return err
+		}
+
+		objs, err := repo.CreateOwnershipTransfers(ctx, []*models.OwnershipTransfer{{
+			ItemId:     id,
+			FromUserId: item.OwnerId,
+			ToUserId:   obj_create.ToUserId,
+			Status:     models.OwnershipTransferStatusAccepted,
+		}})
+		if err != nil {
+			return err
+		}
+		obj = objs[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (u *itemUseCase) TransferAllOwnership(ctx context.Context, obj *models.OwnershipTransferAll) (int, error) {
+	if obj.FromUserId == obj.ToUserId {
+		return 0, httpErrors.ErrValidation(errors.New("items are already owned by this user"))
+	}
+
+	active, err := u.pgRepo.IsActiveUser(ctx, obj.ToUserId)
+	if err != nil {
+		return 0, err
+	}
+	if !active {
+		return 0, httpErrors.ErrNotFound(errors.New("not found user to transfer to"))
+	}
+
+	var count int
+	err = u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) error {
+		ids, err := repo.TransferAllOwner(ctx, obj.FromUserId, obj.ToUserId)
+		if err != nil {
+			return err
+		}
+		count = len(ids)
+
+		for start := 0; start < len(ids); start += ownershipTransferBatchSize {
+			end := start + ownershipTransferBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			objs := make([]*models.OwnershipTransfer, 0, end-start)
+			for _, id := range ids[start:end] {
+				objs = append(objs, &models.OwnershipTransfer{
+					ItemId:     id,
+					FromUserId: obj.FromUserId,
+					ToUserId:   obj.ToUserId,
+					Status:     models.OwnershipTransferStatusAccepted,
+				})
+			}
+			if _, err := repo.CreateOwnershipTransfers(ctx, objs); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (u *itemUseCase) GetOwnershipTransfers(
+	ctx context.Context,
+	user *models.User,
+	status string,
+	offset, limit int,
+) ([]*models.OwnershipTransfer, error) {
+	switch status {
+	case "",
+		models.OwnershipTransferStatusPending,
+		models.OwnershipTransferStatusAccepted,
+		models.OwnershipTransferStatusDeclined,
+		models.OwnershipTransferStatusCancelled:
+	default:
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid status %q", status))
+	}
+
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetOwnershipTransfers(ctx, user.Id, status, offset, limit)
+}
+
+func (u *itemUseCase) AcceptOwnershipTransfer(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error) {
+	transfer, err := u.pgRepo.GetOwnershipTransfer(ctx, transferId)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToUserId != user.Id {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only the recipient can accept an ownership transfer"))
+	}
+
+	var obj *models.OwnershipTransfer
+	err = u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) error {
+		if err := u.quotasUC.CheckItems(ctx, user.Id, 1); err != nil {
+			return err
+		}
+
+		var err error
+		obj, err = repo.UpdateOwnershipTransferStatus(
+			ctx, transferId, models.OwnershipTransferStatusPending, models.OwnershipTransferStatusAccepted,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = repo.TransferOwner(ctx, transfer.ItemId, transfer.FromUserId, transfer.ToUserId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (u *itemUseCase) DeclineOwnershipTransfer(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error) {
+	transfer, err := u.pgRepo.GetOwnershipTransfer(ctx, transferId)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToUserId != user.Id {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only the recipient can decline an ownership transfer"))
+	}
+
+	return u.pgRepo.UpdateOwnershipTransferStatus(
+		ctx, transferId, models.OwnershipTransferStatusPending, models.OwnershipTransferStatusDeclined,
+	)
+}
+
+func (u *itemUseCase) CancelOwnershipTransfer(ctx context.Context, transferId uint, user *models.User) (*models.OwnershipTransfer, error) {
+	transfer, err := u.pgRepo.GetOwnershipTransfer(ctx, transferId)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.FromUserId != user.Id && !user.IsSuperUser {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("only the sender can cancel an ownership transfer"))
+	}
+
+	return u.pgRepo.UpdateOwnershipTransferStatus(
+		ctx, transferId, models.OwnershipTransferStatusPending, models.OwnershipTransferStatusCancelled,
+	)
+}
+
+// maxCalendarItems is the maximum number of items of a calendar feed.
+const maxCalendarItems = 1000
+
+func (u *itemUseCase) CalendarFeed(ctx context.Context, token string) ([]*models.Item, error) {
+	userId, err := u.pgRepo.GetUserIdByCalendarToken(viewer.NewSystemContext(ctx), secureRandom.HashToken(token))
+	if ent.IsNotFound(err) {
+		return nil, httpErrors.ErrNotFound(errors.New("not found calendar"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The feed is read without an authenticated viewer, the user of the token
+	// sees its own items and the items shared with it, even a super user.
+	ctx = viewer.NewContext(ctx, &viewer.Viewer{Id: userId, Role: viewer.RoleUser})
+
+	from := time.Now().AddDate(0, 0, -u.cfg.Calendar.PastDays)
+	return u.pgRepo.GetDue(ctx, from, maxCalendarItems)
+}
+
+// maxSubtreeItems is the maximum number of items of a subtree.
+const maxSubtreeItems = 1000
+
+func (u *itemUseCase) GetChildren(ctx context.Context, id uint, offset, limit int) ([]*models.Item, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetChildren(ctx, id, offset, limit)
+}
+
+func (u *itemUseCase) GetAncestors(ctx context.Context, id uint) ([]*models.ItemNode, error) {
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.pgRepo.GetAncestors(ctx, id, u.cfg.Hierarchy.MaxDepth)
+}
+
+func (u *itemUseCase) GetSubtree(ctx context.Context, id uint, depth int) ([]*models.ItemNode, error) {
+	if depth <= 0 || depth > u.cfg.Hierarchy.MaxDepth {
+		depth = u.cfg.Hierarchy.MaxDepth
+	}
+
+	if _, err := u.pgRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.pgRepo.GetSubtree(ctx, id, depth, maxSubtreeItems)
+}
+
+// Move moves an item under another item, or to the root. The viewer has to be
+// allowed to edit the item and its new parent.
+func (u *itemUseCase) Move(ctx context.Context, id uint, obj_move *models.ItemMove) (*models.Item, error) {
+	if obj_move.ParentId != nil {
+		if *obj_move.ParentId == id {
+			return nil, httpErrors.ErrValidation(errors.New("item can not be its own parent"))
+		}
+		if err := u.checkParent(ctx, *obj_move.ParentId); err != nil {
+			return nil, err
+		}
+	}
+
+	var item *models.Item
+	err := u.pgRepo.WithTx(ctx, func(ctx context.Context, repo items.ItemPgRepository) (err error) {
+		if obj_move.ParentId != nil {
+			if err := u.checkTree(ctx, repo, *obj_move.ParentId, &id); err != nil {
+				return err
+			}
+		}
+		item, err = repo.UpdateParent(ctx, id, obj_move.ParentId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// checkParent checks that the viewer can add items under parentId: super
+// users, the owner and the editors of the parent.
+func (u *itemUseCase) checkParent(ctx context.Context, parentId uint) error {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return httpErrors.ErrNotEnoughPrivileges(errors.New("no viewer"))
+	}
+
+	parent, err := u.pgRepo.Get(ctx, parentId)
+	if err != nil {
+		return err
+	}
+	if v.Admin() || parent.OwnerId == v.Id {
+		return nil
+	}
+
+	share, err := u.pgRepo.GetShare(ctx, parentId, v.Id)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if share != nil && share.Permission == models.ItemPermissionEditor {
+		return nil
+	}
+	return httpErrors.ErrNotEnoughPrivileges(errors.New("user does not have permission on the parent item"))
+}
+
+// checkTree checks that the item id, nil for a new item, can be put under
+// parentId: the parent is not the item or one of its descendants, and the
+// tree stays within MaxDepth levels. It locks the item and the ancestors of
+// the parent until the end of the transaction of repo, so concurrent moves
+// can not create a cycle.
+func (u *itemUseCase) checkTree(ctx context.Context, repo items.ItemPgRepository, parentId uint, id *uint) error {
+	maxDepth := u.cfg.Hierarchy.MaxDepth
+
+	// The ancestors and the descendants may be owned by other users.
+	ctx = viewer.NewSystemContext(ctx)
+
+	ancestorIds, err := repo.GetAncestorIds(ctx, parentId, maxDepth)
+	if err != nil {
+		return err
+	}
+
+	lockIds := append([]uint{parentId}, ancestorIds...)
+	if id != nil {
+		lockIds = append(lockIds, *id)
+	}
+	if err := repo.LockItems(ctx, lockIds); err != nil {
+		return err
+	}
+
+	// Read the ancestors again, a concurrent move could have changed them
+	// before the lock.
+	ancestorIds, err = repo.GetAncestorIds(ctx, parentId, maxDepth)
+	if err != nil {
+		return err
+	}
+
+	height := 0
+	if id != nil {
+		for _, ancestorId := range ancestorIds {
+			if ancestorId == *id {
+				return httpErrors.ErrValidation(errors.New("item can not be moved under one of its descendants"))
+			}
+		}
+
+		if height, err = repo.GetSubtreeHeight(ctx, *id, maxDepth); err != nil {
+			return err
+		}
+	}
+
+	// The parent is at level len(ancestorIds)+1, the item one level below.
+	if len(ancestorIds)+2+height > maxDepth {
+		return httpErrors.ErrValidation(fmt.Errorf("items can not be nested more than %d levels deep", maxDepth))
+	}
+	return nil
+}
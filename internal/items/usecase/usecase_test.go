@@ -0,0 +1,228 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/hibiken/asynq"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	_ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	itemRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/repository"
+	itemUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/workflow"
+	metadataSchemaRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas/repository"
+	metadataSchemaUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	quotaRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/repository"
+	quotaUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// distributor drops the reminders, the other tasks are not sent by the tests.
+type distributor struct {
+	items.ItemRedisTaskDistributor
+}
+
+func (d *distributor) DistributeTaskItemReminder(ctx context.Context, payload *items.PayloadItemReminder, opts ...asynq.Option) error {
+	return nil
+}
+
+func (d *distributor) CancelTaskItemReminder(ctx context.Context, payload *items.PayloadItemReminder, queue string) error {
+	return nil
+}
+
+type fixture struct {
+	client  *ent.Client
+	itemsUC items.ItemUseCase
+	ownerId uint
+	// ctx has the owner as viewer.
+	ctx context.Context
+}
+
+// setup creates the use case of the items of a sqlite database, the owner has
+// a plan of maxItems items.
+func setup(t *testing.T, maxItems int64) *fixture {
+	t.Helper()
+
+	client := enttest.Open(t, dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	cfg := &config.Config{
+		Workflow:  config.WorkflowConfig{Initial: "draft"},
+		Hierarchy: config.HierarchyConfig{MaxDepth: 3, OnDelete: models.ItemOnDeleteCascade},
+		Quota: config.QuotaConfig{
+			DefaultPlan: "test",
+			Plans:       []config.PlanConfig{{Name: "test", MaxItems: maxItems}},
+		},
+	}
+	cfg.Logger.Level = "fatal"
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	itemWorkflow, err := workflow.New(cfg.Workflow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadataSchemaUC := metadataSchemaUseCase.CreateMetadataSchemaUseCase(metadataSchemaRepository.CreateMetadataSchemaPgRepository(client), cfg, apiLogger)
+	quotaUC := quotaUseCase.CreateQuotaUseCase(quotaRepository.CreateQuotaPgRepository(client), nil, cfg, apiLogger)
+	itemsUC := itemUseCase.CreateItemUseCase(
+		itemRepository.CreateItemPgRepository(client), &distributor{}, nil, itemWorkflow, metadataSchemaUC, quotaUC, cfg, apiLogger,
+	)
+
+	ownerId := client.User.Create().
+		SetName("owner").
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		SaveX(viewer.NewSystemContext(context.Background())).ID
+
+	return &fixture{
+		client:  client,
+		itemsUC: itemsUC,
+		ownerId: ownerId,
+		ctx:     viewer.NewContext(context.Background(), &viewer.Viewer{Id: ownerId, Role: viewer.RoleUser}),
+	}
+}
+
+func isQuotaExceeded(err error) bool {
+	return err != nil && httpErrors.ParseErrors(err).GetStatusText() == httpErrors.ErrorQuotaExceeded.Error()
+}
+
+func TestCreateQuota(t *testing.T) {
+	f := setup(t, 2)
+
+	for i := 0; i < 2; i++ {
+		if _, err := f.itemsUC.CreateWithOwner(f.ctx, f.ownerId, &models.ItemCreate{Title: "item"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := f.itemsUC.CreateWithOwner(f.ctx, f.ownerId, &models.ItemCreate{Title: "item"}); !isQuotaExceeded(err) {
+		t.Fatalf("got %v, want a quota exceeded error", err)
+	}
+}
+
+// TestBulkQuota checks that only the creates past the quota fail when the
+// operations are not atomic, and that the whole batch fails otherwise.
+func TestBulkQuota(t *testing.T) {
+	f := setup(t, 3)
+
+	item, err := f.itemsUC.CreateWithOwner(f.ctx, f.ownerId, &models.ItemCreate{Title: "existing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	title := "created"
+	renamed := "renamed"
+	create := &models.ItemBulkOperation{Op: models.ItemBulkOpCreate, Create: &models.ItemCreate{Title: title}}
+	operations := []*models.ItemBulkOperation{
+		create,
+		create,
+		create,
+		{Op: models.ItemBulkOpUpdate, Id: item.Id, Title: &renamed},
+	}
+
+	results, err := f.itemsUC.Bulk(f.ctx, f.ownerId, operations, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range results {
+		if wantExceeded := i == 2; isQuotaExceeded(result.Err) != wantExceeded || (!wantExceeded && result.Err != nil) {
+			t.Fatalf("operation %d: got %v", i, result.Err)
+		}
+	}
+
+	results, err = f.itemsUC.Bulk(f.ctx, f.ownerId, []*models.ItemBulkOperation{
+		{Op: models.ItemBulkOpUpdate, Id: item.Id, Title: &title},
+		create,
+	}, true)
+	if !isQuotaExceeded(err) {
+		t.Fatalf("got %v, want a quota exceeded error", err)
+	}
+	if status := httpErrors.ParseErrors(results[0].Err).GetStatus(); status != http.StatusFailedDependency {
+		t.Fatalf("got status %d for the rolled back update", status)
+	}
+
+	count := f.client.Item.Query().CountX(viewer.NewSystemContext(context.Background()))
+	if count != 3 {
+		t.Fatalf("got %d items, want 3", count)
+	}
+}
+
+func isValidation(err error) bool {
+	return err != nil && httpErrors.ParseErrors(err).GetStatus() == http.StatusUnprocessableEntity
+}
+
+// TestBulkCreateFields checks that the creates of a bulk set the metadata, the
+// due date and the parent, and are validated like the single creates.
+func TestBulkCreateFields(t *testing.T) {
+	f := setup(t, 10)
+
+	f.client.MetadataSchema.Create().
+		SetOwnerID(f.ownerId).
+		SetDefinition(map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"priority": map[string]interface{}{"type": "integer"}},
+		}).
+		SaveX(viewer.NewSystemContext(context.Background()))
+
+	root, err := f.itemsUC.CreateWithOwner(f.ctx, f.ownerId, &models.ItemCreate{Title: "root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := f.itemsUC.CreateWithOwner(f.ctx, f.ownerId, &models.ItemCreate{Title: "child", ParentId: &root.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	grandchild, err := f.itemsUC.CreateWithOwner(f.ctx, f.ownerId, &models.ItemCreate{Title: "grandchild", ParentId: &child.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dueAt := time.Date(2023, 9, 1, 9, 0, 0, 500, time.UTC)
+	results, err := f.itemsUC.Bulk(f.ctx, f.ownerId, []*models.ItemBulkOperation{
+		{Op: models.ItemBulkOpCreate, Create: &models.ItemCreate{
+			Title:    "valid",
+			Metadata: map[string]interface{}{"priority": 1},
+			DueAt:    &dueAt,
+			ParentId: &root.Id,
+		}},
+		{Op: models.ItemBulkOpCreate, Create: &models.ItemCreate{Title: "too deep", ParentId: &grandchild.Id}},
+		{Op: models.ItemBulkOpCreate, Create: &models.ItemCreate{
+			Title:    "invalid metadata",
+			Metadata: map[string]interface{}{"priority": "high"},
+		}},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results[0].Err != nil {
+		t.Fatal(results[0].Err)
+	}
+	created := results[0].Item
+	if created.Metadata["priority"] == nil {
+		t.Fatalf("got metadata %v", created.Metadata)
+	}
+	if created.DueAt == nil || !created.DueAt.Equal(dueAt.Truncate(time.Second)) {
+		t.Fatalf("got due date %v", created.DueAt)
+	}
+	if created.ParentId == nil || *created.ParentId != root.Id {
+		t.Fatalf("got parent %v", created.ParentId)
+	}
+
+	for i, result := range results[1:] {
+		if !isValidation(result.Err) {
+			t.Fatalf("operation %d: got %v, want a validation error", i+1, result.Err)
+		}
+	}
+}
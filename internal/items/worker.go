@@ -0,0 +1,83 @@
+package items
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TaskPurgeTrash        = "task:purge_item_trash"
+	TaskProcessAttachment = "task:process_item_attachment"
+	TaskImportItems       = "task:import_items"
+	TaskNotifyMentions    = "task:notify_comment_mentions"
+	TaskItemTransitioned  = "task:item_transitioned"
+	TaskItemReminder      = "task:item_reminder"
+)
+
+type PayloadProcessAttachment struct {
+	ItemId       uint `json:"itemId"`
+	AttachmentId uint `json:"attachmentId"`
+}
+
+type PayloadImportItems struct {
+	ImportId uint `json:"importId"`
+}
+
+// PayloadNotifyMentions holds the emails newly mentioned in a comment and the
+// name of the user who mentioned them.
+type PayloadNotifyMentions struct {
+	ItemId     uint     `json:"itemId"`
+	CommentId  uint     `json:"commentId"`
+	AuthorName string   `json:"authorName"`
+	Emails     []string `json:"emails"`
+}
+
+// PayloadItemTransitioned is the event of a change of status of an item, the
+// users with a Notify role on the item are emailed.
+type PayloadItemTransitioned struct {
+	ItemId       uint     `json:"itemId"`
+	TransitionId uint     `json:"transitionId"`
+	Transition   string   `json:"transition"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	UserId       uint     `json:"userId"`
+	UserName     string   `json:"userName"`
+	Comment      string   `json:"comment"`
+	Notify       []string `json:"notify"`
+}
+
+// PayloadItemReminder is the reminder of the due date of an item. The reminder
+// is skipped when the item has another due date by the time it runs.
+type PayloadItemReminder struct {
+	ItemId uint      `json:"itemId"`
+	DueAt  time.Time `json:"dueAt"`
+}
+
+// TaskId is the id of the reminder task, unique per item and due date so that
+// the reminder of a previous due date can be deleted.
+func (payload *PayloadItemReminder) TaskId() string {
+	return fmt.Sprintf("item_reminder:%d:%d", payload.ItemId, payload.DueAt.Unix())
+}
+
+type ItemRedisTaskDistributor interface {
+	DistributeTaskProcessAttachment(ctx context.Context, payload *PayloadProcessAttachment, opts ...asynq.Option) error
+	DistributeTaskImportItems(ctx context.Context, payload *PayloadImportItems, opts ...asynq.Option) error
+	DistributeTaskNotifyMentions(ctx context.Context, payload *PayloadNotifyMentions, opts ...asynq.Option) error
+	DistributeTaskItemTransitioned(ctx context.Context, payload *PayloadItemTransitioned, opts ...asynq.Option) error
+	DistributeTaskItemReminder(ctx context.Context, payload *PayloadItemReminder, opts ...asynq.Option) error
+	// CancelTaskItemReminder deletes a scheduled reminder, it does nothing if
+	// the reminder is not scheduled.
+	CancelTaskItemReminder(ctx context.Context, payload *PayloadItemReminder, queue string) error
+}
+
+type ItemRedisTaskProcessor interface {
+	ProcessTaskPurgeTrash(ctx context.Context, task *asynq.Task) error
+	ProcessTaskProcessAttachment(ctx context.Context, task *asynq.Task) error
+	ProcessTaskImportItems(ctx context.Context, task *asynq.Task) error
+	ProcessTaskNotifyMentions(ctx context.Context, task *asynq.Task) error
+	ProcessTaskItemTransitioned(ctx context.Context, task *asynq.Task) error
+	ProcessTaskItemReminder(ctx context.Context, task *asynq.Task) error
+}
@@ -0,0 +1,152 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// RoleSuperUser is the role of super users, the other roles are the item
+// permissions (models.ItemPermissionOwner, Editor and Viewer).
+const RoleSuperUser = "superuser"
+
+// Transition is a change of status of an item, allowed from the From statuses
+// to the users with one of the Allowed roles.
+type Transition struct {
+	Name    string
+	From    []string
+	To      string
+	Allowed []string
+	Notify  []string
+}
+
+// CanStartFrom reports whether the transition can be made from status.
+func (t *Transition) CanStartFrom(status string) bool {
+	return contains(t.From, status)
+}
+
+// AllowedFor reports whether a user with roles may make the transition.
+func (t *Transition) AllowedFor(roles []string) bool {
+	for _, role := range roles {
+		if contains(t.Allowed, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Workflow is the state machine of the item statuses, read from the configuration.
+type Workflow struct {
+	Initial     string
+	Transitions []*Transition
+	byName      map[string]*Transition
+}
+
+// New checks the workflow of the configuration: the transitions must have
+// unique names, known roles and every status must be reachable from the
+// initial status.
+func New(cfg config.WorkflowConfig) (*Workflow, error) {
+	if cfg.Initial == "" {
+		return nil, errors.New("workflow: initial status is required")
+	}
+
+	w := &Workflow{
+		Initial: cfg.Initial,
+		byName:  make(map[string]*Transition, len(cfg.Transitions)),
+	}
+
+	for _, transitionCfg := range cfg.Transitions {
+		transition := &Transition{
+			Name:    transitionCfg.Name,
+			From:    transitionCfg.From,
+			To:      transitionCfg.To,
+			Allowed: transitionCfg.Allowed,
+			Notify:  transitionCfg.Notify,
+		}
+
+		if transition.Name == "" {
+			return nil, errors.New("workflow: transition name is required")
+		}
+		if _, ok := w.byName[transition.Name]; ok {
+			return nil, fmt.Errorf("workflow: duplicate transition %q", transition.Name)
+		}
+		if len(transition.From) == 0 || transition.To == "" {
+			return nil, fmt.Errorf("workflow: transition %q needs from and to statuses", transition.Name)
+		}
+		for _, role := range transition.Allowed {
+			if !validRole(role) {
+				return nil, fmt.Errorf("workflow: transition %q has unknown role %q", transition.Name, role)
+			}
+		}
+		for _, role := range transition.Notify {
+			if role == RoleSuperUser || !validRole(role) {
+				return nil, fmt.Errorf("workflow: transition %q can not notify role %q", transition.Name, role)
+			}
+		}
+
+		w.Transitions = append(w.Transitions, transition)
+		w.byName[transition.Name] = transition
+	}
+
+	reachable := map[string]bool{w.Initial: true}
+	for changed := true; changed; {
+		changed = false
+		for _, transition := range w.Transitions {
+			if reachable[transition.To] {
+				continue
+			}
+			for _, from := range transition.From {
+				if reachable[from] {
+					reachable[transition.To] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	for _, transition := range w.Transitions {
+		for _, from := range transition.From {
+			if !reachable[from] {
+				return nil, fmt.Errorf("workflow: status %q of transition %q is not reachable", from, transition.Name)
+			}
+		}
+	}
+
+	return w, nil
+}
+
+// Transition returns the transition with name.
+func (w *Workflow) Transition(name string) (*Transition, bool) {
+	transition, ok := w.byName[name]
+	return transition, ok
+}
+
+// Available returns the transitions a user with roles may make from status.
+func (w *Workflow) Available(status string, roles []string) []*Transition {
+	transitions := make([]*Transition, 0)
+	for _, transition := range w.Transitions {
+		if transition.CanStartFrom(status) && transition.AllowedFor(roles) {
+			transitions = append(transitions, transition)
+		}
+	}
+	return transitions
+}
+
+func validRole(role string) bool {
+	switch role {
+	case models.ItemPermissionOwner, models.ItemPermissionEditor, models.ItemPermissionViewer, RoleSuperUser:
+		return true
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
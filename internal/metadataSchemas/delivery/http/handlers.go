@@ -0,0 +1,250 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+)
+
+type metadataSchemaHandler struct {
+	cfg               *config.Config
+	metadataSchemasUC metadataSchemas.MetadataSchemaUseCase
+	logger            logger.Logger
+}
+
+func CreateMetadataSchemaHandler(uc metadataSchemas.MetadataSchemaUseCase, cfg *config.Config, logger logger.Logger) metadataSchemas.Handlers {
+	return &metadataSchemaHandler{cfg: cfg, metadataSchemasUC: uc, logger: logger}
+}
+
+// Create godoc
+// @Summary Create metadata schema
+// @Description Create the JSON Schema the metadata of items must match: the global schema when owner_id is not set,
+// @Description otherwise the schema of the items of that user. Indexed keys are top level properties item lists can
+// @Description filter by with `metadata.<key>=value`.
+// @Tags metadata-schemas
+// @Accept json
+// @Produce json
+// @Param schema body presenter.MetadataSchemaCreate true "Add metadata schema"
+// @Success 200 {object} responses.SuccessResponse[presenter.MetadataSchemaResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /metadata-schema [post]
+func (h *metadataSchemaHandler) Create() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		schema := new(presenter.MetadataSchemaCreate)
+
+		err := json.NewDecoder(r.Body).Decode(&schema)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, schema)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		newSchema, err := h.metadataSchemasUC.Create(ctx, &models.MetadataSchemaCreate{
+			OwnerId:     schema.OwnerId,
+			Definition:  schema.Definition,
+			IndexedKeys: schema.IndexedKeys,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(newSchema)))
+	}
+}
+
+// Get godoc
+// @Summary Read metadata schema
+// @Description Get metadata schema by ID. Users can read the global schema and their own.
+// @Tags metadata-schemas
+// @Accept json
+// @Produce json
+// @Param id path string true "Metadata schema Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.MetadataSchemaResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /metadata-schema/{id} [get]
+func (h *metadataSchemaHandler) Get() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		schema, err := h.metadataSchemasUC.Get(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(schema)))
+	}
+}
+
+// GetMulti godoc
+// @Summary Read metadata schemas
+// @Description Retrieve metadata schemas, users only see the global schema and their own.
+// @Tags metadata-schemas
+// @Accept json
+// @Produce json
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.MetadataSchemaResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /metadata-schema [get]
+func (h *metadataSchemaHandler) GetMulti() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		schemas, err := h.metadataSchemasUC.GetMulti(r.Context(), offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelsResponse(schemas)))
+	}
+}
+
+// Update godoc
+// @Summary Update metadata schema
+// @Description Replace the definition and the indexed keys of a metadata schema. The metadata of existing items is not
+// @Description validated again, only the metadata written afterwards is.
+// @Tags metadata-schemas
+// @Accept json
+// @Produce json
+// @Param id path string true "Metadata schema Id"
+// @Param schema body presenter.MetadataSchemaUpdate true "Update metadata schema"
+// @Success 200 {object} responses.SuccessResponse[presenter.MetadataSchemaResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /metadata-schema/{id} [put]
+func (h *metadataSchemaHandler) Update() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		schema := new(presenter.MetadataSchemaUpdate)
+
+		err = json.NewDecoder(r.Body).Decode(&schema)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, schema)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		updatedSchema, err := h.metadataSchemasUC.Update(ctx, uint(id), &models.MetadataSchemaUpdate{
+			Definition:  schema.Definition,
+			IndexedKeys: schema.IndexedKeys,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedSchema)))
+	}
+}
+
+// Delete godoc
+// @Summary Delete metadata schema
+// @Description Delete a metadata schema by ID, the metadata of items is kept.
+// @Tags metadata-schemas
+// @Accept json
+// @Produce json
+// @Param id path string true "Metadata schema Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.MetadataSchemaResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /metadata-schema/{id} [delete]
+func (h *metadataSchemaHandler) Delete() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		schema, err := h.metadataSchemasUC.Delete(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(schema)))
+	}
+}
+
+func mapModelResponse(exp *models.MetadataSchema) *presenter.MetadataSchemaResponse {
+	indexedKeys := exp.IndexedKeys
+	if indexedKeys == nil {
+		indexedKeys = []string{}
+	}
+	return &presenter.MetadataSchemaResponse{
+		Id:          exp.Id,
+		OwnerId:     exp.OwnerId,
+		Definition:  exp.Definition,
+		IndexedKeys: indexedKeys,
+		CreateTime:  exp.CreateTime,
+		UpdateTime:  exp.UpdateTime,
+	}
+}
+
+func mapModelsResponse(exp []*models.MetadataSchema) []*presenter.MetadataSchemaResponse {
+	out := make([]*presenter.MetadataSchemaResponse, len(exp))
+	for i, schema := range exp {
+		out[i] = mapModelResponse(schema)
+	}
+	return out
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+)
+
+func MapMetadataSchemaRoute(router *chi.Mux, h metadataSchemas.Handlers, mw *middleware.MiddlewareManager) {
+	// Metadata schema routes
+	router.Route("/metadata-schema", func(r chi.Router) {
+		// Protected routes
+		r.Group(func(r chi.Router) {
+			r.Use(mw.Verifier(true))
+			r.Use(mw.Authenticator())
+			r.Use(mw.CurrentUser())
+			r.Use(mw.ActiveUser())
+			r.Use(mw.CountApiCall())
+			r.Get("/", h.GetMulti())
+			r.Get("/{id}", h.Get())
+			// Admin routes
+			r.Group(func(r chi.Router) {
+				r.Use(mw.SuperUser())
+				r.Post("/", h.Create())
+				r.Put("/{id}", h.Update())
+				r.Delete("/{id}", h.Delete())
+			})
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package metadataSchemas
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+type MetadataSchemaPgRepository interface {
+	Get(ctx context.Context, id uint) (*models.MetadataSchema, error)
+	// GetByOwner returns the schema of an owner, or the global schema when
+	// ownerId is nil.
+	GetByOwner(ctx context.Context, ownerId *uint) (*models.MetadataSchema, error)
+	GetMulti(ctx context.Context, offset, limit int) ([]*models.MetadataSchema, error)
+	GetAll(ctx context.Context) ([]*models.MetadataSchema, error)
+	Create(ctx context.Context, obj_create *models.MetadataSchemaCreate) (*models.MetadataSchema, error)
+	Update(ctx context.Context, id uint, obj_update *models.MetadataSchemaUpdate) (*models.MetadataSchema, error)
+	Delete(ctx context.Context, id uint) (*models.MetadataSchema, error)
+}
@@ -0,0 +1,25 @@
+package presenter
+
+import (
+	"time"
+)
+
+type MetadataSchemaCreate struct {
+	OwnerId     *uint                  `json:"owner_id,omitempty" example:"1"`
+	Definition  map[string]interface{} `json:"definition" validate:"required" swaggertype:"object"`
+	IndexedKeys []string               `json:"indexed_keys,omitempty" validate:"omitempty,dive,required" example:"priority"`
+}
+
+type MetadataSchemaUpdate struct {
+	Definition  map[string]interface{} `json:"definition" validate:"required" swaggertype:"object"`
+	IndexedKeys []string               `json:"indexed_keys,omitempty" validate:"omitempty,dive,required" example:"priority"`
+}
+
+type MetadataSchemaResponse struct {
+	Id          uint                   `json:"id"`
+	OwnerId     *uint                  `json:"owner_id"`
+	Definition  map[string]interface{} `json:"definition" swaggertype:"object"`
+	IndexedKeys []string               `json:"indexed_keys" example:"priority"`
+	CreateTime  time.Time              `json:"create_time"`
+	UpdateTime  time.Time              `json:"update_time"`
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+type MetadataSchemaPgRepo struct {
+	client *ent.Client
+}
+
+func CreateMetadataSchemaPgRepository(client *ent.Client) metadataSchemas.MetadataSchemaPgRepository {
+	return &MetadataSchemaPgRepo{client: client}
+}
+
+func (r *MetadataSchemaPgRepo) mapModel(db_obj *ent.MetadataSchema) *models.MetadataSchema {
+	return &models.MetadataSchema{
+		Id:          db_obj.ID,
+		CreateTime:  db_obj.CreateTime,
+		UpdateTime:  db_obj.UpdateTime,
+		OwnerId:     db_obj.OwnerID,
+		Definition:  db_obj.Definition,
+		IndexedKeys: db_obj.IndexedKeys,
+	}
+}
+
+func (r *MetadataSchemaPgRepo) mapModels(db_objs []*ent.MetadataSchema) []*models.MetadataSchema {
+	objs := make([]*models.MetadataSchema, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapModel(db_obj)
+	}
+	return objs
+}
+
+func (r *MetadataSchemaPgRepo) Get(ctx context.Context, id uint) (*models.MetadataSchema, error) {
+	db_obj, err := r.client.MetadataSchema.Query().
+		Where(metadataschema.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *MetadataSchemaPgRepo) GetByOwner(ctx context.Context, ownerId *uint) (*models.MetadataSchema, error) {
+	query := r.client.MetadataSchema.Query()
+	if ownerId == nil {
+		query = query.Where(metadataschema.OwnerIDIsNil())
+	} else {
+		query = query.Where(metadataschema.OwnerID(*ownerId))
+	}
+
+	db_obj, err := query.First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *MetadataSchemaPgRepo) GetMulti(ctx context.Context, offset, limit int) ([]*models.MetadataSchema, error) {
+	db_objs, err := r.client.MetadataSchema.Query().
+		Order(metadataschema.ByID(sql.OrderAsc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+func (r *MetadataSchemaPgRepo) GetAll(ctx context.Context) ([]*models.MetadataSchema, error) {
+	db_objs, err := r.client.MetadataSchema.Query().
+		Order(metadataschema.ByID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+func (r *MetadataSchemaPgRepo) Create(ctx context.Context, obj_create *models.MetadataSchemaCreate) (*models.MetadataSchema, error) {
+	db_obj, err := r.client.MetadataSchema.
+		Create().
+		SetNillableOwnerID(obj_create.OwnerId).
+		SetDefinition(obj_create.Definition).
+		SetIndexedKeys(obj_create.IndexedKeys).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, httpErrors.ErrValidation(errors.New("owner does not exist or already has a metadata schema"))
+		}
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *MetadataSchemaPgRepo) Update(ctx context.Context, id uint, obj_update *models.MetadataSchemaUpdate) (*models.MetadataSchema, error) {
+	db_obj, err := r.client.MetadataSchema.UpdateOneID(id).
+		SetDefinition(obj_update.Definition).
+		SetIndexedKeys(obj_update.IndexedKeys).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *MetadataSchemaPgRepo) Delete(ctx context.Context, id uint) (*models.MetadataSchema, error) {
+	db_obj, err := r.client.MetadataSchema.Query().
+		Where(metadataschema.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.client.MetadataSchema.DeleteOne(db_obj).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapModel(db_obj), nil
+}
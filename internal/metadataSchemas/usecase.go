@@ -0,0 +1,22 @@
+package metadataSchemas
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+type MetadataSchemaUseCase interface {
+	Get(ctx context.Context, id uint) (*models.MetadataSchema, error)
+	GetMulti(ctx context.Context, offset, limit int) ([]*models.MetadataSchema, error)
+	Create(ctx context.Context, obj_create *models.MetadataSchemaCreate) (*models.MetadataSchema, error)
+	Update(ctx context.Context, id uint, obj_update *models.MetadataSchemaUpdate) (*models.MetadataSchema, error)
+	Delete(ctx context.Context, id uint) (*models.MetadataSchema, error)
+	// Validate checks the metadata of an item of ownerId against the global
+	// schema and the schema of the owner.
+	Validate(ctx context.Context, ownerId uint, metadata map[string]interface{}) error
+	// IndexedKeys returns the JSON type of the metadata keys item lists can
+	// filter by: the keys of the global schema and of the schema of ownerId, or
+	// of every schema when ownerId is nil.
+	IndexedKeys(ctx context.Context, ownerId *uint) (map[string]string, error)
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/jsonSchema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// Metadata schemas are managed by admins, the ent privacy policies (see
+// internal/rule) let users read the global schema and their own.
+type metadataSchemaUseCase struct {
+	pgRepo metadataSchemas.MetadataSchemaPgRepository
+	cfg    *config.Config
+	logger logger.Logger
+
+	// compiled caches the compiled schemas by id, an entry is compiled again
+	// when the schema is updated.
+	mu       sync.Mutex
+	compiled map[uint]*compiledSchema
+}
+
+type compiledSchema struct {
+	updateTime time.Time
+	schema     *jsonSchema.Schema
+}
+
+func CreateMetadataSchemaUseCase(
+	pgRepo metadataSchemas.MetadataSchemaPgRepository,
+	cfg *config.Config,
+	logger logger.Logger,
+) metadataSchemas.MetadataSchemaUseCase {
+	return &metadataSchemaUseCase{
+		pgRepo:   pgRepo,
+		cfg:      cfg,
+		logger:   logger,
+		compiled: make(map[uint]*compiledSchema),
+	}
+}
+
+func (u *metadataSchemaUseCase) Get(ctx context.Context, id uint) (*models.MetadataSchema, error) {
+	return u.pgRepo.Get(ctx, id)
+}
+
+func (u *metadataSchemaUseCase) GetMulti(ctx context.Context, offset, limit int) ([]*models.MetadataSchema, error) {
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetMulti(ctx, offset, limit)
+}
+
+func (u *metadataSchemaUseCase) Create(ctx context.Context, obj_create *models.MetadataSchemaCreate) (*models.MetadataSchema, error) {
+	if err := checkDefinition(obj_create.Definition, obj_create.IndexedKeys); err != nil {
+		return nil, err
+	}
+
+	_, err := u.pgRepo.GetByOwner(ctx, obj_create.OwnerId)
+	if err == nil {
+		if obj_create.OwnerId == nil {
+			return nil, httpErrors.ErrConflict(errors.New("global metadata schema already exists"))
+		}
+		return nil, httpErrors.ErrConflict(fmt.Errorf("metadata schema of user %d already exists", *obj_create.OwnerId))
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return u.pgRepo.Create(ctx, obj_create)
+}
+
+// Update replaces the definition of a schema. The items saved before are not
+// validated again, only their next create or update is.
+func (u *metadataSchemaUseCase) Update(ctx context.Context, id uint, obj_update *models.MetadataSchemaUpdate) (*models.MetadataSchema, error) {
+	if err := checkDefinition(obj_update.Definition, obj_update.IndexedKeys); err != nil {
+		return nil, err
+	}
+	return u.pgRepo.Update(ctx, id, obj_update)
+}
+
+func (u *metadataSchemaUseCase) Delete(ctx context.Context, id uint) (*models.MetadataSchema, error) {
+	obj, err := u.pgRepo.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u.mu.Lock()
+	delete(u.compiled, id)
+	u.mu.Unlock()
+
+	return obj, nil
+}
+
+func (u *metadataSchemaUseCase) Validate(ctx context.Context, ownerId uint, metadata map[string]interface{}) error {
+	objs, err := u.schemasOf(ctx, &ownerId)
+	if err != nil {
+		return err
+	}
+
+	var value interface{} = metadata
+	if metadata == nil {
+		value = map[string]interface{}{}
+	}
+
+	var messages []string
+	for _, obj := range objs {
+		schema, err := u.compile(obj)
+		if err != nil {
+			return err
+		}
+		for _, schemaErr := range schema.Validate(value) {
+			path := "$.metadata" + strings.TrimPrefix(schemaErr.Path, "$")
+			messages = append(messages, fmt.Sprintf("%s: %s", path, schemaErr.Message))
+		}
+	}
+
+	if len(messages) > 0 {
+		return httpErrors.ErrValidation(errors.New(strings.Join(messages, "; ")))
+	}
+	return nil
+}
+
+func (u *metadataSchemaUseCase) IndexedKeys(ctx context.Context, ownerId *uint) (map[string]string, error) {
+	var objs []*models.MetadataSchema
+	var err error
+	if ownerId == nil {
+		objs, err = u.pgRepo.GetAll(viewer.NewSystemContext(ctx))
+	} else {
+		objs, err = u.schemasOf(ctx, ownerId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string)
+	for _, obj := range objs {
+		for _, key := range obj.IndexedKeys {
+			if _, ok := keys[key]; !ok {
+				keys[key] = jsonSchema.PropertyType(obj.Definition, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// schemasOf returns the global schema and the schema of ownerId. They are read
+// as the system, the editors of an item are not allowed to read the schema of
+// its owner.
+func (u *metadataSchemaUseCase) schemasOf(ctx context.Context, ownerId *uint) ([]*models.MetadataSchema, error) {
+	ctx = viewer.NewSystemContext(ctx)
+
+	objs := make([]*models.MetadataSchema, 0, 2)
+	for _, id := range []*uint{nil, ownerId} {
+		obj, err := u.pgRepo.GetByOwner(ctx, id)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+func (u *metadataSchemaUseCase) compile(obj *models.MetadataSchema) (*jsonSchema.Schema, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if cached, ok := u.compiled[obj.Id]; ok && cached.updateTime.Equal(obj.UpdateTime) {
+		return cached.schema, nil
+	}
+
+	schema, err := jsonSchema.Compile(obj.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile metadata schema %d: %w", obj.Id, err)
+	}
+
+	u.compiled[obj.Id] = &compiledSchema{updateTime: obj.UpdateTime, schema: schema}
+	return schema, nil
+}
+
+// checkDefinition checks that a definition compiles and that the indexed keys
+// are top level properties with a scalar type.
+func checkDefinition(definition map[string]interface{}, indexedKeys []string) error {
+	if _, err := jsonSchema.Compile(definition); err != nil {
+		return httpErrors.ErrValidation(fmt.Errorf("invalid schema: %w", err))
+	}
+
+	for _, key := range indexedKeys {
+		switch jsonSchema.PropertyType(definition, key) {
+		case "string", "integer", "number", "boolean":
+		default:
+			return httpErrors.ErrValidation(fmt.Errorf("indexed key %q must be a property of type string, integer, number or boolean", key))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+)
+
+type deprecation struct {
+	config.DeprecationConfig
+	date   time.Time
+	sunset time.Time
+}
+
+func (d *deprecation) matches(method, pattern string) bool {
+	if d.Method != "" && !strings.EqualFold(d.Method, method) {
+		return false
+	}
+	return d.Route == "" || trimRoute(d.Route) == trimRoute(pattern)
+}
+
+func trimRoute(route string) string {
+	if len(route) > 1 {
+		return strings.TrimSuffix(route, "/")
+	}
+	return route
+}
+
+// Deprecation sends the Deprecation (RFC 9745), Sunset (RFC 8594) and Link
+// headers on the routes of version deprecated in the config, and logs every
+// call with the number of calls of the route since the start. routes is the
+// router of the version, the middleware must be used on its mount.
+func (mw *MiddlewareManager) Deprecation(version string, routes chi.Routes) func(http.Handler) http.Handler {
+	var deprecations []*deprecation
+	for _, c := range mw.cfg.Api.Deprecations {
+		if c.Version != version {
+			continue
+		}
+		date, sunset, err := c.Times()
+		if err != nil {
+			mw.logger.Warnf("invalid deprecation of %s %s: %v", c.Method, c.Route, err)
+			continue
+		}
+		deprecations = append(deprecations, &deprecation{DeprecationConfig: c, date: date, sunset: sunset})
+	}
+
+	var mu sync.Mutex
+	counts := make(map[string]int64)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(deprecations) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// The route is not matched yet, find its pattern in the router of
+			// the version.
+			path := r.URL.Path
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+				path = rctx.RoutePath
+			}
+
+			tctx := chi.NewRouteContext()
+			if !routes.Match(tctx, r.Method, path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			pattern := tctx.RoutePattern()
+
+			for _, d := range deprecations {
+				if !d.matches(r.Method, pattern) {
+					continue
+				}
+
+				w.Header().Set("Deprecation", fmt.Sprintf("@%d", d.date.Unix()))
+				if !d.sunset.IsZero() {
+					w.Header().Set("Sunset", d.sunset.UTC().Format(http.TimeFormat))
+				}
+				if d.Link != "" {
+					w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"deprecation\"", d.Link))
+				}
+
+				key := r.Method + " " + pattern
+				mu.Lock()
+				counts[key]++
+				count := counts[key]
+				mu.Unlock()
+
+				mw.logger.Warnf(
+					"Version: %s, Method: %s, Route: %s, Path: %s, Count: %d, User-Agent: %s, Msg: deprecated route called",
+					version, r.Method, pattern, r.URL.Path, count, r.UserAgent(),
+				)
+				break
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/grpcErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// GrpcAccess is who can call a gRPC method, the counterpart of the middlewares
+// of the REST routes.
+type GrpcAccess int
+
+const (
+	// GrpcUser methods need an access token of an active user and count
+	// against the API calls quota, it is the access of the methods left out of
+	// the access map.
+	GrpcUser GrpcAccess = iota
+	// GrpcPublic methods need no token.
+	GrpcPublic
+	// GrpcRefreshToken methods need a refresh token, the method reads it with
+	// TokenFromMetadata.
+	GrpcRefreshToken
+	// GrpcUserNotCounted is GrpcUser without counting the API call.
+	GrpcUserNotCounted
+	// GrpcSuperUser methods are GrpcUser methods for super users.
+	GrpcSuperUser
+)
+
+// GrpcUnaryInterceptor authenticates the calls with the access of their method
+// in access and converts the returned errors with grpcErrors.ParseErrors.
+func (mw *MiddlewareManager) GrpcUnaryInterceptor(access map[string]GrpcAccess) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := mw.grpcAuthenticate(ctx, access[info.FullMethod])
+		if err != nil {
+			return nil, grpcErrors.ParseErrors(err)
+		}
+
+		resp, err := handler(ctx, req)
+		return resp, grpcErrors.ParseErrors(err)
+	}
+}
+
+// GrpcStreamInterceptor is GrpcUnaryInterceptor for streams.
+func (mw *MiddlewareManager) GrpcStreamInterceptor(access map[string]GrpcAccess) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := mw.grpcAuthenticate(ss.Context(), access[info.FullMethod])
+		if err != nil {
+			return grpcErrors.ParseErrors(err)
+		}
+
+		return grpcErrors.ParseErrors(handler(srv, &grpcServerStream{ServerStream: ss, ctx: ctx}))
+	}
+}
+
+// GrpcRecoverer is middleware.Recoverer for gRPC, a panic of a call is logged
+// and answered with an internal error.
+func (mw *MiddlewareManager) GrpcRecoverer() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if rvr := recover(); rvr != nil {
+				mw.logger.Errorf("panic in %s: %v\n%s", info.FullMethod, rvr, debug.Stack())
+				err = grpcErrors.ParseErrors(httpErrors.ErrInternalServer(fmt.Errorf("%v", rvr)))
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// GrpcStreamRecoverer is GrpcRecoverer for streams.
+func (mw *MiddlewareManager) GrpcStreamRecoverer() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if rvr := recover(); rvr != nil {
+				mw.logger.Errorf("panic in %s: %v\n%s", info.FullMethod, rvr, debug.Stack())
+				err = grpcErrors.ParseErrors(httpErrors.ErrInternalServer(fmt.Errorf("%v", rvr)))
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// GrpcTimeout is middleware.Timeout for gRPC, the calls are canceled after
+// timeout.
+func (mw *MiddlewareManager) GrpcTimeout(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+type grpcServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *grpcServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// grpcAuthenticate runs the checks of Verifier, Authenticator, CurrentUser,
+// ActiveUser, CountApiCall and SuperUser that apply to access, and sets the
+// same context values.
+func (mw *MiddlewareManager) grpcAuthenticate(ctx context.Context, access GrpcAccess) (context.Context, error) {
+	if access == GrpcPublic {
+		return ctx, nil
+	}
+
+	token := TokenFromMetadata(ctx)
+	if token == "" {
+		return nil, httpErrors.ErrTokenNotFound(errors.New("not found token in metadata"))
+	}
+
+	publicKey := mw.cfg.Jwt.AccessTokenPublicKey
+	if access == GrpcRefreshToken {
+		publicKey = mw.cfg.Jwt.RefreshTokenPublicKey
+	}
+
+	id, email, err := jwt.ParseTokenRS256(token, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, TokenCtxKey, token)
+	ctx = context.WithValue(ctx, IdCtxKey, id)
+	ctx = context.WithValue(ctx, EmailCtxKey, email)
+
+	if access == GrpcRefreshToken {
+		return ctx, nil
+	}
+
+	idParsed, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, httpErrors.ErrInvalidJWTClaims(errors.New("can not convert id to uuid from id in token"))
+	}
+
+	// The viewer is not known yet, load the user as the system
+	user, err := mw.usersUC.Get(viewer.NewSystemContext(ctx), uint(idParsed))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, UserCtxKey, user)
+	ctx = viewer.NewUserContext(ctx, user)
+
+	if !user.IsActive {
+		return nil, httpErrors.ErrInactiveUser(errors.New("user inactive"))
+	}
+
+	if access != GrpcUserNotCounted {
+		if err := mw.quotasUC.CountApiCall(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	if access == GrpcSuperUser && !user.IsSuperUser {
+		return nil, httpErrors.ErrNotEnoughPrivileges(errors.New("user is not super user"))
+	}
+
+	return ctx, nil
+}
+
+// TokenFromMetadata tries to retreive the token string from the
+// "authorization" metadata: "authorization: bearer T".
+func TokenFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, bearer := range md.Get("authorization") {
+		if len(bearer) > 7 && strings.ToUpper(bearer[0:6]) == "BEARER" {
+			return bearer[7:]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	// maxIdempotentBodySize is the largest body of a request with an
+	// Idempotency-Key header, the body is read to its fingerprint.
+	maxIdempotentBodySize = 1 << 20
+)
+
+// Idempotency runs a request sent with an Idempotency-Key header once per key
+// of the current user, it must come after CurrentUser. The retries get the
+// response of the first request with the Idempotent-Replayed header, a 409
+// while it is still running and a 422 when their method, path or body differ,
+// the path is taken in the version of the api so a retry through the /api alias
+// of v1 matches. The responses of server errors are not saved, the request runs
+// again.
+func (mw *MiddlewareManager) Idempotency() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+
+			if len(key) > maxIdempotencyKeyLength {
+				render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrBadRequest(fmt.Errorf("idempotency key is longer than %d characters", maxIdempotencyKeyLength)))) //nolint:errcheck
+				return
+			}
+
+			user, err := GetUserFromCtx(ctx)
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := sha256.New()
+			fmt.Fprintf(hash, "%s %s\n", r.Method, versionPath(r))
+			hash.Write(body)
+			fingerprint := hex.EncodeToString(hash.Sum(nil))
+
+			response, err := mw.idempotencyUC.Start(ctx, user.Id, key, fingerprint)
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+
+			if response != nil {
+				for name, values := range response.Header {
+					w.Header()[name] = values
+				}
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(response.Status)
+				w.Write(response.Body) //nolint:errcheck
+				return
+			}
+
+			// Only the headers set by the handler are saved, the previous
+			// middlewares set theirs again on the retries.
+			header := w.Header().Clone()
+
+			var buf bytes.Buffer
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+
+			// The key is released when the request panics, fails on the server
+			// or its response can not be saved, the retries run it again. The
+			// redis calls do not use the context of the request, which can be
+			// canceled by then.
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := mw.idempotencyUC.Cancel(context.Background(), user.Id, key); err != nil {
+					mw.logger.Warnf("failed to release the idempotency key of user %d: %v", user.Id, err)
+				}
+			}()
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				return
+			}
+
+			response = &models.IdempotentResponse{
+				Status: status,
+				Header: http.Header{},
+				Body:   buf.Bytes(),
+			}
+			for name, values := range w.Header() {
+				if !reflect.DeepEqual(header[name], values) {
+					response.Header[name] = values
+				}
+			}
+
+			if err := mw.idempotencyUC.Complete(context.Background(), user.Id, key, fingerprint, response); err != nil {
+				mw.logger.Warnf("failed to save the idempotent response of user %d: %v", user.Id, err)
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// versionPath returns the path of a request in the router of its api version,
+// without the pattern of the mount of the version, e.g. /item/ for both
+// /api/v1/item/ and /api/item/.
+func versionPath(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || len(rctx.RoutePatterns) < 2 || !strings.HasSuffix(rctx.RoutePatterns[0], "/*") {
+		return r.URL.Path
+	}
+	return strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(rctx.RoutePatterns[0], "/*"))
+}
@@ -0,0 +1,106 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// idempotencyUseCase keeps the records in memory, the requests are completed
+// before the retries.
+type idempotencyUseCase struct {
+	idempotency.IdempotencyUseCase
+	records map[string]*models.IdempotencyRecord
+}
+
+func (u *idempotencyUseCase) Start(ctx context.Context, userId uint, key string, fingerprint string) (*models.IdempotentResponse, error) {
+	record, ok := u.records[key]
+	if !ok {
+		u.records[key] = &models.IdempotencyRecord{Fingerprint: fingerprint}
+		return nil, nil
+	}
+	if record.Fingerprint != fingerprint {
+		return nil, httpErrors.ErrIdempotencyKeyReused(errors.New("idempotency key was used for another request"))
+	}
+	return record.Response, nil
+}
+
+func (u *idempotencyUseCase) Complete(ctx context.Context, userId uint, key string, fingerprint string, response *models.IdempotentResponse) error {
+	u.records[key] = &models.IdempotencyRecord{Fingerprint: fingerprint, Response: response}
+	return nil
+}
+
+func (u *idempotencyUseCase) Cancel(ctx context.Context, userId uint, key string) error {
+	delete(u.records, key)
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Logger.Level = "fatal"
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mw := middleware.CreateMiddlewareManager(cfg, apiLogger, nil, nil, &idempotencyUseCase{records: map[string]*models.IdempotencyRecord{}})
+
+	calls := 0
+	apiV1Router := chi.NewRouter()
+	apiV1Router.Route("/item", func(r chi.Router) {
+		r.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx := context.WithValue(r.Context(), middleware.UserCtxKey, &models.User{Id: 1})
+				next.ServeHTTP(w, r.WithContext(ctx))
+			})
+		})
+		r.With(mw.Idempotency()).Post("/", func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		})
+	})
+
+	router := chi.NewRouter()
+	router.Mount("/api/v1", apiV1Router)
+	router.Mount("/api", apiV1Router)
+
+	tests := []struct {
+		name     string
+		path     string
+		key      string
+		body     string
+		status   int
+		replayed bool
+		calls    int
+	}{
+		{name: "first request", path: "/api/v1/item/", key: "a", body: `{"title":"a"}`, status: http.StatusCreated, calls: 1},
+		{name: "retry through the alias", path: "/api/item/", key: "a", body: `{"title":"a"}`, status: http.StatusCreated, replayed: true, calls: 1},
+		{name: "other body", path: "/api/item/", key: "a", body: `{"title":"b"}`, status: http.StatusUnprocessableEntity, calls: 1},
+		{name: "body too large", path: "/api/v1/item/", key: "b", body: strings.Repeat("a", 1<<20+1), status: http.StatusRequestEntityTooLarge, calls: 1},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+		r.Header.Set(middleware.IdempotencyKeyHeader, tt.key)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		if w.Code != tt.status {
+			t.Fatalf("%s: got status %d, want %d: %s", tt.name, w.Code, tt.status, w.Body.String())
+		}
+		if replayed := w.Header().Get(middleware.IdempotentReplayedHeader) == "true"; replayed != tt.replayed {
+			t.Fatalf("%s: got replayed %v, want %v", tt.name, replayed, tt.replayed)
+		}
+		if calls != tt.calls {
+			t.Fatalf("%s: got %d calls, want %d", tt.name, calls, tt.calls)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/jwt"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
@@ -115,13 +116,15 @@ func (mw *MiddlewareManager) CurrentUser() func(http.Handler) http.Handler {
 				return
 			}
 
-			user, err := mw.usersUC.Get(ctx, uint(idParsed))
+			// The viewer is not known yet, load the user as the system
+			user, err := mw.usersUC.Get(viewer.NewSystemContext(ctx), uint(idParsed))
 			if err != nil {
 				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 				return
 			}
 
 			ctx = context.WithValue(ctx, UserCtxKey, user)
+			ctx = viewer.NewUserContext(ctx, user)
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
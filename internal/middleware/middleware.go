@@ -2,20 +2,26 @@ package middleware
 
 import (
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
 )
 
 type MiddlewareManager struct {
-	cfg     *config.Config
-	logger  logger.Logger
-	usersUC users.UserUseCase
+	cfg           *config.Config
+	logger        logger.Logger
+	usersUC       users.UserUseCase
+	quotasUC      quotas.QuotaUseCase
+	idempotencyUC idempotency.IdempotencyUseCase
 }
 
-func CreateMiddlewareManager(cfg *config.Config, logger logger.Logger, usersUC users.UserUseCase) *MiddlewareManager {
+func CreateMiddlewareManager(cfg *config.Config, logger logger.Logger, usersUC users.UserUseCase, quotasUC quotas.QuotaUseCase, idempotencyUC idempotency.IdempotencyUseCase) *MiddlewareManager {
 	return &MiddlewareManager{
-		cfg:     cfg,
-		logger:  logger,
-		usersUC: usersUC,
+		cfg:           cfg,
+		logger:        logger,
+		usersUC:       usersUC,
+		quotasUC:      quotasUC,
+		idempotencyUC: idempotencyUC,
 	}
 }
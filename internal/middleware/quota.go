@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
+)
+
+// CountApiCall counts the call against the API calls quota of the current
+// user, it must come after CurrentUser.
+func (mw *MiddlewareManager) CountApiCall() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			user, err := GetUserFromCtx(ctx)
+			if err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+
+			if err := mw.quotasUC.CountApiCall(ctx, user); err != nil {
+				render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"net/http"
+)
+
+// IdempotencyRecord is a request sent with an Idempotency-Key. Fingerprint
+// identifies the request, Response is nil while the request is running.
+type IdempotencyRecord struct {
+	Fingerprint string
+	Response    *IdempotentResponse
+}
+
+// IdempotentResponse is the response of a request, replayed on the retries.
+type IdempotentResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
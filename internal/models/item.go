@@ -1,18 +1,75 @@
 package models
 
+import (
+	"time"
+)
+
 type Item struct {
 	Id          uint
 	Title       string
 	Description string
 	OwnerId     uint
+	Status      string
+	Metadata    map[string]interface{}
+	DueAt       *time.Time
+	ParentId    *uint
+	DeleteTime  *time.Time
+	Version     int
+	Tags        []*Tag
 }
 
 type ItemCreate struct {
 	Title       string
 	Description string
+	Metadata    map[string]interface{}
+	DueAt       *time.Time
+	ParentId    *uint
+	// Status is the initial status of the workflow, set by the use case.
+	Status string
 }
 
 type ItemUpdate struct {
 	Title       *string
 	Description *string
+	// Metadata replaces the metadata of the item when not nil.
+	Metadata map[string]interface{}
+	// DueAt sets the due date when not nil, ClearDueAt removes it.
+	DueAt      *time.Time
+	ClearDueAt bool
+	// Version is the version the update expects the item to have, nil to update
+	// the item whatever its version.
+	Version *int
+}
+
+type ItemSearchResult struct {
+	Item
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+const (
+	ItemBulkOpCreate = "create"
+	ItemBulkOpUpdate = "update"
+	ItemBulkOpDelete = "delete"
+)
+
+// ItemBulkOperation is one operation of a bulk request. Create is the item of
+// a create, Id and Version are only used by updates and deletes, Title and
+// Description by updates.
+type ItemBulkOperation struct {
+	Op          string
+	Create      *ItemCreate
+	Id          uint
+	Title       *string
+	Description *string
+	Version     *int
+}
+
+// ItemBulkResult is the outcome of an ItemBulkOperation, Err is nil when the
+// operation succeeded.
+type ItemBulkResult struct {
+	Op   string
+	Item *Item
+	Err  error
 }
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	ItemAttachmentStatusPending   = "pending"
+	ItemAttachmentStatusProcessed = "processed"
+	ItemAttachmentStatusFailed    = "failed"
+)
+
+// ItemAttachmentThumbnail is a resized copy of an image attachment, stored
+// next to it.
+type ItemAttachmentThumbnail struct {
+	Name        string `json:"name"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+	StorageKey  string `json:"storage_key"`
+	// Url is the presigned download URL, it is not stored.
+	Url string `json:"-"`
+}
+
+type ItemAttachment struct {
+	Id          uint
+	CreateTime  time.Time
+	UpdateTime  time.Time
+	ItemId      uint
+	UploaderId  *uint
+	Filename    string
+	ContentType string
+	Size        int64
+	Checksum    string
+	StorageKey  string
+
+	ProcessingStatus *string
+	Width            *int
+	Height           *int
+	Thumbnails       []ItemAttachmentThumbnail
+}
+
+type ItemAttachmentCreate struct {
+	Filename string
+	// Checksum is the hex encoded SHA-256 expected by the client, the upload
+	// is rejected if the content does not match. Optional.
+	Checksum string
+}
+
+type ItemAttachmentURL struct {
+	Url       string
+	ExpiresAt time.Time
+}
+
+// ItemAttachmentProcessed is the result of the processing of an image
+// attachment. Size and Checksum are set when the content was rewritten.
+type ItemAttachmentProcessed struct {
+	Status     string
+	Width      *int
+	Height     *int
+	Size       *int64
+	Checksum   *string
+	Thumbnails []ItemAttachmentThumbnail
+}
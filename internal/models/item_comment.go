@@ -0,0 +1,59 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+type ItemComment struct {
+	Id         uint
+	CreateTime time.Time
+	UpdateTime time.Time
+	ItemId     uint
+	AuthorId   *uint
+	Body       string
+	EditedAt   *time.Time
+}
+
+type ItemCommentCreate struct {
+	Body string
+}
+
+type ItemCommentUpdate struct {
+	Body string
+}
+
+const (
+	ItemActivityComment  = "comment"
+	ItemActivityRevision = "revision"
+)
+
+// ItemActivity is an event of the activity feed of an item, Comment is set for
+// comments and Revision for the changes of the item.
+type ItemActivity struct {
+	Type     string
+	Time     time.Time
+	UserId   *uint
+	Comment  *ItemComment
+	Revision *ItemRevision
+}
+
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w@.+-])@([\w.%+-]+@[\w-]+(?:\.[\w-]+)*\.[A-Za-z]{2,})`)
+
+// ParseMentions returns the lower cased emails mentioned with @email in a
+// comment body, without duplicates.
+func ParseMentions(body string) []string {
+	emails := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, match := range mentionRegexp.FindAllStringSubmatch(body, -1) {
+		email := strings.ToLower(match[1])
+		if !seen[email] {
+			seen[email] = true
+			emails = append(emails, email)
+		}
+	}
+
+	return emails
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"reflect"
+	"time"
+)
+
+const (
+	ItemRevisionActionCreate  = "create"
+	ItemRevisionActionUpdate  = "update"
+	ItemRevisionActionDelete  = "delete"
+	ItemRevisionActionRestore = "restore"
+	// ItemRevisionActionTransfer is an update changing the owner of the item.
+	ItemRevisionActionTransfer = "transfer"
+)
+
+// ItemSnapshot is the state of an item saved with each revision.
+type ItemSnapshot struct {
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	OwnerId     uint                   `json:"owner_id"`
+	Status      string                 `json:"status,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	DueAt       *time.Time             `json:"due_at,omitempty"`
+	ParentId    *uint                  `json:"parent_id,omitempty"`
+	DeleteTime  *time.Time             `json:"delete_time,omitempty"`
+}
+
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+type ItemRevision struct {
+	Id         uint
+	CreateTime time.Time
+	ItemId     uint
+	UserId     *uint
+	Action     string
+	Snapshot   ItemSnapshot
+	Changes    []FieldChange
+}
+
+type ItemRevisionDiff struct {
+	From    *ItemRevision
+	To      *ItemRevision
+	Changes []FieldChange
+}
+
+// DiffItemSnapshots returns the fields that differ between two snapshots, a
+// nil from snapshot is an item that did not exist yet.
+func DiffItemSnapshots(from *ItemSnapshot, to *ItemSnapshot) []FieldChange {
+	changes := make([]FieldChange, 0)
+
+	if from == nil {
+		changes = append(changes,
+			FieldChange{Field: "title", New: to.Title},
+			FieldChange{Field: "description", New: to.Description},
+			FieldChange{Field: "owner_id", New: to.OwnerId},
+			FieldChange{Field: "status", New: to.Status},
+		)
+		if len(to.Metadata) > 0 {
+			changes = append(changes, FieldChange{Field: "metadata", New: to.Metadata})
+		}
+		if to.DueAt != nil {
+			changes = append(changes, FieldChange{Field: "due_at", New: to.DueAt})
+		}
+		if to.ParentId != nil {
+			changes = append(changes, FieldChange{Field: "parent_id", New: to.ParentId})
+		}
+		return changes
+	}
+
+	if from.Title != to.Title {
+		changes = append(changes, FieldChange{Field: "title", Old: from.Title, New: to.Title})
+	}
+	if from.Description != to.Description {
+		changes = append(changes, FieldChange{Field: "description", Old: from.Description, New: to.Description})
+	}
+	if from.OwnerId != to.OwnerId {
+		changes = append(changes, FieldChange{Field: "owner_id", Old: from.OwnerId, New: to.OwnerId})
+	}
+	if !reflect.DeepEqual(from.Metadata, to.Metadata) && (len(from.Metadata) > 0 || len(to.Metadata) > 0) {
+		changes = append(changes, FieldChange{Field: "metadata", Old: from.Metadata, New: to.Metadata})
+	}
+	if from.Status != to.Status {
+		changes = append(changes, FieldChange{Field: "status", Old: from.Status, New: to.Status})
+	}
+	if (from.DueAt == nil) != (to.DueAt == nil) ||
+		(from.DueAt != nil && !from.DueAt.Equal(*to.DueAt)) {
+		changes = append(changes, FieldChange{Field: "due_at", Old: from.DueAt, New: to.DueAt})
+	}
+	if (from.ParentId == nil) != (to.ParentId == nil) ||
+		(from.ParentId != nil && *from.ParentId != *to.ParentId) {
+		changes = append(changes, FieldChange{Field: "parent_id", Old: from.ParentId, New: to.ParentId})
+	}
+	if (from.DeleteTime == nil) != (to.DeleteTime == nil) ||
+		(from.DeleteTime != nil && !from.DeleteTime.Equal(*to.DeleteTime)) {
+		changes = append(changes, FieldChange{Field: "delete_time", Old: from.DeleteTime, New: to.DeleteTime})
+	}
+
+	return changes
+}
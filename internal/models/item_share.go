@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	ItemPermissionOwner  = "owner"
+	ItemPermissionEditor = "editor"
+	ItemPermissionViewer = "viewer"
+)
+
+type ItemShare struct {
+	Id         uint
+	CreateTime time.Time
+	UpdateTime time.Time
+	ItemId     uint
+	UserId     uint
+	Permission string
+}
+
+type ItemShareCreate struct {
+	UserId     uint
+	Permission string
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+)
+
+// Formats of the item import and export.
+const (
+	ItemFormatCsv    = "csv"
+	ItemFormatJson   = "json"
+	ItemFormatNdjson = "ndjson"
+)
+
+const (
+	ItemImportStatusPending   = "pending"
+	ItemImportStatusRunning   = "running"
+	ItemImportStatusSucceeded = "succeeded"
+	ItemImportStatusFailed    = "failed"
+)
+
+// ItemImportRowError is the error of a row which was not imported. Line is the
+// line number for CSV and NDJSON and the position in the array for JSON.
+type ItemImportRowError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// ItemImportResult is the outcome of an import.
+type ItemImportResult struct {
+	CreatedRows int
+	FailedRows  int
+	Errors      []ItemImportRowError
+}
+
+type ItemImport struct {
+	Id          uint
+	CreateTime  time.Time
+	UpdateTime  time.Time
+	OwnerId     uint
+	Format      string
+	Status      string
+	StorageKey  string
+	Size        int64
+	ReadBytes   int64
+	CreatedRows int
+	FailedRows  int
+	Errors      []ItemImportRowError
+	Error       *string
+	FinishTime  *time.Time
+}
+
+type ItemImportCreate struct {
+	Format     string
+	StorageKey string
+	Size       int64
+}
+
+// ItemImportProgress updates a running import.
+type ItemImportProgress struct {
+	Status      string
+	ReadBytes   int64
+	CreatedRows int
+	FailedRows  int
+	Errors      []ItemImportRowError
+	Error       *string
+	FinishTime  *time.Time
+}
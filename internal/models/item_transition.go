@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// ItemTransition is a change of status of an item made with a transition of
+// the workflow.
+type ItemTransition struct {
+	Id         uint
+	CreateTime time.Time
+	ItemId     uint
+	UserId     *uint
+	Name       string
+	FromStatus string
+	ToStatus   string
+	Comment    *string
+}
+
+type ItemTransitionCreate struct {
+	Transition string
+	Comment    *string
+}
+
+// ItemMember is a user who can see an item, with its permission on the item.
+type ItemMember struct {
+	Id         uint
+	Name       string
+	Email      string
+	Permission string
+}
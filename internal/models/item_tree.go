@@ -0,0 +1,19 @@
+package models
+
+// What happens to the children of a deleted item (see config Hierarchy).
+const (
+	ItemOnDeleteCascade  = "cascade"
+	ItemOnDeleteReparent = "reparent"
+)
+
+// ItemNode is an item of a subtree or of the ancestors of an item, Depth is
+// its distance to that item: 1 for the children or the parent.
+type ItemNode struct {
+	Item
+	Depth int
+}
+
+// ItemMove moves an item under ParentId, or to the root when it is nil.
+type ItemMove struct {
+	ParentId *uint
+}
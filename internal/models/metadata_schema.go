@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// MetadataSchema is a JSON Schema for the metadata of items, global when
+// OwnerId is nil.
+type MetadataSchema struct {
+	Id          uint
+	CreateTime  time.Time
+	UpdateTime  time.Time
+	OwnerId     *uint
+	Definition  map[string]interface{}
+	IndexedKeys []string
+}
+
+type MetadataSchemaCreate struct {
+	OwnerId     *uint
+	Definition  map[string]interface{}
+	IndexedKeys []string
+}
+
+type MetadataSchemaUpdate struct {
+	Definition  map[string]interface{}
+	IndexedKeys []string
+}
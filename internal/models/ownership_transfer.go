@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	OwnershipTransferStatusPending   = "pending"
+	OwnershipTransferStatusAccepted  = "accepted"
+	OwnershipTransferStatusDeclined  = "declined"
+	OwnershipTransferStatusCancelled = "cancelled"
+)
+
+type OwnershipTransfer struct {
+	Id         uint
+	CreateTime time.Time
+	UpdateTime time.Time
+	ItemId     uint
+	FromUserId uint
+	ToUserId   uint
+	Status     string
+}
+
+type OwnershipTransferCreate struct {
+	ToUserId uint
+}
+
+// OwnershipTransferAll moves every item of a user to another one.
+type OwnershipTransferAll struct {
+	FromUserId uint
+	ToUserId   uint
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+// Quotas of a plan.
+const (
+	QuotaItems    = "items"
+	QuotaStorage  = "storage"
+	QuotaApiCalls = "api_calls"
+)
+
+// QuotaUsage is the usage of a quota, a Limit of 0 is unlimited.
+type QuotaUsage struct {
+	Used  int64
+	Limit int64
+}
+
+// Usage is the usage of the quotas of a user. The API calls are counted per UTC
+// day and reset at ApiCallsResetTime.
+type Usage struct {
+	Plan              string
+	Items             QuotaUsage
+	Storage           QuotaUsage
+	ApiCalls          QuotaUsage
+	ApiCallsResetTime time.Time
+}
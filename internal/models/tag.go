@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+type Tag struct {
+	Id         uint
+	CreateTime time.Time
+	UpdateTime time.Time
+	Name       string
+	Color      string
+	OwnerId    uint
+}
+
+type TagCreate struct {
+	Name  string
+	Color *string
+}
+
+type TagUpdate struct {
+	Name  *string
+	Color *string
+}
@@ -17,6 +17,9 @@ type User struct {
 	VerificationCode   *string
 	PasswordResetToken *string
 	PasswordResetAt    *time.Time
+	Plan               string
+	DeleteTime         *time.Time
+	Version            int
 }
 
 type UserCreate struct {
@@ -37,4 +40,9 @@ type UserUpdate struct {
 	VerificationCode   *string
 	PasswordResetToken *string
 	PasswordResetAt    *time.Time
+	// Plan is the new plan of the user, empty for the default plan.
+	Plan *string
+	// Version is the version the update expects the user to have, nil to update
+	// the user whatever its version.
+	Version *int
 }
@@ -0,0 +1,22 @@
+package quotas
+
+import (
+	"context"
+)
+
+// The quota queries are filtered by the privacy policies, the use case reads
+// them with the system as viewer. They run in the transaction of the context
+// when there is one.
+type QuotaPgRepository interface {
+	// GetPlan returns the plan of a user, empty for the default plan.
+	GetPlan(ctx context.Context, userId uint) (string, error)
+	// LockUser locks the row of a user until the end of the transaction of
+	// ctx, the concurrent checks of its quotas wait for the transaction.
+	LockUser(ctx context.Context, userId uint) error
+	// CountItems returns the number of items owned by a user, the deleted
+	// items excluded.
+	CountItems(ctx context.Context, userId uint) (int64, error)
+	// SumStorage returns the bytes of the attachments uploaded by a user,
+	// including the attachments of deleted items until they are purged.
+	SumStorage(ctx context.Context, userId uint) (int64, error)
+}
@@ -0,0 +1,14 @@
+package quotas
+
+import (
+	"context"
+	"time"
+)
+
+type QuotaRedisRepository interface {
+	// IncrApiCalls counts an API call of a user on the day of t and returns the
+	// calls of that day.
+	IncrApiCalls(ctx context.Context, userId uint, t time.Time) (int64, error)
+	// GetApiCalls returns the API calls of a user on the day of t.
+	GetApiCalls(ctx context.Context, userId uint, t time.Time) (int64, error)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+)
+
+type QuotaPgRepo struct {
+	client *ent.Client
+}
+
+func CreateQuotaPgRepository(client *ent.Client) quotas.QuotaPgRepository {
+	return &QuotaPgRepo{client: client}
+}
+
+// clientOf returns the client of the transaction of ctx if any, so that the
+// usage counted in a transaction includes its changes.
+func (r *QuotaPgRepo) clientOf(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return r.client
+}
+
+func (r *QuotaPgRepo) GetPlan(ctx context.Context, userId uint) (string, error) {
+	db_obj, err := r.clientOf(ctx).User.Query().
+		Where(user.ID(userId)).
+		Select(user.FieldPlan).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	return db_obj.Plan, nil
+}
+
+func (r *QuotaPgRepo) LockUser(ctx context.Context, userId uint) error {
+	_, err := r.clientOf(ctx).User.Query().
+		Where(user.ID(userId)).
+		Select(user.FieldID).
+		Modify(func(s *entsql.Selector) {
+			// SQLite has no row locks, a transaction writing locks the whole
+			// database.
+			if s.Dialect() != dialect.SQLite {
+				s.ForUpdate()
+			}
+		}).
+		Ints(ctx)
+	return err
+}
+
+func (r *QuotaPgRepo) CountItems(ctx context.Context, userId uint) (int64, error) {
+	count, err := r.clientOf(ctx).Item.Query().
+		Where(item.OwnerID(userId)).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(count), nil
+}
+
+func (r *QuotaPgRepo) SumStorage(ctx context.Context, userId uint) (int64, error) {
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err := r.clientOf(ctx).Attachment.Query().
+		Where(attachment.UploaderID(userId)).
+		Aggregate(ent.Sum(attachment.FieldSize)).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+	return v[0].Sum.Int64, nil
+}
@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	_ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/repository"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCountItemsInTransaction(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:quotas?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := viewer.NewSystemContext(context.Background())
+	repo := repository.CreateQuotaPgRepository(client)
+
+	userId := client.User.Create().
+		SetName("owner").
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		SaveX(ctx).ID
+	client.Item.Create().SetTitle("committed").SetDescription("").SetOwnerID(userId).SaveX(ctx)
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+	tx.Item.Create().SetTitle("uncommitted").SetDescription("").SetOwnerID(userId).SaveX(ctx)
+
+	count, err := repo.CountItems(ent.NewTxContext(ctx, tx), userId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("got %d items in the transaction, want 2", count)
+	}
+}
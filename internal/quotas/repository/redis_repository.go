@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	"github.com/redis/go-redis/v9"
+)
+
+// apiCallsExpiration keeps the counter of a day until the day is over in every
+// time zone.
+const apiCallsExpiration = 48 * time.Hour
+
+type QuotaRedisRepo struct {
+	redisClient *redis.Client
+}
+
+func CreateQuotaRedisRepository(redisClient *redis.Client) quotas.QuotaRedisRepository {
+	return &QuotaRedisRepo{redisClient: redisClient}
+}
+
+func (r *QuotaRedisRepo) apiCallsKey(userId uint, t time.Time) string {
+	return fmt.Sprintf("Quota:ApiCalls:%v:%v", userId, t.UTC().Format("2006-01-02"))
+}
+
+func (r *QuotaRedisRepo) IncrApiCalls(ctx context.Context, userId uint, t time.Time) (int64, error) {
+	key := r.apiCallsKey(userId, t)
+
+	pipe := r.redisClient.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, apiCallsExpiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (r *QuotaRedisRepo) GetApiCalls(ctx context.Context, userId uint, t time.Time) (int64, error) {
+	calls, err := r.redisClient.Get(ctx, r.apiCallsKey(userId, t)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return calls, err
+}
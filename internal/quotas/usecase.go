@@ -0,0 +1,24 @@
+package quotas
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// The checks fail with a quota_exceeded error holding the usage of the
+// exceeded quota.
+type QuotaUseCase interface {
+	GetUsage(ctx context.Context, userId uint) (*models.Usage, error)
+	// CheckItems checks that a user can own count more items. In a
+	// transaction, the items it created are counted already and the user is
+	// locked until its end, the items must be created in the same transaction.
+	CheckItems(ctx context.Context, userId uint, count int64) error
+	// CheckStorage checks that a user can upload size more bytes.
+	CheckStorage(ctx context.Context, userId uint, size int64) error
+	// StorageLeft returns the bytes a user can still upload, -1 when unlimited.
+	StorageLeft(ctx context.Context, userId uint) (int64, error)
+	// CountApiCall counts an API call of user, the call is rejected once the
+	// calls of the day exceed the quota.
+	CountApiCall(ctx context.Context, user *models.User) error
+}
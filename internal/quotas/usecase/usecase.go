@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// The limits come from the plan of the user in the config, the items and the
+// storage are counted from the database and the API calls in redis. The usage
+// is read with the system as viewer, the quotas of a user are also checked
+// when another user acts on their behalf.
+type quotaUseCase struct {
+	pgRepo    quotas.QuotaPgRepository
+	redisRepo quotas.QuotaRedisRepository
+	cfg       *config.Config
+	logger    logger.Logger
+}
+
+func CreateQuotaUseCase(
+	pgRepo quotas.QuotaPgRepository,
+	redisRepo quotas.QuotaRedisRepository,
+	cfg *config.Config,
+	logger logger.Logger,
+) quotas.QuotaUseCase {
+	return &quotaUseCase{
+		pgRepo:    pgRepo,
+		redisRepo: redisRepo,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+func (u *quotaUseCase) getPlan(ctx context.Context, userId uint) (config.PlanConfig, error) {
+	name, err := u.pgRepo.GetPlan(ctx, userId)
+	if err != nil {
+		return config.PlanConfig{}, err
+	}
+	return u.plan(userId, name), nil
+}
+
+func (u *quotaUseCase) plan(userId uint, name string) config.PlanConfig {
+	plan, ok := u.cfg.Quota.Plan(name)
+	if !ok {
+		// The plan was removed from the config, the default plan applies.
+		u.logger.Warnf("user %d has the unknown plan %q", userId, name)
+		plan, _ = u.cfg.Quota.Plan("")
+	}
+	return plan
+}
+
+func (u *quotaUseCase) GetUsage(ctx context.Context, userId uint) (*models.Usage, error) {
+	ctx = viewer.NewSystemContext(ctx)
+
+	plan, err := u.getPlan(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := u.pgRepo.CountItems(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := u.pgRepo.SumStorage(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	apiCalls, err := u.redisRepo.GetApiCalls(ctx, userId, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Usage{
+		Plan:              plan.Name,
+		Items:             models.QuotaUsage{Used: items, Limit: plan.MaxItems},
+		Storage:           models.QuotaUsage{Used: storage, Limit: plan.MaxStorage},
+		ApiCalls:          models.QuotaUsage{Used: apiCalls, Limit: plan.MaxApiCalls},
+		ApiCallsResetTime: time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+func (u *quotaUseCase) CheckItems(ctx context.Context, userId uint, count int64) error {
+	ctx = viewer.NewSystemContext(ctx)
+
+	plan, err := u.getPlan(ctx, userId)
+	if err != nil || plan.MaxItems == 0 {
+		return err
+	}
+
+	// The items are counted once the concurrent transactions of the user
+	// are done.
+	if err := u.pgRepo.LockUser(ctx, userId); err != nil {
+		return err
+	}
+
+	items, err := u.pgRepo.CountItems(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if items+count > plan.MaxItems {
+		return httpErrors.ErrQuotaExceeded(
+			fmt.Errorf("the %s plan allows %d items", plan.Name, plan.MaxItems),
+			httpErrors.QuotaUsage{Quota: models.QuotaItems, Used: items, Limit: plan.MaxItems},
+		)
+	}
+	return nil
+}
+
+func (u *quotaUseCase) CheckStorage(ctx context.Context, userId uint, size int64) error {
+	ctx = viewer.NewSystemContext(ctx)
+
+	plan, err := u.getPlan(ctx, userId)
+	if err != nil || plan.MaxStorage == 0 {
+		return err
+	}
+
+	storage, err := u.pgRepo.SumStorage(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if storage+size > plan.MaxStorage {
+		return httpErrors.ErrQuotaExceeded(
+			fmt.Errorf("the %s plan allows %d bytes of attachments", plan.Name, plan.MaxStorage),
+			httpErrors.QuotaUsage{Quota: models.QuotaStorage, Used: storage, Limit: plan.MaxStorage},
+		)
+	}
+	return nil
+}
+
+func (u *quotaUseCase) StorageLeft(ctx context.Context, userId uint) (int64, error) {
+	ctx = viewer.NewSystemContext(ctx)
+
+	plan, err := u.getPlan(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	if plan.MaxStorage == 0 {
+		return -1, nil
+	}
+
+	storage, err := u.pgRepo.SumStorage(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	if storage >= plan.MaxStorage {
+		return 0, nil
+	}
+	return plan.MaxStorage - storage, nil
+}
+
+func (u *quotaUseCase) CountApiCall(ctx context.Context, user *models.User) error {
+	plan := u.plan(user.Id, user.Plan)
+	if plan.MaxApiCalls == 0 {
+		return nil
+	}
+
+	calls, err := u.redisRepo.IncrApiCalls(ctx, user.Id, time.Now())
+	if err != nil {
+		// The API stays available when the counter can not be updated.
+		u.logger.Warnf("failed to count the api call of user %d: %v", user.Id, err)
+		return nil
+	}
+
+	if calls > plan.MaxApiCalls {
+		return httpErrors.ErrQuotaExceeded(
+			fmt.Errorf("the %s plan allows %d api calls per day", plan.Name, plan.MaxApiCalls),
+			httpErrors.QuotaUsage{Quota: models.QuotaApiCalls, Used: calls, Limit: plan.MaxApiCalls},
+		)
+	}
+	return nil
+}
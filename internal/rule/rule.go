@@ -0,0 +1,543 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/attachment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/comment"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/item"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemimport"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemrevision"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemtransition"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/metadataschema"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+)
+
+// DenyIfNoViewer is a rule that returns deny decision if the viewer is missing in the context.
+func DenyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx) == nil {
+			return privacy.Denyf("viewer-context is missing")
+		}
+		// Skip to the next privacy rule (equivalent to returning nil).
+		return privacy.Skip
+	})
+}
+
+// AllowIfAdmin is a rule that returns allow decision if the viewer is a super user or the system.
+func AllowIfAdmin() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx).Admin() {
+			return privacy.Allow
+		}
+		// Skip to the next privacy rule (equivalent to returning nil).
+		return privacy.Skip
+	})
+}
+
+// FilterVisibleItems is a rule that limits item queries to the items owned by or shared with the viewer.
+func FilterVisibleItems() privacy.ItemQueryRuleFunc {
+	return privacy.ItemQueryRuleFunc(func(ctx context.Context, q *ent.ItemQuery) error {
+		q.Where(visibleItem(viewer.FromContext(ctx).Id))
+		return privacy.Allow
+	})
+}
+
+// AllowItemMutation is a rule that allows owners to create, update and delete their items,
+// editors to update the items shared with them, the users who can see an item to change its
+// status and the recipient of an accepted ownership transfer to take the item.
+func AllowItemMutation() privacy.ItemMutationRuleFunc {
+	return privacy.ItemMutationRuleFunc(func(ctx context.Context, m *ent.ItemMutation) error {
+		v := viewer.FromContext(ctx)
+
+		if m.Op().Is(ent.OpCreate) {
+			if ownerId, ok := m.OwnerID(); ok && ownerId == v.Id {
+				return privacy.Allow
+			}
+			return privacy.Denyf("items can only be created for the viewer")
+		}
+
+		if ownerId, ok := m.OwnerID(); ok {
+			if m.Op().Is(ent.OpUpdateOne) && ownerId == v.Id {
+				m.Where(transferredTo(v.Id))
+				return privacy.Allow
+			}
+			return privacy.Denyf("item owner can not be changed")
+		}
+
+		switch op := m.Op(); {
+		case op.Is(ent.OpUpdate):
+			m.Where(editableItem(v.Id))
+			return privacy.Allow
+		case op.Is(ent.OpDelete):
+			m.Where(item.OwnerID(v.Id))
+			return privacy.Allow
+		}
+
+		// The workflow roles of status changes are checked by the use case.
+		if _, ok := m.Status(); ok && m.Op().Is(ent.OpUpdateOne) &&
+			onlyFields(m.Fields(), item.FieldStatus, item.FieldUpdateTime) {
+			m.Where(visibleItem(v.Id))
+			return privacy.Allow
+		}
+
+		id, ok := m.ID()
+		if !ok {
+			return privacy.Denyf("missing item id")
+		}
+
+		// The query is filtered by FilterVisibleItems, an item the viewer can not see is not found.
+		db_obj, err := m.Client().Item.Query().
+			Where(item.ID(id)).
+			WithShares(func(q *ent.ItemShareQuery) {
+				q.Where(itemshare.UserID(v.Id))
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		if db_obj.OwnerID == v.Id {
+			return privacy.Allow
+		}
+
+		if m.Op().Is(ent.OpUpdateOne) {
+			for _, share := range db_obj.Edges.Shares {
+				if share.Permission == itemshare.PermissionEditor {
+					return privacy.Allow
+				}
+			}
+		}
+
+		return privacy.Denyf("user does not have permission on this item")
+	})
+}
+
+// FilterVisibleItemShares is a rule that limits share queries to the shares granted to the viewer
+// and the shares of the items owned by the viewer.
+func FilterVisibleItemShares() privacy.ItemShareQueryRuleFunc {
+	return privacy.ItemShareQueryRuleFunc(func(ctx context.Context, q *ent.ItemShareQuery) error {
+		v := viewer.FromContext(ctx)
+		q.Where(itemshare.Or(
+			itemshare.UserID(v.Id),
+			itemshare.HasItemWith(item.OwnerID(v.Id)),
+		))
+		return privacy.Allow
+	})
+}
+
+// AllowItemShareMutation is a rule that allows item owners to manage the shares of their items.
+func AllowItemShareMutation() privacy.ItemShareMutationRuleFunc {
+	return privacy.ItemShareMutationRuleFunc(func(ctx context.Context, m *ent.ItemShareMutation) error {
+		v := viewer.FromContext(ctx)
+
+		if !m.Op().Is(ent.OpCreate) {
+			m.Where(itemshare.HasItemWith(item.OwnerID(v.Id)))
+			return privacy.Allow
+		}
+
+		itemId, ok := m.ItemID()
+		if !ok {
+			return privacy.Denyf("missing item id")
+		}
+
+		isOwner, err := m.Client().Item.Query().
+			Where(item.ID(itemId), item.OwnerID(v.Id)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return privacy.Denyf("only the item owner can share the item")
+		}
+
+		return privacy.Allow
+	})
+}
+
+// FilterVisibleItemRevisions is a rule that limits revision queries to the revisions of the items
+// visible to the viewer.
+func FilterVisibleItemRevisions() privacy.ItemRevisionQueryRuleFunc {
+	return privacy.ItemRevisionQueryRuleFunc(func(ctx context.Context, q *ent.ItemRevisionQuery) error {
+		q.Where(itemrevision.HasItemWith(visibleItem(viewer.FromContext(ctx).Id)))
+		return privacy.Allow
+	})
+}
+
+// FilterVisibleTags is a rule that limits tag queries to the tags owned by the viewer and the tags
+// of the items visible to the viewer.
+func FilterVisibleTags() privacy.TagQueryRuleFunc {
+	return privacy.TagQueryRuleFunc(func(ctx context.Context, q *ent.TagQuery) error {
+		v := viewer.FromContext(ctx)
+		q.Where(tag.Or(
+			tag.OwnerID(v.Id),
+			tag.HasItemsWith(visibleItem(v.Id)),
+		))
+		return privacy.Allow
+	})
+}
+
+// AllowTagMutation is a rule that allows users to create, update and delete their own tags.
+func AllowTagMutation() privacy.TagMutationRuleFunc {
+	return privacy.TagMutationRuleFunc(func(ctx context.Context, m *ent.TagMutation) error {
+		v := viewer.FromContext(ctx)
+
+		if m.Op().Is(ent.OpCreate) {
+			if ownerId, ok := m.OwnerID(); ok && ownerId == v.Id {
+				return privacy.Allow
+			}
+			return privacy.Denyf("tags can only be created for the viewer")
+		}
+
+		if _, ok := m.OwnerID(); ok {
+			return privacy.Denyf("tag owner can not be changed")
+		}
+
+		m.Where(tag.OwnerID(v.Id))
+		return privacy.Allow
+	})
+}
+
+// FilterVisibleAttachments is a rule that limits attachment queries to the attachments of the
+// items visible to the viewer.
+func FilterVisibleAttachments() privacy.AttachmentQueryRuleFunc {
+	return privacy.AttachmentQueryRuleFunc(func(ctx context.Context, q *ent.AttachmentQuery) error {
+		q.Where(attachment.HasItemWith(visibleItem(viewer.FromContext(ctx).Id)))
+		return privacy.Allow
+	})
+}
+
+// AllowAttachmentMutation is a rule that allows the users who can edit an item to upload and
+// delete its attachments. Attachments can not be updated, a new file is uploaded instead.
+func AllowAttachmentMutation() privacy.AttachmentMutationRuleFunc {
+	return privacy.AttachmentMutationRuleFunc(func(ctx context.Context, m *ent.AttachmentMutation) error {
+		v := viewer.FromContext(ctx)
+
+		switch op := m.Op(); {
+		case op.Is(ent.OpDelete) || op.Is(ent.OpDeleteOne):
+			m.Where(attachment.HasItemWith(editableItem(v.Id)))
+			return privacy.Allow
+		case !op.Is(ent.OpCreate):
+			return privacy.Denyf("attachments can not be updated")
+		}
+
+		if uploaderId, ok := m.UploaderID(); !ok || uploaderId != v.Id {
+			return privacy.Denyf("attachments can only be uploaded by the viewer")
+		}
+
+		itemId, ok := m.ItemID()
+		if !ok {
+			return privacy.Denyf("missing item id")
+		}
+
+		canEdit, err := m.Client().Item.Query().
+			Where(item.ID(itemId), editableItem(v.Id)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !canEdit {
+			return privacy.Denyf("user does not have permission on this item")
+		}
+
+		return privacy.Allow
+	})
+}
+
+// FilterOwnItemImports is a rule that limits import queries to the imports of the viewer.
+func FilterOwnItemImports() privacy.ItemImportQueryRuleFunc {
+	return privacy.ItemImportQueryRuleFunc(func(ctx context.Context, q *ent.ItemImportQuery) error {
+		q.Where(itemimport.OwnerID(viewer.FromContext(ctx).Id))
+		return privacy.Allow
+	})
+}
+
+// AllowItemImportCreate is a rule that allows users to start imports for themselves. Imports are
+// updated by the worker only.
+func AllowItemImportCreate() privacy.ItemImportMutationRuleFunc {
+	return privacy.ItemImportMutationRuleFunc(func(ctx context.Context, m *ent.ItemImportMutation) error {
+		if !m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+
+		if ownerId, ok := m.OwnerID(); ok && ownerId == viewer.FromContext(ctx).Id {
+			return privacy.Allow
+		}
+		return privacy.Denyf("imports can only be created for the viewer")
+	})
+}
+
+// FilterVisibleComments is a rule that limits comment queries to the comments of the items visible
+// to the viewer.
+func FilterVisibleComments() privacy.CommentQueryRuleFunc {
+	return privacy.CommentQueryRuleFunc(func(ctx context.Context, q *ent.CommentQuery) error {
+		q.Where(comment.HasItemWith(visibleItem(viewer.FromContext(ctx).Id)))
+		return privacy.Allow
+	})
+}
+
+// AllowCommentMutation is a rule that allows the users who can see an item to comment on it,
+// authors to edit their comments and authors and item owners to delete them.
+func AllowCommentMutation() privacy.CommentMutationRuleFunc {
+	return privacy.CommentMutationRuleFunc(func(ctx context.Context, m *ent.CommentMutation) error {
+		v := viewer.FromContext(ctx)
+
+		if !m.Op().Is(ent.OpCreate) {
+			// Deletes come back as updates of delete_time from the soft delete mixin.
+			_, softDelete := m.DeleteTime()
+			if m.Op().Is(ent.OpDelete) || m.Op().Is(ent.OpDeleteOne) || softDelete {
+				m.Where(
+					comment.HasItemWith(visibleItem(v.Id)),
+					comment.Or(comment.AuthorID(v.Id), comment.HasItemWith(item.OwnerID(v.Id))),
+				)
+				return privacy.Allow
+			}
+
+			m.Where(comment.AuthorID(v.Id), comment.HasItemWith(visibleItem(v.Id)))
+			return privacy.Allow
+		}
+
+		if authorId, ok := m.AuthorID(); !ok || authorId != v.Id {
+			return privacy.Denyf("comments can only be written by the viewer")
+		}
+
+		itemId, ok := m.ItemID()
+		if !ok {
+			return privacy.Denyf("missing item id")
+		}
+
+		// The query is filtered by FilterVisibleItems, an item the viewer can not see is not found.
+		canSee, err := m.Client().Item.Query().
+			Where(item.ID(itemId)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !canSee {
+			return privacy.Denyf("user does not have permission on this item")
+		}
+
+		return privacy.Allow
+	})
+}
+
+// FilterVisibleItemTransitions is a rule that limits transition queries to the transitions of the
+// items visible to the viewer.
+func FilterVisibleItemTransitions() privacy.ItemTransitionQueryRuleFunc {
+	return privacy.ItemTransitionQueryRuleFunc(func(ctx context.Context, q *ent.ItemTransitionQuery) error {
+		q.Where(itemtransition.HasItemWith(visibleItem(viewer.FromContext(ctx).Id)))
+		return privacy.Allow
+	})
+}
+
+// AllowItemTransitionCreate is a rule that allows the users who can see an item to record the
+// transitions they make on it. Transitions can not be updated or deleted.
+func AllowItemTransitionCreate() privacy.ItemTransitionMutationRuleFunc {
+	return privacy.ItemTransitionMutationRuleFunc(func(ctx context.Context, m *ent.ItemTransitionMutation) error {
+		if !m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+
+		if userId, ok := m.UserID(); !ok || userId != viewer.FromContext(ctx).Id {
+			return privacy.Denyf("transitions can only be made by the viewer")
+		}
+
+		itemId, ok := m.ItemID()
+		if !ok {
+			return privacy.Denyf("missing item id")
+		}
+
+		// The query is filtered by FilterVisibleItems, an item the viewer can not see is not found.
+		canSee, err := m.Client().Item.Query().
+			Where(item.ID(itemId)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !canSee {
+			return privacy.Denyf("user does not have permission on this item")
+		}
+
+		return privacy.Allow
+	})
+}
+
+// FilterVisibleMetadataSchemas is a rule that limits metadata schema queries to the global schema
+// and the schema of the viewer.
+func FilterVisibleMetadataSchemas() privacy.MetadataSchemaQueryRuleFunc {
+	return privacy.MetadataSchemaQueryRuleFunc(func(ctx context.Context, q *ent.MetadataSchemaQuery) error {
+		q.Where(metadataschema.Or(
+			metadataschema.OwnerIDIsNil(),
+			metadataschema.OwnerID(viewer.FromContext(ctx).Id),
+		))
+		return privacy.Allow
+	})
+}
+
+// FilterOwnOwnershipTransfers is a rule that limits ownership transfer queries to the transfers sent
+// or received by the viewer.
+func FilterOwnOwnershipTransfers() privacy.OwnershipTransferQueryRuleFunc {
+	return privacy.OwnershipTransferQueryRuleFunc(func(ctx context.Context, q *ent.OwnershipTransferQuery) error {
+		userId := viewer.FromContext(ctx).Id
+		q.Where(ownershiptransfer.Or(
+			ownershiptransfer.FromUserID(userId),
+			ownershiptransfer.ToUserID(userId),
+		))
+		return privacy.Allow
+	})
+}
+
+// AllowOwnershipTransferMutation is a rule that allows owners to offer their items to other users,
+// recipients to accept or decline the transfers sent to them and senders to cancel theirs.
+// Transfers can not be deleted.
+func AllowOwnershipTransferMutation() privacy.OwnershipTransferMutationRuleFunc {
+	return privacy.OwnershipTransferMutationRuleFunc(func(ctx context.Context, m *ent.OwnershipTransferMutation) error {
+		v := viewer.FromContext(ctx)
+
+		switch op := m.Op(); {
+		case op.Is(ent.OpDelete) || op.Is(ent.OpDeleteOne):
+			return privacy.Denyf("ownership transfers can not be deleted")
+		case !op.Is(ent.OpCreate):
+			if !onlyFields(m.Fields(), ownershiptransfer.FieldStatus, ownershiptransfer.FieldUpdateTime) {
+				return privacy.Denyf("only the status of ownership transfers can be updated")
+			}
+
+			status, _ := m.Status()
+			switch status {
+			case ownershiptransfer.StatusAccepted, ownershiptransfer.StatusDeclined:
+				m.Where(ownershiptransfer.ToUserID(v.Id))
+			case ownershiptransfer.StatusCancelled:
+				m.Where(ownershiptransfer.FromUserID(v.Id))
+			default:
+				return privacy.Denyf("ownership transfers can not be set to %s", status)
+			}
+			return privacy.Allow
+		}
+
+		if fromUserId, ok := m.FromUserID(); !ok || fromUserId != v.Id {
+			return privacy.Denyf("ownership transfers can only be sent by the viewer")
+		}
+		if status, ok := m.Status(); ok && status != ownershiptransfer.StatusPending {
+			return privacy.Denyf("ownership transfers can only be sent pending")
+		}
+
+		itemId, ok := m.ItemID()
+		if !ok {
+			return privacy.Denyf("missing item id")
+		}
+
+		isOwner, err := m.Client().Item.Query().
+			Where(item.ID(itemId), item.OwnerID(v.Id)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return privacy.Denyf("only the item owner can transfer the item")
+		}
+
+		return privacy.Allow
+	})
+}
+
+// FilterSelfUser is a rule that limits user queries to the viewer itself.
+func FilterSelfUser() privacy.UserQueryRuleFunc {
+	return privacy.UserQueryRuleFunc(func(ctx context.Context, q *ent.UserQuery) error {
+		q.Where(user.ID(viewer.FromContext(ctx).Id))
+		return privacy.Allow
+	})
+}
+
+// AllowSelfUserUpdate is a rule that allows users to update their own name and password.
+func AllowSelfUserUpdate() privacy.UserMutationRuleFunc {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *ent.UserMutation) error {
+		if !m.Op().Is(ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+
+		if id, ok := m.ID(); !ok || id != viewer.FromContext(ctx).Id {
+			return privacy.Skip
+		}
+
+		for _, field := range m.Fields() {
+			switch field {
+			case user.FieldName, user.FieldPassword, user.FieldUpdateTime:
+			default:
+				return privacy.Denyf("user can not update field %s", field)
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+func visibleItem(userId uint) predicate.Item {
+	return item.Or(
+		item.OwnerID(userId),
+		item.HasSharesWith(itemshare.UserID(userId)),
+	)
+}
+
+func editableItem(userId uint) predicate.Item {
+	return item.Or(
+		item.OwnerID(userId),
+		item.HasSharesWith(
+			itemshare.UserID(userId),
+			itemshare.PermissionEQ(itemshare.PermissionEditor),
+		),
+	)
+}
+
+// transferredTo matches the items whose last accepted ownership transfer was sent by their
+// current owner to userId.
+func transferredTo(userId uint) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		t := sql.Table(ownershiptransfer.Table).As("t")
+		later := sql.Table(ownershiptransfer.Table).As("later")
+		s.Where(sql.Exists(
+			sql.Select(t.C(ownershiptransfer.FieldID)).
+				From(t).
+				Where(sql.And(
+					sql.ColumnsEQ(t.C(ownershiptransfer.FieldItemID), s.C(item.FieldID)),
+					sql.ColumnsEQ(t.C(ownershiptransfer.FieldFromUserID), s.C(item.FieldOwnerID)),
+					sql.EQ(t.C(ownershiptransfer.FieldToUserID), userId),
+					sql.EQ(t.C(ownershiptransfer.FieldStatus), ownershiptransfer.StatusAccepted.String()),
+					sql.NotExists(
+						sql.Select(later.C(ownershiptransfer.FieldID)).
+							From(later).
+							Where(sql.And(
+								sql.ColumnsEQ(later.C(ownershiptransfer.FieldItemID), t.C(ownershiptransfer.FieldItemID)),
+								sql.EQ(later.C(ownershiptransfer.FieldStatus), ownershiptransfer.StatusAccepted.String()),
+								sql.ColumnsGT(later.C(ownershiptransfer.FieldID), t.C(ownershiptransfer.FieldID)),
+							)),
+					),
+				)),
+		))
+	})
+}
+
+// onlyFields reports whether fields are all among allowed.
+func onlyFields(fields []string, allowed ...string) bool {
+	for _, field := range fields {
+		found := false
+		for _, a := range allowed {
+			if field == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
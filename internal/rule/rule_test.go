@@ -0,0 +1,670 @@
+package rule_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/itemshare"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/ownershiptransfer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
+	_ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// fixture is a database where owner owns item, shared with editor as editor
+// and with reader as viewer, and stranger owns strangerItem.
+type fixture struct {
+	client       *ent.Client
+	users        map[string]*ent.User
+	item         *ent.Item
+	strangerItem *ent.Item
+}
+
+var databases int64
+
+func setup(t *testing.T) *fixture {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:rule%d?mode=memory&cache=shared&_fk=1", atomic.AddInt64(&databases, 1))
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+
+	f := &fixture{client: client, users: map[string]*ent.User{}}
+	ctx := f.ctx("system")
+
+	for _, name := range []string{"owner", "editor", "reader", "stranger", "admin"} {
+		f.users[name] = client.User.Create().
+			SetName(name).
+			SetEmail(name + "@example.com").
+			SetPassword("password").
+			SetIsSuperUser(name == "admin").
+			SaveX(ctx)
+	}
+
+	f.item = client.Item.Create().
+		SetTitle("item").
+		SetDescription("item").
+		SetOwnerID(f.id("owner")).
+		SaveX(ctx)
+	f.strangerItem = client.Item.Create().
+		SetTitle("stranger item").
+		SetDescription("stranger item").
+		SetOwnerID(f.id("stranger")).
+		SaveX(ctx)
+
+	client.ItemShare.Create().
+		SetItemID(f.item.ID).
+		SetUserID(f.id("editor")).
+		SetPermission(itemshare.PermissionEditor).
+		SaveX(ctx)
+	client.ItemShare.Create().
+		SetItemID(f.item.ID).
+		SetUserID(f.id("reader")).
+		SetPermission(itemshare.PermissionViewer).
+		SaveX(ctx)
+
+	return f
+}
+
+func (f *fixture) id(name string) uint {
+	return f.users[name].ID
+}
+
+// ctx returns a context with the user name as viewer, the system for "system"
+// and no viewer for "".
+func (f *fixture) ctx(name string) context.Context {
+	ctx := context.Background()
+	switch name {
+	case "":
+		return ctx
+	case "system":
+		return viewer.NewSystemContext(ctx)
+	}
+
+	v := &viewer.Viewer{Id: f.id(name), Role: viewer.RoleUser}
+	if f.users[name].IsSuperUser {
+		v.Role = viewer.RoleAdmin
+	}
+	return viewer.NewContext(ctx, v)
+}
+
+func (f *fixture) transfer(t *testing.T, from, to string, status ownershiptransfer.Status) *ent.OwnershipTransfer {
+	t.Helper()
+	return f.client.OwnershipTransfer.Create().
+		SetItemID(f.item.ID).
+		SetFromUserID(f.id(from)).
+		SetToUserID(f.id(to)).
+		SetStatus(status).
+		SaveX(f.ctx("system"))
+}
+
+type outcome int
+
+const (
+	allowed outcome = iota
+	denied
+	notFound
+)
+
+func (o outcome) String() string {
+	return [...]string{"allowed", "denied", "not found"}[o]
+}
+
+func check(t *testing.T, err error, want outcome) {
+	t.Helper()
+
+	var got outcome
+	switch {
+	case err == nil:
+		got = allowed
+	case errors.Is(err, privacy.Deny):
+		got = denied
+	case ent.IsNotFound(err):
+		got = notFound
+	default:
+		t.Fatalf("want %s, got unexpected error: %v", want, err)
+	}
+	if got != want {
+		t.Fatalf("want %s, got %s: %v", want, got, err)
+	}
+}
+
+// affected returns the error of a bulk mutation, or an error when it did not
+// change want rows.
+func affected(n int, err error) func(want int) error {
+	return func(want int) error {
+		if err == nil && n != want {
+			return fmt.Errorf("%d rows changed, want %d", n, want)
+		}
+		return err
+	}
+}
+
+type mutationCase struct {
+	name string
+	as   string
+	// prepare changes the fixture with the system as viewer, before fn.
+	prepare func(t *testing.T, f *fixture)
+	fn      func(ctx context.Context, f *fixture) error
+	want    outcome
+}
+
+func runMutationCases(t *testing.T, cases []mutationCase) {
+	t.Helper()
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := setup(t)
+			if tc.prepare != nil {
+				tc.prepare(t, f)
+			}
+			check(t, tc.fn(f.ctx(tc.as), f), tc.want)
+		})
+	}
+}
+
+func TestDenyIfNoViewer(t *testing.T) {
+	f := setup(t)
+	ctx := f.ctx("")
+
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"query items", func() error { _, err := f.client.Item.Query().All(ctx); return err }},
+		{"query users", func() error { _, err := f.client.User.Query().All(ctx); return err }},
+		{"query shares", func() error { _, err := f.client.ItemShare.Query().All(ctx); return err }},
+		{"query transitions", func() error { _, err := f.client.ItemTransition.Query().All(ctx); return err }},
+		{"query transfers", func() error { _, err := f.client.OwnershipTransfer.Query().All(ctx); return err }},
+		{"create item", func() error {
+			_, err := f.client.Item.Create().
+				SetTitle("new").
+				SetDescription("new").
+				SetOwnerID(f.id("owner")).
+				Save(ctx)
+			return err
+		}},
+		{"update item", func() error {
+			_, err := f.client.Item.UpdateOneID(f.item.ID).SetTitle("changed").Save(ctx)
+			return err
+		}},
+		{"update user", func() error {
+			_, err := f.client.User.UpdateOneID(f.id("owner")).SetName("changed").Save(ctx)
+			return err
+		}},
+		{"delete share", func() error {
+			_, err := f.client.ItemShare.Delete().Exec(ctx)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			check(t, tt.fn(), denied)
+		})
+	}
+}
+
+func TestAllowIfAdmin(t *testing.T) {
+	for _, as := range []string{"admin", "system"} {
+		as := as
+		t.Run(as, func(t *testing.T) {
+			f := setup(t)
+			ctx := f.ctx(as)
+
+			if n := f.client.Item.Query().CountX(ctx); n != 2 {
+				t.Fatalf("got %d items, want 2", n)
+			}
+			if n := f.client.User.Query().CountX(ctx); n != 5 {
+				t.Fatalf("got %d users, want 5", n)
+			}
+			if n := f.client.ItemShare.Query().CountX(ctx); n != 2 {
+				t.Fatalf("got %d shares, want 2", n)
+			}
+
+			_, err := f.client.Item.UpdateOneID(f.strangerItem.ID).SetTitle("changed").Save(ctx)
+			check(t, err, allowed)
+
+			_, err = f.client.Item.UpdateOneID(f.strangerItem.ID).SetOwnerID(f.id("owner")).Save(ctx)
+			check(t, err, allowed)
+
+			_, err = f.client.User.UpdateOneID(f.id("owner")).SetPlan("pro").Save(ctx)
+			check(t, err, allowed)
+
+			_, err = f.client.ItemTransition.Create().
+				SetItemID(f.item.ID).
+				SetUserID(f.id("owner")).
+				SetName("submit").
+				SetFromStatus("draft").
+				SetToStatus("review").
+				Save(ctx)
+			check(t, err, allowed)
+
+			err = affected(f.client.ItemShare.Delete().Exec(ctx))(2)
+			check(t, err, allowed)
+		})
+	}
+}
+
+func TestQueryFilters(t *testing.T) {
+	f := setup(t)
+	f.transfer(t, "owner", "stranger", ownershiptransfer.StatusPending)
+
+	tests := []struct {
+		as        string
+		items     []uint
+		shares    int
+		users     []uint
+		transfers int
+	}{
+		{as: "owner", items: []uint{f.item.ID}, shares: 2, users: []uint{f.id("owner")}, transfers: 1},
+		{as: "editor", items: []uint{f.item.ID}, shares: 1, users: []uint{f.id("editor")}, transfers: 0},
+		{as: "reader", items: []uint{f.item.ID}, shares: 1, users: []uint{f.id("reader")}, transfers: 0},
+		{as: "stranger", items: []uint{f.strangerItem.ID}, shares: 0, users: []uint{f.id("stranger")}, transfers: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.as, func(t *testing.T) {
+			ctx := f.ctx(tt.as)
+
+			items := f.client.Item.Query().IDsX(ctx)
+			if !equalIds(items, tt.items) {
+				t.Errorf("got items %v, want %v", items, tt.items)
+			}
+
+			if n := f.client.ItemShare.Query().CountX(ctx); n != tt.shares {
+				t.Errorf("got %d shares, want %d", n, tt.shares)
+			}
+
+			users := f.client.User.Query().IDsX(ctx)
+			if !equalIds(users, tt.users) {
+				t.Errorf("got users %v, want %v", users, tt.users)
+			}
+
+			if n := f.client.OwnershipTransfer.Query().CountX(ctx); n != tt.transfers {
+				t.Errorf("got %d transfers, want %d", n, tt.transfers)
+			}
+		})
+	}
+}
+
+func equalIds(got, want []uint) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+	sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func updateTitle(ctx context.Context, f *fixture) error {
+	_, err := f.client.Item.UpdateOneID(f.item.ID).SetTitle("changed").Save(ctx)
+	return err
+}
+
+func updateStatus(ctx context.Context, f *fixture) error {
+	_, err := f.client.Item.UpdateOneID(f.item.ID).SetStatus("review").Save(ctx)
+	return err
+}
+
+func takeItem(ctx context.Context, f *fixture) error {
+	_, err := f.client.Item.UpdateOneID(f.item.ID).
+		SetOwnerID(viewer.FromContext(ctx).Id).
+		Save(ctx)
+	return err
+}
+
+func TestAllowItemMutation(t *testing.T) {
+	runMutationCases(t, []mutationCase{
+		{
+			name: "create for the viewer",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.Item.Create().
+					SetTitle("new").
+					SetDescription("new").
+					SetOwnerID(f.id("owner")).
+					Save(ctx)
+				return err
+			},
+			want: allowed,
+		},
+		{
+			name: "create for another user",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.Item.Create().
+					SetTitle("new").
+					SetDescription("new").
+					SetOwnerID(f.id("stranger")).
+					Save(ctx)
+				return err
+			},
+			want: denied,
+		},
+		{name: "owner updates", as: "owner", fn: updateTitle, want: allowed},
+		{name: "editor updates", as: "editor", fn: updateTitle, want: allowed},
+		{name: "reader updates", as: "reader", fn: updateTitle, want: denied},
+		{name: "stranger updates", as: "stranger", fn: updateTitle, want: notFound},
+		{
+			name: "editor bulk updates",
+			as:   "editor",
+			fn: func(ctx context.Context, f *fixture) error {
+				return affected(f.client.Item.Update().SetTitle("changed").Save(ctx))(1)
+			},
+			want: allowed,
+		},
+		{
+			name: "reader bulk updates",
+			as:   "reader",
+			fn: func(ctx context.Context, f *fixture) error {
+				return affected(f.client.Item.Update().SetTitle("changed").Save(ctx))(0)
+			},
+			want: allowed,
+		},
+		{name: "reader changes the status", as: "reader", fn: updateStatus, want: allowed},
+		{name: "stranger changes the status", as: "stranger", fn: updateStatus, want: notFound},
+		{
+			name: "reader changes the status and the title",
+			as:   "reader",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.Item.UpdateOneID(f.item.ID).
+					SetStatus("review").
+					SetTitle("changed").
+					Save(ctx)
+				return err
+			},
+			want: denied,
+		},
+		{
+			name: "owner gives the item away",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.Item.UpdateOneID(f.item.ID).SetOwnerID(f.id("stranger")).Save(ctx)
+				return err
+			},
+			want: denied,
+		},
+		{name: "recipient takes the item without transfer", as: "stranger", fn: takeItem, want: notFound},
+		{
+			name: "recipient takes the item with a pending transfer",
+			as:   "stranger",
+			prepare: func(t *testing.T, f *fixture) {
+				f.transfer(t, "owner", "stranger", ownershiptransfer.StatusPending)
+			},
+			fn:   takeItem,
+			want: notFound,
+		},
+		{
+			name: "recipient takes the item with an accepted transfer",
+			as:   "stranger",
+			prepare: func(t *testing.T, f *fixture) {
+				f.transfer(t, "owner", "stranger", ownershiptransfer.StatusAccepted)
+			},
+			fn:   takeItem,
+			want: allowed,
+		},
+		{
+			name: "recipient takes the item with an older accepted transfer",
+			as:   "stranger",
+			prepare: func(t *testing.T, f *fixture) {
+				f.transfer(t, "owner", "stranger", ownershiptransfer.StatusAccepted)
+				f.transfer(t, "owner", "editor", ownershiptransfer.StatusAccepted)
+			},
+			fn:   takeItem,
+			want: notFound,
+		},
+		{
+			name: "recipient takes the item from a previous owner",
+			as:   "stranger",
+			prepare: func(t *testing.T, f *fixture) {
+				f.transfer(t, "editor", "stranger", ownershiptransfer.StatusAccepted)
+			},
+			fn:   takeItem,
+			want: notFound,
+		},
+		{
+			name: "owner deletes",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				return f.client.Item.DeleteOneID(f.item.ID).Exec(ctx)
+			},
+			want: allowed,
+		},
+		{
+			name: "editor deletes",
+			as:   "editor",
+			fn: func(ctx context.Context, f *fixture) error {
+				return f.client.Item.DeleteOneID(f.item.ID).Exec(ctx)
+			},
+			want: denied,
+		},
+		{
+			name: "stranger deletes",
+			as:   "stranger",
+			fn: func(ctx context.Context, f *fixture) error {
+				return f.client.Item.DeleteOneID(f.item.ID).Exec(ctx)
+			},
+			want: notFound,
+		},
+		{
+			name: "editor bulk deletes",
+			as:   "editor",
+			fn: func(ctx context.Context, f *fixture) error {
+				return affected(f.client.Item.Delete().Exec(ctx))(0)
+			},
+			want: allowed,
+		},
+	})
+}
+
+func TestAllowItemShareMutation(t *testing.T) {
+	createShare := func(ctx context.Context, f *fixture) error {
+		_, err := f.client.ItemShare.Create().
+			SetItemID(f.item.ID).
+			SetUserID(f.id("stranger")).
+			Save(ctx)
+		return err
+	}
+
+	runMutationCases(t, []mutationCase{
+		{name: "owner shares", as: "owner", fn: createShare, want: allowed},
+		{name: "editor shares", as: "editor", fn: createShare, want: denied},
+		{
+			name: "owner unshares",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				return affected(f.client.ItemShare.Delete().Where(itemshare.ItemID(f.item.ID)).Exec(ctx))(2)
+			},
+			want: allowed,
+		},
+		{
+			name: "editor unshares",
+			as:   "editor",
+			fn: func(ctx context.Context, f *fixture) error {
+				return affected(f.client.ItemShare.Delete().Where(itemshare.ItemID(f.item.ID)).Exec(ctx))(0)
+			},
+			want: allowed,
+		},
+	})
+}
+
+func TestAllowSelfUserUpdate(t *testing.T) {
+	updateSelf := func(update func(*ent.UserUpdateOne) *ent.UserUpdateOne) func(context.Context, *fixture) error {
+		return func(ctx context.Context, f *fixture) error {
+			_, err := update(f.client.User.UpdateOneID(viewer.FromContext(ctx).Id)).Save(ctx)
+			return err
+		}
+	}
+
+	runMutationCases(t, []mutationCase{
+		{
+			name: "name",
+			as:   "owner",
+			fn:   updateSelf(func(u *ent.UserUpdateOne) *ent.UserUpdateOne { return u.SetName("changed") }),
+			want: allowed,
+		},
+		{
+			name: "password",
+			as:   "owner",
+			fn:   updateSelf(func(u *ent.UserUpdateOne) *ent.UserUpdateOne { return u.SetPassword("changed") }),
+			want: allowed,
+		},
+		{
+			name: "name and super user",
+			as:   "owner",
+			fn: updateSelf(func(u *ent.UserUpdateOne) *ent.UserUpdateOne {
+				return u.SetName("changed").SetIsSuperUser(true)
+			}),
+			want: denied,
+		},
+		{
+			name: "email",
+			as:   "owner",
+			fn:   updateSelf(func(u *ent.UserUpdateOne) *ent.UserUpdateOne { return u.SetEmail("changed@example.com") }),
+			want: denied,
+		},
+		{
+			name: "plan",
+			as:   "owner",
+			fn:   updateSelf(func(u *ent.UserUpdateOne) *ent.UserUpdateOne { return u.SetPlan("pro") }),
+			want: denied,
+		},
+		{
+			name: "another user",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.User.UpdateOneID(f.id("stranger")).SetName("changed").Save(ctx)
+				return err
+			},
+			want: denied,
+		},
+		{
+			name: "bulk update",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.User.Update().SetName("changed").Save(ctx)
+				return err
+			},
+			want: denied,
+		},
+		{
+			name: "create",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.User.Create().
+					SetName("new").
+					SetEmail("new@example.com").
+					SetPassword("password").
+					Save(ctx)
+				return err
+			},
+			want: denied,
+		},
+		{
+			name: "delete",
+			as:   "owner",
+			fn: func(ctx context.Context, f *fixture) error {
+				return f.client.User.DeleteOneID(viewer.FromContext(ctx).Id).Exec(ctx)
+			},
+			want: denied,
+		},
+	})
+}
+
+func TestAllowItemTransitionCreate(t *testing.T) {
+	createTransition := func(user string) func(context.Context, *fixture) error {
+		return func(ctx context.Context, f *fixture) error {
+			_, err := f.client.ItemTransition.Create().
+				SetItemID(f.item.ID).
+				SetUserID(f.id(user)).
+				SetName("submit").
+				SetFromStatus("draft").
+				SetToStatus("review").
+				Save(ctx)
+			return err
+		}
+	}
+
+	runMutationCases(t, []mutationCase{
+		{name: "owner", as: "owner", fn: createTransition("owner"), want: allowed},
+		{name: "reader", as: "reader", fn: createTransition("reader"), want: allowed},
+		{name: "for another user", as: "reader", fn: createTransition("owner"), want: denied},
+		{name: "stranger", as: "stranger", fn: createTransition("stranger"), want: denied},
+		{
+			name: "delete",
+			as:   "owner",
+			prepare: func(t *testing.T, f *fixture) {
+				createTransition("owner")(f.ctx("system"), f) //nolint:errcheck
+			},
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.ItemTransition.Delete().Exec(ctx)
+				return err
+			},
+			want: denied,
+		},
+	})
+}
+
+func TestAllowOwnershipTransferMutation(t *testing.T) {
+	createTransfer := func(from string, status ownershiptransfer.Status) func(context.Context, *fixture) error {
+		return func(ctx context.Context, f *fixture) error {
+			_, err := f.client.OwnershipTransfer.Create().
+				SetItemID(f.item.ID).
+				SetFromUserID(f.id(from)).
+				SetToUserID(f.id("stranger")).
+				SetStatus(status).
+				Save(ctx)
+			return err
+		}
+	}
+	setStatus := func(status ownershiptransfer.Status) func(context.Context, *fixture) error {
+		return func(ctx context.Context, f *fixture) error {
+			id := f.client.OwnershipTransfer.Query().OnlyIDX(f.ctx("system"))
+			_, err := f.client.OwnershipTransfer.UpdateOneID(id).SetStatus(status).Save(ctx)
+			return err
+		}
+	}
+	pending := func(t *testing.T, f *fixture) {
+		f.transfer(t, "owner", "stranger", ownershiptransfer.StatusPending)
+	}
+
+	runMutationCases(t, []mutationCase{
+		{name: "owner sends", as: "owner", fn: createTransfer("owner", ownershiptransfer.StatusPending), want: allowed},
+		{name: "owner sends accepted", as: "owner", fn: createTransfer("owner", ownershiptransfer.StatusAccepted), want: denied},
+		{name: "editor sends", as: "editor", fn: createTransfer("editor", ownershiptransfer.StatusPending), want: denied},
+		{name: "editor sends for the owner", as: "editor", fn: createTransfer("owner", ownershiptransfer.StatusPending), want: denied},
+		{name: "recipient accepts", as: "stranger", prepare: pending, fn: setStatus(ownershiptransfer.StatusAccepted), want: allowed},
+		{name: "recipient declines", as: "stranger", prepare: pending, fn: setStatus(ownershiptransfer.StatusDeclined), want: allowed},
+		{name: "recipient cancels", as: "stranger", prepare: pending, fn: setStatus(ownershiptransfer.StatusCancelled), want: notFound},
+		{name: "sender accepts", as: "owner", prepare: pending, fn: setStatus(ownershiptransfer.StatusAccepted), want: notFound},
+		{name: "sender cancels", as: "owner", prepare: pending, fn: setStatus(ownershiptransfer.StatusCancelled), want: allowed},
+		{name: "sender sets pending", as: "owner", prepare: pending, fn: setStatus(ownershiptransfer.StatusPending), want: denied},
+		{
+			name:    "delete",
+			as:      "owner",
+			prepare: pending,
+			fn: func(ctx context.Context, f *fixture) error {
+				_, err := f.client.OwnershipTransfer.Delete().Exec(ctx)
+				return err
+			},
+			want: denied,
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/grpcErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// newGateway creates the REST gateway of the grpc services, the calls are
+// proxied to the grpc server at grpcAddr so they go through its interceptors.
+// The responses and the errors have the shape of the REST api.
+func newGateway(ctx context.Context, cfg *config.Config, grpcAddr string) (*http.Server, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gatewayMarshaler{
+			JSONPb: runtime.JSONPb{
+				MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+				UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+			},
+		}),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+	)
+
+	endpoint := grpcAddr
+	if host, port, err := net.SplitHostPort(grpcAddr); err == nil && host == "" {
+		endpoint = net.JoinHostPort("localhost", port)
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	for _, register := range []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		pb.RegisterAuthServiceHandlerFromEndpoint,
+		pb.RegisterUserServiceHandlerFromEndpoint,
+		pb.RegisterItemServiceHandlerFromEndpoint,
+	} {
+		if err := register(ctx, mux, endpoint, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	var addr string
+	if strings.Contains(cfg.Grpc.GatewayPort, ":") {
+		addr = cfg.Grpc.GatewayPort
+	} else {
+		addr = ":" + cfg.Grpc.GatewayPort
+	}
+
+	return &http.Server{
+		Addr:           addr,
+		Handler:        mux,
+		ReadTimeout:    time.Second * time.Duration(cfg.Server.ReadTimeout),
+		WriteTimeout:   time.Second * time.Duration(cfg.Server.WriteTimeout),
+		MaxHeaderBytes: maxHeaderBytes,
+	}, nil
+}
+
+// gatewayMarshaler wraps the responses in the data of a
+// responses.CreateSuccessResponse like the REST handlers.
+type gatewayMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *gatewayMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"data":`)
+	buf.Write(data)
+	buf.WriteString(`,"is_success":true}`)
+	return buf.Bytes(), nil
+}
+
+// gatewayErrorHandler renders the errors of the calls like the REST handlers,
+// with the status and the status text of the grpcErrors.ParseErrors details.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	render.Render(w, r, responses.CreateErrorResponse(grpcErrors.ToRest(err))) //nolint:errcheck
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/grpcErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// userServer answers GetMe to the calls with an authorization and fails
+// the other calls of a user.
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+}
+
+func (s *userServer) GetMe(ctx context.Context, in *emptypb.Empty) (*pb.User, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("authorization")) == 0 {
+		return nil, grpcErrors.ParseErrors(httpErrors.ErrUnauthorized(errors.New("no token")))
+	}
+	return &pb.User{Id: 1, Name: "me"}, nil
+}
+
+func (s *userServer) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User, error) {
+	return nil, grpcErrors.ParseErrors(httpErrors.ErrNotFound(errors.New("user not found")))
+}
+
+type gatewayResponse struct {
+	Data  map[string]interface{} `json:"data"`
+	Error *struct {
+		Status     int    `json:"status"`
+		StatusText string `json:"statusText"`
+	} `json:"error"`
+	IsSuccess bool `json:"is_success"`
+}
+
+func TestGateway(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterUserServiceServer(grpcServer, &userServer{})
+	go grpcServer.Serve(listener) //nolint:errcheck
+	t.Cleanup(grpcServer.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	gateway, err := newGateway(ctx, &config.Config{}, listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		token      bool
+		status     int
+		statusText string
+	}{
+		{name: "me", path: "/api/v1/user/me", token: true, status: http.StatusOK},
+		{name: "me without token", path: "/api/v1/user/me", status: http.StatusUnauthorized, statusText: "unauthorized"},
+		{name: "user", path: "/api/v1/user/5", token: true, status: http.StatusNotFound, statusText: "not_found"},
+		{name: "unknown route", path: "/api/v1/nothing", status: http.StatusNotFound, statusText: "not_found"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.token {
+				r.Header.Set("Authorization", "Bearer token")
+			}
+			w := httptest.NewRecorder()
+			gateway.Handler.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			res := &gatewayResponse{}
+			if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.status == http.StatusOK {
+				if !res.IsSuccess || res.Data["name"] != "me" {
+					t.Fatalf("got %s", w.Body.String())
+				}
+				return
+			}
+			if res.IsSuccess || res.Error == nil || res.Error.Status != tt.status || res.Error.StatusText != tt.statusText {
+				t.Fatalf("got %s", w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	authGrpc "github.com/hiennguyen9874/go-boilerplate-v2/internal/auth/delivery/grpc"
+	itemGrpc "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/delivery/grpc"
+	apiMiddleware "github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	userGrpc "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/delivery/grpc"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// GrpcServer provides a grpc.Server serving the auth, user and item services
+// and their REST gateway.
+type GrpcServer struct {
+	server  *grpc.Server
+	health  *health.Server
+	gateway *http.Server
+	addr    string
+	cfg     *config.Config
+	client  *ent.Client
+	logger  logger.Logger
+}
+
+// NewGrpcServer creates and configures a GrpcServer, it authenticates the calls
+// like the REST routes of the api.
+func NewGrpcServer(cfg *config.Config, client *ent.Client, redisClient *redis.Client, taskRedisClient *asynq.Client, logger logger.Logger) (*GrpcServer, error) {
+	logger.Info("configuring grpc server...")
+
+	uc, err := newUseCases(client, redisClient, taskRedisClient, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Server
+	authServer := authGrpc.CreateAuthServer(uc.userUC, cfg, logger)
+	userServer := userGrpc.CreateUserServer(uc.userUC, uc.quotaUC, cfg, logger)
+	itemServer := itemGrpc.CreateItemServer(uc.itemUC, cfg, logger)
+
+	// middleware
+	mw := apiMiddleware.CreateMiddlewareManager(cfg, logger, uc.userUC, uc.quotaUC, uc.idempotencyUC)
+
+	// The access of the methods is filled in when the services are
+	// registered, before the first call.
+	access := map[string]apiMiddleware.GrpcAccess{}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			mw.GrpcRecoverer(),
+			mw.GrpcTimeout(time.Duration(cfg.Server.ProcessTimeout)*time.Second),
+			mw.GrpcUnaryInterceptor(access),
+		),
+		grpc.ChainStreamInterceptor(
+			mw.GrpcStreamRecoverer(),
+			mw.GrpcStreamInterceptor(access),
+		),
+	)
+
+	authGrpc.MapAuthService(server, authServer, access)
+	userGrpc.MapUserService(server, userServer, access)
+	itemGrpc.MapItemService(server, itemServer, access)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	access[healthpb.Health_Check_FullMethodName] = apiMiddleware.GrpcPublic
+	access[healthpb.Health_Watch_FullMethodName] = apiMiddleware.GrpcPublic
+
+	if cfg.Grpc.Reflection {
+		reflection.Register(server)
+		access[reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName] = apiMiddleware.GrpcPublic
+		access[reflectionv1alphapb.ServerReflection_ServerReflectionInfo_FullMethodName] = apiMiddleware.GrpcPublic
+	}
+
+	var addr string
+	if strings.Contains(cfg.Grpc.Port, ":") {
+		addr = cfg.Grpc.Port
+	} else {
+		addr = ":" + cfg.Grpc.Port
+	}
+
+	var gateway *http.Server
+	if cfg.Grpc.GatewayPort != "" {
+		// The connections to the grpc server are made lazily, after Start.
+		gateway, err = newGateway(context.Background(), cfg, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &GrpcServer{
+		server:  server,
+		health:  healthServer,
+		gateway: gateway,
+		addr:    addr,
+		cfg:     cfg,
+		client:  client,
+		logger:  logger,
+	}, nil
+}
+
+// Start runs Serve on the grpc.Server and the gateway with graceful shutdown.
+func (srv *GrpcServer) Start() {
+	srv.logger.Info("starting grpc server...")
+
+	listener, err := net.Listen("tcp", srv.addr)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		srv.logger.Infof("Listening on %s\n", srv.addr)
+		if err := srv.server.Serve(listener); err != nil {
+			panic(err)
+		}
+	}()
+
+	if srv.gateway != nil {
+		go func() {
+			srv.logger.Infof("Gateway listening on %s\n", srv.gateway.Addr)
+			if err := srv.gateway.ListenAndServe(); err != http.ErrServerClosed {
+				panic(err)
+			}
+		}()
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	sig := <-quit
+
+	srv.logger.Infof("Shutting down grpc server... Reason: %s", sig)
+
+	// The health checks fail while the calls in flight finish.
+	srv.health.Shutdown()
+
+	if srv.gateway != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), ctxTimeout*time.Second)
+		defer cancel()
+
+		if err := srv.gateway.Shutdown(ctx); err != nil {
+			srv.logger.Errorf("Could not gracefully shutdown the gateway: %v", err)
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(ctxTimeout * time.Second):
+		srv.server.Stop()
+	}
+	srv.logger.Info("Grpc server gracefully stopped")
+}
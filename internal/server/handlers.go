@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -16,45 +18,150 @@ import (
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	authHttp "github.com/hiennguyen9874/go-boilerplate-v2/internal/auth/delivery/http"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/graphql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency"
+	idempotencyRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency/repository"
+	idempotencyUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/idempotency/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
 	itemHttp "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/delivery/http"
+	itemDistributor "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/distributor"
 	itemRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/repository"
 	itemUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items/workflow"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas"
+	metadataSchemaHttp "github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas/delivery/http"
+	metadataSchemaRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas/repository"
+	metadataSchemaUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/metadataSchemas/usecase"
 	apiMiddleware "github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	quotaRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/repository"
+	quotaUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/tags"
+	tagHttp "github.com/hiennguyen9874/go-boilerplate-v2/internal/tags/delivery/http"
+	tagRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/tags/repository"
+	tagUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/tags/usecase"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
 	userHttp "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/delivery/http"
 	userDistributor "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/distributor"
 	userRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/repository"
 	userUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/usecase"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
 )
 
-// @title Go boilerplate
-// @version 1.0
+// useCases are the use cases shared by the http and the grpc server.
+type useCases struct {
+	fileStorage      storage.Storage
+	userUC           users.UserUseCase
+	itemUC           items.ItemUseCase
+	tagUC            tags.TagUseCase
+	metadataSchemaUC metadataSchemas.MetadataSchemaUseCase
+	quotaUC          quotas.QuotaUseCase
+	idempotencyUC    idempotency.IdempotencyUseCase
+}
 
-// @BasePath /api
-// @securitydefinitions.oauth2.password	OAuth2Password
-// @tokenUrl /api/auth/login
-func New(client *ent.Client, redisClient *redis.Client, taskRedisClient *asynq.Client, cfg *config.Config, logger logger.Logger) (*chi.Mux, error) {
-	r := chi.NewRouter()
+func newUseCases(client *ent.Client, redisClient *redis.Client, taskRedisClient *asynq.Client, cfg *config.Config, logger logger.Logger) (*useCases, error) {
+	// Storage
+	fileStorage, err := storage.NewStorage(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Workflow
+	itemWorkflow, err := workflow.New(cfg.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	// Item tree
+	if cfg.Hierarchy.MaxDepth < 1 {
+		return nil, fmt.Errorf("hierarchy max depth must be at least 1, got %d", cfg.Hierarchy.MaxDepth)
+	}
+	if cfg.Hierarchy.OnDelete != models.ItemOnDeleteCascade && cfg.Hierarchy.OnDelete != models.ItemOnDeleteReparent {
+		return nil, fmt.Errorf("unknown hierarchy delete behavior %q", cfg.Hierarchy.OnDelete)
+	}
+
+	// Idempotency
+	if cfg.Idempotency.Ttl < 1 || cfg.Idempotency.LockTtl < 1 {
+		return nil, fmt.Errorf("idempotency ttl and lock ttl must be at least 1 second, got %d and %d", cfg.Idempotency.Ttl, cfg.Idempotency.LockTtl)
+	}
+
+	// Quotas
+	if _, ok := cfg.Quota.Plan(""); !ok {
+		return nil, fmt.Errorf("unknown default plan %q", cfg.Quota.DefaultPlan)
+	}
 
 	// Repository
 	userPgRepo := userRepository.CreateUserPgRepository(client)
 	userRedisRepo := userRepository.CreateUserRedisRepository(redisClient)
 	itemPgRepo := itemRepository.CreateItemPgRepository(client)
+	tagPgRepo := tagRepository.CreateTagPgRepository(client)
+	metadataSchemaPgRepo := metadataSchemaRepository.CreateMetadataSchemaPgRepository(client)
+	quotaPgRepo := quotaRepository.CreateQuotaPgRepository(client)
+	quotaRedisRepo := quotaRepository.CreateQuotaRedisRepository(redisClient)
+	idempotencyRedisRepo := idempotencyRepository.CreateIdempotencyRedisRepository(redisClient)
 
 	// Distributor
 	userRedisTaskDistributor := userDistributor.NewUserRedisTaskDistributor(taskRedisClient, cfg, logger)
+	itemRedisTaskDistributor := itemDistributor.NewItemRedisTaskDistributor(taskRedisClient, cfg, logger)
 
 	// UseCase
 	userUC := userUseCase.CreateUserUseCase(userPgRepo, userRedisRepo, userRedisTaskDistributor, cfg, logger)
-	itemUC := itemUseCase.CreateItemUseCase(itemPgRepo, cfg, logger)
+	metadataSchemaUC := metadataSchemaUseCase.CreateMetadataSchemaUseCase(metadataSchemaPgRepo, cfg, logger)
+	quotaUC := quotaUseCase.CreateQuotaUseCase(quotaPgRepo, quotaRedisRepo, cfg, logger)
+	itemUC := itemUseCase.CreateItemUseCase(itemPgRepo, itemRedisTaskDistributor, fileStorage, itemWorkflow, metadataSchemaUC, quotaUC, cfg, logger)
+	tagUC := tagUseCase.CreateTagUseCase(tagPgRepo, cfg, logger)
+	idempotencyUC := idempotencyUseCase.CreateIdempotencyUseCase(idempotencyRedisRepo, cfg, logger)
+
+	return &useCases{
+		fileStorage:      fileStorage,
+		userUC:           userUC,
+		itemUC:           itemUC,
+		tagUC:            tagUC,
+		metadataSchemaUC: metadataSchemaUC,
+		quotaUC:          quotaUC,
+		idempotencyUC:    idempotencyUC,
+	}, nil
+}
+
+// @title Go boilerplate
+// @version 1.0
+
+// @BasePath /api/v1
+// @securitydefinitions.oauth2.password	OAuth2Password
+// @tokenUrl /api/v1/auth/login
+func New(client *ent.Client, redisClient *redis.Client, taskRedisClient *asynq.Client, cfg *config.Config, logger logger.Logger) (*chi.Mux, error) {
+	r := chi.NewRouter()
+
+	uc, err := newUseCases(client, redisClient, taskRedisClient, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deprecations
+	for _, deprecation := range cfg.Api.Deprecations {
+		if deprecation.Version != "v1" && deprecation.Version != apiLegacyVersion {
+			return nil, fmt.Errorf("unknown api version %q of the deprecation of %s %s", deprecation.Version, deprecation.Method, deprecation.Route)
+		}
+		if _, _, err := deprecation.Times(); err != nil {
+			return nil, fmt.Errorf("invalid deprecation of %s %s %s: %w", deprecation.Version, deprecation.Method, deprecation.Route, err)
+		}
+	}
 
 	// Handler
-	userHandler := userHttp.CreateUserHandler(userUC, cfg, logger)
-	authHandler := authHttp.CreateAuthHandler(userUC, cfg, logger)
-	itemHandler := itemHttp.CreateItemHandler(itemUC, cfg, logger)
+	userHandler := userHttp.CreateUserHandler(uc.userUC, uc.quotaUC, cfg, logger)
+	authHandler := authHttp.CreateAuthHandler(uc.userUC, cfg, logger)
+	itemHandler := itemHttp.CreateItemHandler(uc.itemUC, cfg, logger)
+	tagHandler := tagHttp.CreateTagHandler(uc.tagUC, cfg, logger)
+	metadataSchemaHandler := metadataSchemaHttp.CreateMetadataSchemaHandler(uc.metadataSchemaUC, cfg, logger)
+	graphqlHandler, err := graphql.CreateGraphqlHandler(client, uc.userUC, uc.itemUC, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	// middleware
-	mw := apiMiddleware.CreateMiddlewareManager(cfg, logger, userUC)
+	mw := apiMiddleware.CreateMiddlewareManager(cfg, logger, uc.userUC, uc.quotaUC, uc.idempotencyUC)
 
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
@@ -69,16 +176,43 @@ func New(client *ent.Client, redisClient *redis.Client, taskRedisClient *asynq.C
 		httpSwagger.URL("/swagger/doc.json"), //The url pointing to API definition
 	))
 
-	apiRouter := chi.NewRouter()
-	r.Mount("/api", apiRouter)
+	// The Map*Route functions and the presenters of the delivery packages are
+	// the v1 api. A version changing the responses gets its own router, with
+	// its own Map*Route functions and presenters in the delivery packages,
+	// mounted at /api/<version> next to v1.
+	apiV1Router := chi.NewRouter()
 
-	apiRouter.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
+	apiV1Router.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
 		render.Respond(w, r, "pong")
 	})
 
-	authHttp.MapAuthRoute(apiRouter, authHandler, mw)
-	userHttp.MapUserRoute(apiRouter, userHandler, mw)
-	itemHttp.MapItemRoute(apiRouter, itemHandler, mw)
+	authHttp.MapAuthRoute(apiV1Router, authHandler, mw)
+	userHttp.MapUserRoute(apiV1Router, userHandler, mw)
+	itemHttp.MapItemRoute(apiV1Router, itemHandler, mw)
+	tagHttp.MapTagRoute(apiV1Router, tagHandler, mw)
+	metadataSchemaHttp.MapMetadataSchemaRoute(apiV1Router, metadataSchemaHandler, mw)
+	graphql.MapGraphqlRoute(apiV1Router, graphqlHandler, mw)
+
+	// Presigned URLs of the local storage are served by the api itself.
+	if localStorage, ok := uc.fileStorage.(*storage.LocalStorage); ok {
+		apiV1Router.Get("/storage", localStorage.ServeHTTP)
+	}
+
+	mountVersion(r, "/api/v1", "v1", apiV1Router, mw)
+	// The clients from before the api was versioned call the v1 routes without
+	// the version, /api is kept as an alias of v1 with its own deprecations.
+	mountVersion(r, "/api", apiLegacyVersion, apiV1Router, mw)
 
 	return r, nil
 }
+
+// apiLegacyVersion is the version of the deprecations of the /api alias of v1.
+const apiLegacyVersion = "legacy"
+
+// mountVersion mounts the router of a version of the api at pattern, with the
+// deprecations of version. The deprecations are added to the mount, not to the
+// router, a router mounted twice only answers with the deprecations of the
+// pattern called.
+func mountVersion(r chi.Router, pattern string, version string, router *chi.Mux, mw *apiMiddleware.MiddlewareManager) {
+	r.Mount(pattern, chi.Chain(mw.Deprecation(version, router)).Handler(router))
+}
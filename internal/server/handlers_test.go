@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	apiMiddleware "github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// TestMountVersion mounts a router at /api/v1 and at its /api alias, the
+// deprecations of a version are only sent on its mount.
+func TestMountVersion(t *testing.T) {
+	cfg := &config.Config{Api: config.ApiConfig{Deprecations: []config.DeprecationConfig{
+		{Version: apiLegacyVersion, Date: "2024-01-01T00:00:00Z"},
+		{Version: "v1", Method: http.MethodGet, Route: "/item/shared", Date: "2024-02-01T00:00:00Z", Sunset: "2024-06-01T00:00:00Z"},
+	}}}
+	cfg.Logger.Level = "fatal"
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mw := apiMiddleware.CreateMiddlewareManager(cfg, apiLogger, nil, nil, nil)
+
+	apiV1Router := chi.NewRouter()
+	apiV1Router.Get("/item/shared", func(w http.ResponseWriter, r *http.Request) {})
+	apiV1Router.Get("/item/{id}", func(w http.ResponseWriter, r *http.Request) {})
+
+	r := chi.NewRouter()
+	mountVersion(r, "/api/v1", "v1", apiV1Router, mw)
+	mountVersion(r, "/api", apiLegacyVersion, apiV1Router, mw)
+
+	tests := []struct {
+		path        string
+		deprecation string
+		sunset      string
+	}{
+		{path: "/api/v1/item/1"},
+		{path: "/api/v1/item/shared", deprecation: "@1706745600", sunset: "Sat, 01 Jun 2024 00:00:00 GMT"},
+		{path: "/api/item/1", deprecation: "@1704067200"},
+		{path: "/api/item/shared", deprecation: "@1704067200"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("got status %d", w.Code)
+			}
+			if got := w.Header().Get("Deprecation"); got != tt.deprecation {
+				t.Fatalf("got Deprecation %q, want %q", got, tt.deprecation)
+			}
+			if got := w.Header().Get("Sunset"); got != tt.sunset {
+				t.Fatalf("got Sunset %q, want %q", got, tt.sunset)
+			}
+		})
+	}
+}
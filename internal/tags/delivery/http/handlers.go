@@ -0,0 +1,253 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/tags"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/tags/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+)
+
+type tagHandler struct {
+	cfg    *config.Config
+	tagsUC tags.TagUseCase
+	logger logger.Logger
+}
+
+func CreateTagHandler(uc tags.TagUseCase, cfg *config.Config, logger logger.Logger) tags.Handlers {
+	return &tagHandler{cfg: cfg, tagsUC: uc, logger: logger}
+}
+
+// Create godoc
+// @Summary Create Tag
+// @Description Create new tag for current user.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body presenter.TagCreate true "Add tag"
+// @Success 200 {object} responses.SuccessResponse[presenter.TagResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /tag [post]
+func (h *tagHandler) Create() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		tag := new(presenter.TagCreate)
+
+		err := json.NewDecoder(r.Body).Decode(&tag)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, tag)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		newTag, err := h.tagsUC.CreateWithOwner(ctx, user.Id, &models.TagCreate{
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(newTag)))
+	}
+}
+
+// Get godoc
+// @Summary Read tag
+// @Description Get tag by ID.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.TagResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /tag/{id} [get]
+func (h *tagHandler) Get() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		tag, err := h.tagsUC.Get(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(tag)))
+	}
+}
+
+// GetMulti godoc
+// @Summary Read Tags
+// @Description Retrieve tags of current user, ordered by name.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Success 200 {object} responses.SuccessResponse[[]presenter.TagResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /tag [get]
+func (h *tagHandler) GetMulti() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		tags, err := h.tagsUC.GetMultiByOwnerId(ctx, user.Id, offset, limit)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelsResponse(tags)))
+	}
+}
+
+// Update godoc
+// @Summary Update tag
+// @Description Update a tag by ID.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag Id"
+// @Param tag body presenter.TagUpdate true "Update tag"
+// @Success 200 {object} responses.SuccessResponse[presenter.TagResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /tag/{id} [put]
+func (h *tagHandler) Update() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		tag := new(presenter.TagUpdate)
+
+		err = json.NewDecoder(r.Body).Decode(&tag)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, tag)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		updatedTag, err := h.tagsUC.Update(ctx, uint(id), &models.TagUpdate{
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedTag)))
+	}
+}
+
+// Delete godoc
+// @Summary Delete tag
+// @Description Delete a tag by ID, it is detached from all items.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.TagResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /tag/{id} [delete]
+func (h *tagHandler) Delete() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		tag, err := h.tagsUC.Delete(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(tag)))
+	}
+}
+
+func mapModelResponse(exp *models.Tag) *presenter.TagResponse {
+	return &presenter.TagResponse{
+		Id:         exp.Id,
+		Name:       exp.Name,
+		Color:      exp.Color,
+		OwnerId:    exp.OwnerId,
+		CreateTime: exp.CreateTime,
+		UpdateTime: exp.UpdateTime,
+	}
+}
+
+func mapModelsResponse(exp []*models.Tag) []*presenter.TagResponse {
+	out := make([]*presenter.TagResponse, len(exp))
+	for i, tag := range exp {
+		out[i] = mapModelResponse(tag)
+	}
+	return out
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/tags"
+)
+
+func MapTagRoute(router *chi.Mux, h tags.Handlers, mw *middleware.MiddlewareManager) {
+	// Tag routes
+	router.Route("/tag", func(r chi.Router) {
+		// Protected routes
+		r.Group(func(r chi.Router) {
+			r.Use(mw.Verifier(true))
+			r.Use(mw.Authenticator())
+			r.Use(mw.CurrentUser())
+			r.Use(mw.ActiveUser())
+			r.Use(mw.CountApiCall())
+			r.Get("/", h.GetMulti())
+			r.Post("/", h.Create())
+			// Per id routes
+			r.Route("/{id}", func(r chi.Router) {
+				r.Get("/", h.Get())
+				r.Put("/", h.Update())
+				r.Delete("/", h.Delete())
+			})
+		})
+	})
+}
@@ -0,0 +1,24 @@
+package presenter
+
+import (
+	"time"
+)
+
+type TagCreate struct {
+	Name  string  `json:"name" validate:"required,max=50" example:"urgent"`
+	Color *string `json:"color,omitempty" validate:"omitempty,hexcolor" example:"#ff0000"`
+}
+
+type TagUpdate struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=50" example:"urgent"`
+	Color *string `json:"color,omitempty" validate:"omitempty,hexcolor" example:"#ff0000"`
+}
+
+type TagResponse struct {
+	Id         uint      `json:"id,omitempty"`
+	Name       string    `json:"name,omitempty"`
+	Color      string    `json:"color,omitempty"`
+	OwnerId    uint      `json:"owner_id,omitempty"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/tag"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/tags"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+type TagPgRepo struct {
+	client *ent.Client
+}
+
+func CreateTagPgRepository(client *ent.Client) tags.TagPgRepository {
+	return &TagPgRepo{client: client}
+}
+
+func (r *TagPgRepo) mapModel(db_obj *ent.Tag) *models.Tag {
+	return &models.Tag{
+		Id:         db_obj.ID,
+		CreateTime: db_obj.CreateTime,
+		UpdateTime: db_obj.UpdateTime,
+		Name:       db_obj.Name,
+		Color:      db_obj.Color,
+		OwnerId:    db_obj.OwnerID,
+	}
+}
+
+func (r *TagPgRepo) mapModels(db_objs []*ent.Tag) []*models.Tag {
+	objs := make([]*models.Tag, len(db_objs))
+	for i, db_obj := range db_objs {
+		objs[i] = r.mapModel(db_obj)
+	}
+	return objs
+}
+
+func (r *TagPgRepo) Get(ctx context.Context, id uint) (*models.Tag, error) {
+	db_obj, err := r.client.Tag.Query().
+		Where(tag.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *TagPgRepo) GetMultiByOwnerId(ctx context.Context, ownerId uint, offset, limit int) ([]*models.Tag, error) {
+	db_objs, err := r.client.Tag.Query().
+		Where(tag.OwnerID(ownerId)).
+		Order(tag.ByName(sql.OrderAsc()), tag.ByID(sql.OrderAsc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModels(db_objs), nil
+}
+
+func (r *TagPgRepo) CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.TagCreate) (*models.Tag, error) {
+	db_obj, err := r.client.Tag.
+		Create().
+		SetName(obj_create.Name).
+		SetNillableColor(obj_create.Color).
+		SetOwnerID(ownerId).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, httpErrors.ErrValidation(errors.New("tag name already exists"))
+		}
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *TagPgRepo) Update(ctx context.Context, id uint, obj_update *models.TagUpdate) (*models.Tag, error) {
+	query := r.client.Tag.UpdateOneID(id)
+	if obj_update.Name != nil {
+		query = query.SetName(*obj_update.Name)
+	}
+	if obj_update.Color != nil {
+		query = query.SetColor(*obj_update.Color)
+	}
+	db_obj, err := query.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, httpErrors.ErrValidation(errors.New("tag name already exists"))
+		}
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *TagPgRepo) Delete(ctx context.Context, id uint) (*models.Tag, error) {
+	db_obj, err := r.client.Tag.Query().
+		Where(tag.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.client.Tag.DeleteOne(db_obj).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapModel(db_obj), nil
+}
@@ -0,0 +1,15 @@
+package tags
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+type TagUseCase interface {
+	Get(ctx context.Context, id uint) (*models.Tag, error)
+	GetMultiByOwnerId(ctx context.Context, ownerId uint, offset, limit int) ([]*models.Tag, error)
+	CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.TagCreate) (*models.Tag, error)
+	Update(ctx context.Context, id uint, obj_update *models.TagUpdate) (*models.Tag, error)
+	Delete(ctx context.Context, id uint) (*models.Tag, error)
+}
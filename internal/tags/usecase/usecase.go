@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/tags"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// Access to tags is enforced by the ent privacy policies (see internal/rule),
+// users only manage their own tags.
+type tagUseCase struct {
+	pgRepo tags.TagPgRepository
+	cfg    *config.Config
+	logger logger.Logger
+}
+
+func CreateTagUseCase(
+	pgRepo tags.TagPgRepository,
+	cfg *config.Config,
+	logger logger.Logger,
+) tags.TagUseCase {
+	return &tagUseCase{
+		pgRepo: pgRepo,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+func (u *tagUseCase) Get(ctx context.Context, id uint) (*models.Tag, error) {
+	return u.pgRepo.Get(ctx, id)
+}
+
+func (u *tagUseCase) GetMultiByOwnerId(ctx context.Context, ownerId uint, offset, limit int) ([]*models.Tag, error) {
+	offset, limit = listQuery.Paginate(offset, limit)
+	return u.pgRepo.GetMultiByOwnerId(ctx, ownerId, offset, limit)
+}
+
+func (u *tagUseCase) CreateWithOwner(ctx context.Context, ownerId uint, obj_create *models.TagCreate) (*models.Tag, error) {
+	return u.pgRepo.CreateWithOwner(ctx, ownerId, obj_create)
+}
+
+func (u *tagUseCase) Update(ctx context.Context, id uint, obj_update *models.TagUpdate) (*models.Tag, error) {
+	return u.pgRepo.Update(ctx, id, obj_update)
+}
+
+func (u *tagUseCase) Delete(ctx context.Context, id uint) (*models.Tag, error) {
+	return u.pgRepo.Delete(ctx, id)
+}
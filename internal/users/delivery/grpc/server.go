@@ -0,0 +1,284 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+	cfg      *config.Config
+	usersUC  users.UserUseCase
+	quotasUC quotas.QuotaUseCase
+	logger   logger.Logger
+}
+
+func CreateUserServer(uc users.UserUseCase, quotasUC quotas.QuotaUseCase, cfg *config.Config, logger logger.Logger) pb.UserServiceServer {
+	return &userServer{cfg: cfg, usersUC: uc, quotasUC: quotasUC, logger: logger}
+}
+
+func (s *userServer) GetMe(ctx context.Context, req *emptypb.Empty) (*pb.User, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(user), nil
+}
+
+func (s *userServer) UpdateMe(ctx context.Context, req *pb.UpdateMeRequest) (*pb.User, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUpdate := &presenter.UserUpdate{Name: req.GetName()}
+
+	err = utils.ValidateStruct(ctx, userUpdate)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	updatedUser, err := s.usersUC.Update(ctx, user.Id, &models.UserUpdate{
+		Name:    &userUpdate.Name,
+		Version: mapVersion(req.Version),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapModelResponse(updatedUser), nil
+}
+
+func (s *userServer) GetUsage(ctx context.Context, req *emptypb.Empty) (*pb.Usage, error) {
+	user, err := middleware.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.quotasUC.GetUsage(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUsageResponse(usage), nil
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	user, err := s.usersUC.Get(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(user), nil
+}
+
+func (s *userServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	query, err := listQuery.Parse(req.GetOptions().Values())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.usersUC.GetMulti(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPageResponse(page), nil
+}
+
+func (s *userServer) ListUsersTrash(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	query, err := listQuery.Parse(req.GetOptions().Values())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.usersUC.GetMultiTrash(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPageResponse(page), nil
+}
+
+func (s *userServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	user := &presenter.UserCreate{
+		Name:            req.GetName(),
+		Email:           req.GetEmail(),
+		Password:        req.GetPassword(),
+		ConfirmPassword: req.GetConfirmPassword(),
+	}
+
+	err := utils.ValidateStruct(ctx, user)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	newUser, err := s.usersUC.Create(
+		ctx,
+		&models.UserCreate{
+			Name:     user.Name,
+			Email:    user.Email,
+			Password: user.Password,
+		},
+		user.ConfirmPassword,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapModelResponse(newUser), nil
+}
+
+func (s *userServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	current, err := s.usersUC.Get(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	// The fields that are not set keep their value, the user is validated
+	// like a patched user.
+	user := &presenter.UserPatch{
+		Name:        current.Name,
+		Email:       current.Email,
+		IsActive:    current.IsActive,
+		IsSuperUser: current.IsSuperUser,
+		Plan:        current.Plan,
+	}
+	if req.Name != nil {
+		user.Name = req.GetName()
+	}
+	if req.Email != nil {
+		user.Email = req.GetEmail()
+	}
+	if req.IsActive != nil {
+		user.IsActive = req.GetIsActive()
+	}
+	if req.IsSuperuser != nil {
+		user.IsSuperUser = req.GetIsSuperuser()
+	}
+	if req.Plan != nil {
+		user.Plan = req.GetPlan()
+	}
+
+	err = utils.ValidateStruct(ctx, user)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(err)
+	}
+
+	user_update := models.UserUpdate{Version: mapVersion(req.Version)}
+	if req.Name != nil {
+		user_update.Name = &user.Name
+	}
+	if req.Email != nil {
+		user_update.Email = &user.Email
+	}
+	if req.IsActive != nil {
+		user_update.IsActive = &user.IsActive
+	}
+	if req.IsSuperuser != nil {
+		user_update.IsSuperUser = &user.IsSuperUser
+	}
+	if req.Plan != nil {
+		user_update.Plan = &user.Plan
+	}
+
+	updatedUser, err := s.usersUC.Update(ctx, current.Id, &user_update)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapModelResponse(updatedUser), nil
+}
+
+func (s *userServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.User, error) {
+	user, err := s.usersUC.Delete(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(user), nil
+}
+
+func (s *userServer) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.User, error) {
+	user, err := s.usersUC.Restore(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return mapModelResponse(user), nil
+}
+
+func mapVersion(version *int32) *int {
+	if version == nil {
+		return nil
+	}
+	out := int(*version)
+	return &out
+}
+
+func mapTime(exp *time.Time) *timestamppb.Timestamp {
+	if exp == nil {
+		return nil
+	}
+	return timestamppb.New(*exp)
+}
+
+func mapModelResponse(exp *models.User) *pb.User {
+	return &pb.User{
+		Id:          uint64(exp.Id),
+		Name:        exp.Name,
+		Email:       exp.Email,
+		CreateTime:  timestamppb.New(exp.CreateTime),
+		UpdateTime:  timestamppb.New(exp.UpdateTime),
+		IsActive:    exp.IsActive,
+		IsSuperuser: exp.IsSuperUser,
+		Verified:    exp.Verified,
+		Plan:        exp.Plan,
+		DeleteTime:  mapTime(exp.DeleteTime),
+		Version:     int32(exp.Version),
+	}
+}
+
+func mapPageResponse(exp *listQuery.Page[*models.User]) *pb.ListUsersResponse {
+	out := &pb.ListUsersResponse{
+		Users: make([]*pb.User, len(exp.Items)),
+		Page:  pb.CreatePageInfo(exp.NextCursor, exp.PrevCursor, exp.Total),
+	}
+	for i, user := range exp.Items {
+		out.Users[i] = mapModelResponse(user)
+	}
+	return out
+}
+
+func mapQuotaUsageResponse(exp models.QuotaUsage) *pb.QuotaUsage {
+	out := &pb.QuotaUsage{Used: exp.Used, Limit: exp.Limit}
+	if exp.Limit > 0 {
+		remaining := exp.Limit - exp.Used
+		if remaining < 0 {
+			remaining = 0
+		}
+		out.Remaining = &remaining
+	}
+	return out
+}
+
+func mapUsageResponse(exp *models.Usage) *pb.Usage {
+	return &pb.Usage{
+		Plan:              exp.Plan,
+		Items:             mapQuotaUsageResponse(exp.Items),
+		Storage:           mapQuotaUsageResponse(exp.Storage),
+		ApiCalls:          mapQuotaUsageResponse(exp.ApiCalls),
+		ApiCallsResetTime: timestamppb.New(exp.ApiCallsResetTime),
+	}
+}
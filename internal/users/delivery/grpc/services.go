@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/pb"
+	"google.golang.org/grpc"
+)
+
+func MapUserService(server *grpc.Server, s pb.UserServiceServer, access map[string]middleware.GrpcAccess) {
+	pb.RegisterUserServiceServer(server, s)
+
+	// The usage is not counted, so it can be read once the API calls quota is
+	// exceeded.
+	access[pb.UserService_GetUsage_FullMethodName] = middleware.GrpcUserNotCounted
+	// Admin methods
+	access[pb.UserService_ListUsers_FullMethodName] = middleware.GrpcSuperUser
+	access[pb.UserService_ListUsersTrash_FullMethodName] = middleware.GrpcSuperUser
+	access[pb.UserService_CreateUser_FullMethodName] = middleware.GrpcSuperUser
+	access[pb.UserService_UpdateUser_FullMethodName] = middleware.GrpcSuperUser
+	access[pb.UserService_DeleteUser_FullMethodName] = middleware.GrpcSuperUser
+	access[pb.UserService_RestoreUser_FullMethodName] = middleware.GrpcSuperUser
+}
@@ -4,28 +4,34 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/middleware"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users/presenter"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/etag"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/patch"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/responses"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/utils"
 )
 
 type userHandler struct {
-	cfg     *config.Config
-	usersUC users.UserUseCase
-	logger  logger.Logger
+	cfg      *config.Config
+	usersUC  users.UserUseCase
+	quotasUC quotas.QuotaUseCase
+	logger   logger.Logger
 }
 
-func CreateUserHandler(uc users.UserUseCase, cfg *config.Config, logger logger.Logger) users.Handlers {
-	return &userHandler{cfg: cfg, usersUC: uc, logger: logger}
+func CreateUserHandler(uc users.UserUseCase, quotasUC quotas.QuotaUseCase, cfg *config.Config, logger logger.Logger) users.Handlers {
+	return &userHandler{cfg: cfg, usersUC: uc, quotasUC: quotasUC, logger: logger}
 }
 
 // Create godoc
@@ -35,9 +41,11 @@ func CreateUserHandler(uc users.UserUseCase, cfg *config.Config, logger logger.L
 // @Accept json
 // @Produce json
 // @Param user body presenter.UserCreate true "Add user"
+// @Param Idempotency-Key header string false "Key of the request, a retry with the same key gets the response of the first request"
 // @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
+// @Failure 409	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
 // @Security OAuth2Password
 // @Router /user [post]
@@ -71,6 +79,7 @@ func (h *userHandler) Create() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		etag.Set(w, newUser.Version)
 		userResponse := *mapModelResponse(newUser)
 
 		render.Respond(w, r, responses.CreateSuccessResponse(userResponse))
@@ -85,6 +94,7 @@ func (h *userHandler) Create() func(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path string true "User Id"
 // @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
+// @Header 200 {string} ETag "version of the user"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 403	{object} responses.ErrorResponse
@@ -106,6 +116,7 @@ func (h *userHandler) Get() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		etag.Set(w, user.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(user)))
 	}
 }
@@ -113,12 +124,22 @@ func (h *userHandler) Get() func(w http.ResponseWriter, r *http.Request) {
 // GetMulti godoc
 // @Summary Read Users
 // @Description Retrieve users.
+// @Description Filterable fields: id, create_time, update_time, name, email, is_active, is_super_user, verified.
+// @Description Sortable fields: id, create_time, update_time, name, email.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param limit query int false "limit" Format(limit)
 // @Param offset query int false "offset" Format(offset)
-// @Success 200 {object} responses.SuccessResponse[[]presenter.UserResponse]
+// @Param after query string false "cursor of the next page"
+// @Param before query string false "cursor of the previous page"
+// @Param total query bool false "include the total count"
+// @Param filter query []string false "filter as field<op>value, op is one of = != ~ > >= < <=" collectionFormat(multi)
+// @Param sort query string false "comma separated fields, prefix a field with - to sort descending" example(-create_time,id)
+// @Param email query string false "filter by exact email"
+// @Param is_active query bool false "filter by active status"
+// @Success 200 {object} responses.SuccessResponse[responses.PageResponse[presenter.UserResponse]]
+// @Header 200 {string} Link "links to the next and previous pages"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
@@ -126,18 +147,25 @@ func (h *userHandler) Get() func(w http.ResponseWriter, r *http.Request) {
 // @Router /user [get]
 func (h *userHandler) GetMulti() func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		q := r.URL.Query()
-
-		limit, _ := strconv.Atoi(q.Get("limit"))
-		offset, _ := strconv.Atoi(q.Get("offset"))
+		query, err := listQuery.Parse(r.URL.Query())
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
 
-		users, err := h.usersUC.GetMulti(r.Context(), limit, offset)
+		page, err := h.usersUC.GetMulti(r.Context(), query)
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
 
-		render.Respond(w, r, responses.CreateSuccessResponse(mapModelsResponse(users)))
+		if link := listQuery.LinkHeader(r.URL, page.NextCursor, page.PrevCursor); link != "" {
+			w.Header().Add("Link", link)
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(
+			responses.CreatePageResponse(mapModelsResponse(page.Items), page.NextCursor, page.PrevCursor, page.Total),
+		))
 	}
 }
 
@@ -177,16 +205,20 @@ func (h *userHandler) Delete() func(w http.ResponseWriter, r *http.Request) {
 // Update godoc
 // @Summary Update user
 // @Description Update an user by ID.
+// @Description With If-Match the update only applies if the user still has that ETag, otherwise 412 is returned with the current user.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param id path string true "User Id"
+// @Param If-Match header string false "ETag of the user the update is based on"
 // @Param user body presenter.UserUpdate true "Update user"
 // @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
+// @Header 200 {string} ETag "version of the user"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 403	{object} responses.ErrorResponse
 // @Failure 404	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
 // @Security OAuth2Password
 // @Router /user/{id} [put]
@@ -198,6 +230,12 @@ func (h *userHandler) Update() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		version, err := etag.ParseIfMatch(r)
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
 		user := new(presenter.UserUpdate)
 
 		err = json.NewDecoder(r.Body).Decode(&user)
@@ -213,13 +251,111 @@ func (h *userHandler) Update() func(w http.ResponseWriter, r *http.Request) {
 		}
 
 		updatedUser, err := h.usersUC.Update(r.Context(), uint(id), &models.UserUpdate{
-			Name: &user.Name,
+			Name:    &user.Name,
+			Version: version,
 		})
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
+		etag.Set(w, updatedUser.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedUser)))
+	}
+}
+
+// Patch godoc
+// @Summary Patch user
+// @Description Partially update an user by ID with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902).
+// @Description The patched user is validated like a new user, plan must be a configured quota plan or empty for the default plan.
+// @Description With If-Match the update only applies if the user still has that ETag, otherwise 412 is returned with the current user.
+// @Tags users
+// @Accept application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "User Id"
+// @Param If-Match header string false "ETag of the user the patch is based on"
+// @Param user body presenter.UserPatch true "Patch of the user"
+// @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
+// @Header 200 {string} ETag "version of the user"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
+// @Failure 415	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /user/{id} [patch]
+func (h *userHandler) Patch() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		version, err := etag.ParseIfMatch(r)
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
+		current, err := h.usersUC.Get(ctx, uint(id))
 		if err != nil {
 			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
 			return
 		}
 
+		original := presenter.UserPatch{
+			Name:        current.Name,
+			Email:       current.Email,
+			IsActive:    current.IsActive,
+			IsSuperUser: current.IsSuperUser,
+			Plan:        current.Plan,
+		}
+
+		user := new(presenter.UserPatch)
+
+		err = patch.Apply(r, original, user)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		err = utils.ValidateStruct(ctx, user)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		// Only the changed fields are written, so concurrent updates of other
+		// fields are kept.
+		user_update := models.UserUpdate{Version: version}
+		if user.Name != original.Name {
+			user_update.Name = &user.Name
+		}
+		if user.Email != original.Email {
+			user_update.Email = &user.Email
+		}
+		if user.IsActive != original.IsActive {
+			user_update.IsActive = &user.IsActive
+		}
+		if user.IsSuperUser != original.IsSuperUser {
+			user_update.IsSuperUser = &user.IsSuperUser
+		}
+		if user.Plan != original.Plan {
+			user_update.Plan = &user.Plan
+		}
+
+		updatedUser, err := h.usersUC.Update(ctx, uint(id), &user_update)
+		if err != nil {
+			h.renderUpdateError(w, r, uint(id), err)
+			return
+		}
+
+		etag.Set(w, updatedUser.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedUser)))
 	}
 }
@@ -274,6 +410,7 @@ func (h *userHandler) UpdatePassword() func(w http.ResponseWriter, r *http.Reque
 			return
 		}
 
+		etag.Set(w, updatedUser.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedUser)))
 	}
 }
@@ -285,6 +422,7 @@ func (h *userHandler) UpdatePassword() func(w http.ResponseWriter, r *http.Reque
 // @Accept json
 // @Produce json
 // @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
+// @Header 200 {string} ETag "version of the user"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 403	{object} responses.ErrorResponse
@@ -302,6 +440,7 @@ func (h *userHandler) Me() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		etag.Set(w, user.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(user)))
 	}
 }
@@ -309,15 +448,19 @@ func (h *userHandler) Me() func(w http.ResponseWriter, r *http.Request) {
 // UpdateMe godoc
 // @Summary Update user me
 // @Description Update user me.
+// @Description With If-Match the update only applies if the user still has that ETag, otherwise 412 is returned with the current user.
 // @Tags users
 // @Accept json
 // @Produce json
+// @Param If-Match header string false "ETag of the user the update is based on"
 // @Param user body presenter.UserUpdate true "Update user"
 // @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
+// @Header 200 {string} ETag "version of the user"
 // @Failure 400	{object} responses.ErrorResponse
 // @Failure 401	{object} responses.ErrorResponse
 // @Failure 403	{object} responses.ErrorResponse
 // @Failure 404	{object} responses.ErrorResponse
+// @Failure 412	{object} responses.ErrorResponse
 // @Failure 422	{object} responses.ErrorResponse
 // @Security OAuth2Password
 // @Router /user/me [put]
@@ -331,6 +474,12 @@ func (h *userHandler) UpdateMe() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		version, err := etag.ParseIfMatch(r)
+		if err != nil {
+			h.renderUpdateError(w, r, user.Id, err)
+			return
+		}
+
 		userUpdate := new(presenter.UserUpdate)
 
 		err = json.NewDecoder(r.Body).Decode(&userUpdate)
@@ -346,13 +495,15 @@ func (h *userHandler) UpdateMe() func(w http.ResponseWriter, r *http.Request) {
 		}
 
 		updatedUser, err := h.usersUC.Update(r.Context(), user.Id, &models.UserUpdate{
-			Name: &userUpdate.Name,
+			Name:    &userUpdate.Name,
+			Version: version,
 		})
 		if err != nil {
-			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			h.renderUpdateError(w, r, user.Id, err)
 			return
 		}
 
+		etag.Set(w, updatedUser.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedUser)))
 	}
 }
@@ -408,6 +559,7 @@ func (h *userHandler) UpdatePasswordMe() func(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+		etag.Set(w, updatedUser.Version)
 		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(updatedUser)))
 	}
 }
@@ -445,6 +597,98 @@ func (h *userHandler) LogoutAllAdmin() func(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// GetMultiTrash godoc
+// @Summary Read deleted users
+// @Description Retrieve deleted users, accepts the same query parameters as the user list.
+// @Description Deleted users are purged permanently after the configured retention period.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param limit query int false "limit" Format(limit)
+// @Param offset query int false "offset" Format(offset)
+// @Param after query string false "cursor of the next page"
+// @Param before query string false "cursor of the previous page"
+// @Param total query bool false "include the total count"
+// @Param filter query []string false "filter as field<op>value, op is one of = != ~ > >= < <=" collectionFormat(multi)
+// @Param sort query string false "comma separated fields, prefix a field with - to sort descending" example(-delete_time)
+// @Success 200 {object} responses.SuccessResponse[responses.PageResponse[presenter.UserResponse]]
+// @Header 200 {string} Link "links to the next and previous pages"
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /user/trash [get]
+func (h *userHandler) GetMultiTrash() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := listQuery.Parse(r.URL.Query())
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		page, err := h.usersUC.GetMultiTrash(r.Context(), query)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		if link := listQuery.LinkHeader(r.URL, page.NextCursor, page.PrevCursor); link != "" {
+			w.Header().Add("Link", link)
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(
+			responses.CreatePageResponse(mapModelsResponse(page.Items), page.NextCursor, page.PrevCursor, page.Total),
+		))
+	}
+}
+
+// Restore godoc
+// @Summary Restore user
+// @Description Restore a deleted user by ID.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} responses.SuccessResponse[presenter.UserResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 404	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /user/{id}/restore [post]
+func (h *userHandler) Restore() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(httpErrors.ErrValidation(err))) //nolint:errcheck
+			return
+		}
+
+		user, err := h.usersUC.Restore(r.Context(), uint(id))
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		etag.Set(w, user.Version)
+		render.Respond(w, r, responses.CreateSuccessResponse(mapModelResponse(user)))
+	}
+}
+
+// renderUpdateError renders the error of an update. A failed If-Match is
+// answered with the current user and its ETag, so the client can merge.
+func (h *userHandler) renderUpdateError(w http.ResponseWriter, r *http.Request, id uint, err error) {
+	if httpErrors.ParseErrors(err).GetStatus() == http.StatusPreconditionFailed {
+		if current, getErr := h.usersUC.Get(r.Context(), id); getErr == nil {
+			etag.Set(w, current.Version)
+			render.Render(w, r, responses.CreateErrorResponseWithData(err, mapModelResponse(current))) //nolint:errcheck
+			return
+		}
+	}
+	render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+}
+
 func mapModelResponse(exp *models.User) *presenter.UserResponse {
 	return &presenter.UserResponse{
 		Id:          exp.Id,
@@ -455,6 +699,9 @@ func mapModelResponse(exp *models.User) *presenter.UserResponse {
 		IsActive:    exp.IsActive,
 		IsSuperUser: exp.IsSuperUser,
 		Verified:    exp.Verified,
+		Plan:        exp.Plan,
+		DeleteTime:  exp.DeleteTime,
+		Version:     exp.Version,
 	}
 }
 
@@ -465,3 +712,126 @@ func mapModelsResponse(exp []*models.User) []*presenter.UserResponse {
 	}
 	return out
 }
+
+// CreateCalendarToken godoc
+// @Summary Create calendar token
+// @Description Create the secret token of the iCalendar feed of the items with a due date.
+// @Description The token is only returned once, creating a new token revokes the previous one.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Success 201 {object} responses.SuccessResponse[presenter.CalendarToken]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /user/me/calendar-token [post]
+func (h *userHandler) CreateCalendarToken() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		token, err := h.usersUC.CreateCalendarToken(ctx, user.Id)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.Respond(w, r, responses.CreateSuccessResponse(presenter.CalendarToken{
+			Token: token,
+			Url:   strings.TrimSuffix(h.cfg.Calendar.FeedUrl, "/") + "/" + token + ".ics",
+		}))
+	}
+}
+
+// DeleteCalendarToken godoc
+// @Summary Delete calendar token
+// @Description Revoke the iCalendar feed token.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Success 200
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Failure 422	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /user/me/calendar-token [delete]
+func (h *userHandler) DeleteCalendarToken() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		if err := h.usersUC.DeleteCalendarToken(ctx, user.Id); err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+	}
+}
+
+// GetUsage godoc
+// @Summary Read usage
+// @Description Get the plan of the current user and the usage of its quotas. A limit of 0 is unlimited.
+// @Description The API calls are counted per UTC day, this route is not counted.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Success 200 {object} responses.SuccessResponse[presenter.UsageResponse]
+// @Failure 400	{object} responses.ErrorResponse
+// @Failure 401	{object} responses.ErrorResponse
+// @Failure 403	{object} responses.ErrorResponse
+// @Security OAuth2Password
+// @Router /user/me/usage [get]
+func (h *userHandler) GetUsage() func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		user, err := middleware.GetUserFromCtx(ctx)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		usage, err := h.quotasUC.GetUsage(ctx, user.Id)
+		if err != nil {
+			render.Render(w, r, responses.CreateErrorResponse(err)) //nolint:errcheck
+			return
+		}
+
+		render.Respond(w, r, responses.CreateSuccessResponse(mapUsageResponse(usage)))
+	}
+}
+
+func mapQuotaUsageResponse(exp models.QuotaUsage) presenter.QuotaUsageResponse {
+	out := presenter.QuotaUsageResponse{Used: exp.Used, Limit: exp.Limit}
+	if exp.Limit > 0 {
+		remaining := exp.Limit - exp.Used
+		if remaining < 0 {
+			remaining = 0
+		}
+		out.Remaining = &remaining
+	}
+	return out
+}
+
+func mapUsageResponse(exp *models.Usage) *presenter.UsageResponse {
+	return &presenter.UsageResponse{
+		Plan:              exp.Plan,
+		Items:             mapQuotaUsageResponse(exp.Items),
+		Storage:           mapQuotaUsageResponse(exp.Storage),
+		ApiCalls:          mapQuotaUsageResponse(exp.ApiCalls),
+		ApiCallsResetTime: exp.ApiCallsResetTime,
+	}
+}
@@ -15,25 +15,36 @@ func MapUserRoute(router *chi.Mux, h users.Handlers, mw *middleware.MiddlewareMa
 			r.Use(mw.Authenticator())
 			r.Use(mw.CurrentUser())
 			r.Use(mw.ActiveUser())
-			r.Get("/me", h.Me())
-			r.Put("/me", h.UpdateMe())
-			// r.Patch("/me/updatepass", h.UpdatePasswordMe())
-			// Admin routes
+			// The usage is not counted, so it can be read once the API calls
+			// quota is exceeded.
+			r.Get("/me/usage", h.GetUsage())
 			r.Group(func(r chi.Router) {
-				r.Use(mw.SuperUser())
-				r.Get("/", h.GetMulti())
-				r.Post("/", h.Create())
-			})
-			// Per id routes
-			r.Route("/{id}", func(r chi.Router) {
-				r.Get("/", h.Get())
+				r.Use(mw.CountApiCall())
+				r.Get("/me", h.Me())
+				r.Put("/me", h.UpdateMe())
+				r.Post("/me/calendar-token", h.CreateCalendarToken())
+				r.Delete("/me/calendar-token", h.DeleteCalendarToken())
+				// r.Patch("/me/updatepass", h.UpdatePasswordMe())
 				// Admin routes
 				r.Group(func(r chi.Router) {
 					r.Use(mw.SuperUser())
-					r.Delete("/", h.Delete())
-					r.Put("/", h.Update())
-					r.Patch("/updatepass", h.UpdatePassword())
-					r.Get("/logoutall", h.LogoutAllAdmin())
+					r.Get("/", h.GetMulti())
+					r.With(mw.Idempotency()).Post("/", h.Create())
+					r.Get("/trash", h.GetMultiTrash())
+				})
+				// Per id routes
+				r.Route("/{id}", func(r chi.Router) {
+					r.Get("/", h.Get())
+					// Admin routes
+					r.Group(func(r chi.Router) {
+						r.Use(mw.SuperUser())
+						r.Delete("/", h.Delete())
+						r.Put("/", h.Update())
+						r.Patch("/", h.Patch())
+						r.Post("/restore", h.Restore())
+						r.Patch("/updatepass", h.UpdatePassword())
+						r.Get("/logoutall", h.LogoutAllAdmin())
+					})
 				})
 			})
 		})
@@ -8,9 +8,15 @@ type Handlers interface {
 	GetMulti() func(w http.ResponseWriter, r *http.Request)
 	Delete() func(w http.ResponseWriter, r *http.Request)
 	Update() func(w http.ResponseWriter, r *http.Request)
+	Patch() func(w http.ResponseWriter, r *http.Request)
 	Me() func(w http.ResponseWriter, r *http.Request)
 	UpdateMe() func(w http.ResponseWriter, r *http.Request)
 	UpdatePassword() func(w http.ResponseWriter, r *http.Request)
 	UpdatePasswordMe() func(w http.ResponseWriter, r *http.Request)
 	LogoutAllAdmin() func(w http.ResponseWriter, r *http.Request)
+	GetMultiTrash() func(w http.ResponseWriter, r *http.Request)
+	Restore() func(w http.ResponseWriter, r *http.Request)
+	CreateCalendarToken() func(w http.ResponseWriter, r *http.Request)
+	DeleteCalendarToken() func(w http.ResponseWriter, r *http.Request)
+	GetUsage() func(w http.ResponseWriter, r *http.Request)
 }
@@ -5,11 +5,12 @@ import (
 	"time"
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 )
 
 type UserPgRepository interface {
 	Get(ctx context.Context, id uint) (*models.User, error)
-	GetMulti(ctx context.Context, offset, limit int) ([]*models.User, error)
+	GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error)
 	Create(ctx context.Context, obj_in *models.UserCreate) (*models.User, error)
 	Delete(ctx context.Context, id uint) (*models.User, error)
 	Update(ctx context.Context, id uint, obj_update *models.UserUpdate) (*models.User, error)
@@ -22,4 +23,8 @@ type UserPgRepository interface {
 	GetByResetToken(ctx context.Context, resetToken string) (*models.User, error)
 	GetByResetTokenResetAt(ctx context.Context, resetToken string, resetAt time.Time) (*models.User, error)
 	UpdatePasswordResetToken(ctx context.Context, id uint, newPassword string, resetToken string) (*models.User, error)
+	GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error)
+	Restore(ctx context.Context, id uint) (*models.User, error)
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error)
+	UpdateCalendarToken(ctx context.Context, id uint, calendarToken *string) (*models.User, error)
 }
@@ -15,15 +15,29 @@ type UserUpdate struct {
 	Name string `json:"name" example:"Xuan Hien"`
 }
 
+// UserPatch is the document PATCH requests are applied to, it is validated
+// with the same rules as UserCreate.
+type UserPatch struct {
+	Name        string `json:"name" validate:"required" example:"Xuan Hien"`
+	Email       string `json:"email" validate:"required" example:"hiennguyen9874@gmail.com"`
+	IsActive    bool   `json:"is_active" example:"true"`
+	IsSuperUser bool   `json:"is_superuser" example:"false"`
+	// Plan is the quota plan of the user, empty for the default plan.
+	Plan string `json:"plan" example:"pro"`
+}
+
 type UserResponse struct {
-	Id          uint      `json:"id,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Email       string    `json:"email,omitempty"`
-	CreateTime  time.Time `json:"create_time"`
-	UpdateTime  time.Time `json:"update_time"`
-	IsActive    bool      `json:"is_active"`
-	IsSuperUser bool      `json:"is_superuser"`
-	Verified    bool      `json:"verified"`
+	Id          uint       `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Email       string     `json:"email,omitempty"`
+	CreateTime  time.Time  `json:"create_time"`
+	UpdateTime  time.Time  `json:"update_time"`
+	IsActive    bool       `json:"is_active"`
+	IsSuperUser bool       `json:"is_superuser"`
+	Verified    bool       `json:"verified"`
+	Plan        string     `json:"plan,omitempty" example:"pro"`
+	DeleteTime  *time.Time `json:"delete_time,omitempty"`
+	Version     int        `json:"version" example:"1"`
 }
 
 type UserSignIn struct {
@@ -56,3 +70,26 @@ type ResetPassword struct {
 	NewPassword     string `json:"new_password" validate:"required,min=8" example:"password"`
 	ConfirmPassword string `json:"confirm_password" validate:"required,min=8" example:"password"`
 }
+
+// CalendarToken is returned once, Url is the iCalendar feed to subscribe to.
+type CalendarToken struct {
+	Token string `json:"token"`
+	Url   string `json:"url"`
+}
+
+// QuotaUsageResponse is the usage of a quota. A Limit of 0 is unlimited,
+// Remaining is then left out.
+type QuotaUsageResponse struct {
+	Used      int64  `json:"used" example:"42"`
+	Limit     int64  `json:"limit" example:"100"`
+	Remaining *int64 `json:"remaining,omitempty" example:"58"`
+}
+
+type UsageResponse struct {
+	Plan     string             `json:"plan" example:"free"`
+	Items    QuotaUsageResponse `json:"items"`
+	Storage  QuotaUsageResponse `json:"storage"`
+	ApiCalls QuotaUsageResponse `json:"api_calls"`
+	// ApiCallsResetTime is when the API calls of the day are reset.
+	ApiCallsResetTime time.Time `json:"api_calls_reset_time"`
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/processor"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/sendEmail"
 )
@@ -16,12 +18,20 @@ import (
 type userRedisTaskProcessor struct {
 	processor.RedisTaskProcessor
 	emailSender sendEmail.EmailSender
+	pgRepo      users.UserPgRepository
 }
 
-func NewUserRedisTaskProcessor(server *asynq.Server, cfg *config.Config, logger logger.Logger, emailSender sendEmail.EmailSender) users.UserRedisTaskProcessor {
+func NewUserRedisTaskProcessor(
+	server *asynq.Server,
+	cfg *config.Config,
+	logger logger.Logger,
+	emailSender sendEmail.EmailSender,
+	pgRepo users.UserPgRepository,
+) users.UserRedisTaskProcessor {
 	return &userRedisTaskProcessor{
 		RedisTaskProcessor: processor.NewRedisTaskProcessor(server, cfg, logger),
 		emailSender:        emailSender,
+		pgRepo:             pgRepo,
 	}
 }
 
@@ -46,3 +56,17 @@ func (processor *userRedisTaskProcessor) ProcessTaskSendEmail(ctx context.Contex
 
 	return nil
 }
+
+func (processor *userRedisTaskProcessor) ProcessTaskPurgeTrash(ctx context.Context, task *asynq.Task) error {
+	deletedBefore := time.Now().AddDate(0, 0, -processor.Cfg.Trash.RetentionDays)
+
+	// Items of purged users are removed by the database (ON DELETE CASCADE).
+	count, err := processor.pgRepo.PurgeTrash(viewer.NewSystemContext(ctx), deletedBefore)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted users: %w", err)
+	}
+
+	processor.Logger.Infof("Type: %v, Count: %v, Msg: deleted users purged", task.Type(), count)
+
+	return nil
+}
@@ -2,15 +2,37 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/predicate"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/schema"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent/user"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 )
 
+// UserListFields are the user columns that list queries may filter and sort by.
+var UserListFields = listQuery.Fields{
+	user.FieldID:          {Kind: listQuery.KindUint, Sortable: true},
+	user.FieldCreateTime:  {Kind: listQuery.KindTime, Sortable: true},
+	user.FieldUpdateTime:  {Kind: listQuery.KindTime, Sortable: true},
+	user.FieldName:        {Kind: listQuery.KindString, Sortable: true},
+	user.FieldEmail:       {Kind: listQuery.KindString, Sortable: true},
+	user.FieldIsActive:    {Kind: listQuery.KindBool},
+	user.FieldIsSuperUser: {Kind: listQuery.KindBool},
+	user.FieldVerified:    {Kind: listQuery.KindBool},
+}
+
+// UserTrashListFields are the columns of the trash list, which is the only
+// list whose users have a delete time.
+var UserTrashListFields = UserListFields.With(listQuery.Fields{
+	user.FieldDeleteTime: {Kind: listQuery.KindTime, Sortable: true},
+})
+
 type UserPgRepo struct {
 	client *ent.Client
 }
@@ -33,6 +55,9 @@ func (r *UserPgRepo) mapModel(db_obj *ent.User) *models.User {
 		VerificationCode:   db_obj.VerificationCode,
 		PasswordResetToken: db_obj.PasswordResetToken,
 		PasswordResetAt:    db_obj.PasswordResetAt,
+		Plan:               db_obj.Plan,
+		DeleteTime:         db_obj.DeleteTime,
+		Version:            db_obj.Version,
 	}
 }
 
@@ -56,16 +81,82 @@ func (r *UserPgRepo) Get(ctx context.Context, id uint) (*models.User, error) {
 	return r.mapModel(db_obj), nil
 }
 
-func (r *UserPgRepo) GetMulti(ctx context.Context, limit, offset int) ([]*models.User, error) {
-	db_objs, err := r.client.User.Query().
-		Order(user.ByID(sql.OrderAsc())).
-		Offset(offset).
-		Limit(limit).
+func (r *UserPgRepo) GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error) {
+	return r.getPage(ctx, r.client.User.Query(), query, UserListFields)
+}
+
+func (r *UserPgRepo) GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error) {
+	return r.getPage(schema.SkipSoftDelete(ctx), r.client.User.Query().Where(user.DeleteTimeNotNil()), query, UserTrashListFields)
+}
+
+func (r *UserPgRepo) getPage(
+	ctx context.Context,
+	q *ent.UserQuery,
+	query *listQuery.Query,
+	fields listQuery.Fields,
+) (*listQuery.Page[*models.User], error) {
+	predicates, err := query.Predicates(fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range predicates {
+		q = q.Where(predicate.User(p))
+	}
+
+	var total *int
+	if query.WithTotal {
+		count, err := q.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		total = &count
+	}
+
+	cursor, err := query.CursorPredicate(fields)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		q = q.Where(predicate.User(cursor))
+	}
+
+	orders, err := query.Orders(fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		q = q.Order(user.OrderOption(o))
+	}
+
+	db_objs, err := q.
+		Offset(query.Offset).
+		Limit(query.Limit + 1).
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return r.mapModels(db_objs), nil
+
+	page := listQuery.MapPage(listQuery.NewPage(query, db_objs, userCursorKey), r.mapModels)
+	page.Total = total
+	return page, nil
+}
+
+func userCursorKey(db_obj *ent.User, field string) (string, uint) {
+	switch field {
+	case user.FieldCreateTime:
+		return db_obj.CreateTime.Format(time.RFC3339Nano), db_obj.ID
+	case user.FieldUpdateTime:
+		return db_obj.UpdateTime.Format(time.RFC3339Nano), db_obj.ID
+	case user.FieldName:
+		return db_obj.Name, db_obj.ID
+	case user.FieldEmail:
+		return db_obj.Email, db_obj.ID
+	case user.FieldDeleteTime:
+		// Only the trash is sorted by delete time, its users have one.
+		return db_obj.DeleteTime.Format(time.RFC3339Nano), db_obj.ID
+	default:
+		return "", db_obj.ID
+	}
 }
 
 func (r *UserPgRepo) Create(ctx context.Context, obj_in *models.UserCreate) (*models.User, error) {
@@ -102,6 +193,11 @@ func (r *UserPgRepo) Delete(ctx context.Context, id uint) (*models.User, error)
 
 func (r *UserPgRepo) Update(ctx context.Context, id uint, obj_update *models.UserUpdate) (*models.User, error) {
 	query := r.client.User.UpdateOneID(id)
+	if obj_update.Version != nil {
+		// The version is checked by the update statement itself, so a concurrent
+		// write between a read and this update can not be overwritten.
+		query = query.Where(user.Version(*obj_update.Version))
+	}
 	if obj_update.Name != nil {
 		query = query.SetName(*obj_update.Name)
 	}
@@ -111,6 +207,13 @@ func (r *UserPgRepo) Update(ctx context.Context, id uint, obj_update *models.Use
 	if obj_update.Password != nil {
 		query = query.SetPassword(*obj_update.Password)
 	}
+	if obj_update.Plan != nil {
+		if *obj_update.Plan == "" {
+			query = query.ClearPlan()
+		} else {
+			query = query.SetPlan(*obj_update.Plan)
+		}
+	}
 	db_obj, err := query.
 		SetNillableIsActive(obj_update.IsActive).
 		SetNillableIsSuperUser(obj_update.IsSuperUser).
@@ -120,6 +223,15 @@ func (r *UserPgRepo) Update(ctx context.Context, id uint, obj_update *models.Use
 		SetNillablePasswordResetAt(obj_update.PasswordResetAt).
 		Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) && obj_update.Version != nil {
+			exist, existErr := r.client.User.Query().Where(user.ID(id)).Exist(ctx)
+			if existErr != nil {
+				return nil, existErr
+			}
+			if exist {
+				return nil, httpErrors.ErrPreconditionFailed(errors.New("user was modified"))
+			}
+		}
 		return nil, err
 	}
 	return r.mapModel(db_obj), nil
@@ -242,3 +354,41 @@ func (r *UserPgRepo) UpdatePasswordResetToken(
 	}
 	return r.mapModel(db_obj), nil
 }
+
+func (r *UserPgRepo) Restore(ctx context.Context, id uint) (*models.User, error) {
+	db_obj, err := r.client.User.UpdateOneID(id).
+		Where(user.DeleteTimeNotNil()).
+		ClearDeleteTime().
+		Save(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		// The email was registered again after the user was trashed.
+		if ent.IsConstraintError(err) {
+			return nil, httpErrors.ErrValidation(errors.New("email already exists"))
+		}
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
+
+func (r *UserPgRepo) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	return r.client.User.Delete().
+		Where(user.DeleteTimeLT(deletedBefore)).
+		Exec(schema.SkipSoftDelete(ctx))
+}
+
+// UpdateCalendarToken sets the hash of the calendar feed token, a nil token
+// disables the feed.
+func (r *UserPgRepo) UpdateCalendarToken(ctx context.Context, id uint, calendarToken *string) (*models.User, error) {
+	update := r.client.User.UpdateOneID(id)
+	if calendarToken != nil {
+		update.SetCalendarToken(*calendarToken)
+	} else {
+		update.ClearCalendarToken()
+	}
+
+	db_obj, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapModel(db_obj), nil
+}
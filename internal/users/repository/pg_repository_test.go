@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/enttest"
+	_ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users/repository"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestCreateTrashedEmail registers the email of a trashed user again, the
+// trashed user can not be restored while the email is taken.
+func TestCreateTrashedEmail(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:users?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := viewer.NewSystemContext(context.Background())
+	repo := repository.CreateUserPgRepository(client)
+
+	create := &models.UserCreate{Name: "user", Email: "user@example.com", Password: "password"}
+
+	trashed, err := repo.Create(ctx, create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Create(ctx, create); err == nil {
+		t.Fatal("registered the email of an active user again")
+	}
+
+	if _, err := repo.Delete(ctx, trashed.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Create(ctx, create); err != nil {
+		t.Fatalf("registering the email of a trashed user: %v", err)
+	}
+
+	_, err = repo.Restore(ctx, trashed.Id)
+	if err == nil || httpErrors.ParseErrors(err).GetStatus() != http.StatusUnprocessableEntity {
+		t.Fatalf("got %v, want a validation error", err)
+	}
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 )
 
 type UserUseCase interface {
 	Create(ctx context.Context, obj_create *models.UserCreate, confirmPassword string) (*models.User, error)
 	Get(ctx context.Context, id uint) (*models.User, error)
-	GetMulti(ctx context.Context, offset, limit int) ([]*models.User, error)
+	GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error)
 	Delete(ctx context.Context, id uint) (*models.User, error)
 	Update(ctx context.Context, id uint, obj_update *models.UserUpdate) (*models.User, error)
 	SignIn(ctx context.Context, email string, password string) (string, string, error)
@@ -22,4 +23,8 @@ type UserUseCase interface {
 	Verify(ctx context.Context, verificationCode string) error
 	ForgotPassword(ctx context.Context, email string) error
 	ResetPassword(ctx context.Context, resetToken string, newPassword string, confirmPassword string) error
+	GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error)
+	Restore(ctx context.Context, id uint) (*models.User, error)
+	CreateCalendarToken(ctx context.Context, id uint) (string, error)
+	DeleteCalendarToken(ctx context.Context, id uint) error
 }
@@ -12,11 +12,13 @@ import (
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/viewer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/worker"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/cryptpass"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/emailTemplates"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/jwt"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/listQuery"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/newPointer"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/secureRandom"
@@ -113,6 +115,11 @@ func (u *userUseCase) Create(ctx context.Context, obj_create *models.UserCreate,
 }
 
 func (u *userUseCase) Get(ctx context.Context, id uint) (*models.User, error) {
+	// The cache is not covered by the privacy policies, other viewers read from database.
+	if v := viewer.FromContext(ctx); v == nil || (!v.Admin() && v.Id != id) {
+		return u.pgRepo.Get(ctx, id)
+	}
+
 	cachedUser, err := u.redisRepo.Get(ctx, u.generateRedisUserKey(id))
 	if err != nil {
 		return nil, err
@@ -134,14 +141,8 @@ func (u *userUseCase) Get(ctx context.Context, id uint) (*models.User, error) {
 	return user, nil
 }
 
-func (u *userUseCase) GetMulti(ctx context.Context, offset, limit int) ([]*models.User, error) {
-	if offset < 0 {
-		offset = 0
-	}
-	if limit <= 0 {
-		limit = 50
-	}
-	return u.pgRepo.GetMulti(ctx, offset, limit)
+func (u *userUseCase) GetMulti(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error) {
+	return u.pgRepo.GetMulti(ctx, query)
 }
 
 func (u *userUseCase) Delete(ctx context.Context, id uint) (*models.User, error) {
@@ -161,6 +162,23 @@ func (u *userUseCase) Delete(ctx context.Context, id uint) (*models.User, error)
 	return user, nil
 }
 
+func (u *userUseCase) GetMultiTrash(ctx context.Context, query *listQuery.Query) (*listQuery.Page[*models.User], error) {
+	return u.pgRepo.GetMultiTrash(ctx, query)
+}
+
+func (u *userUseCase) Restore(ctx context.Context, id uint) (*models.User, error) {
+	user, err := u.pgRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.Delete(ctx, u.generateRedisUserKey(id)); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (u *userUseCase) Update(
 	ctx context.Context,
 	id uint,
@@ -171,6 +189,17 @@ func (u *userUseCase) Update(
 		return nil, err
 	}
 
+	if obj_update.Email != nil {
+		email := strings.ToLower(strings.TrimSpace(*obj_update.Email))
+		obj_update.Email = &email
+	}
+
+	if obj_update.Plan != nil && *obj_update.Plan != "" {
+		if _, ok := u.cfg.Quota.Plan(*obj_update.Plan); !ok {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("unknown plan %q", *obj_update.Plan))
+		}
+	}
+
 	user, err := u.pgRepo.Update(ctx, obj.Id, obj_update)
 	if err != nil {
 		return nil, err
@@ -210,6 +239,8 @@ func (u *userUseCase) createToken(ctx context.Context, obj models.User) (string,
 }
 
 func (u *userUseCase) SignIn(ctx context.Context, email string, password string) (string, string, error) {
+	ctx = viewer.NewSystemContext(ctx)
+
 	user, err := u.pgRepo.GetByEmail(ctx, email)
 	if err != nil {
 		return "", "", httpErrors.ErrNotFound(err)
@@ -236,6 +267,8 @@ func (u *userUseCase) SignIn(ctx context.Context, email string, password string)
 }
 
 func (u *userUseCase) CreateSuperUserIfNotExist(ctx context.Context) (bool, error) {
+	ctx = viewer.NewSystemContext(ctx)
+
 	user, err := u.pgRepo.GetByEmail(ctx, u.cfg.FirstSuperUser.Email)
 
 	if err != nil || user == nil {
@@ -314,6 +347,8 @@ func (u *userUseCase) parseIdFromRefreshToken(
 }
 
 func (u *userUseCase) Refresh(ctx context.Context, refreshToken string) (string, string, error) {
+	ctx = viewer.NewSystemContext(ctx)
+
 	idParsed, err := u.parseIdFromRefreshToken(ctx, refreshToken)
 	if err != nil {
 		return "", "", err
@@ -401,6 +436,8 @@ func (u *userUseCase) LogoutAllWithToken(ctx context.Context, refreshToken strin
 }
 
 func (u *userUseCase) Verify(ctx context.Context, verificationCode string) error {
+	ctx = viewer.NewSystemContext(ctx)
+
 	user, err := u.pgRepo.GetByVerificationCode(ctx, verificationCode)
 	if err != nil {
 		return err
@@ -423,6 +460,8 @@ func (u *userUseCase) Verify(ctx context.Context, verificationCode string) error
 }
 
 func (u *userUseCase) ForgotPassword(ctx context.Context, email string) error {
+	ctx = viewer.NewSystemContext(ctx)
+
 	user, err := u.pgRepo.GetByEmail(ctx, email)
 
 	if err != nil {
@@ -484,6 +523,8 @@ func (u *userUseCase) ResetPassword(
 	newPassword string,
 	confirmPassword string,
 ) error {
+	ctx = viewer.NewSystemContext(ctx)
+
 	if newPassword != confirmPassword {
 		return httpErrors.ErrValidation(errors.New("password do not match"))
 	}
@@ -526,3 +567,31 @@ func (u *userUseCase) generateRedisUserKey(id uint) string {
 func (u *userUseCase) GenerateRedisRefreshTokenKey(id uint) string {
 	return fmt.Sprintf("RefreshToken:%v", id)
 }
+
+// CreateCalendarToken replaces the calendar feed token of the user. Only the
+// hash is stored, the token is returned once.
+func (u *userUseCase) CreateCalendarToken(ctx context.Context, id uint) (string, error) {
+	token, err := secureRandom.RandomHex(32)
+	if err != nil {
+		return "", err
+	}
+
+	hash := secureRandom.HashToken(token)
+	if _, err = u.pgRepo.UpdateCalendarToken(viewer.NewSystemContext(ctx), id, &hash); err != nil {
+		return "", err
+	}
+
+	if err = u.redisRepo.Delete(ctx, u.generateRedisUserKey(id)); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (u *userUseCase) DeleteCalendarToken(ctx context.Context, id uint) error {
+	if _, err := u.pgRepo.UpdateCalendarToken(viewer.NewSystemContext(ctx), id, nil); err != nil {
+		return err
+	}
+
+	return u.redisRepo.Delete(ctx, u.generateRedisUserKey(id))
+}
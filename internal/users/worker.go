@@ -6,7 +6,10 @@ import (
 	"github.com/hibiken/asynq"
 )
 
-const TaskSendEmail = "task:send_email"
+const (
+	TaskSendEmail  = "task:send_email"
+	TaskPurgeTrash = "task:purge_user_trash"
+)
 
 type PayloadSendEmail struct {
 	From      string `json:"from"`
@@ -22,4 +25,5 @@ type UserRedisTaskDistributor interface {
 
 type UserRedisTaskProcessor interface {
 	ProcessTaskSendEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPurgeTrash(ctx context.Context, task *asynq.Task) error
 }
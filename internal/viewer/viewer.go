@@ -0,0 +1,74 @@
+package viewer
+
+import (
+	"context"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/models"
+)
+
+// Role of a viewer.
+type Role int
+
+const (
+	RoleUser Role = iota + 1
+	RoleAdmin
+	RoleSystem
+)
+
+// Viewer describes who is performing the current operation. It is stored in
+// the request context and read by the ent privacy rules.
+type Viewer struct {
+	Id   uint
+	Role Role
+}
+
+// Admin reports whether the viewer can access every row.
+func (v *Viewer) Admin() bool {
+	return v.Role == RoleAdmin || v.Role == RoleSystem
+}
+
+// System reports whether the viewer is an internal process, not an user.
+func (v *Viewer) System() bool {
+	return v.Role == RoleSystem
+}
+
+type ctxKey struct{}
+
+// FromContext returns the viewer stored in ctx, or nil if there is none.
+func FromContext(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(ctxKey{}).(*Viewer)
+	return v
+}
+
+// NewContext returns a copy of parent that carries v.
+func NewContext(parent context.Context, v *Viewer) context.Context {
+	return context.WithValue(parent, ctxKey{}, v)
+}
+
+// NewUserContext returns a copy of parent with user as viewer.
+func NewUserContext(parent context.Context, user *models.User) context.Context {
+	v := &Viewer{Id: user.Id, Role: RoleUser}
+	if user.IsSuperUser {
+		v.Role = RoleAdmin
+	}
+	return NewContext(parent, v)
+}
+
+// NewSystemContext returns a copy of parent with the system as viewer. It is
+// used by flows that run before an user is known (sign in, token refresh,
+// password reset) and by background workers.
+func NewSystemContext(parent context.Context) context.Context {
+	return NewContext(parent, &Viewer{Role: RoleSystem})
+}
+
+// NewElevatedContext returns a copy of parent with the system as viewer, on
+// behalf of the viewer of parent who stays the author of the changes. It is
+// used by the use cases for the changes they checked already, which touch rows
+// the viewer can not access, like the descendants of an item owned by others.
+func NewElevatedContext(parent context.Context) context.Context {
+	v := &Viewer{Role: RoleSystem}
+	if parentViewer := FromContext(parent); parentViewer != nil {
+		v.Id = parentViewer.Id
+	}
+	return NewContext(parent, v)
+}
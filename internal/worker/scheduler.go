@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"github.com/hibiken/asynq"
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
+)
+
+// TaskScheduler enqueues the periodic tasks handled by the TaskProcessor.
+type TaskScheduler struct {
+	scheduler *asynq.Scheduler
+	cfg       *config.Config
+	logger    logger.Logger
+}
+
+func NewTaskScheduler(cfg *config.Config, logger logger.Logger) *TaskScheduler {
+	redisOpt := asynq.RedisClientOpt{
+		Addr: cfg.TaskRedis.Addr,
+		DB:   cfg.TaskRedis.Db,
+	}
+
+	scheduler := asynq.NewScheduler(
+		redisOpt,
+		&asynq.SchedulerOpts{
+			Logger: logger,
+		},
+	)
+
+	return &TaskScheduler{
+		scheduler: scheduler,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+func (taskScheduler *TaskScheduler) Start() error {
+	for _, taskType := range []string{items.TaskPurgeTrash, users.TaskPurgeTrash} {
+		if _, err := taskScheduler.scheduler.Register(
+			taskScheduler.cfg.Trash.PurgeCron,
+			asynq.NewTask(taskType, nil),
+			asynq.Queue(QueueDefault),
+		); err != nil {
+			return err
+		}
+	}
+
+	return taskScheduler.scheduler.Start()
+}
+
+func (taskScheduler *TaskScheduler) Shutdown() {
+	taskScheduler.scheduler.Shutdown()
+}
@@ -6,11 +6,20 @@ import (
 
 	"github.com/hibiken/asynq"
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
 
+	"github.com/hiennguyen9874/go-boilerplate-v2/internal/items"
+	itemProcessor "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/processor"
+	itemRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/items/repository"
+	quotaRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/repository"
+	quotaUseCase "github.com/hiennguyen9874/go-boilerplate-v2/internal/quotas/usecase"
 	"github.com/hiennguyen9874/go-boilerplate-v2/internal/users"
 	userProcessor "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/processor"
+	userRepository "github.com/hiennguyen9874/go-boilerplate-v2/internal/users/repository"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/db/redis"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/logger"
 	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/sendEmail"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
 )
 
 const (
@@ -19,12 +28,13 @@ const (
 )
 
 type TaskProcessor struct {
-	server *asynq.Server
-	cfg    *config.Config
-	logger logger.Logger
+	server     *asynq.Server
+	cfg        *config.Config
+	logger     logger.Logger
+	psqlClient *ent.Client
 }
 
-func NewTaskProcessor(cfg *config.Config, logger logger.Logger) (*TaskProcessor, error) {
+func NewTaskProcessor(cfg *config.Config, logger logger.Logger, psqlClient *ent.Client) (*TaskProcessor, error) {
 	redisOpt := asynq.RedisClientOpt{
 		Addr: cfg.TaskRedis.Addr,
 		DB:   cfg.TaskRedis.Db,
@@ -45,9 +55,10 @@ func NewTaskProcessor(cfg *config.Config, logger logger.Logger) (*TaskProcessor,
 	)
 
 	return &TaskProcessor{
-		server: server,
-		cfg:    cfg,
-		logger: logger,
+		server:     server,
+		cfg:        cfg,
+		logger:     logger,
+		psqlClient: psqlClient,
 	}, nil
 }
 
@@ -56,10 +67,32 @@ func (taskProcessor *TaskProcessor) Start() error {
 
 	emailSender := sendEmail.NewEmailSender(taskProcessor.cfg)
 
+	fileStorage, err := storage.NewStorage(context.Background(), taskProcessor.cfg)
+	if err != nil {
+		return err
+	}
+
+	// Repository
+	itemPgRepo := itemRepository.CreateItemPgRepository(taskProcessor.psqlClient)
+	userPgRepo := userRepository.CreateUserPgRepository(taskProcessor.psqlClient)
+	quotaPgRepo := quotaRepository.CreateQuotaPgRepository(taskProcessor.psqlClient)
+	quotaRedisRepo := quotaRepository.CreateQuotaRedisRepository(redis.NewRedis(taskProcessor.cfg))
+
+	// UseCase
+	quotaUC := quotaUseCase.CreateQuotaUseCase(quotaPgRepo, quotaRedisRepo, taskProcessor.cfg, taskProcessor.logger)
+
 	// Processor
-	userRedisTaskProcessor := userProcessor.NewUserRedisTaskProcessor(taskProcessor.server, taskProcessor.cfg, taskProcessor.logger, emailSender)
+	itemRedisTaskProcessor := itemProcessor.NewItemRedisTaskProcessor(taskProcessor.server, taskProcessor.cfg, taskProcessor.logger, itemPgRepo, quotaUC, fileStorage, emailSender)
+	userRedisTaskProcessor := userProcessor.NewUserRedisTaskProcessor(taskProcessor.server, taskProcessor.cfg, taskProcessor.logger, emailSender, userPgRepo)
 
 	mux.HandleFunc(users.TaskSendEmail, userRedisTaskProcessor.ProcessTaskSendEmail)
+	mux.HandleFunc(items.TaskProcessAttachment, itemRedisTaskProcessor.ProcessTaskProcessAttachment)
+	mux.HandleFunc(items.TaskImportItems, itemRedisTaskProcessor.ProcessTaskImportItems)
+	mux.HandleFunc(items.TaskNotifyMentions, itemRedisTaskProcessor.ProcessTaskNotifyMentions)
+	mux.HandleFunc(items.TaskItemTransitioned, itemRedisTaskProcessor.ProcessTaskItemTransitioned)
+	mux.HandleFunc(items.TaskItemReminder, itemRedisTaskProcessor.ProcessTaskItemReminder)
+	mux.HandleFunc(users.TaskPurgeTrash, userRedisTaskProcessor.ProcessTaskPurgeTrash)
+	mux.HandleFunc(items.TaskPurgeTrash, itemRedisTaskProcessor.ProcessTaskPurgeTrash)
 
 	return taskProcessor.server.Run(mux)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	_ "github.com/hiennguyen9874/go-boilerplate-v2/ent/runtime"
 
 	_ "github.com/lib/pq"
 )
@@ -2,6 +2,7 @@ package emailTemplates
 
 import (
 	"context"
+	"time"
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/config"
 	"github.com/matcornic/hermes/v2"
@@ -10,6 +11,9 @@ import (
 type EmailTemplatesGenerator interface {
 	GenerateVerificationCodeTemplate(ctx context.Context, name string, verificationLink string) (string, string, error)
 	GeneratePasswordResetTemplate(ctx context.Context, name string, resetLink string) (string, string, error)
+	GenerateMentionTemplate(ctx context.Context, name string, authorName string, itemTitle string, comment string) (string, string, error)
+	GenerateTransitionTemplate(ctx context.Context, name string, userName string, itemTitle string, from string, to string, comment string) (string, string, error)
+	GenerateReminderTemplate(ctx context.Context, name string, itemTitle string, dueAt time.Time) (string, string, error)
 }
 
 type emailTemplatesGenerator struct {
@@ -0,0 +1,38 @@
+package emailTemplates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matcornic/hermes/v2"
+)
+
+func (etg *emailTemplatesGenerator) GenerateMentionTemplate(ctx context.Context, name string, authorName string, itemTitle string, comment string) (string, string, error) {
+	email := hermes.Email{
+		Body: hermes.Body{
+			Name: name,
+			Intros: []string{
+				fmt.Sprintf("%s mentioned you in a comment on the item \"%s\":", authorName, itemTitle),
+				comment,
+			},
+			Outros: []string{
+				fmt.Sprintf("You received this email because you have access to this item on %s.", etg.cfg.Email.Name),
+			},
+			Signature: "Thanks",
+		},
+	}
+
+	// Generate an HTML email with the provided contents (for modern clients)
+	emailBody, err := etg.h.GenerateHTML(email)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Generate the plaintext version of the e-mail (for clients that do not support xHTML)
+	emailText, err := etg.h.GeneratePlainText(email)
+	if err != nil {
+		return "", "", err
+	}
+
+	return emailBody, emailText, nil
+}
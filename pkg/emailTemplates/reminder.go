@@ -0,0 +1,43 @@
+package emailTemplates
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matcornic/hermes/v2"
+)
+
+func (etg *emailTemplatesGenerator) GenerateReminderTemplate(
+	ctx context.Context,
+	name string,
+	itemTitle string,
+	dueAt time.Time,
+) (string, string, error) {
+	email := hermes.Email{
+		Body: hermes.Body{
+			Name: name,
+			Intros: []string{
+				fmt.Sprintf("The item \"%s\" is due on %s.", itemTitle, dueAt.UTC().Format("Mon, 02 Jan 2006 15:04 MST")),
+			},
+			Outros: []string{
+				fmt.Sprintf("You received this email because you have access to this item on %s.", etg.cfg.Email.Name),
+			},
+			Signature: "Thanks",
+		},
+	}
+
+	// Generate an HTML email with the provided contents (for modern clients)
+	emailBody, err := etg.h.GenerateHTML(email)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Generate the plaintext version of the e-mail (for clients that do not support xHTML)
+	emailText, err := etg.h.GeneratePlainText(email)
+	if err != nil {
+		return "", "", err
+	}
+
+	return emailBody, emailText, nil
+}
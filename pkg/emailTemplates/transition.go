@@ -0,0 +1,50 @@
+package emailTemplates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matcornic/hermes/v2"
+)
+
+func (etg *emailTemplatesGenerator) GenerateTransitionTemplate(
+	ctx context.Context,
+	name string,
+	userName string,
+	itemTitle string,
+	from string,
+	to string,
+	comment string,
+) (string, string, error) {
+	intros := []string{
+		fmt.Sprintf("%s moved the item \"%s\" from %s to %s.", userName, itemTitle, from, to),
+	}
+	if comment != "" {
+		intros = append(intros, comment)
+	}
+
+	email := hermes.Email{
+		Body: hermes.Body{
+			Name:   name,
+			Intros: intros,
+			Outros: []string{
+				fmt.Sprintf("You received this email because you have access to this item on %s.", etg.cfg.Email.Name),
+			},
+			Signature: "Thanks",
+		},
+	}
+
+	// Generate an HTML email with the provided contents (for modern clients)
+	emailBody, err := etg.h.GenerateHTML(email)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Generate the plaintext version of the e-mail (for clients that do not support xHTML)
+	emailText, err := etg.h.GeneratePlainText(email)
+	if err != nil {
+		return "", "", err
+	}
+
+	return emailBody, emailText, nil
+}
@@ -0,0 +1,51 @@
+package etag
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+// Format returns the entity tag of a resource version.
+func Format(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Set writes the ETag header of a resource version.
+func Set(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", Format(version))
+}
+
+// ParseIfMatch returns the version required by the If-Match header of the
+// request, or nil when the header is missing or "*". Weak tags never match
+// under the strong comparison If-Match uses.
+func ParseIfMatch(r *http.Request) (*int, error) {
+	raw := strings.TrimSpace(r.Header.Get("If-Match"))
+	if raw == "" || raw == "*" {
+		return nil, nil
+	}
+
+	if strings.Contains(raw, ",") {
+		return nil, httpErrors.ErrValidation(errors.New("If-Match with several entity tags is not supported"))
+	}
+
+	if strings.HasPrefix(raw, "W/") {
+		return nil, httpErrors.ErrPreconditionFailed(errors.New("weak entity tags do not match"))
+	}
+
+	value, err := strconv.Unquote(raw)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid If-Match %q", raw))
+	}
+
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, httpErrors.ErrPreconditionFailed(fmt.Errorf("entity tag %s does not match", raw))
+	}
+
+	return &version, nil
+}
@@ -0,0 +1,124 @@
+package grpcErrors
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the domain of the ErrorInfo details of the errors.
+const Domain = "go-boilerplate"
+
+var httpCodes = map[int]codes.Code{
+	http.StatusBadRequest:            codes.InvalidArgument,
+	http.StatusUnauthorized:          codes.Unauthenticated,
+	http.StatusForbidden:             codes.PermissionDenied,
+	http.StatusNotFound:              codes.NotFound,
+	http.StatusRequestTimeout:        codes.DeadlineExceeded,
+	http.StatusConflict:              codes.AlreadyExists,
+	http.StatusPreconditionFailed:    codes.FailedPrecondition,
+	http.StatusRequestEntityTooLarge: codes.ResourceExhausted,
+	http.StatusUnsupportedMediaType:  codes.InvalidArgument,
+	http.StatusUnprocessableEntity:   codes.InvalidArgument,
+	http.StatusFailedDependency:      codes.FailedPrecondition,
+	http.StatusTooManyRequests:       codes.ResourceExhausted,
+	http.StatusInternalServerError:   codes.Internal,
+}
+
+// reasonCodes are the codes of the REST errors sharing an http status with
+// other errors, e.g. the conflicts.
+var reasonCodes = map[string]codes.Code{
+	httpErrors.ErrorInvalidTransition.Error(): codes.FailedPrecondition,
+	httpErrors.ErrorAborted.Error():           codes.Aborted,
+}
+
+// Code returns the gRPC code of an http status.
+func Code(httpStatus int) codes.Code {
+	if code, ok := httpCodes[httpStatus]; ok {
+		return code
+	}
+	return codes.Unknown
+}
+
+// CodeOf returns the gRPC code of a REST error, by its status text first then
+// by its http status.
+func CodeOf(err httpErrors.ErrRest) codes.Code {
+	if code, ok := reasonCodes[err.GetStatusText()]; ok {
+		return code
+	}
+	return Code(err.GetStatus())
+}
+
+// ParseErrors converts an error to a gRPC status error the same way
+// httpErrors.ParseErrors converts it to a REST error. The status text of the
+// REST error is the reason of an ErrorInfo detail, with the http status and
+// the usage of the exceeded quota in its metadata.
+func ParseErrors(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	parsedErr := httpErrors.ParseErrors(err)
+
+	info := &errdetails.ErrorInfo{
+		Reason: parsedErr.GetStatusText(),
+		Domain: Domain,
+		Metadata: map[string]string{
+			"status": strconv.Itoa(parsedErr.GetStatus()),
+		},
+	}
+	if usage := parsedErr.GetUsage(); usage != nil {
+		info.Metadata["quota"] = usage.Quota
+		info.Metadata["used"] = strconv.FormatInt(usage.Used, 10)
+		info.Metadata["limit"] = strconv.FormatInt(usage.Limit, 10)
+	}
+
+	st := status.New(CodeOf(parsedErr), parsedErr.GetMsg())
+	if withDetails, detailsErr := st.WithDetails(info); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// ToRest converts a gRPC status error back to the REST error, with the
+// ErrorInfo detail set by ParseErrors when there is one. The gateway answers
+// with it like the REST api.
+func ToRest(err error) httpErrors.ErrRest {
+	st := status.Convert(err)
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	restErr := &httpErrors.ErrResponse{
+		Err:        err,
+		Status:     httpStatus,
+		StatusText: strings.ReplaceAll(strings.ToLower(http.StatusText(httpStatus)), " ", "_"),
+		Msg:        st.Message(),
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != Domain {
+			continue
+		}
+
+		restErr.StatusText = info.Reason
+		if httpStatus, err := strconv.Atoi(info.Metadata["status"]); err == nil {
+			restErr.Status = httpStatus
+		}
+		if quota, ok := info.Metadata["quota"]; ok {
+			used, _ := strconv.ParseInt(info.Metadata["used"], 10, 64)
+			limit, _ := strconv.ParseInt(info.Metadata["limit"], 10, 64)
+			restErr.Usage = &httpErrors.QuotaUsage{Quota: quota, Used: used, Limit: limit}
+		}
+	}
+
+	return restErr
+}
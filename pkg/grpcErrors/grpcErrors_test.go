@@ -0,0 +1,87 @@
+package grpcErrors_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/grpcErrors"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRoundTrip converts the REST errors to gRPC status errors and back, the
+// conflicts keep a distinct code and the REST error keeps its status text.
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "conflict",
+			err:  httpErrors.ErrConflict(errors.New("item already has a pending ownership transfer")),
+			code: codes.AlreadyExists,
+		},
+		{
+			name: "invalid transition",
+			err:  httpErrors.ErrInvalidTransition(errors.New(`transition "archive" is not allowed from status "draft"`)),
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "aborted",
+			err:  httpErrors.ErrAborted(errors.New("request with the idempotency key is still running")),
+			code: codes.Aborted,
+		},
+		{
+			name: "not found",
+			err:  httpErrors.ErrNotFound(errors.New("not found")),
+			code: codes.NotFound,
+		},
+		{
+			name: "quota exceeded",
+			err: httpErrors.ErrQuotaExceeded(errors.New("quota exceeded"), httpErrors.QuotaUsage{
+				Quota: "items",
+				Used:  10,
+				Limit: 10,
+			}),
+			code: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			restErr := httpErrors.ParseErrors(tt.err)
+
+			grpcErr := grpcErrors.ParseErrors(tt.err)
+			if code := status.Code(grpcErr); code != tt.code {
+				t.Fatalf("got code %s, want %s", code, tt.code)
+			}
+
+			got := grpcErrors.ToRest(grpcErr)
+			if got.GetStatus() != restErr.GetStatus() || got.GetStatusText() != restErr.GetStatusText() || got.GetMsg() != restErr.GetMsg() {
+				t.Fatalf("got %d %s %q, want %d %s %q",
+					got.GetStatus(), got.GetStatusText(), got.GetMsg(),
+					restErr.GetStatus(), restErr.GetStatusText(), restErr.GetMsg())
+			}
+			if want := restErr.GetUsage(); want != nil {
+				if usage := got.GetUsage(); usage == nil || *usage != *want {
+					t.Fatalf("got usage %+v, want %+v", usage, want)
+				}
+			} else if got.GetUsage() != nil {
+				t.Fatalf("got usage %+v, want none", got.GetUsage())
+			}
+		})
+	}
+}
+
+// TestToRestWithoutDetails converts the status errors which were not made by
+// ParseErrors, e.g. the routing errors of the gateway.
+func TestToRestWithoutDetails(t *testing.T) {
+	got := grpcErrors.ToRest(status.Error(codes.NotFound, "Not Found"))
+	if got.GetStatus() != http.StatusNotFound || got.GetStatusText() != "not_found" {
+		t.Fatalf("got %d %s, want a not found error", got.GetStatus(), got.GetStatusText())
+	}
+}
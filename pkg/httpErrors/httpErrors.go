@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/hiennguyen9874/go-boilerplate-v2/ent"
+	"github.com/hiennguyen9874/go-boilerplate-v2/ent/privacy"
 )
 
 var (
@@ -28,6 +29,15 @@ var (
 	ErrorNotFoundRefreshTokenRedis = errors.New("not_found_refresh_token_redis")
 	ErrorUserAlreadyVerified       = errors.New("user_already_verified")
 	ErrorUserNotVerified           = errors.New("user_not_verified")
+	ErrorPreconditionFailed        = errors.New("precondition_failed")
+	ErrorUnsupportedMediaType      = errors.New("unsupported_media_type")
+	ErrorFailedDependency          = errors.New("failed_dependency")
+	ErrorRequestEntityTooLarge     = errors.New("request_entity_too_large")
+	ErrorConflict                  = errors.New("conflict")
+	ErrorQuotaExceeded             = errors.New("quota_exceeded")
+	ErrorIdempotencyKeyReused      = errors.New("idempotency_key_reused")
+	ErrorInvalidTransition         = errors.New("invalid_transition")
+	ErrorAborted                   = errors.New("aborted")
 )
 
 // Rest error interface
@@ -36,9 +46,18 @@ type ErrRest interface {
 	GetStatus() int
 	GetStatusText() string
 	GetMsg() string
+	GetUsage() *QuotaUsage
 	Error() string
 }
 
+// QuotaUsage is the usage of the quota a quota_exceeded error is about. A
+// Limit of 0 is unlimited.
+type QuotaUsage struct {
+	Quota string `json:"quota" example:"items"`
+	Used  int64  `json:"used" example:"100"`
+	Limit int64  `json:"limit" example:"100"`
+}
+
 //--
 // Error response payloads & renderers
 //--
@@ -49,10 +68,11 @@ type ErrRest interface {
 // helps reveal information on the error, setting it on Err, and in the Render()
 // method, using it to set the application-specific error code in AppCode.
 type ErrResponse struct {
-	Err        error  `json:"-"`                                      // low-level runtime error
-	Status     int    `json:"status" example:"404"`                   // http response status code
-	StatusText string `json:"statusText" example:"not_found"`         // user-level status message
-	Msg        string `json:"msg,omitempty" example:"not found user"` // application-level error message, for debugging
+	Err        error       `json:"-"`                                      // low-level runtime error
+	Status     int         `json:"status" example:"404"`                   // http response status code
+	StatusText string      `json:"statusText" example:"not_found"`         // user-level status message
+	Msg        string      `json:"msg,omitempty" example:"not found user"` // application-level error message, for debugging
+	Usage      *QuotaUsage `json:"usage,omitempty"`                        // usage of the exceeded quota
 }
 
 func (e *ErrResponse) GetErr() error {
@@ -71,6 +91,10 @@ func (e *ErrResponse) GetMsg() string {
 	return e.Msg
 }
 
+func (e *ErrResponse) GetUsage() *QuotaUsage {
+	return e.Usage
+}
+
 // Error Error() interface method
 func (e *ErrResponse) Error() string {
 	return fmt.Sprintf("status: %d - statusText: %s - msg: %s - error: %v", e.Status, e.StatusText, e.Msg, e.Err)
@@ -229,13 +253,104 @@ func ErrUserNotVerified(err error) ErrRest {
 	}
 }
 
+func ErrPreconditionFailed(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusPreconditionFailed,
+		StatusText: ErrorPreconditionFailed.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+func ErrUnsupportedMediaType(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusUnsupportedMediaType,
+		StatusText: ErrorUnsupportedMediaType.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+func ErrFailedDependency(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusFailedDependency,
+		StatusText: ErrorFailedDependency.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+func ErrRequestEntityTooLarge(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusRequestEntityTooLarge,
+		StatusText: ErrorRequestEntityTooLarge.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+func ErrConflict(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusConflict,
+		StatusText: ErrorConflict.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+// ErrInvalidTransition is a conflict with the state of a resource, e.g. a
+// workflow transition not allowed from the current status.
+func ErrInvalidTransition(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusConflict,
+		StatusText: ErrorInvalidTransition.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+// ErrAborted is a conflict with a concurrent request, retrying can succeed.
+func ErrAborted(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusConflict,
+		StatusText: ErrorAborted.Error(),
+		Msg:        err.Error(),
+	}
+}
+
+func ErrQuotaExceeded(err error, usage QuotaUsage) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusTooManyRequests,
+		StatusText: ErrorQuotaExceeded.Error(),
+		Msg:        err.Error(),
+		Usage:      &usage,
+	}
+}
+
+func ErrIdempotencyKeyReused(err error) ErrRest {
+	return &ErrResponse{
+		Err:        err,
+		Status:     http.StatusUnprocessableEntity,
+		StatusText: ErrorIdempotencyKeyReused.Error(),
+		Msg:        err.Error(),
+	}
+}
+
 // Parser of error string messages ,returns RestError
 func ParseErrors(err error) ErrRest {
+	var maxBytesErr *http.MaxBytesError
+
 	switch {
 	case ent.IsNotFound(err):
 		return ErrNotFound(err)
 	case errors.Is(err, context.DeadlineExceeded):
 		return ErrRequestTimeoutError(err)
+	case errors.Is(err, privacy.Deny):
+		return ErrNotEnoughPrivileges(err)
+	case errors.As(err, &maxBytesErr):
+		return ErrRequestEntityTooLarge(err)
 	default:
 		if restErr, ok := err.(ErrRest); ok {
 			return restErr
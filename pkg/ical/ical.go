@@ -0,0 +1,116 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	timeFormat = "20060102T150405Z"
+	// Content lines are folded at 75 octets (RFC 5545 section 3.1).
+	maxLineLength = 75
+)
+
+// Event is a VEVENT of a calendar, an instant when End is zero.
+type Event struct {
+	Uid         string
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Url         string
+}
+
+// Calendar is a VCALENDAR published as a read only feed.
+type Calendar struct {
+	ProdId string
+	Name   string
+	Events []Event
+}
+
+// Write encodes the calendar as an iCalendar (RFC 5545) document.
+func (c *Calendar) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	lw := &lineWriter{w: bw}
+
+	lw.line("BEGIN", "VCALENDAR")
+	lw.line("VERSION", "2.0")
+	lw.line("PRODID", c.ProdId)
+	lw.line("CALSCALE", "GREGORIAN")
+	lw.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME", escape(c.Name))
+	}
+
+	for _, event := range c.Events {
+		lw.line("BEGIN", "VEVENT")
+		lw.line("UID", event.Uid)
+		lw.line("DTSTAMP", event.Stamp.UTC().Format(timeFormat))
+		lw.line("DTSTART", event.Start.UTC().Format(timeFormat))
+		end := event.End
+		if end.IsZero() {
+			end = event.Start
+		}
+		lw.line("DTEND", end.UTC().Format(timeFormat))
+		lw.line("SUMMARY", escape(event.Summary))
+		if event.Description != "" {
+			lw.line("DESCRIPTION", escape(event.Description))
+		}
+		if event.Url != "" {
+			lw.line("URL", event.Url)
+		}
+		lw.line("END", "VEVENT")
+	}
+
+	lw.line("END", "VCALENDAR")
+
+	if lw.err != nil {
+		return lw.err
+	}
+	return bw.Flush()
+}
+
+// escape escapes a TEXT value.
+func escape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// lineWriter writes folded content lines and keeps the first error.
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (lw *lineWriter) line(name string, value string) {
+	if lw.err != nil {
+		return
+	}
+
+	line := name + ":" + value
+	limit := maxLineLength
+	for len(line) > limit {
+		// Never split an UTF-8 sequence.
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		if _, lw.err = lw.w.WriteString(line[:cut] + "\r\n "); lw.err != nil {
+			return
+		}
+		line = line[cut:]
+		// The leading space of the continuation counts in its length.
+		limit = maxLineLength - 1
+	}
+	_, lw.err = lw.w.WriteString(line + "\r\n")
+}
@@ -0,0 +1,164 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// MaxPixels is the largest image Process decodes, to bound the memory used by
+// a single image.
+const MaxPixels = 50_000_000
+
+const jpegQuality = 85
+
+var ErrUnsupported = errors.New("unsupported image")
+
+// Supported reports whether Process can handle images of contentType.
+func Supported(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Size is a thumbnail size, the longest side of the thumbnail is at most Max pixels.
+type Size struct {
+	Name string
+	Max  int
+}
+
+type Thumbnail struct {
+	Name        string
+	Width       int
+	Height      int
+	ContentType string
+	Data        []byte
+}
+
+type Result struct {
+	Width  int
+	Height int
+	// Stripped is the original re-encoded without its metadata, nil when the
+	// original has no metadata to strip.
+	Stripped    []byte
+	Thumbnails  []Thumbnail
+	ContentType string
+}
+
+// Process decodes an image, applies its EXIF orientation and returns its
+// dimensions and thumbnails of the given sizes. Sizes which are not smaller
+// than the image are skipped. JPEG and PNG images are re-encoded without their
+// metadata (EXIF, text chunks, ...), GIF images are kept as is so that
+// animations are not lost.
+func Process(data []byte, sizes []Size) (*Result, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	if config.Width*config.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: image has more than %d pixels", ErrUnsupported, MaxPixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+
+	result := &Result{}
+
+	switch format {
+	case "jpeg":
+		img = applyOrientation(img, jpegOrientation(data))
+		result.ContentType = "image/jpeg"
+		if hasJpegMetadata(data) {
+			if result.Stripped, err = encode(img, result.ContentType); err != nil {
+				return nil, err
+			}
+		}
+	case "png":
+		result.ContentType = "image/png"
+		if hasPngMetadata(data) {
+			if result.Stripped, err = encode(img, result.ContentType); err != nil {
+				return nil, err
+			}
+		}
+	case "gif":
+		// Thumbnails of GIF images are PNG, only their first frame is kept.
+		result.ContentType = "image/png"
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, format)
+	}
+
+	bounds := img.Bounds()
+	result.Width, result.Height = bounds.Dx(), bounds.Dy()
+
+	for _, size := range sizes {
+		width, height := fit(result.Width, result.Height, size.Max)
+		if width >= result.Width && height >= result.Height {
+			continue
+		}
+
+		thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+		xdraw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), img, bounds, draw.Src, nil)
+
+		encoded, err := encode(thumbnail, result.ContentType)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Thumbnails = append(result.Thumbnails, Thumbnail{
+			Name:        size.Name,
+			Width:       width,
+			Height:      height,
+			ContentType: result.ContentType,
+			Data:        encoded,
+		})
+	}
+
+	return result, nil
+}
+
+// fit returns the dimensions of an image of width x height scaled down so that
+// its longest side is at most max, keeping its aspect ratio.
+func fit(width, height, max int) (int, int) {
+	if width <= max && height <= max {
+		return width, height
+	}
+	if width >= height {
+		return max, maxInt(1, height*max/width)
+	}
+	return maxInt(1, width*max/height), max
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func encode(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case "image/png":
+		err = png.Encode(&buf, img)
+	default:
+		err = fmt.Errorf("%w: can not encode %s", ErrUnsupported, contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
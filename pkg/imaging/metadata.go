@@ -0,0 +1,158 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+// jpegOrientation returns the EXIF orientation (1 to 8) of a JPEG image, 1
+// when it has none.
+func jpegOrientation(data []byte) int {
+	for _, segment := range jpegSegments(data) {
+		if segment.marker != 0xe1 || !bytes.HasPrefix(segment.data, []byte("Exif\x00\x00")) {
+			continue
+		}
+
+		tiff := segment.data[6:]
+		if len(tiff) < 8 {
+			return 1
+		}
+
+		var order binary.ByteOrder
+		switch string(tiff[:2]) {
+		case "II":
+			order = binary.LittleEndian
+		case "MM":
+			order = binary.BigEndian
+		default:
+			return 1
+		}
+
+		ifd := int(order.Uint32(tiff[4:8]))
+		if ifd < 0 || ifd+2 > len(tiff) {
+			return 1
+		}
+
+		count := int(order.Uint16(tiff[ifd:]))
+		for i := 0; i < count; i++ {
+			entry := ifd + 2 + i*12
+			if entry+12 > len(tiff) {
+				return 1
+			}
+			if order.Uint16(tiff[entry:]) == 0x0112 {
+				orientation := int(order.Uint16(tiff[entry+8:]))
+				if orientation < 1 || orientation > 8 {
+					return 1
+				}
+				return orientation
+			}
+		}
+		return 1
+	}
+	return 1
+}
+
+// hasJpegMetadata reports whether a JPEG image has APP1 to APP15 (EXIF, XMP,
+// ICC profile, ...) or comment segments.
+func hasJpegMetadata(data []byte) bool {
+	for _, segment := range jpegSegments(data) {
+		if (segment.marker >= 0xe1 && segment.marker <= 0xef) || segment.marker == 0xfe {
+			return true
+		}
+	}
+	return false
+}
+
+type jpegSegment struct {
+	marker byte
+	data   []byte
+}
+
+// jpegSegments returns the segments of a JPEG image up to the start of scan.
+func jpegSegments(data []byte) []jpegSegment {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return nil
+	}
+
+	var segments []jpegSegment
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			break
+		}
+		marker := data[i+1]
+		if marker == 0xda || marker == 0xd9 {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			break
+		}
+		segments = append(segments, jpegSegment{marker: marker, data: data[i+4 : i+2+length]})
+		i += 2 + length
+	}
+	return segments
+}
+
+// hasPngMetadata reports whether a PNG image has EXIF, text or time chunks.
+func hasPngMetadata(data []byte) bool {
+	const signatureSize = 8
+
+	for i := signatureSize; i+8 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		switch string(data[i+4 : i+8]) {
+		case "eXIf", "tEXt", "zTXt", "iTXt", "tIME":
+			return true
+		case "IDAT", "IEND":
+			// Metadata after the image data is allowed but rare, an image
+			// without metadata ahead of it is kept as is.
+			return false
+		}
+		if length < 0 || i+12+length > len(data) {
+			return false
+		}
+		i += 12 + length
+	}
+	return false
+}
+
+// applyOrientation returns img rotated and flipped as described by an EXIF
+// orientation, so that it is displayed upright without its metadata.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
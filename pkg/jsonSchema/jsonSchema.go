@@ -0,0 +1,124 @@
+package jsonSchema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const resourceUrl = "metadata.json"
+
+// Schema is a compiled JSON Schema.
+type Schema struct {
+	schema *jsonschema.Schema
+}
+
+// Error is a constraint a value does not match, Path is the JSONPath of the
+// failing value ("$" for the value itself).
+type Error struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// Compile compiles a JSON Schema, draft 2020-12 unless $schema says otherwise.
+// References to other documents are never loaded.
+func Compile(definition map[string]interface{}) (*Schema, error) {
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("can not load %q, only local references are supported", url)
+	}
+
+	if err := compiler.AddResource(resourceUrl, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	schema, err := compiler.Compile(resourceUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{schema: schema}, nil
+}
+
+// Validate returns the errors of value, nil if it matches the schema. value
+// must hold the types decoded by encoding/json.
+func (s *Schema) Validate(value interface{}) []Error {
+	err := s.schema.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []Error{{Path: "$", Message: err.Error()}}
+	}
+
+	errs := make([]Error, 0)
+	collectErrors(validationErr, &errs)
+	return errs
+}
+
+// collectErrors appends the innermost errors, the outer ones only say that a
+// sub schema did not match.
+func collectErrors(err *jsonschema.ValidationError, errs *[]Error) {
+	if len(err.Causes) == 0 {
+		*errs = append(*errs, Error{Path: Path(err.InstanceLocation), Message: err.Message})
+		return
+	}
+	for _, cause := range err.Causes {
+		collectErrors(cause, errs)
+	}
+}
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Path converts a JSON Pointer into a JSONPath, "/tags/0" is "$.tags[0]".
+func Path(pointer string) string {
+	var b strings.Builder
+	b.WriteString("$")
+
+	if pointer == "" {
+		return b.String()
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		if _, err := strconv.ParseUint(token, 10, 64); err == nil {
+			b.WriteString("[" + token + "]")
+		} else if identifierRegexp.MatchString(token) {
+			b.WriteString("." + token)
+		} else {
+			b.WriteString("[" + strconv.Quote(token) + "]")
+		}
+	}
+
+	return b.String()
+}
+
+// PropertyType returns the type of a top level property of a schema, or "" if
+// the property is not declared or does not have a single type.
+func PropertyType(definition map[string]interface{}, key string) string {
+	properties, ok := definition["properties"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	property, ok := properties[key].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	propertyType, _ := property["type"].(string)
+	return propertyType
+}
@@ -0,0 +1,438 @@
+package listQuery
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+type Op string
+
+const (
+	OpEQ       Op = "="
+	OpNEQ      Op = "!="
+	OpContains Op = "~"
+	OpGT       Op = ">"
+	OpGTE      Op = ">="
+	OpLT       Op = "<"
+	OpLTE      Op = "<="
+)
+
+// Longest operators first so that ">=" is not read as ">".
+var ops = []Op{OpNEQ, OpGTE, OpLTE, OpEQ, OpContains, OpGT, OpLT}
+
+type Kind int
+
+const (
+	KindString Kind = iota
+	KindUint
+	KindBool
+	KindTime
+)
+
+// Field describes a column that can be used in a list query.
+type Field struct {
+	Kind     Kind
+	Sortable bool
+}
+
+// Fields is the whitelist of columns a list query may reference.
+type Fields map[string]Field
+
+// With returns a copy of f with the fields of more added.
+func (f Fields) With(more Fields) Fields {
+	fields := make(Fields, len(f)+len(more))
+	for name, field := range f {
+		fields[name] = field
+	}
+	for name, field := range more {
+		fields[name] = field
+	}
+	return fields
+}
+
+type Filter struct {
+	Field string
+	Op    Op
+	Value string
+}
+
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+const (
+	// DefaultLimit is the page size when the limit is missing.
+	DefaultLimit = 50
+	// MaxLimit is the largest page size, larger limits are clamped to it.
+	MaxLimit = 100
+)
+
+// Paginate returns offset and limit made valid: a negative offset reads from
+// the first row, a missing limit is DefaultLimit and a limit above MaxLimit is
+// MaxLimit.
+func Paginate(offset, limit int) (int, int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	return offset, limit
+}
+
+// Cursor points at a row by the value of the sort key and the row id.
+type Cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v,omitempty"`
+	Id    uint   `json:"id"`
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(raw string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid cursor"))
+	}
+
+	cursor := &Cursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid cursor"))
+	}
+	return cursor, nil
+}
+
+type Query struct {
+	Filters   []Filter
+	Sorts     []Sort
+	Limit     int
+	Offset    int
+	After     *Cursor
+	Before    *Cursor
+	WithTotal bool
+	// Params holds the endpoint specific parameters passed to Parse, they are
+	// not read as filters.
+	Params url.Values
+}
+
+// Parse reads `filter=field<op>value` (repeatable), `sort=field,-field`,
+// `field=value` and the pagination parameters `limit`, `offset`, `after`,
+// `before` and `total`, see Paginate for the limits. The values of params are
+// copied to Query.Params.
+func Parse(values url.Values, params ...string) (*Query, error) {
+	query := &Query{Params: url.Values{}}
+
+	skip := map[string]bool{
+		"filter": true,
+		"sort":   true,
+		"limit":  true,
+		"offset": true,
+		"after":  true,
+		"before": true,
+		"total":  true,
+	}
+
+	for _, param := range params {
+		skip[param] = true
+		if vals, ok := values[param]; ok {
+			query.Params[param] = vals
+		}
+	}
+
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("invalid limit %q", raw))
+		}
+		query.Limit = limit
+	}
+
+	if raw := values.Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("invalid offset %q", raw))
+		}
+		query.Offset = offset
+	}
+
+	query.Offset, query.Limit = Paginate(query.Offset, query.Limit)
+
+	if raw := values.Get("after"); raw != "" {
+		cursor, err := DecodeCursor(raw)
+		if err != nil {
+			return nil, err
+		}
+		query.After = cursor
+	}
+
+	if raw := values.Get("before"); raw != "" {
+		cursor, err := DecodeCursor(raw)
+		if err != nil {
+			return nil, err
+		}
+		query.Before = cursor
+	}
+
+	if query.After != nil && query.Before != nil {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("after and before can not be used together"))
+	}
+	if (query.After != nil || query.Before != nil) && query.Offset > 0 {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("offset can not be used together with a cursor"))
+	}
+
+	if raw := values.Get("total"); raw != "" {
+		withTotal, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("invalid total %q", raw))
+		}
+		query.WithTotal = withTotal
+	}
+
+	for _, raw := range values["filter"] {
+		filter, err := parseFilter(raw)
+		if err != nil {
+			return nil, err
+		}
+		query.Filters = append(query.Filters, *filter)
+	}
+
+	for key, vals := range values {
+		if skip[key] {
+			continue
+		}
+		for _, val := range vals {
+			query.Filters = append(query.Filters, Filter{Field: key, Op: OpEQ, Value: val})
+		}
+	}
+
+	for _, raw := range values["sort"] {
+		for _, field := range strings.Split(raw, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			if strings.HasPrefix(field, "-") {
+				query.Sorts = append(query.Sorts, Sort{Field: field[1:], Desc: true})
+			} else {
+				query.Sorts = append(query.Sorts, Sort{Field: strings.TrimPrefix(field, "+")})
+			}
+		}
+	}
+
+	return query, nil
+}
+
+func parseFilter(raw string) (*Filter, error) {
+	idx := strings.IndexAny(raw, "=!~<>")
+	if idx <= 0 {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid filter %q", raw))
+	}
+
+	for _, op := range ops {
+		if strings.HasPrefix(raw[idx:], string(op)) {
+			return &Filter{
+				Field: raw[:idx],
+				Op:    op,
+				Value: raw[idx+len(op):],
+			}, nil
+		}
+	}
+
+	return nil, httpErrors.ErrValidation(fmt.Errorf("invalid filter operator in %q", raw))
+}
+
+// Predicates converts the filters into sql predicates, rejecting fields that
+// are not whitelisted.
+func (q *Query) Predicates(fields Fields) ([]func(*sql.Selector), error) {
+	if q == nil {
+		return nil, nil
+	}
+
+	predicates := make([]func(*sql.Selector), 0, len(q.Filters))
+	for _, filter := range q.Filters {
+		field, ok := fields[filter.Field]
+		if !ok {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("can not filter by field %q", filter.Field))
+		}
+
+		value, err := parseValue(field.Kind, filter.Value)
+		if err != nil {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("invalid value for field %q: %w", filter.Field, err))
+		}
+
+		if field.Kind == KindBool && filter.Op != OpEQ && filter.Op != OpNEQ {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("operator %q not supported for field %q", filter.Op, filter.Field))
+		}
+		if filter.Op == OpContains && field.Kind != KindString {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("operator %q not supported for field %q", filter.Op, filter.Field))
+		}
+
+		switch filter.Op {
+		case OpEQ:
+			predicates = append(predicates, sql.FieldEQ(filter.Field, value))
+		case OpNEQ:
+			predicates = append(predicates, sql.FieldNEQ(filter.Field, value))
+		case OpContains:
+			predicates = append(predicates, sql.FieldContainsFold(filter.Field, filter.Value))
+		case OpGT:
+			predicates = append(predicates, sql.FieldGT(filter.Field, value))
+		case OpGTE:
+			predicates = append(predicates, sql.FieldGTE(filter.Field, value))
+		case OpLT:
+			predicates = append(predicates, sql.FieldLT(filter.Field, value))
+		case OpLTE:
+			predicates = append(predicates, sql.FieldLTE(filter.Field, value))
+		}
+	}
+
+	return predicates, nil
+}
+
+// Orders converts the sorts into order options. The id column is always
+// appended as a tie breaker so that pages are stable. When paginating
+// backwards the order is reversed, NewPage restores it.
+func (q *Query) Orders(fields Fields) ([]func(*sql.Selector), error) {
+	var sorts []Sort
+	backwards := false
+	if q != nil {
+		sorts = q.Sorts
+		backwards = q.Before != nil
+	}
+
+	orders := make([]func(*sql.Selector), 0, len(sorts)+1)
+	hasId := false
+	for _, sort := range sorts {
+		field, ok := fields[sort.Field]
+		if !ok || !field.Sortable {
+			return nil, httpErrors.ErrValidation(fmt.Errorf("can not sort by field %q", sort.Field))
+		}
+
+		orders = append(orders, orderBy(sort.Field, sort.Desc != backwards))
+
+		if sort.Field == "id" {
+			hasId = true
+		}
+	}
+
+	if !hasId {
+		desc := len(sorts) > 0 && sorts[0].Desc
+		orders = append(orders, orderBy("id", desc != backwards))
+	}
+
+	return orders, nil
+}
+
+// CursorPredicate returns the keyset predicate for the after or before
+// cursor, or nil when the query has no cursor.
+func (q *Query) CursorPredicate(fields Fields) (func(*sql.Selector), error) {
+	if q == nil || (q.After == nil && q.Before == nil) {
+		return nil, nil
+	}
+
+	cursor := q.After
+	if q.Before != nil {
+		cursor = q.Before
+	}
+
+	key, ok := q.keyset()
+	if !ok {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("cursor pagination supports a single sort field"))
+	}
+	if cursor.Sort != key.String() {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("cursor does not match sort %q", key.String()))
+	}
+
+	compare := sql.GT
+	if key.Desc != (q.Before != nil) {
+		compare = sql.LT
+	}
+
+	if key.Field == "id" {
+		return func(s *sql.Selector) {
+			s.Where(compare(s.C("id"), cursor.Id))
+		}, nil
+	}
+
+	field, ok := fields[key.Field]
+	if !ok || !field.Sortable {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("can not sort by field %q", key.Field))
+	}
+
+	value, err := parseValue(field.Kind, cursor.Value)
+	if err != nil {
+		return nil, httpErrors.ErrValidation(fmt.Errorf("invalid cursor"))
+	}
+
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			compare(s.C(key.Field), value),
+			sql.And(
+				sql.EQ(s.C(key.Field), value),
+				compare(s.C("id"), cursor.Id),
+			),
+		))
+	}, nil
+}
+
+// keyset returns the sort key used by cursors. Only a single sort field,
+// optionally followed by id in the same direction, can be paginated by
+// cursor.
+func (q *Query) keyset() (Sort, bool) {
+	var sorts []Sort
+	if q != nil {
+		sorts = q.Sorts
+	}
+
+	switch {
+	case len(sorts) == 0:
+		return Sort{Field: "id"}, true
+	case len(sorts) == 1:
+		return sorts[0], true
+	case len(sorts) == 2 && sorts[1].Field == "id" && sorts[1].Desc == sorts[0].Desc:
+		return sorts[0], true
+	default:
+		return Sort{}, false
+	}
+}
+
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+	return s.Field
+}
+
+func orderBy(field string, desc bool) func(*sql.Selector) {
+	if desc {
+		return sql.OrderByField(field, sql.OrderDesc()).ToFunc()
+	}
+	return sql.OrderByField(field, sql.OrderAsc()).ToFunc()
+}
+
+func parseValue(kind Kind, value string) (interface{}, error) {
+	switch kind {
+	case KindUint:
+		return strconv.ParseUint(value, 10, 64)
+	case KindBool:
+		return strconv.ParseBool(value)
+	case KindTime:
+		return time.Parse(time.RFC3339Nano, value)
+	default:
+		return value, nil
+	}
+}
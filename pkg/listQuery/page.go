@@ -0,0 +1,91 @@
+package listQuery
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+	Total      *int
+}
+
+// NewPage builds a page from rows fetched with a limit of q.Limit+1. The
+// extra row only tells whether there is another page and is dropped. key
+// returns the value of the sort field and the id of a row.
+func NewPage[T any](q *Query, rows []T, key func(row T, field string) (string, uint)) *Page[T] {
+	hasMore := len(rows) > q.Limit
+	if hasMore {
+		rows = rows[:q.Limit]
+	}
+
+	backwards := q.Before != nil
+	if backwards {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	page := &Page[T]{Items: rows}
+
+	sort, ok := q.keyset()
+	if !ok || len(rows) == 0 {
+		return page
+	}
+
+	cursor := func(row T) string {
+		value, id := key(row, sort.Field)
+		if sort.Field == "id" {
+			value = ""
+		}
+		return Cursor{Sort: sort.String(), Value: value, Id: id}.Encode()
+	}
+
+	if hasMore || backwards {
+		page.NextCursor = cursor(rows[len(rows)-1])
+	}
+	if (backwards && hasMore) || q.After != nil || q.Offset > 0 {
+		page.PrevCursor = cursor(rows[0])
+	}
+
+	return page
+}
+
+// MapPage converts the items of a page keeping the cursors and total.
+func MapPage[T, U any](page *Page[T], mapItems func([]T) []U) *Page[U] {
+	return &Page[U]{
+		Items:      mapItems(page.Items),
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		Total:      page.Total,
+	}
+}
+
+// LinkHeader returns the RFC 5988 Link header for the next and previous
+// pages of a list request.
+func LinkHeader(u *url.URL, nextCursor, prevCursor string) string {
+	links := make([]string, 0, 2)
+
+	link := func(param, cursor, rel string) string {
+		values := u.Query()
+		values.Del("after")
+		values.Del("before")
+		values.Del("offset")
+		values.Set(param, cursor)
+
+		target := url.URL{Path: u.Path, RawQuery: values.Encode()}
+		return fmt.Sprintf("<%s>; rel=\"%s\"", target.String(), rel)
+	}
+
+	if nextCursor != "" {
+		links = append(links, link("after", nextCursor, "next"))
+	}
+	if prevCursor != "" {
+		links = append(links, link("before", prevCursor, "prev"))
+	}
+
+	return strings.Join(links, ", ")
+}
@@ -0,0 +1,76 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/httpErrors"
+)
+
+const (
+	// MergePatchContentType is the media type of JSON Merge Patch (RFC 7396).
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType is the media type of JSON Patch (RFC 6902).
+	JSONPatchContentType = "application/json-patch+json"
+)
+
+// Apply applies the patch in the body of the request to the JSON document of
+// original and decodes the patched document into target. The patch format is
+// picked by the Content-Type of the request.
+//
+// A merge patch removes the members set to null, and a JSON Patch "remove"
+// removes the member, so target gets the zero value of those fields.
+func Apply(r *http.Request, original interface{}, target interface{}) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return httpErrors.ErrUnsupportedMediaType(errors.New("missing or invalid Content-Type"))
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return httpErrors.ErrBadRequest(err)
+	}
+
+	doc, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	switch mediaType {
+	case MergePatchContentType:
+		patched, err = jsonpatch.MergePatch(doc, body)
+		if err != nil {
+			return httpErrors.ErrBadRequest(fmt.Errorf("invalid merge patch: %w", err))
+		}
+	case JSONPatchContentType:
+		operations, err := jsonpatch.DecodePatch(body)
+		if err != nil {
+			return httpErrors.ErrBadRequest(fmt.Errorf("invalid json patch: %w", err))
+		}
+		patched, err = operations.Apply(doc)
+		if err != nil {
+			// Failed "test" operations and missing paths are semantic errors of
+			// the patch, not of its syntax.
+			return httpErrors.ErrValidation(fmt.Errorf("can not apply json patch: %w", err))
+		}
+	default:
+		return httpErrors.ErrUnsupportedMediaType(
+			fmt.Errorf("Content-Type must be %s or %s", MergePatchContentType, JSONPatchContentType),
+		)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return httpErrors.ErrValidation(fmt.Errorf("invalid patched document: %w", err))
+	}
+
+	return nil
+}
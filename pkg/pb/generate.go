@@ -0,0 +1,3 @@
+package pb
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=github.com/hiennguyen9874/go-boilerplate-v2 --go-grpc_out=../.. --go-grpc_opt=module=github.com/hiennguyen9874/go-boilerplate-v2 --grpc-gateway_out=../.. --grpc-gateway_opt=module=github.com/hiennguyen9874/go-boilerplate-v2 boilerplate/v1/common.proto boilerplate/v1/auth.proto boilerplate/v1/users.proto boilerplate/v1/items.proto
@@ -0,0 +1,50 @@
+package pb
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Values returns the options as the query parameters of a REST list, to be
+// parsed with listQuery.Parse.
+func (o *ListOptions) Values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+
+	for key, value := range o.GetParams() {
+		values.Set(key, value)
+	}
+	for _, filter := range o.GetFilter() {
+		values.Add("filter", filter)
+	}
+	if o.GetSort() != "" {
+		values.Set("sort", o.GetSort())
+	}
+	if o.GetLimit() != 0 {
+		values.Set("limit", strconv.Itoa(int(o.GetLimit())))
+	}
+	if o.GetOffset() != 0 {
+		values.Set("offset", strconv.Itoa(int(o.GetOffset())))
+	}
+	if o.GetAfter() != "" {
+		values.Set("after", o.GetAfter())
+	}
+	if o.GetBefore() != "" {
+		values.Set("before", o.GetBefore())
+	}
+	if o.GetTotal() {
+		values.Set("total", "true")
+	}
+	return values
+}
+
+func CreatePageInfo(nextCursor, prevCursor string, total *int) *PageInfo {
+	page := &PageInfo{NextCursor: nextCursor, PrevCursor: prevCursor}
+	if total != nil {
+		count := int64(*total)
+		page.Total = &count
+	}
+	return page
+}
@@ -23,6 +23,25 @@ func CreateErrorResponse(err error) render.Renderer {
 			Status:     parsedErr.GetStatus(),
 			StatusText: parsedErr.GetStatusText(),
 			Msg:        parsedErr.GetMsg(),
+			Usage:      parsedErr.GetUsage(),
+		},
+		IsSuccess: false,
+	}
+}
+
+// CreateErrorResponseWithData is CreateErrorResponse with a payload, e.g. the
+// current representation of a resource when a conditional update failed.
+func CreateErrorResponseWithData[D any](err error, data D) render.Renderer {
+	parsedErr := httpErrors.ParseErrors(err)
+
+	return &Response[D]{
+		Data: data,
+		Error: &httpErrors.ErrResponse{
+			Err:        parsedErr.GetErr(),
+			Status:     parsedErr.GetStatus(),
+			StatusText: parsedErr.GetStatusText(),
+			Msg:        parsedErr.GetMsg(),
+			Usage:      parsedErr.GetUsage(),
 		},
 		IsSuccess: false,
 	}
@@ -0,0 +1,12 @@
+package responses
+
+type PageResponse[D any] struct {
+	Items      []D    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	Total      *int   `json:"total,omitempty"`
+}
+
+func CreatePageResponse[D any](items []D, nextCursor string, prevCursor string, total *int) PageResponse[D] {
+	return PageResponse[D]{Items: items, NextCursor: nextCursor, PrevCursor: prevCursor, Total: total}
+}
@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+)
+
+// LocalStorage stores the objects as files under a directory. Presigned URLs
+// are signed with HMAC-SHA256 and served by ServeHTTP.
+type LocalStorage struct {
+	root      string
+	baseUrl   string
+	secretKey []byte
+}
+
+func NewLocalStorage(cfg *config.Config) (*LocalStorage, error) {
+	if cfg.Storage.LocalSecretKey == "" {
+		return nil, errors.New("storage local secret key is required")
+	}
+
+	root, err := filepath.Abs(cfg.Storage.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		root:      root,
+		baseUrl:   cfg.Storage.LocalBaseUrl,
+		secretKey: []byte(cfg.Storage.LocalSecretKey),
+	}, nil
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a failed upload never leaves a
+	// partial object behind.
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close() //nolint:errcheck
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *LocalStorage) PresignGet(ctx context.Context, key string, expires time.Duration, filename string, contentType string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set("key", key)
+	values.Set("filename", filename)
+	values.Set("content_type", contentType)
+	values.Set("expires", strconv.FormatInt(time.Now().Add(expires).Unix(), 10))
+	values.Set("signature", hex.EncodeToString(s.sign(values)))
+
+	return s.baseUrl + "?" + values.Encode(), nil
+}
+
+func (s *LocalStorage) sign(values url.Values) []byte {
+	mac := hmac.New(sha256.New, s.secretKey)
+	for _, name := range []string{"key", "filename", "content_type", "expires"} {
+		mac.Write([]byte(values.Get(name)))
+		mac.Write([]byte{0})
+	}
+	return mac.Sum(nil)
+}
+
+// ServeHTTP serves the downloads of the URLs returned by PresignGet.
+func (s *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+
+	signature, err := hex.DecodeString(values.Get("signature"))
+	if err != nil || !hmac.Equal(signature, s.sign(values)) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	expires, err := strconv.ParseInt(values.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		http.Error(w, "url expired", http.StatusForbidden)
+		return
+	}
+
+	name, err := s.path(values.Get("key"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", values.Get("content_type"))
+	w.Header().Set("Content-Disposition", ContentDisposition(values.Get("filename")))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+// ContentDisposition returns the Content-Disposition header value to download
+// a file named filename.
+func ContentDisposition(filename string) string {
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+	if disposition == "" {
+		return "attachment"
+	}
+	return disposition
+}
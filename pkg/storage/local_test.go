@@ -0,0 +1,156 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
+)
+
+// newLocalStorage returns a local storage under a temporary directory, its
+// presigned URLs are served by a test server.
+func newLocalStorage(t *testing.T) (*storage.LocalStorage, string) {
+	t.Helper()
+
+	var handler http.Handler
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	root := filepath.Join(t.TempDir(), "storage")
+	s, err := storage.NewLocalStorage(&config.Config{
+		Storage: config.StorageConfig{
+			LocalPath:      root,
+			LocalBaseUrl:   server.URL + "/storage",
+			LocalSecretKey: "secret",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler = s
+
+	return s, root
+}
+
+func TestLocalStorage(t *testing.T) {
+	s, _ := newLocalStorage(t)
+
+	testStorage(t, s, http.Get)
+}
+
+func TestNewLocalStorageWithoutSecretKey(t *testing.T) {
+	_, err := storage.NewLocalStorage(&config.Config{
+		Storage: config.StorageConfig{LocalPath: t.TempDir()},
+	})
+	if err == nil {
+		t.Fatal("want an error without secret key")
+	}
+}
+
+func TestLocalStorageTraversal(t *testing.T) {
+	s, root := newLocalStorage(t)
+	ctx := context.Background()
+
+	s.Put(ctx, "../escape", strings.NewReader("x"), 1, "text/plain") //nolint:errcheck
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(root), "escape")); !os.IsNotExist(err) {
+		t.Fatalf("a file was written outside the root: %v", err)
+	}
+}
+
+func TestLocalStoragePresignRejected(t *testing.T) {
+	s, _ := newLocalStorage(t)
+	ctx := context.Background()
+
+	if err := s.Put(ctx, "items/1/object", strings.NewReader("secret"), -1, "text/plain"); err != nil {
+		t.Fatal(err)
+	}
+
+	presign := func(t *testing.T, expires time.Duration) url.Values {
+		t.Helper()
+		raw, err := s.PresignGet(ctx, "items/1/object", expires, "object.txt", "text/plain")
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u.Query()
+	}
+
+	tests := []struct {
+		name   string
+		values func(t *testing.T) url.Values
+		status int
+	}{
+		{
+			name: "expired",
+			values: func(t *testing.T) url.Values {
+				return presign(t, -time.Minute)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "other key",
+			values: func(t *testing.T) url.Values {
+				values := presign(t, time.Minute)
+				values.Set("key", "items/2/object")
+				return values
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "other content type",
+			values: func(t *testing.T) url.Values {
+				values := presign(t, time.Minute)
+				values.Set("content_type", "text/html")
+				return values
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "no signature",
+			values: func(t *testing.T) url.Values {
+				values := presign(t, time.Minute)
+				values.Del("signature")
+				return values
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "valid",
+			values: func(t *testing.T) url.Values {
+				return presign(t, time.Minute)
+			},
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/storage?"+tt.values(t).Encode(), nil)
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.status == http.StatusOK && w.Header().Get("X-Content-Type-Options") != "nosniff" {
+				t.Error("want X-Content-Type-Options nosniff")
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3PartSize is the size of the parts of the multipart uploads of unknown
+// size, which are buffered in memory.
+const s3PartSize = 16 << 20
+
+// S3Storage stores the objects in a bucket of an S3 compatible server, such
+// as AWS S3 or MinIO.
+type S3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3Storage(ctx context.Context, cfg *config.Config) (*S3Storage, error) {
+	client, err := minio.New(cfg.Storage.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.Storage.S3AccessKey, cfg.Storage.S3SecretKey, ""),
+		Secure: cfg.Storage.S3UseSsl,
+		Region: cfg.Storage.S3Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Storage.S3Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		err = client.MakeBucket(ctx, cfg.Storage.S3Bucket, minio.MakeBucketOptions{Region: cfg.Storage.S3Region})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &S3Storage{
+		client: client,
+		bucket: cfg.Storage.S3Bucket,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    s3PartSize,
+	})
+	return err
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// GetObject is lazy, Stat sends the request to find out whether the object exists.
+	if _, err := obj.Stat(); err != nil {
+		obj.Close() //nolint:errcheck
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3Storage) PresignGet(ctx context.Context, key string, expires time.Duration, filename string, contentType string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response-content-disposition", ContentDisposition(filename))
+	params.Set("response-content-type", contentType)
+
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, key, expires, params)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
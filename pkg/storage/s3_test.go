@@ -0,0 +1,90 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// The S3 tests run against the server of TEST_S3_ENDPOINT, e.g. a MinIO
+// started with
+//
+//	docker run -p 9000:9000 minio/minio server /data
+//
+// and TEST_S3_ACCESS_KEY and TEST_S3_SECRET_KEY, minioadmin by default. They
+// are skipped when TEST_S3_ENDPOINT is not set. Every test uses a bucket of
+// its own, removed at the end.
+const (
+	s3EndpointEnv  = "TEST_S3_ENDPOINT"
+	s3AccessKeyEnv = "TEST_S3_ACCESS_KEY"
+	s3SecretKeyEnv = "TEST_S3_SECRET_KEY"
+)
+
+func getenv(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func newS3Storage(t *testing.T) *storage.S3Storage {
+	t.Helper()
+
+	endpoint := os.Getenv(s3EndpointEnv)
+	if endpoint == "" {
+		t.Skipf("%s is not set", s3EndpointEnv)
+	}
+
+	cfg := &config.Config{
+		Storage: config.StorageConfig{
+			S3Endpoint:  endpoint,
+			S3AccessKey: getenv(s3AccessKeyEnv, "minioadmin"),
+			S3SecretKey: getenv(s3SecretKeyEnv, "minioadmin"),
+			S3Bucket:    fmt.Sprintf("test-%d", time.Now().UnixNano()),
+			S3Region:    "us-east-1",
+		},
+	}
+
+	ctx := context.Background()
+
+	s, err := storage.NewS3Storage(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := minio.New(cfg.Storage.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.Storage.S3AccessKey, cfg.Storage.S3SecretKey, ""),
+		Region: cfg.Storage.S3Region,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		for obj := range client.ListObjects(ctx, cfg.Storage.S3Bucket, minio.ListObjectsOptions{Recursive: true}) {
+			if obj.Err != nil {
+				t.Errorf("failed to list bucket %s: %v", cfg.Storage.S3Bucket, obj.Err)
+				return
+			}
+			client.RemoveObject(ctx, cfg.Storage.S3Bucket, obj.Key, minio.RemoveObjectOptions{}) //nolint:errcheck
+		}
+		if err := client.RemoveBucket(ctx, cfg.Storage.S3Bucket); err != nil {
+			t.Errorf("failed to remove bucket %s: %v", cfg.Storage.S3Bucket, err)
+		}
+	})
+
+	return s
+}
+
+func TestS3Storage(t *testing.T) {
+	s := newS3Storage(t)
+
+	testStorage(t, s, http.Get)
+}
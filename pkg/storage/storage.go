@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/config"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")
+	ErrInvalidKey = errors.New("invalid object key")
+)
+
+// Storage is a blob storage, objects are addressed by a key such as
+// "items/1/9f86d081884c7d65".
+type Storage interface {
+	// Put stores the content of r under key. size is -1 when it is unknown.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get returns the content of key, or ErrNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// PresignGet returns an URL to download key without authentication until
+	// expires. The download is served as an attachment named filename.
+	PresignGet(ctx context.Context, key string, expires time.Duration, filename string, contentType string) (string, error)
+}
+
+// NewStorage returns the storage of the configured driver.
+func NewStorage(ctx context.Context, cfg *config.Config) (Storage, error) {
+	switch cfg.Storage.Driver {
+	case "local":
+		return NewLocalStorage(cfg)
+	case "s3":
+		return NewS3Storage(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+}
+
+// checkKey checks that key is a clean relative path, so that it can not
+// escape the root directory or the bucket prefix with ".." or "/".
+func checkKey(key string) error {
+	clean := path.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		return ErrInvalidKey
+	}
+	return nil
+}
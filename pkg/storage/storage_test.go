@@ -0,0 +1,174 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiennguyen9874/go-boilerplate-v2/pkg/storage"
+)
+
+// invalidKeys are keys which could escape the root directory or the bucket
+// prefix, every driver must reject them.
+var invalidKeys = []string{
+	"",
+	"/",
+	"/items/1/abs",
+	"../escape",
+	"items/../../escape",
+	"items/./1",
+	"items//1",
+	"items/1/",
+	"..",
+}
+
+// testStorage runs the tests every driver must pass. get downloads a
+// presigned URL.
+func testStorage(t *testing.T, s storage.Storage, get func(url string) (*http.Response, error)) {
+	ctx := context.Background()
+
+	put := func(t *testing.T, key, content string, size int64) {
+		t.Helper()
+		if err := s.Put(ctx, key, strings.NewReader(content), size, "text/plain"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read := func(t *testing.T, key string) (string, error) {
+		t.Helper()
+		r, err := s.Get(ctx, key)
+		if err != nil {
+			return "", err
+		}
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content), nil
+	}
+
+	t.Run("put and get", func(t *testing.T) {
+		put(t, "items/1/known", "known size", int64(len("known size")))
+		put(t, "items/1/unknown", "unknown size", -1)
+
+		for key, want := range map[string]string{
+			"items/1/known":   "known size",
+			"items/1/unknown": "unknown size",
+		} {
+			got, err := read(t, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("got %q for %s, want %q", got, key, want)
+			}
+		}
+	})
+
+	t.Run("put replaces", func(t *testing.T) {
+		put(t, "items/2/object", "first", -1)
+		put(t, "items/2/object", "second", -1)
+
+		got, err := read(t, "items/2/object")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "second" {
+			t.Errorf("got %q, want %q", got, "second")
+		}
+	})
+
+	t.Run("get missing", func(t *testing.T) {
+		if _, err := read(t, "items/3/missing"); !errors.Is(err, storage.ErrNotFound) {
+			t.Fatalf("got %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		put(t, "items/4/object", "deleted", -1)
+
+		if err := s.Delete(ctx, "items/4/object"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := read(t, "items/4/object"); !errors.Is(err, storage.ErrNotFound) {
+			t.Fatalf("got %v after delete, want ErrNotFound", err)
+		}
+
+		// Deleting is idempotent.
+		if err := s.Delete(ctx, "items/4/object"); err != nil {
+			t.Fatalf("got %v deleting a missing object", err)
+		}
+	})
+
+	t.Run("presign", func(t *testing.T) {
+		put(t, "items/5/object", "presigned", -1)
+
+		url, err := s.PresignGet(ctx, "items/5/object", time.Minute, "report 1.txt", "text/plain")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("got status %d: %s", resp.StatusCode, body)
+		}
+		if !bytes.Equal(body, []byte("presigned")) {
+			t.Errorf("got %q, want %q", body, "presigned")
+		}
+		if got := resp.Header.Get("Content-Type"); got != "text/plain" {
+			t.Errorf("got Content-Type %q", got)
+		}
+		if got, want := resp.Header.Get("Content-Disposition"), storage.ContentDisposition("report 1.txt"); got != want {
+			t.Errorf("got Content-Disposition %q, want %q", got, want)
+		}
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range invalidKeys {
+			if err := s.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"); !errors.Is(err, storage.ErrInvalidKey) {
+				t.Errorf("Put(%q) got %v, want ErrInvalidKey", key, err)
+			}
+			if _, err := s.Get(ctx, key); !errors.Is(err, storage.ErrInvalidKey) {
+				t.Errorf("Get(%q) got %v, want ErrInvalidKey", key, err)
+			}
+			if err := s.Delete(ctx, key); !errors.Is(err, storage.ErrInvalidKey) {
+				t.Errorf("Delete(%q) got %v, want ErrInvalidKey", key, err)
+			}
+			if _, err := s.PresignGet(ctx, key, time.Minute, "x", "text/plain"); !errors.Is(err, storage.ErrInvalidKey) {
+				t.Errorf("PresignGet(%q) got %v, want ErrInvalidKey", key, err)
+			}
+		}
+	})
+}
+
+func TestContentDisposition(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{filename: "report.pdf", want: `attachment; filename=report.pdf`},
+		{filename: "my report.pdf", want: `attachment; filename="my report.pdf"`},
+		{filename: "rapport été.pdf", want: `attachment; filename*=utf-8''rapport%20%C3%A9t%C3%A9.pdf`},
+	}
+
+	for _, tt := range tests {
+		if got := storage.ContentDisposition(tt.filename); got != tt.want {
+			t.Errorf("ContentDisposition(%q) = %q, want %q", tt.filename, got, tt.want)
+		}
+	}
+}